@@ -0,0 +1,68 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/skycoin/cx/cx/cover"
+)
+
+func checkCover(args []string) bool {
+	return len(args) > 0 && args[0] == "cover"
+}
+
+// runCover implements `cx cover`, which summarizes or renders coverage
+// profiles written by `cx --coverprofile`. It returns the exit code.
+func runCover(args []string) int {
+	coverFlags := flag.NewFlagSet("cover", flag.ExitOnError)
+	html := coverFlags.Bool("html", false, "Render the annotated sources as HTML instead of printing a summary")
+	output := coverFlags.String("o", "", "File to write the output to; defaults to standard output")
+	coverFlags.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: cx cover [-html] [-o file] cover.out...\n")
+		coverFlags.PrintDefaults()
+	}
+	coverFlags.Parse(args)
+
+	if coverFlags.NArg() == 0 {
+		coverFlags.Usage()
+		return 2
+	}
+
+	// Several profiles are merged, e.g. from different test suites.
+	prof := cover.NewProfile()
+	for _, filename := range coverFlags.Args() {
+		p, err := cover.ReadFile(filename)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "ProgramError reading:", filename, err)
+			return 1
+		}
+		prof.Merge(p)
+	}
+
+	w := os.Stdout
+	if *output != "" {
+		f, err := os.Create(*output)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "ProgramError writing:", *output, err)
+			return 1
+		}
+		defer f.Close()
+		w = f
+	}
+
+	if *html {
+		if err := cover.WriteHTML(w, prof); err != nil {
+			fmt.Fprintln(os.Stderr, "ProgramError writing:", *output, err)
+			return 1
+		}
+		return 0
+	}
+
+	for _, file := range prof.FileNames() {
+		covered, total := prof.Coverage(file)
+		fmt.Fprintf(w, "%s:\t%d/%d lines\n", file, covered, total)
+	}
+	fmt.Fprintf(w, "total:\t%.1f%% of lines\n", prof.TotalCoverage())
+	return 0
+}
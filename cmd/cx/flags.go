@@ -25,6 +25,15 @@ type cxCmdFlags struct {
 	minHeapFreeRatio float64
 	maxHeapFreeRatio float64
 	cxpath           string
+	traceOutput      string
+	tracePackages    string
+	traceFunctions   string
+	profileOutput    string
+	profileRate      int
+	coverProfile     string
+	deterministic    bool
+	seed             int64
+	noBoundsCheck    bool
 
 	// Debug flags for the CX developers
 	debugLexer   bool
@@ -41,6 +50,7 @@ func defaultCmdFlags() cxCmdFlags {
 		printVersion:  false,
 		debugLexer:    false,
 		debugProfile:  0,
+		profileRate:   100,
 	}
 }
 
@@ -84,6 +94,15 @@ func parseFlags(options *cxCmdFlags, args []string) {
 	commandLine.Float64Var(&options.minHeapFreeRatio, "--min-heap-free", options.minHeapFreeRatio, "Minimum heap space percentage that should be free after calling the garbage collector. Value must be in the range of 0.0 and 1.0.")
 	commandLine.Float64Var(&options.maxHeapFreeRatio, "--max-heap-free", options.maxHeapFreeRatio, "Maximum heap space percentage that should be free after calling the garbage collector. Value must be in the range of 0.0 and 1.0.")
 	commandLine.StringVar(&options.cxpath, "cxpath", options.cxpath, "Used for dynamically setting the value of the environment variable CXPATH")
+	commandLine.StringVar(&options.traceOutput, "trace", options.traceOutput, "Record every executed expression to the given file, one JSON object per line")
+	commandLine.StringVar(&options.tracePackages, "trace-pkg", options.tracePackages, "Comma separated list of packages to restrict -trace to")
+	commandLine.StringVar(&options.traceFunctions, "trace-func", options.traceFunctions, "Comma separated list of functions (\"fn\" or \"pkg.fn\") to restrict -trace to")
+	commandLine.StringVar(&options.profileOutput, "profile", options.profileOutput, "Profile the CX functions of the program and write a pprof profile to the given file. Visualize it with \"pprof -http=:8080 file.pprof\"")
+	commandLine.IntVar(&options.profileRate, "profile-rate", options.profileRate, "Number of samples per second taken by -profile")
+	commandLine.StringVar(&options.coverProfile, "coverprofile", options.coverProfile, "Count the executions of every line and merge them into the given coverage profile. Render it with \"cx cover -html cover.out\"")
+	commandLine.BoolVar(&options.deterministic, "deterministic", options.deterministic, "Run the program deterministically: random numbers use -seed, time functions use a virtual clock advanced by time.Sleep and natives such as os.Run or http.Do are rejected")
+	commandLine.Int64Var(&options.seed, "seed", options.seed, "Seed for the random number generator used in -deterministic mode")
+	commandLine.BoolVar(&options.noBoundsCheck, "no-bounds-check", options.noBoundsCheck, "Disable the runtime checks of array indexes, e.g. in release builds. Out of range indexes then read and write the memory next to the arrays")
 
 	// Debug flags
 	commandLine.BoolVar(&options.debugLexer, "debug-lexer", options.debugLexer, "Debug the lexer by printing all scanner tokens")
@@ -78,6 +78,11 @@ func parseProgram(options cxCmdFlags, fileNames []string, sourceCode []*os.File)
 
 	actions.LineNo = 0
 
+	if options.deterministic {
+		globals.SetDeterministic(options.seed)
+		cxparsering.CheckDeterministic(actions.AST)
+	}
+
 	if globals.FoundCompileErrors {
 		//cleanupAndExit(cxcore.CX_COMPILATION_ERROR)
 		profiling.StopCPUProfile(profile)
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"fmt"
 	"os"
 	"runtime"
 
@@ -10,6 +11,7 @@ import (
 	"github.com/skycoin/cx/cx/ast"
 	"github.com/skycoin/cx/cx/constants"
 	"github.com/skycoin/cx/cx/execute"
+	"github.com/skycoin/cx/cx/util"
 	"github.com/skycoin/cx/cxparser/actions"
 	parsingcompletor "github.com/skycoin/cx/cxparser/cxparsingcompletor"
 	"github.com/skycoin/cx/cxparser/util/profiling"
@@ -24,6 +26,14 @@ func main() {
 
 func Run(args []string) {
 
+	/*
+		checkCover checks for the "cover" subcommand
+		$cx cover -html=cover.html cover.out
+	*/
+	if checkCover(args) {
+		os.Exit(runCover(args[1:]))
+	}
+
 	runtime.LockOSThread()
 
 	runtime.GOMAXPROCS(2)
@@ -142,6 +152,15 @@ func Run(args []string) {
 		constants.MAX_HEAP_FREE_RATIO = float32(options.maxHeapFreeRatio)
 	}
 
+	/*
+		options.noBoundsCheck checks for flags string "--no-bounds-check"
+		$cx --no-bounds-check
+	*/
+
+	if options.noBoundsCheck {
+		constants.ARRAY_BOUNDS_CHECK = false
+	}
+
 	// options, file pointers, filenames
 	cxArgs, sourceCode, fileNames := ast.ParseArgsForCX(commandLine.Args(), true)
 
@@ -191,12 +210,47 @@ func runProgram(options cxCmdFlags, cxArgs []string, sourceCode []*os.File) {
 		return
 	}
 
+	if options.traceOutput != "" {
+		traceFile, err := util.CXCreateFile(options.traceOutput)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, "ProgramError writing:", options.traceOutput, err)
+			os.Exit(constants.CX_INTERNAL_ERROR)
+		}
+		defer traceFile.Close()
+
+		execute.Tracer = execute.NewTrace(traceFile, options.tracePackages, options.traceFunctions)
+	}
+
+	if options.coverProfile != "" {
+		execute.Coverage = execute.NewCover(options.coverProfile)
+	}
+
+	if options.profileOutput != "" {
+		execute.Profiler = execute.NewProfile(options.profileOutput, options.profileRate)
+	}
+
 	err := execute.RunCompiled(actions.AST, 0, cxArgs)
 
 	if err != nil {
 		panic(err)
 	}
 
+	if execute.Tracer != nil {
+		execute.Tracer.Flush()
+	}
+
+	if execute.Profiler != nil {
+		if err := execute.Profiler.Flush(); err != nil {
+			fmt.Fprintln(os.Stderr, "ProgramError writing:", options.profileOutput, err)
+		}
+	}
+
+	if execute.Coverage != nil {
+		if err := execute.Coverage.Flush(actions.AST); err != nil {
+			fmt.Fprintln(os.Stderr, "ProgramError writing:", options.coverProfile, err)
+		}
+	}
+
 	if opcodes.AssertFailed() {
 		os.Exit(constants.CX_ASSERT)
 	}
@@ -5,10 +5,12 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
 	"github.com/skycoin/cx/cmd/cxtest/runner"
+	"github.com/skycoin/cx/cx/cover"
 	"github.com/urfave/cli/v2"
 )
 
@@ -39,6 +41,10 @@ func main() {
 				Name:  "disable-tests",
 				Usage: "Disable test set (all, stable, issue, gui)",
 			},
+			&cli.StringFlag{
+				Name:  "coverprofile",
+				Usage: "Merge the line coverage of every test into this file (see \"cx cover\")",
+			},
 			&cli.BoolFlag{
 				Name:  "debug",
 				Usage: "Print debug information",
@@ -102,12 +108,22 @@ func Execute(c *cli.Context) error {
 		fmt.Printf("Resulting test mask: %06b\n", testsMask)
 	}
 
+	coverProfile := c.String("coverprofile")
+	if coverProfile != "" {
+		// Tests run inside `workingDir`, so the profile needs an absolute path.
+		var err error
+		if coverProfile, err = filepath.Abs(coverProfile); err != nil {
+			return err
+		}
+	}
+
 	tester := runner.NewTestRunner(&runner.Config{
 		CxPath:         cxPath,
 		WorkingDir:     workingDir,
 		TestsMask:      testsMask,
 		LogMask:        logMask,
 		DefaultTimeout: 10 * time.Second,
+		CoverProfile:   coverProfile,
 	})
 
 	var start = time.Now().Unix()
@@ -125,6 +141,14 @@ func Execute(c *cli.Context) error {
 	fmt.Printf("%d failed\n", tester.TestCount-tester.TestSuccess)
 	fmt.Printf("%d skipped\n", tester.TestSkipped)
 
+	if coverProfile != "" {
+		prof, err := cover.ReadFile(coverProfile)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("coverage: %.1f%% of lines\n", prof.TotalCoverage())
+	}
+
 	if tester.TestCount == 0 || (tester.TestSuccess != tester.TestCount) {
 		return errors.New("not all test succeeded")
 	}
@@ -148,6 +172,104 @@ func runTestCases(t *runner.TestRunner) {
 	t.Run("test-array.cx", runner.CxSuccess, "array")
 	t.Run("test-function.cx", runner.CxSuccess, "function")
 	t.Run("test-control-flow.cx", runner.CxSuccess, "control floow")
+	t.Run("test-switch.cx", runner.CxSuccess, "switch")
+	t.Run("test-switch-duplicate-case.cx", runner.CxCompilationError, "Duplicate constant cases in a switch not reported.")
+	t.Run("test-const.cx", runner.CxSuccess, "const")
+	t.Run("test-const-assign.cx", runner.CxCompilationError, "Assignment to a constant not reported.")
+	t.Run("test-const-block.cx", runner.CxCompilationError, "Redeclaration of a constant after an inner block not reported.")
+	t.Run("test-const-shadowed.cx", runner.CxCompilationError, "Variable shadowing a constant used in a constant expression.")
+	t.Run("test-const-redeclared-var.cx", runner.CxCompilationError, "Constant redeclaring a variable not reported.")
+	t.Run("test-const-redeclared-func.cx", runner.CxCompilationError, "Constant redeclaring a function not reported.")
+	t.Run("test-const-address.cx", runner.CxCompilationError, "Address of a constant not reported.")
+	t.Run("test-enum.cx", runner.CxSuccess, "enum")
+	t.Run("test-enum-assign.cx", runner.CxCompilationError, "Assignment of an i32 to an enum not reported.")
+	t.Run("test-enum-arithmetic.cx", runner.CxCompilationError, "Assignment of enum arithmetic to an i32 not reported.")
+	t.Run("test-enum-native.cx", runner.CxCompilationError, "Enum value passed as an i32 not reported.")
+	t.Run("test-enum-package.cx", runner.CxSuccess, "enums of other packages")
+	t.Run("test-enum-package-unknown.cx", runner.CxCompilationError, "Unknown enum of another package not reported.")
+	t.Run("test-enum-member-redeclared.cx", runner.CxCompilationError, "Enum member redeclaring a variable not reported.")
+	t.Run("test-map.cx", runner.CxSuccess, "map")
+	t.Run("test-map-key-type.cx", runner.CxCompilationError, "Wrong map key type not reported.")
+	t.Run("test-map-values.cx", runner.CxSuccess, "Test maps with slice and struct values")
+	t.Run("test-interface.cx", runner.CxSuccess, "interface")
+	t.Run("test-interface-missing-method.cx", runner.CxCompilationError, "Struct missing a method of an interface not reported.")
+	t.Run("test-interface-assertion.cx", runner.CxRuntimeInvalidArgument, "Failed type assertion not reported.")
+	t.Run("test-interface-nil.cx", runner.CxSuccess, "Test comparisons of interfaces with nil")
+	t.Run("test-interface-nil-invalid.cx", runner.CxCompilationError, "Ordering of an interface and nil not reported.")
+	t.Run("test-closure.cx", runner.CxSuccess, "closure")
+	t.Run("test-closure-signature.cx", runner.CxCompilationError, "Assignment of a function with a different signature not reported.")
+	t.Run("test-generics.cx", runner.CxSuccess, "generics")
+	t.Run("test-generics-package.cx", runner.CxSuccess, "generics declared in other packages")
+	t.Run("test-generics-constraint.cx", runner.CxCompilationError, "Type argument not satisfying a constraint not reported.")
+	t.Run("test-generics-package-types.cx", runner.CxSuccess, "generics of other packages instantiated with types of main")
+	t.Run("test-generics-unknown-type.cx", runner.CxCompilationError, "Unknown type argument not reported.")
+	t.Run("test-defer.cx", runner.CxSuccess, "defer, panic and recover")
+	t.Run("test-defer-unrecovered.cx", runner.CxRuntimeSliceIndexOutOfRange, "Runtime error not reported after running the deferred calls.")
+	t.Run("test-defer-call.cx", runner.CxCompilationError, "Deferring an expression other than a function call not reported.")
+	t.Run("test-error.cx", runner.CxSuccess, "error type and errors returned by natives")
+	t.Run("test-error-method.cx", runner.CxCompilationError, "Calling an undefined method on an error not reported.")
+	t.Run("test-error-field-method.cx", runner.CxCompilationError, "Calling an undefined method on an error field not reported.")
+	t.Run("test-union.cx", runner.CxSuccess, "tagged unions")
+	t.Run("test-union-exhaustive.cx", runner.CxCompilationError, "Non-exhaustive type switch on a union not reported.")
+	t.Run("test-union-variant.cx", runner.CxCompilationError, "Conversion of a type that is not a variant to a union not reported.")
+	t.Run("test-range.cx", runner.CxSuccess, "for-range loops")
+	t.Run("test-range-type.cx", runner.CxCompilationError, "Ranging over a value that is not an array, slice, string or map not reported.")
+	t.Run("test-variadic.cx", runner.CxSuccess, "variadic functions and spread arguments")
+	t.Run("test-variadic-type.cx", runner.CxCompilationError, "Variadic argument of the wrong type not reported.")
+	t.Run("test-variadic-spread.cx", runner.CxCompilationError, "Spread argument in a call to a non-variadic function not reported.")
+	t.Run("test-variadic-method.cx", runner.CxCompilationError, "Variadic method not reported.")
+	t.Run("test-variadic-literal.cx", runner.CxCompilationError, "Variadic function literal not reported.")
+	t.Run("test-variadic-func-type.cx", runner.CxCompilationError, "Variadic function type not reported.")
+	t.Run("test-variadic-func-value.cx", runner.CxCompilationError, "Variadic function assigned to a non-variadic function type not reported.")
+	t.Run("test-slice-expr.cx", runner.CxSuccess, "slice expressions of slices, arrays and strings")
+	t.Run("test-slice-expr-type.cx", runner.CxCompilationError, "Slice expression of a value that is not a slice, array or string not reported.")
+	t.Run("test-slice-expr-out-of-range.cx", runner.CxRuntimeSliceIndexOutOfRange, "Test slice expression with low > high")
+	t.Run("test-slice-expr-string-out-of-range.cx", runner.CxRuntimeSliceIndexOutOfRange, "Test string slice expression with high > len")
+	t.Run("test-array-index-constant.cx", runner.CxCompilationError, "Constant array index out of range not reported.")
+	t.Run("test-array-index-out-of-range-a.cx", runner.CxRuntimeArrayIndexOutOfRange, "Test array index >= len")
+	t.Run("test-array-index-out-of-range-b.cx", runner.CxRuntimeArrayIndexOutOfRange, "Test array field index < 0")
+	t.Run("--no-bounds-check test-array-index-unchecked.cx", runner.CxSuccess, "array index checks disabled")
+	t.Run("test-nil-pointer-a.cx", runner.CxRuntimeNilPointerDereference, "Test dereference of nil pointer")
+	t.Run("test-nil-pointer-b.cx", runner.CxRuntimeNilPointerDereference, "Test nil pointer field dereference")
+	t.Run("test-nil-pointer-recover.cx", runner.CxSuccess, "Test recovering from nil pointer dereference")
+	t.Run("test-struct-embedding.cx", runner.CxSuccess, "Test promoted fields and methods of embedded structs")
+	t.Run("test-struct-embedding-ambiguous-a.cx", runner.CxCompilationError, "Ambiguous promoted field not reported.")
+	t.Run("test-struct-embedding-ambiguous-b.cx", runner.CxCompilationError, "Ambiguous promoted method not reported.")
+	t.Run("test-interface-embedding.cx", runner.CxSuccess, "Test interfaces implemented by promoted methods")
+	t.Run("test-interface-embedding-ambiguous.cx", runner.CxCompilationError, "Ambiguous promoted method of interface not reported.")
+	t.Run("test-interface-embedding-hidden.cx", runner.CxCompilationError, "Promoted method of interface hidden by a field not reported.")
+	t.Run("test-named-types.cx", runner.CxSuccess, "Test named types, their methods and conversions")
+	t.Run("test-named-types-mismatch-a.cx", runner.CxCompilationError, "Operation mixing named types not reported.")
+	t.Run("test-named-types-mismatch-b.cx", runner.CxCompilationError, "Implicit conversion to a named type not reported.")
+	t.Run("test-named-types-mismatch-c.cx", runner.CxCompilationError, "Conversion between unrelated types not reported.")
+	t.Run("test-labeled-loops.cx", runner.CxSuccess, "Test labeled break and continue")
+	t.Run("test-labeled-loops-invalid-a.cx", runner.CxCompilationError, "Break to an undefined label not reported.")
+	t.Run("test-labeled-loops-invalid-b.cx", runner.CxCompilationError, "Continue to a label of a loop that ended not reported.")
+	t.Run("test-labeled-loops-invalid-c.cx", runner.CxCompilationError, "Break to a label of a statement that isn't a loop not reported.")
+	t.Run("test-labeled-loops-invalid-d.cx", runner.CxCompilationError, "Label of a loop nested in a loop with the same label not reported.")
+	t.Run("test-value-equality.cx", runner.CxSuccess, "Test equality of struct instances and arrays")
+	t.Run("test-value-equality-invalid-a.cx", runner.CxCompilationError, "Comparison of different struct types not reported.")
+	t.Run("test-value-equality-invalid-b.cx", runner.CxCompilationError, "Comparison of structs holding slices not reported.")
+	t.Run("test-value-equality-invalid-c.cx", runner.CxCompilationError, "Ordering of struct instances not reported.")
+	t.Run("test-map-composite-keys.cx", runner.CxSuccess, "Test maps with struct and array keys")
+	t.Run("test-map-composite-keys-invalid.cx", runner.CxCompilationError, "Map key holding pointers not reported.")
+	t.Run("test-map-callee.cx", runner.CxSuccess, "Test maps grown and filled by callees")
+	t.Run("test-map-named-keys.cx", runner.CxSuccess, "Test maps with keys of named types")
+	t.Run("test-map-named-keys-invalid.cx", runner.CxCompilationError, "Key of the underlying type of a named key type not reported.")
+	t.Run("test-package-init.cx", runner.CxSuccess, "Test package initialization order and init functions")
+	t.Run("test-package-init-cycle.cx", runner.CxCompilationError, "Initialization cycle not reported.")
+	t.Run("test-package-init-signature.cx", runner.CxCompilationError, "init function with results not reported.")
+	t.Run("test-package-init-call.cx", runner.CxCompilationError, "Call to init function not reported.")
+	t.Run("test-package-init-multiple.cx", runner.CxSuccess, "Test several init functions in a package")
+	t.Run("test-package-init-multiple-signature.cx", runner.CxCompilationError, "Second init function with parameters not reported.")
+	t.RunGolden("--trace=/dev/stdout test-trace.cx", runner.CxSuccess, "test-trace.golden", "Test the records written by --trace")
+	t.RunGolden("--trace=/dev/stdout test-trace-error.cx", runner.CxRuntimeSliceIndexOutOfRange, "test-trace-error.golden", "Test the records written by --trace before a runtime error")
+	t.RunGolden("--deterministic --seed=7 test-deterministic.cx", runner.CxSuccess, "test-deterministic.golden", "Test the output of a deterministic run")
+	t.Run("--deterministic test-deterministic-native.cx", runner.CxCompilationError, "Nondeterministic native in deterministic mode not reported.")
+	t.Run("--deterministic test-deterministic-closure.cx", runner.CxCompilationError, "Nondeterministic native called by a function literal in deterministic mode not reported.")
+	t.RunGolden("CXTRACEBACK=none test-traceback.cx", runner.CxRuntimeError, "test-traceback-none.golden", "Test runtime errors without traceback")
+	t.RunGolden("CXTRACEBACK=single test-traceback.cx", runner.CxRuntimeError, "test-traceback-single.golden", "Test the traceback of the failing call")
+	t.RunGolden("CXTRACEBACK=all test-traceback.cx", runner.CxRuntimeError, "test-traceback-all.golden", "Test the traceback of every active call")
 	t.Run("test-utils.cx test-struct.cx", runner.CxSuccess, "struct")
 	t.Run("test-str.cx", runner.CxSuccess, "str")
 	t.Run("test-utils.cx test-pointers.cx", runner.CxSuccess, "pointers")
@@ -158,6 +280,7 @@ func runTestCases(t *runner.TestRunner) {
 	t.Run("--cxpath test-workspace test-workspace-c.cx test-workspace-d.cx", runner.CxSuccess, "Testing if files supplied to the CLI override libraries in the workspace.")
 	t.Run("test-slices-index-out-of-range-a.cx", runner.CxRuntimeSliceIndexOutOfRange, "Test index < 0")
 	t.Run("test-slices-index-out-of-range-b.cx", runner.CxRuntimeSliceIndexOutOfRange, "Test index >= len")
+	t.Run("test-slices-index-nil.cx", runner.CxRuntimeSliceIndexOutOfRange, "Test index of nil slice")
 	t.Run("test-slices-resize-out-of-range-a.cx", runner.CxRuntimeSliceIndexOutOfRange, "Test out of range after resize")
 	t.Run("test-slices-resize-out-of-range-b.cx", runner.CxRuntimeSliceIndexOutOfRange, "Test resize with count < 0")
 	t.Run("test-slices-insert-out-of-range-a.cx", runner.CxRuntimeSliceIndexOutOfRange, "Test insert with index > len")
@@ -46,4 +46,6 @@ const (
 	CxRuntimeInvalidArgument
 	CxRuntimeSliceIndexOutOfRange
 	CxRuntimeNotImplemented
+	CxRuntimeArrayIndexOutOfRange
+	CxRuntimeNilPointerDereference
 )
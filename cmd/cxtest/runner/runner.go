@@ -1,9 +1,13 @@
 package runner
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io/ioutil"
+	"os"
 	"os/exec"
+	"path/filepath"
 	"strings"
 	"time"
 )
@@ -14,6 +18,7 @@ type Config struct {
 	TestsMask      Bits
 	LogMask        Bits
 	DefaultTimeout time.Duration
+	CoverProfile   string // if set, every test merges its coverage into this file
 }
 
 type TestRunner struct {
@@ -33,7 +38,17 @@ func (t *TestRunner) Run(args string, exitCode int, desc string) {
 	t.RunEx(args, exitCode, desc, TestStable, t.cfg.DefaultTimeout)
 }
 
+// RunGolden runs a test like Run, also checking that its output is the same
+// as the contents of the file `golden`, relative to the working directory.
+func (t *TestRunner) RunGolden(args string, exitCode int, golden string, desc string) {
+	t.runTest(args, exitCode, desc, TestStable, t.cfg.DefaultTimeout, golden)
+}
+
 func (t *TestRunner) RunEx(args string, exitCode int, desc string, filter Bits, timeout time.Duration) {
+	t.runTest(args, exitCode, desc, filter, timeout, "")
+}
+
+func (t *TestRunner) runTest(args string, exitCode int, desc string, filter Bits, timeout time.Duration, golden string) {
 	if timeout == 0 {
 		timeout = t.cfg.DefaultTimeout
 	}
@@ -47,8 +62,22 @@ func (t *TestRunner) RunEx(args string, exitCode int, desc string, filter Bits,
 		return
 	}
 
-	cmd := exec.Command(t.cfg.CxPath, strings.Split(args, " ")...)
+	cmdArgs := strings.Split(args, " ")
+	// leading NAME=value arguments set environment variables, as in a shell
+	var env []string
+	for len(cmdArgs) > 0 && !strings.HasPrefix(cmdArgs[0], "-") && strings.Contains(cmdArgs[0], "=") {
+		env = append(env, cmdArgs[0])
+		cmdArgs = cmdArgs[1:]
+	}
+	if t.cfg.CoverProfile != "" {
+		cmdArgs = append([]string{"--coverprofile=" + t.cfg.CoverProfile}, cmdArgs...)
+	}
+
+	cmd := exec.Command(t.cfg.CxPath, cmdArgs...)
 	cmd.Dir = t.cfg.WorkingDir
+	if env != nil {
+		cmd.Env = append(os.Environ(), env...)
+	}
 
 	start := time.Now().Unix()
 	out, err := runCmd(cmd, timeout)
@@ -89,6 +118,22 @@ func (t *TestRunner) RunEx(args string, exitCode int, desc string, filter Bits,
 		}
 	}
 
+	if golden != "" {
+		want, err := ioutil.ReadFile(filepath.Join(t.cfg.WorkingDir, golden))
+		if err != nil || !bytes.Equal(out, want) {
+			if Has(t.cfg.LogMask, LogFail) {
+				fmt.Printf("#%s%d | FAILED  | %s | '%s' | output differs from '%s'\n",
+					padding(t), t.TestCount, timing, args, golden)
+			}
+
+			if Has(t.cfg.LogMask, LogStderr) {
+				fmt.Printf("#%s%d | Stdout: %v\n",
+					padding(t), t.TestCount, string(out))
+			}
+			return
+		}
+	}
+
 	if Has(t.cfg.LogMask, LogSuccess) {
 		fmt.Printf("#%s%d | SUCCESS | %s | '%s' | expected %d | got %d \n",
 			padding(t), t.TestCount, timing, args, exitCode, ec)
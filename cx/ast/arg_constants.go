@@ -24,6 +24,9 @@ var ConstCxArg_BOOL = NewCXArgument(constants.TYPE_BOOL)
 // ConstCxArg_STR Default str parameter
 var ConstCxArg_STR = NewCXArgument(constants.TYPE_STR)
 
+// ConstCxArg_ERROR Default error parameter
+var ConstCxArg_ERROR = NewCXArgument(constants.TYPE_ERROR)
+
 // ConstCxArg_UND_TYPE Default und parameter
 var ConstCxArg_UND_TYPE = NewCXArgument(constants.TYPE_UNDEFINED)
 
@@ -21,11 +21,12 @@ const (
 	CXEXPR_ARRAY_LITERAL
 	CXEXPR_SCOPE_NEW
 	CXEXPR_SCOPE_DEL
+	CXEXPR_DEFER
 )
 
 // String returns alias for constants defined for cx edpression type
 func (cxet CXEXPR_TYPE) String() string {
-	return [...]string{"Unused", "MethodCall", "StructLiteral", "ArrayLiteral", "ScopeNew", "ScopeDel"}[int(cxet)]
+	return [...]string{"Unused", "MethodCall", "StructLiteral", "ArrayLiteral", "ScopeNew", "ScopeDel", "Defer"}[int(cxet)]
 }
 
 /*
@@ -72,6 +73,7 @@ type CXProgram struct {
 	CallStack   []CXCall // Collection of function calls
 	CallCounter int      // What function call is the currently being executed in the CallStack
 	Terminated  bool     // Utility field for the runtime. Indicates if a CX program has already finished or not.
+	Panic       *CXPanic // The panic unwinding the call stack, if any.
 	Version     string   // CX version used to build this CX program.
 
 	// Used by the REPL and cxgo
@@ -86,10 +88,12 @@ type CXPackage struct {
 	Name string // Name of the package
 
 	// Contents
-	Imports   []*CXPackage  // imported packages
-	Functions []*CXFunction // declared functions in this package
-	Structs   []*CXStruct   // declared structs in this package
-	Globals   []*CXArgument // declared global variables in this package
+	Imports   []*CXPackage   // imported packages
+	Functions []*CXFunction  // declared functions in this package
+	Structs   []*CXStruct    // declared structs in this package
+	Enums     []*CXEnum      // declared enums in this package
+	Types     []*CXNamedType // declared named types and type aliases in this package
+	Globals   []*CXArgument  // declared global variables in this package
 
 	// Used by the REPL and cxgo
 	CurrentFunction *CXFunction
@@ -100,12 +104,50 @@ type CXPackage struct {
 //
 type CXStruct struct {
 	// Metadata
-	Name    string     // Name of the struct
-	Package *CXPackage // The package this struct belongs to
-	Size    int        // The size in memory that this struct takes.
+	Name        string     // Name of the struct
+	Package     *CXPackage // The package this struct belongs to
+	Size        int        // The size in memory that this struct takes.
+	IsInterface bool       // True if it's an interface, whose methods are declared as functions without a body
 
 	// Contents
-	Fields []*CXArgument // The fields of the struct
+	Fields   []*CXArgument // The fields of the struct
+	Variants []*CXStruct   // The types of the values a union can hold. Unions are interfaces without methods
+}
+
+// CXEnum is used to represent a CX enum, a distinct i32 type whose members
+// are numbered from 0 in declaration order.
+//
+type CXEnum struct {
+	// Metadata
+	Name    string     // Name of the enum
+	Package *CXPackage // The package this enum belongs to
+
+	// Contents
+	Members []string // The names of the members, indexed by value
+}
+
+// MemberName returns the name of the member of `enum` with value `value`.
+// Values without a member are formatted as a conversion, e.g. `Color(7)`.
+func (enum *CXEnum) MemberName(value int32) string {
+	if value >= 0 && int(value) < len(enum.Members) {
+		return enum.Members[value]
+	}
+	return fmt.Sprintf("%s(%d)", enum.Name, value)
+}
+
+// CXNamedType is used to represent a named type which isn't a struct, e.g.
+// `type Celsius f64`, or a type alias, e.g. `type Temp = Celsius`. A named
+// type is distinct from its underlying type, while an alias is another name
+// of the same type.
+//
+type CXNamedType struct {
+	// Metadata
+	Name    string     // Name of the type
+	Package *CXPackage // The package this type belongs to
+	IsAlias bool       // True if it's a type alias
+
+	// Contents
+	Underlying *CXArgument // The declaration of the underlying type
 }
 
 // CXFunction is used to represent a CX function.
@@ -132,6 +174,8 @@ type CXFunction struct {
 
 	// Used by the GC
 	ListOfPointers []*CXArgument // Root pointers for the GC algorithm
+	Escaped        []*CXArgument // Locals captured by closures, referenced through heap cells
+	Captures       []*CXArgument // Variables captured by the function literal, in closure environment order
 
 	// Used by the REPL and parser
 	CurrentExpression *CXExpression
@@ -202,6 +246,11 @@ func (cxe CXExpression) IsScopeDel() bool {
 	return cxe.ExpressionType == CXEXPR_SCOPE_DEL
 }
 
+// IsDefer checks if expression type is defer
+func (cxe CXExpression) IsDefer() bool {
+	return cxe.ExpressionType == CXEXPR_DEFER
+}
+
 /*
 grep -rn "IsShortAssignmentDeclaration" .
 IsShortAssignmentDeclaration - is this CXArgument the result of a `CASSIGN` operation (`:=`)?
@@ -474,7 +523,7 @@ func GetCurrentCxProgram() (*CXProgram, error) {
 // PrintAllObjects prints all objects in a program
 //
 func (cxprogram *CXProgram) PrintAllObjects() {
-	fp := 0
+	fp := constants.NULL_STACK_ADDRESS_OFFSET
 
 	for c := 0; c <= cxprogram.CallCounter; c++ {
 		op := cxprogram.CallStack[c].Operator
@@ -653,6 +702,44 @@ func (pkg *CXPackage) AddStruct(strct *CXStruct) *CXPackage {
 	return pkg
 }
 
+// AddEnum ...
+func (pkg *CXPackage) AddEnum(enum *CXEnum) *CXPackage {
+	found := false
+	for i, e := range pkg.Enums {
+		if e.Name == enum.Name {
+			pkg.Enums[i] = enum
+			found = true
+			break
+		}
+	}
+	if !found {
+		pkg.Enums = append(pkg.Enums, enum)
+	}
+
+	enum.Package = pkg
+
+	return pkg
+}
+
+// AddNamedType ...
+func (pkg *CXPackage) AddNamedType(typ *CXNamedType) *CXPackage {
+	found := false
+	for i, t := range pkg.Types {
+		if t.Name == typ.Name {
+			pkg.Types[i] = typ
+			found = true
+			break
+		}
+	}
+	if !found {
+		pkg.Types = append(pkg.Types, typ)
+	}
+
+	typ.Package = pkg
+
+	return pkg
+}
+
 // RemoveStruct ...
 func (pkg *CXPackage) RemoveStruct(strctName string) {
 	lenStrcts := len(pkg.Structs)
@@ -714,6 +801,58 @@ func (strct *CXStruct) GetField(name string) (*CXArgument, error) {
 	return nil, fmt.Errorf("field '%s' not found in struct '%s'", name, strct.Name)
 }
 
+// GetPromoted returns the embedded fields of `strct` through which the field
+// or method `name` is promoted, from the outermost to the innermost, or nil if
+// `name` isn't promoted. Names in embedded structs at a lower depth hide the
+// ones at a greater depth, and an error is returned if `name` is found in
+// several embedded structs at the same depth.
+func (strct *CXStruct) GetPromoted(name string) ([]*CXArgument, error) {
+	type embedding struct {
+		strct *CXStruct
+		path  []*CXArgument
+	}
+
+	seen := map[*CXStruct]bool{strct: true}
+	level := []embedding{{strct: strct}}
+	for len(level) > 0 {
+		var found []*CXArgument
+		var next []embedding
+		for _, emb := range level {
+			for _, fld := range emb.strct.Fields {
+				if !fld.IsEmbedded || fld.CustomType == nil || seen[fld.CustomType] {
+					continue
+				}
+				path := append(emb.path[:len(emb.path):len(emb.path)], fld)
+				if !fld.CustomType.hasSelector(name) {
+					next = append(next, embedding{strct: fld.CustomType, path: path})
+					continue
+				}
+				if found != nil {
+					return nil, fmt.Errorf("ambiguous selector '%s' in struct '%s'", name, strct.Name)
+				}
+				found = path
+			}
+		}
+		if found != nil {
+			return found, nil
+		}
+		for _, emb := range next {
+			seen[emb.strct] = true
+		}
+		level = next
+	}
+	return nil, nil
+}
+
+// hasSelector checks if `strct` has a field or a method named `name`.
+func (strct *CXStruct) hasSelector(name string) bool {
+	if _, err := strct.GetField(name); err == nil {
+		return true
+	}
+	_, err := strct.Package.GetMethod(strct.Name+"."+name, strct.Name)
+	return err == nil
+}
+
 // ----------------------------------------------------------------
 //                     `CXStruct` Member handling
 
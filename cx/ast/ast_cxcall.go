@@ -9,6 +9,7 @@ type CXCall struct {
 	Operator     *CXFunction // What CX function will be called when running this CXCall in the runtime
 	Line         int         // What line in the CX function is currently being executed
 	FramePointer int         // Where in the stack is this function call's local variables stored
+	Defers       int32       // Reference to the last call deferred by this function call, run when it returns
 }
 
 //function is only called once and by affordances
@@ -16,6 +17,12 @@ type CXCall struct {
 func (call *CXCall) Ccall(prgrm *CXProgram, globalInputs *[]CXValue, globalOutputs *[]CXValue) error {
 	// CX is still single-threaded, so only one stack
 	if call.Line >= call.Operator.Length {
+		// the calls deferred by this call are run before it returns,
+		// the last one first
+		if call.callDeferred(prgrm) {
+			return nil
+		}
+
 		/*
 		   popping the stack
 		*/
@@ -32,6 +39,21 @@ func (call *CXCall) Ccall(prgrm *CXProgram, globalInputs *[]CXValue, globalOutpu
 			returnFP := returnAddr.FramePointer
 			fp := call.FramePointer
 
+			// return the stack pointer to its previous state
+			prgrm.StackPointer = call.FramePointer
+
+			if prgrm.Panic != nil {
+				// then the previous call is unwound too
+				if returnLine < returnOp.Length {
+					returnAddr.Line = returnOp.Length
+				}
+				return nil
+			}
+			if returnLine >= returnOp.Length {
+				// then it was a deferred call, whose outputs are discarded
+				return nil
+			}
+
 			expr := returnOp.Expressions[returnLine]
 
 			lenOuts := len(expr.Outputs)
@@ -47,8 +69,6 @@ func (call *CXCall) Ccall(prgrm *CXProgram, globalInputs *[]CXValue, globalOutpu
 						out))
 			}
 
-			// return the stack pointer to its previous state
-			prgrm.StackPointer = call.FramePointer
 			// we'll now execute the next command
 			prgrm.CallStack[prgrm.CallCounter].Line++
 			// calling the actual command
@@ -162,34 +182,40 @@ func (call *CXCall) Ccall(prgrm *CXProgram, globalInputs *[]CXValue, globalOutpu
 			   It was not a native, so we need to create another call
 			   with the current expression's operator
 			*/
-			// we're going to use the next call in the callstack
-			prgrm.CallCounter++
-			if prgrm.CallCounter >= constants.CALLSTACK_SIZE {
-				panic(constants.STACK_OVERFLOW_ERROR)
+			// calls to interface methods are dispatched to the method
+			// of the struct instance held by the receiver
+			operator := expr.Operator
+			isInterfaceCall := operator.IsInterfaceMethod()
+			var receiver int32
+			if isInterfaceCall {
+				operator, receiver = ResolveInterfaceMethod(operator, GetFinalOffset(call.FramePointer, expr.Inputs[0]))
 			}
-			newCall := &prgrm.CallStack[prgrm.CallCounter]
-			// setting the new call
-			newCall.Operator = expr.Operator
-			newCall.Line = 0
-			newCall.FramePointer = prgrm.StackPointer
-			// the stack pointer is moved to create room for the next call
-			// prgrm.MemoryPointer += fn.Size
-			prgrm.StackPointer += newCall.Operator.Size
-
-			// checking if enough memory in stack
-			if prgrm.StackPointer > constants.STACK_SIZE {
-				panic(constants.STACK_OVERFLOW_ERROR)
+			// calls to function values are dispatched to the function they
+			// hold, which doesn't receive the function value itself
+			isFunctionValueCall := operator.IsFunctionValueCall()
+			var env int32
+			var skippedInputs int
+			if isFunctionValueCall {
+				operator, env = GetFunctionValue(GetFinalOffset(call.FramePointer, expr.Inputs[0]))
+				skippedInputs = 1
 			}
 
+			// we're going to use the next call in the callstack
+			newCall := prgrm.pushCall(operator)
+
 			fp := call.FramePointer
 			newFP := newCall.FramePointer
 
-			// wiping next stack frame (removing garbage)
-			for c := 0; c < expr.Operator.Size; c++ {
-				prgrm.Memory[newFP+c] = 0
-			}
-
 			for i, inp := range expr.Inputs {
+				if i == 0 && isInterfaceCall {
+					WriteInterfaceReceiver(newFP, operator, receiver)
+					continue
+				}
+				if i < skippedInputs {
+					continue
+				}
+				param := operator.Inputs[i-skippedInputs]
+
 				var byts []byte
 				// finalOffset := inp.Offset
 				finalOffset := GetFinalOffset(fp, inp)
@@ -208,23 +234,78 @@ func (call *CXCall) Ccall(prgrm *CXProgram, globalInputs *[]CXValue, globalOutpu
 					var finalOffsetB [4]byte
 					WriteMemI32(finalOffsetB[:], 0, int32(finalOffset))
 					byts = finalOffsetB[:]
-				} else {
+				}
+
+				// struct instances are boxed when passed as interfaces
+				if param.Type == constants.TYPE_INTERFACE && !param.IsSlice &&
+					GetAssignmentElement(inp).Type != constants.TYPE_INTERFACE {
+					byts = MakeInterface(inp, finalOffset)
+				} else if inp.PassBy != constants.PASSBY_REFERENCE {
+					// the callee shares the entries of a nil map it fills
+					if param.IsMap && inp.ArgDetails.Name != "" {
+						MakeMapHandle(finalOffset)
+					}
 					size := GetSize(inp)
 					byts = prgrm.Memory[finalOffset : finalOffset+size]
 				}
 
 				// writing inputs to new stack frame
 				WriteMemory(
-					GetFinalOffset(newFP, newCall.Operator.Inputs[i]),
+					GetFinalOffset(newFP, param),
 					// newFP + newCall.Operator.ProgramInput[i].Offset,
 					// GetFinalOffset(prgrm.Memory, newFP, newCall.Operator.ProgramInput[i], MEM_WRITE),
 					byts)
 			}
+
+			if isFunctionValueCall {
+				WriteClosureEnv(newFP, operator, env)
+			}
+
+			if expr.IsDefer() {
+				// the call is deferred until this call returns, keeping
+				// the inputs written to the new stack frame
+				var value *CXArgument
+				if isFunctionValueCall {
+					value = expr.Inputs[0]
+				}
+				DeferCall(call, operator, newFP, value)
+				prgrm.CallCounter--
+				prgrm.StackPointer = newFP
+				call.Line++
+			}
 		}
 	}
 	return nil
 }
 
+// pushCall pushes a call to `fn` to the call stack of `prgrm`, with a
+// wiped stack frame, and returns it.
+func (prgrm *CXProgram) pushCall(fn *CXFunction) *CXCall {
+	prgrm.CallCounter++
+	if prgrm.CallCounter >= constants.CALLSTACK_SIZE {
+		panic(constants.STACK_OVERFLOW_ERROR)
+	}
+	newCall := &prgrm.CallStack[prgrm.CallCounter]
+	// setting the new call
+	newCall.Operator = fn
+	newCall.Line = 0
+	newCall.FramePointer = prgrm.StackPointer
+	newCall.Defers = 0
+	// the stack pointer is moved to create room for the next call
+	prgrm.StackPointer += fn.Size
+
+	// checking if enough memory in stack
+	if prgrm.StackPointer > constants.STACK_SIZE {
+		panic(constants.STACK_OVERFLOW_ERROR)
+	}
+
+	// wiping next stack frame (removing garbage)
+	for c := 0; c < fn.Size; c++ {
+		prgrm.Memory[newCall.FramePointer+c] = 0
+	}
+	return newCall
+}
+
 //prgrm.CallStack = MakeCallStack(0)
 func MakeCallStack(size int) []CXCall {
 	return make([]CXCall, 0)
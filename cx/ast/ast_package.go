@@ -51,7 +51,7 @@ func (pkg *CXPackage) GetFunctions() ([]*CXFunction, error) {
 // GetMethod ...
 func (pkg *CXPackage) GetMethod(fnName string, receiverType string) (*CXFunction, error) {
 	for _, fn := range pkg.Functions {
-		if fn.Name == fnName && len(fn.Inputs) > 0 && fn.Inputs[0].CustomType != nil && fn.Inputs[0].CustomType.Name == receiverType {
+		if fn.Name == fnName && len(fn.Inputs) > 0 && ReceiverTypeName(fn.Inputs[0]) == receiverType {
 			return fn, nil
 		}
 	}
@@ -94,6 +94,26 @@ func (pkg *CXPackage) GetStruct(strctName string) (*CXStruct, error) {
 
 }
 
+// GetEnum ...
+func (pkg *CXPackage) GetEnum(enumName string) (*CXEnum, error) {
+	for _, enum := range pkg.Enums {
+		if enum.Name == enumName {
+			return enum, nil
+		}
+	}
+	return nil, fmt.Errorf("enum '%s' not found in package '%s'", enumName, pkg.Name)
+}
+
+// GetNamedType ...
+func (pkg *CXPackage) GetNamedType(typeName string) (*CXNamedType, error) {
+	for _, typ := range pkg.Types {
+		if typ.Name == typeName {
+			return typ, nil
+		}
+	}
+	return nil, fmt.Errorf("type '%s' not found in package '%s'", typeName, pkg.Name)
+}
+
 // GetGlobal ...
 func (pkg *CXPackage) GetGlobal(defName string) (*CXArgument, error) {
 	var foundDef *CXArgument
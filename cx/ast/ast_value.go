@@ -252,3 +252,11 @@ func (value *CXValue) Set_str(data string) {
 	//value.Used = constants.TYPE_STR
 	WriteObject(value.Offset, encoder.Serialize(data))
 }
+
+func (value *CXValue) Get_error() error {
+	return ReadError(value.Offset)
+}
+
+func (value *CXValue) Set_error(err error) {
+	WriteError(value.Offset, err)
+}
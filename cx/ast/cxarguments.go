@@ -77,7 +77,24 @@ type CXArgument struct {
 	ArgDetails *CXArgumentDebug
 
 	CustomType *CXStruct
-	IsSlice    bool
+	// Enum is non-nil if the `CXArgument` is of an enum type. The
+	// basic type of enums is always `TYPE_I32`.
+	Enum *CXEnum
+	// Named is non-nil if the `CXArgument` is of a named type, or of
+	// a type built from it, such as `[]Celsius`. The first
+	// `DeclarationSpecifiers` are then the ones of the named type.
+	Named *CXNamedType
+	// MapKeyType is the `TYPE_*` constant of the keys of a map.
+	MapKeyType int
+	// MapKey is the type specifier of the keys of a map if they are
+	// struct instances or arrays.
+	MapKey *CXArgument
+	// MapValue is the type specifier of the values of a map. Its
+	// `Offset` is the one of a zeroed value in the data segment, which
+	// is read from nil maps.
+	MapValue *CXArgument
+	IsMap    bool
+	IsSlice  bool
 	// IsArray                      bool
 	IsPointer                    bool
 	IsReference                  bool
@@ -85,6 +102,8 @@ type CXArgument struct {
 	IsRest                       bool // pkg.var <- var is rest
 	IsLocalDeclaration           bool
 	IsShortAssignmentDeclaration bool // variables defined with :=
+	IsVariadic                   bool // parameter declared with ..., holding the extra arguments
+	IsEmbedded                   bool // struct field declared without a name, whose fields and methods are promoted
 	IsInnerReference             bool // for example: &slice[0] or &struct.field
 	PreviouslyDeclared           bool
 	DoesEscape                   bool
@@ -28,15 +28,38 @@ func ErrorString(code int) string {
 	return constants.ErrorStrings[constants.CX_RUNTIME_ERROR]
 }
 
+// NilPointerDereference is the runtime error of dereferencing the nil
+// pointer `Name`.
+type NilPointerDereference struct {
+	Name string
+}
+
+func (e NilPointerDereference) String() string {
+	return fmt.Sprintf("nil pointer dereference of '%s'", e.Name)
+}
+
 func errorCode(r interface{}) int {
 	switch v := r.(type) {
 	case int:
 		return int(v)
+	case NilPointerDereference:
+		return constants.CX_RUNTIME_NIL_POINTER_DEREFERENCE
 	default:
 		return constants.CX_RUNTIME_ERROR
 	}
 }
 
+// tracebackLevel returns the level of detail of runtime error tracebacks, as
+// set by the CXTRACEBACK environment variable. It defaults to TRACEBACK_ALL.
+func tracebackLevel() string {
+	switch level := os.Getenv("CXTRACEBACK"); level {
+	case TRACEBACK_NONE, TRACEBACK_SINGLE:
+		return level
+	default:
+		return TRACEBACK_ALL
+	}
+}
+
 func RuntimeErrorInfo(r interface{}, printStack bool, defaultError int) {
 	call := PROGRAM.CallStack[PROGRAM.CallCounter]
 	expr := call.Operator.Expressions[call.Line]
@@ -47,11 +70,15 @@ func RuntimeErrorInfo(r interface{}, printStack bool, defaultError int) {
 
 	fmt.Printf("%s, %s, %v", ErrorHeader(expr.FileName, expr.FileLine), ErrorString(code), r)
 
+	level := tracebackLevel()
 	if printStack {
-		PROGRAM.PrintStack()
+		PROGRAM.PrintTraceback(level)
 	}
+	fmt.Println()
 
-	if globals.DBG_GOLANG_STACK_TRACE {
+	// the stack of the Go runtime is only printed with the full traceback,
+	// so CXTRACEBACK can silence it
+	if globals.DBG_GOLANG_STACK_TRACE && level == TRACEBACK_ALL {
 		debug.PrintStack()
 	}
 
@@ -63,6 +90,11 @@ func RuntimeError() {
 	if r := recover(); r != nil {
 		switch r {
 		case constants.STACK_OVERFLOW_ERROR:
+			if PROGRAM.CallCounter >= len(PROGRAM.CallStack) {
+				// The call stack is full, so the failed call was never pushed.
+				PROGRAM.CallCounter = len(PROGRAM.CallStack) - 1
+				RuntimeErrorInfo(r, true, constants.CX_RUNTIME_STACK_OVERFLOW_ERROR)
+			}
 			call := PROGRAM.CallStack[PROGRAM.CallCounter]
 			if PROGRAM.CallCounter > 0 {
 				PROGRAM.CallCounter--
@@ -80,3 +112,61 @@ func RuntimeError() {
 	}
 }
 
+// CXPanic is a runtime error unwinding the call stack of a CX program. The
+// calls unwound run their deferred calls, which can recover from it.
+type CXPanic struct {
+	Value interface{} // Value the Go runtime panicked with.
+
+	// Call stack at the time of the runtime error, used to report it if
+	// it isn't recovered from.
+	CallStack   []CXCall
+	CallCounter int
+}
+
+// StartPanic starts unwinding the call stack of `prgrm` after the runtime
+// error `r`, beginning with the current call. Stack overflows and heap
+// exhaustion can't be recovered from, so they're raised again, as well as
+// errors outside of function calls.
+func (prgrm *CXProgram) StartPanic(r interface{}) {
+	if r == constants.STACK_OVERFLOW_ERROR || r == constants.HEAP_EXHAUSTED_ERROR ||
+		prgrm.CallCounter < 0 || prgrm.CallStack[prgrm.CallCounter].Operator == nil {
+		panic(r)
+	}
+	prgrm.Panic = &CXPanic{
+		Value:       r,
+		CallStack:   append([]CXCall{}, prgrm.CallStack[:prgrm.CallCounter+1]...),
+		CallCounter: prgrm.CallCounter,
+	}
+
+	// the current call returns as soon as it runs its deferred calls
+	call := &prgrm.CallStack[prgrm.CallCounter]
+	call.Line = call.Operator.Length
+	prgrm.StackPointer = call.FramePointer + call.Operator.Size
+}
+
+// RaisePanic restores the call stack of `prgrm` to the one at the time of
+// the runtime error unwinding it, and raises the error again, so it's
+// reported by `RuntimeError`.
+func (prgrm *CXProgram) RaisePanic() {
+	p := prgrm.Panic
+	prgrm.Panic = nil
+	copy(prgrm.CallStack, p.CallStack)
+	prgrm.CallCounter = p.CallCounter
+	panic(p.Value)
+}
+
+// Recover stops the panic unwinding the call stack of `prgrm` and returns
+// its error code. Only calls made directly by deferred calls can recover,
+// otherwise CX_SUCCESS is returned.
+func (prgrm *CXProgram) Recover() int {
+	if prgrm.Panic == nil || prgrm.CallCounter == 0 {
+		return constants.CX_SUCCESS
+	}
+	if caller := prgrm.CallStack[prgrm.CallCounter-1]; caller.Line < caller.Operator.Length {
+		// then the current call isn't a deferred call
+		return constants.CX_SUCCESS
+	}
+	code := errorCode(prgrm.Panic.Value)
+	prgrm.Panic = nil
+	return code
+}
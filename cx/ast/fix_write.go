@@ -21,7 +21,7 @@ func WriteMemory(offset int, byts []byte) {
 func WriteObjectData(obj []byte) int {
 	size := len(obj) + constants.OBJECT_HEADER_SIZE
 	heapOffset := AllocateSeq(size)
-	WriteI32(heapOffset, int32(size))
+	WriteI32(heapOffset+constants.MARK_SIZE+constants.FORWARDING_ADDRESS_SIZE, int32(size))
 	WriteMemory(heapOffset +constants.OBJECT_HEADER_SIZE, obj)
 	return heapOffset
 }
@@ -8,14 +8,15 @@ import (
 
 // MarkAndCompact ...
 func MarkAndCompact(prgrm *CXProgram) {
-	var fp int
+	var fp = constants.NULL_STACK_ADDRESS_OFFSET
 	var faddr = int32(constants.NULL_HEAP_ADDRESS_OFFSET)
 
 	// marking, setting forward addresses and updating references
 	// global variables
 	for _, pkg := range prgrm.Packages {
 		for _, glbl := range pkg.Globals {
-			if (glbl.IsPointer || glbl.IsSlice || glbl.Type == constants.TYPE_STR) && glbl.CustomType == nil {
+			if (glbl.IsPointer || glbl.IsSlice || glbl.IsMap || glbl.Type == constants.TYPE_STR || glbl.Type == constants.TYPE_ERROR || glbl.Type == constants.TYPE_INTERFACE || glbl.Type == constants.TYPE_FUNC) &&
+				(glbl.CustomType == nil || glbl.IsMap || glbl.Type == constants.TYPE_INTERFACE) {
 				// Getting the offset to the object in the heap
 				var heapOffset int32
 				_, err := encoder.DeserializeAtomic(prgrm.Memory[glbl.Offset:glbl.Offset+constants.TYPE_POINTER_SIZE], &heapOffset)
@@ -30,7 +31,7 @@ func MarkAndCompact(prgrm *CXProgram) {
 			}
 
 			// If `ptr` has fields, we need to navigate the heap and mark its fields too.
-			if glbl.CustomType != nil {
+			if glbl.CustomType != nil && !glbl.IsMap {
 				for _, fld := range glbl.CustomType.Fields {
 					offset := glbl.Offset + fld.Offset
 					// Getting the offset to the object in the heap
@@ -44,7 +45,7 @@ func MarkAndCompact(prgrm *CXProgram) {
 						continue
 					}
 
-					if fld.IsPointer || fld.IsSlice || fld.Type == constants.TYPE_STR {
+					if fld.IsPointer || fld.IsSlice || fld.IsMap || fld.Type == constants.TYPE_STR || fld.Type == constants.TYPE_ERROR || fld.Type == constants.TYPE_FUNC {
 						MarkObjectsTree(prgrm, offset, fld.Type, fld.DeclarationSpecifiers[1:])
 					}
 				}
@@ -52,6 +53,11 @@ func MarkAndCompact(prgrm *CXProgram) {
 		}
 	}
 
+	// function values held outside the program's memory
+	for _, root := range functionRoots {
+		markClosureEnv(prgrm, root.Env, root.tag)
+	}
+
 	// marking, setting forward addresses and updating references
 	// local variables
 	for c := 0; c <= prgrm.CallCounter; c++ {
@@ -76,7 +82,7 @@ func MarkAndCompact(prgrm *CXProgram) {
 			// Checking if we need to mark `ptr`.
 			if ptrIsPointer {
 				// If `ptr` has fields, we need to navigate the heap and mark its fields too.
-				if ptr.CustomType != nil {
+				if ptr.CustomType != nil && !ptr.IsMap {
 					// Getting the offset to the object in the heap
 					var heapOffset int32
 					_, err := encoder.DeserializeAtomic(prgrm.Memory[offset:offset+constants.TYPE_POINTER_SIZE], &heapOffset)
@@ -102,6 +108,16 @@ func MarkAndCompact(prgrm *CXProgram) {
 			}
 		}
 
+		// The cells of the locals captured by closures, and of the
+		// variables captured by the function itself.
+		for _, v := range op.Escaped {
+			markCell(prgrm, fp+v.Offset, v)
+		}
+		for _, v := range op.Captures {
+			markCell(prgrm, fp+v.Offset, v)
+		}
+		markDeferredCalls(prgrm, prgrm.CallStack[c].Defers)
+
 		fp += op.Size
 	}
 
@@ -178,13 +194,35 @@ func doDisplaceReferences(prgrm *CXProgram, updated *map[int]int, atOffset int,
 		return
 	}
 
+	// Maps hold references in their entries, whose storage is referenced by
+	// the handle of the map.
+	if declSpecs[numDeclSpecs-1] == constants.DECL_MAP {
+		storageRef := int(heapOffset) + condPlusOff + constants.OBJECT_HEADER_SIZE
+		storage := helper.Deserialize_i32(prgrm.Memory[storageRef : storageRef+constants.TYPE_POINTER_SIZE])
+		if int(storage) <= prgrm.HeapStartsAt {
+			return
+		}
+		updateDisplaceReference(prgrm, updated, storageRef-condPlusOff, plusOff)
+		for _, ref := range mapEntryReferences(storage+int32(condPlusOff), baseType, declSpecs[:numDeclSpecs-1]) {
+			cHeapOffset := helper.Deserialize_i32(prgrm.Memory[ref.offset : ref.offset+constants.TYPE_POINTER_SIZE])
+			if int(cHeapOffset) <= prgrm.HeapStartsAt+condPlusOff {
+				// Then it's pointing to null or data segment
+				continue
+			}
+
+			// Displacing this entry's reference.
+			updateDisplaceReference(prgrm, updated, ref.offset-condPlusOff, plusOff)
+		}
+		return
+	}
+
 	// Checking if it's a tree of objects.
 	// TODO: We're not considering struct instances with pointer fields.
 	if declSpecs[0] == constants.DECL_SLICE {
 		if (numDeclSpecs > 1 &&
 			(declSpecs[1] == constants.DECL_SLICE ||
 				declSpecs[1] == constants.DECL_POINTER)) ||
-			(numDeclSpecs == 1 && baseType == constants.TYPE_STR) {
+			(numDeclSpecs == 1 && (baseType == constants.TYPE_STR || baseType == constants.TYPE_ERROR)) {
 			// Then we need to iterate each of the slice objects
 			// and check if we need to update their address.
 			sliceLen := helper.Deserialize_i32(GetSliceHeader(heapOffset + int32(condPlusOff))[4:8])
@@ -218,14 +256,14 @@ func DisplaceReferences(prgrm *CXProgram, off int, numPkgs int) {
 		// as any other object should be destroyed, as the program finished its
 		// execution.
 		for _, glbl := range pkg.Globals {
-			if glbl.IsPointer || glbl.IsSlice {
+			if glbl.IsPointer || glbl.IsSlice || glbl.IsMap || glbl.Type == constants.TYPE_INTERFACE || glbl.Type == constants.TYPE_FUNC {
 				doDisplaceReferences(prgrm, &updated, glbl.Offset, off, glbl.Type, glbl.DeclarationSpecifiers[1:])
 			}
 
 			// If it's a struct instance we need to displace each of its fields.
-			if glbl.CustomType != nil {
+			if glbl.CustomType != nil && !glbl.IsMap {
 				for _, fld := range glbl.CustomType.Fields {
-					if fld.IsPointer || fld.IsSlice {
+					if fld.IsPointer || fld.IsSlice || fld.IsMap {
 						doDisplaceReferences(prgrm, &updated, glbl.Offset+fld.Offset, off, fld.Type, fld.DeclarationSpecifiers[1:])
 					}
 				}
@@ -263,20 +301,58 @@ func MarkObjectsTree(prgrm *CXProgram, offset int, baseType int, declSpecs []int
 		return
 	}
 
+	// Then the closure environment held by the function value references
+	// the cells of the variables it captured.
+	if numDeclSpecs == 0 && baseType == constants.TYPE_FUNC {
+		markFunctionValue(prgrm, offset)
+		return
+	}
+
 	// marking the root object
 	Mark(prgrm, heapOffset)
 
+	// Then the fields of the struct instance held by the interface can
+	// reference other objects.
+	if numDeclSpecs == 0 && baseType == constants.TYPE_INTERFACE {
+		if obj, strct := interfaceObject(offset); strct != nil {
+			for _, fld := range strct.Fields {
+				if IsPointer(fld) {
+					MarkObjectsTree(prgrm, int(obj)+constants.OBJECT_HEADER_SIZE+fld.Offset, fld.Type, fld.DeclarationSpecifiers[1:])
+				}
+			}
+		}
+		return
+	}
+
 	if numDeclSpecs == 0 {
 		return
 	}
 
+	// Then the map's entries can reference other objects.
+	if declSpecs[numDeclSpecs-1] == constants.DECL_MAP {
+		for _, ref := range mapReferences(heapOffset, baseType, declSpecs[:numDeclSpecs-1]) {
+			MarkObjectsTree(prgrm, ref.offset, ref.baseType, ref.declSpecs)
+		}
+		return
+	}
+
+	// Then each of the interfaces or functions in the slice can hold an object.
+	if numDeclSpecs == 1 && declSpecs[0] == constants.DECL_SLICE && (baseType == constants.TYPE_INTERFACE || baseType == constants.TYPE_FUNC) {
+		sliceLen := helper.Deserialize_i32(GetSliceHeader(heapOffset)[4:8])
+		offsetToElements := int(heapOffset) + constants.OBJECT_HEADER_SIZE + constants.SLICE_HEADER_SIZE
+		for c := 0; c < int(sliceLen); c++ {
+			MarkObjectsTree(prgrm, offsetToElements+c*constants.GetArgSize(baseType), baseType, nil)
+		}
+		return
+	}
+
 	// Then it's a tree of objects.
 	// TODO: We're not considering struct instances with pointer fields.
 	if declSpecs[0] == constants.DECL_SLICE {
 		if (numDeclSpecs > 1 &&
 			(declSpecs[1] == constants.DECL_SLICE ||
 				declSpecs[1] == constants.DECL_POINTER)) ||
-			(numDeclSpecs == 1 && baseType == constants.TYPE_STR) {
+			(numDeclSpecs == 1 && (baseType == constants.TYPE_STR || baseType == constants.TYPE_ERROR)) {
 			// Then we need to iterate each of the slice objects and mark them as alive
 			sliceLen := helper.Deserialize_i32(GetSliceHeader(heapOffset)[4:8])
 
@@ -313,18 +389,65 @@ func updatePointerTree(prgrm *CXProgram, atOffset int, oldAddr, newAddr int32, b
 		updatePointer(prgrm, atOffset, newAddr)
 	}
 
+	// Checking if the cells referenced by the closure environment held by
+	// the function value reference the moved object.
+	if numDeclSpecs == 0 && baseType == constants.TYPE_FUNC {
+		_, tag := getFunctionValue(atOffset)
+		updateFunctionValue(prgrm, heapOffset, tag, oldAddr, newAddr)
+		return
+	}
+
+	// Checking if the fields of the struct instance held by the interface
+	// reference the moved object.
+	if numDeclSpecs == 0 && baseType == constants.TYPE_INTERFACE {
+		if obj, strct := interfaceObject(atOffset); strct != nil {
+			if obj == newAddr {
+				// The root was just updated, but the object wasn't moved yet.
+				obj = oldAddr
+			}
+			for _, fld := range strct.Fields {
+				if IsPointer(fld) {
+					updatePointerTree(prgrm, int(obj)+constants.OBJECT_HEADER_SIZE+fld.Offset, oldAddr, newAddr, fld.Type, fld.DeclarationSpecifiers[1:])
+				}
+			}
+		}
+		return
+	}
+
 	// It can't be a tree of objects.
 	if numDeclSpecs == 0 || int(heapOffset) <= prgrm.HeapStartsAt {
 		return
 	}
 
+	// Checking if the map's entries reference the moved object.
+	if declSpecs[numDeclSpecs-1] == constants.DECL_MAP {
+		if heapOffset == newAddr {
+			// The root was just updated, but the map wasn't moved yet.
+			heapOffset = oldAddr
+		}
+		for _, ref := range mapReferences(heapOffset, baseType, declSpecs[:numDeclSpecs-1]) {
+			updatePointerTree(prgrm, ref.offset, oldAddr, newAddr, ref.baseType, ref.declSpecs)
+		}
+		return
+	}
+
+	// Checking if the interfaces or functions in the slice reference the moved object.
+	if numDeclSpecs == 1 && declSpecs[0] == constants.DECL_SLICE && (baseType == constants.TYPE_INTERFACE || baseType == constants.TYPE_FUNC) {
+		sliceLen := helper.Deserialize_i32(GetSliceHeader(heapOffset)[4:8])
+		offsetToElements := int(heapOffset) + constants.OBJECT_HEADER_SIZE + constants.SLICE_HEADER_SIZE
+		for c := 0; c < int(sliceLen); c++ {
+			updatePointerTree(prgrm, offsetToElements+c*constants.GetArgSize(baseType), oldAddr, newAddr, baseType, nil)
+		}
+		return
+	}
+
 	// Checking if it's a tree of objects.
 	// TODO: We're not considering struct instances with pointer fields.
 	if declSpecs[0] == constants.DECL_SLICE {
 		if (numDeclSpecs > 1 &&
 			(declSpecs[1] == constants.DECL_SLICE ||
 				declSpecs[1] == constants.DECL_POINTER)) ||
-			(numDeclSpecs == 1 && baseType == constants.TYPE_STR) {
+			(numDeclSpecs == 1 && (baseType == constants.TYPE_STR || baseType == constants.TYPE_ERROR)) {
 			// Then we need to iterate each of the slice objects
 			// and check if we need to update their address.
 			sliceLen := helper.Deserialize_i32(GetSliceHeader(heapOffset)[4:8])
@@ -357,12 +480,14 @@ func updatePointers(prgrm *CXProgram, oldAddr, newAddr int32) {
 	if oldAddr == newAddr {
 		return
 	}
+	updatedCells = map[int32]bool{}
 	// TODO: `oldAddr` could be received as a slice of bytes that represent the old address of the object,
 	// as it needs to be converted to bytes later on anyways. However, I'm sticking to an int32
 	// for a bit more of clarity.
 	for _, pkg := range prgrm.Packages {
 		for _, glbl := range pkg.Globals {
-			if (glbl.IsPointer || glbl.IsSlice || glbl.Type == constants.TYPE_STR) && glbl.CustomType == nil {
+			if (glbl.IsPointer || glbl.IsSlice || glbl.IsMap || glbl.Type == constants.TYPE_STR || glbl.Type == constants.TYPE_ERROR || glbl.Type == constants.TYPE_INTERFACE || glbl.Type == constants.TYPE_FUNC) &&
+				(glbl.CustomType == nil || glbl.IsMap || glbl.Type == constants.TYPE_INTERFACE) {
 				// Getting the offset to the object in the heap
 				var heapOffset int32
 				_, err := encoder.DeserializeAtomic(prgrm.Memory[glbl.Offset:glbl.Offset+constants.TYPE_POINTER_SIZE], &heapOffset)
@@ -378,7 +503,7 @@ func updatePointers(prgrm *CXProgram, oldAddr, newAddr int32) {
 			}
 
 			// If `ptr` has fields, we need to navigate the heap and mark its fields too.
-			if glbl.CustomType != nil {
+			if glbl.CustomType != nil && !glbl.IsMap {
 				for _, fld := range glbl.CustomType.Fields {
 					if !IsPointer(fld) {
 						continue
@@ -395,7 +520,7 @@ func updatePointers(prgrm *CXProgram, oldAddr, newAddr int32) {
 						continue
 					}
 
-					if fld.IsPointer || fld.IsSlice || fld.Type == constants.TYPE_STR {
+					if fld.IsPointer || fld.IsSlice || fld.IsMap || fld.Type == constants.TYPE_STR || fld.Type == constants.TYPE_ERROR || fld.Type == constants.TYPE_FUNC {
 						updatePointerTree(prgrm, offset, oldAddr, newAddr, fld.Type, fld.DeclarationSpecifiers[1:])
 					}
 				}
@@ -403,7 +528,15 @@ func updatePointers(prgrm *CXProgram, oldAddr, newAddr int32) {
 		}
 	}
 
-	var fp int
+	for _, root := range functionRoots {
+		env := root.Env
+		if env == oldAddr {
+			root.Env = newAddr
+		}
+		updateFunctionValue(prgrm, env, root.tag, oldAddr, newAddr)
+	}
+
+	var fp = constants.NULL_STACK_ADDRESS_OFFSET
 
 	for c := 0; c <= prgrm.CallCounter; c++ {
 		op := prgrm.CallStack[c].Operator
@@ -437,7 +570,7 @@ func updatePointers(prgrm *CXProgram, oldAddr, newAddr int32) {
 					updatePointerTree(prgrm, offset, oldAddr, newAddr, ptr.Type, ptr.DeclarationSpecifiers[1:])
 
 					// If `ptr` has fields, we need to navigate the heap and mark its fields too.
-					if ptr.CustomType != nil {
+					if ptr.CustomType != nil && !ptr.IsMap {
 						if int(heapOffset) >= prgrm.HeapStartsAt {
 							for _, fld := range ptr.CustomType.Fields {
 								updatePointerTree(prgrm, int(heapOffset)+constants.OBJECT_HEADER_SIZE+fld.Offset, oldAddr, newAddr, fld.Type, fld.DeclarationSpecifiers[1:])
@@ -466,6 +599,14 @@ func updatePointers(prgrm *CXProgram, oldAddr, newAddr int32) {
 
 		}
 
+		for _, v := range op.Escaped {
+			updateCell(prgrm, fp+v.Offset, oldAddr, newAddr, v)
+		}
+		for _, v := range op.Captures {
+			updateCell(prgrm, fp+v.Offset, oldAddr, newAddr, v)
+		}
+		updateDeferredCalls(prgrm, &prgrm.CallStack[c], oldAddr, newAddr)
+
 		fp += op.Size
 	}
 }
@@ -93,5 +93,14 @@ func AllocateSeq(size int) (offset int) {
 	// Returning absolute memory address (not relative to where heap starts at).
 	// Above this point we were performing all operations taking into
 	// consideration only heap offsets.
-	return addr + PROGRAM.HeapStartsAt
+	offset = addr + PROGRAM.HeapStartsAt
+
+	// The heap can still hold the bytes of collected objects. A mark left
+	// by them would make the garbage collector keep the new object and
+	// update the references to its stale forwarding address.
+	for c := offset; c < offset+constants.MARK_SIZE+constants.FORWARDING_ADDRESS_SIZE; c++ {
+		PROGRAM.Memory[c] = 0
+	}
+
+	return offset
 }
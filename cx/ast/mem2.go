@@ -48,6 +48,7 @@ func CalculateDereferences(arg *CXArgument, finalOffset int, fp int) int {
 			byts = PROGRAM.Memory[finalOffset : finalOffset+constants.TYPE_POINTER_SIZE]
 
 			offset = helper.Deserialize_i32(byts)
+			checkNilSlice(offset)
 
 			finalOffset = int(offset)
 
@@ -58,7 +59,7 @@ func CalculateDereferences(arg *CXArgument, finalOffset int, fp int) int {
 
 			//TODO: delete
 			sizeToUse := GetDerefSize(arg) //TODO: is always arg.Size unless arg.CustomType != nil
-			finalOffset += int(ReadI32(fp, arg.Indexes[idxCounter])) * sizeToUse
+			finalOffset += int(ReadArray(fp, arg.Indexes[idxCounter])) * sizeToUse
 			if !IsValidSliceIndex(baseOffset, finalOffset, sizeToUse) {
 				panic(constants.CX_RUNTIME_SLICE_INDEX_OUT_OF_RANGE)
 			}
@@ -80,7 +81,15 @@ func CalculateDereferences(arg *CXArgument, finalOffset int, fp int) int {
 			baseOffset = finalOffset
 			sizeofElement = subSize * sizeToUse
 			// finalOffset += int(ReadI32(fp, arg.Indexes[idxCounter])) * sizeofElement //TODO: FIX INTEGER CAST
-			finalOffset += int(ReadArray(fp, arg.Indexes[idxCounter])) * sizeofElement //TODO: FIX INTEGER CAST
+			finalOffset += int(ReadArrayIndex(fp, arg, idxCounter)) * sizeofElement //TODO: FIX INTEGER CAST
+			idxCounter++
+		case constants.DEREF_MAP, constants.DEREF_MAP_ASSIGN:
+			if len(arg.Indexes) == 0 {
+				continue
+			}
+
+			isPointer = false
+			finalOffset = GetMapElementOffset(fp, arg, finalOffset, arg.Indexes[idxCounter], op == constants.DEREF_MAP_ASSIGN)
 			idxCounter++
 		case constants.DEREF_POINTER: //TODO: Move to CalculateDereference_ptr
 			isPointer = true
@@ -90,6 +99,7 @@ func CalculateDereferences(arg *CXArgument, finalOffset int, fp int) int {
 			byts = PROGRAM.Memory[finalOffset : finalOffset+constants.TYPE_POINTER_SIZE]
 
 			offset = helper.Deserialize_i32(byts)
+			checkNilPointer(arg, offset)
 			finalOffset = int(offset) //TODO: FIX INTEGER CAST
 		}
 
@@ -129,11 +139,24 @@ func CalculateDereferences_array(arg *CXArgument, finalOffset *int, fp int) {
 
 		sizeofElement = subSize * sizeToUse
 		// *finalOffset += int(ReadI32(fp, arg.Indexes[idxCounter])) * sizeofElement //TODO: FIX INTEGER CAST
-		*finalOffset += int(ReadArray(fp, arg.Indexes[idxCounter])) * sizeofElement //TODO: FIX INTEGER CAST
+		*finalOffset += int(ReadArrayIndex(fp, arg, idxCounter)) * sizeofElement //TODO: FIX INTEGER CAST
 		idxCounter++
 	}
 }
 
+// ReadArrayIndex reads the index `idx` of `arg`, which indexes an array,
+// and panics with CX_RUNTIME_ARRAY_INDEX_OUT_OF_RANGE if it's out of the
+// bounds of the array, unless constants.ARRAY_BOUNDS_CHECK is false.
+func ReadArrayIndex(fp int, arg *CXArgument, idx int) int32 {
+	index := ReadArray(fp, arg.Indexes[idx])
+	if constants.ARRAY_BOUNDS_CHECK && idx < len(arg.Lengths) && arg.Lengths[idx] > 0 {
+		if index < 0 || int(index) >= arg.Lengths[idx] {
+			panic(constants.CX_RUNTIME_ARRAY_INDEX_OUT_OF_RANGE)
+		}
+	}
+	return index
+}
+
 // CalculateDereferences_slice
 func CalculateDereferences_slice(arg *CXArgument, finalOffset *int, fp int) {
 
@@ -155,6 +178,7 @@ func CalculateDereferences_slice(arg *CXArgument, finalOffset *int, fp int) {
 		byts = PROGRAM.Memory[*finalOffset : *finalOffset+constants.TYPE_POINTER_SIZE]
 
 		offset = helper.Deserialize_i32(byts)
+		checkNilSlice(offset)
 
 		*finalOffset = int(offset)
 
@@ -195,6 +219,7 @@ func CalculateDereferences_ptr(arg *CXArgument, finalOffset *int, fp int) {
 		byts = PROGRAM.Memory[*finalOffset : *finalOffset+constants.TYPE_POINTER_SIZE]
 
 		offset = helper.Deserialize_i32(byts)
+		checkNilPointer(arg, offset)
 		*finalOffset = int(offset) //TODO: FIX INTEGER CAST
 
 	}
@@ -307,3 +332,20 @@ func CalculateDereferences_bool(arg *CXArgument, finalOffset int, fp int) int {
 	}
 	return CalculateDereferences(arg, finalOffset, fp)
 }
+
+// checkNilPointer panics with a NilPointerDereference if `offset`, the
+// value of the pointer `arg` being dereferenced, is the null heap address.
+func checkNilPointer(arg *CXArgument, offset int32) {
+	if offset == constants.NULL_HEAP_ADDRESS {
+		panic(NilPointerDereference{Name: arg.ArgDetails.Name})
+	}
+}
+
+// checkNilSlice panics with CX_RUNTIME_SLICE_INDEX_OUT_OF_RANGE if `offset`,
+// the address of an indexed slice, is the null heap address. Nil slices are
+// empty, and don't have a header holding their length.
+func checkNilSlice(offset int32) {
+	if offset == constants.NULL_HEAP_ADDRESS {
+		panic(constants.CX_RUNTIME_SLICE_INDEX_OUT_OF_RANGE)
+	}
+}
@@ -0,0 +1,202 @@
+package ast
+
+import (
+	"bytes"
+
+	"github.com/skycoin/cx/cx/constants"
+	"github.com/skycoin/cx/cx/helper"
+)
+
+// IsCompositeValue checks if `arg` is a struct instance or an array, whose
+// values are compared by `ValuesEqual` instead of by the typed operators.
+func IsCompositeValue(arg *CXArgument) bool {
+	elt := GetAssignmentElement(arg)
+	if len(elt.DeclarationSpecifiers) == 0 {
+		return false
+	}
+	switch elt.DeclarationSpecifiers[len(elt.DeclarationSpecifiers)-1] {
+	case constants.DECL_ARRAY:
+		return true
+	case constants.DECL_STRUCT:
+		return elt.Type == constants.TYPE_CUSTOM
+	}
+	return false
+}
+
+// IsComparable checks if the values of the type of `arg` can be compared with
+// `==` and `!=`. Slices, maps, functions and interfaces can't be compared,
+// and neither can the structs and arrays holding them.
+func IsComparable(arg *CXArgument) bool {
+	elt := GetAssignmentElement(arg)
+	return isComparableType(elt, elt.DeclarationSpecifiers)
+}
+
+// IsHashable checks if the values of the type of `arg` can be hashed by
+// their contents, i.e. if they are comparable and don't hold pointers, whose
+// values change when the garbage collector moves the objects they reference.
+// These values can be used as keys of maps.
+func IsHashable(arg *CXArgument) bool {
+	elt := GetAssignmentElement(arg)
+	return isHashableType(elt, elt.DeclarationSpecifiers)
+}
+
+// ValuesEqual checks if the values of the type of `arg` located at `offset1`
+// and `offset2` are equal. Struct instances and arrays are equal if their
+// fields and elements are, and strings if their contents are.
+func ValuesEqual(arg *CXArgument, offset1, offset2 int) bool {
+	elt := GetAssignmentElement(arg)
+	return valuesEqual(elt, elt.DeclarationSpecifiers, arrayLengths(elt), offset1, offset2)
+}
+
+// ValueSize returns the size of the values of the type of `arg`.
+func ValueSize(arg *CXArgument) int {
+	elt := GetAssignmentElement(arg)
+	return typeSize(elt, elt.DeclarationSpecifiers, arrayLengths(elt))
+}
+
+// arrayLengths returns the lengths of the arrays declared by the
+// declaration specifiers of `arg`, in the same order.
+func arrayLengths(arg *CXArgument) []int {
+	var lengths []int
+	// inner arrays are declared first and use the last lengths,
+	// as in `formatType`
+	arrDeclCount := len(arg.Lengths) - 1
+	for _, spec := range arg.DeclarationSpecifiers {
+		if spec == constants.DECL_ARRAY {
+			lengths = append(lengths, arg.Lengths[arrDeclCount])
+			arrDeclCount--
+		}
+	}
+	return lengths
+}
+
+// typeSize returns the size of the values of the type declared by `specs`,
+// whose base type is the type of `arg`, and whose arrays have the lengths
+// `lengths`.
+func typeSize(arg *CXArgument, specs []int, lengths []int) int {
+	switch specs[len(specs)-1] {
+	case constants.DECL_ARRAY:
+		return lengths[len(lengths)-1] * typeSize(arg, specs[:len(specs)-1], lengths[:len(lengths)-1])
+	case constants.DECL_STRUCT:
+		return arg.CustomType.Size
+	case constants.DECL_BASIC:
+		return constants.GetArgSize(arg.Type)
+	}
+	return constants.TYPE_POINTER_SIZE
+}
+
+func isComparableType(arg *CXArgument, specs []int) bool {
+	if len(specs) == 0 {
+		return false
+	}
+	switch specs[len(specs)-1] {
+	case constants.DECL_POINTER:
+		return true
+	case constants.DECL_ARRAY:
+		return isComparableType(arg, specs[:len(specs)-1])
+	case constants.DECL_STRUCT:
+		if arg.Type != constants.TYPE_CUSTOM || arg.CustomType == nil {
+			return false
+		}
+		for _, fld := range arg.CustomType.Fields {
+			if !isComparableType(fld, fld.DeclarationSpecifiers) {
+				return false
+			}
+		}
+		return true
+	case constants.DECL_BASIC:
+		return arg.Type != constants.TYPE_FUNC && arg.Type != constants.TYPE_AFF
+	}
+	return false
+}
+
+func isHashableType(arg *CXArgument, specs []int) bool {
+	if len(specs) == 0 {
+		return false
+	}
+	switch specs[len(specs)-1] {
+	case constants.DECL_ARRAY:
+		return isHashableType(arg, specs[:len(specs)-1])
+	case constants.DECL_STRUCT:
+		if arg.Type != constants.TYPE_CUSTOM || arg.CustomType == nil {
+			return false
+		}
+		for _, fld := range arg.CustomType.Fields {
+			if !isHashableType(fld, fld.DeclarationSpecifiers) {
+				return false
+			}
+		}
+		return true
+	case constants.DECL_BASIC:
+		return isComparableType(arg, specs)
+	}
+	return false
+}
+
+func valuesEqual(arg *CXArgument, specs []int, lengths []int, offset1, offset2 int) bool {
+	switch specs[len(specs)-1] {
+	case constants.DECL_ARRAY:
+		eltSpecs := specs[:len(specs)-1]
+		eltLengths := lengths[:len(lengths)-1]
+		size := typeSize(arg, eltSpecs, eltLengths)
+		for c := 0; c < lengths[len(lengths)-1]; c++ {
+			if !valuesEqual(arg, eltSpecs, eltLengths, offset1+c*size, offset2+c*size) {
+				return false
+			}
+		}
+		return true
+	case constants.DECL_STRUCT:
+		for _, fld := range arg.CustomType.Fields {
+			if !valuesEqual(fld, fld.DeclarationSpecifiers, arrayLengths(fld), offset1+fld.Offset, offset2+fld.Offset) {
+				return false
+			}
+		}
+		return true
+	case constants.DECL_BASIC:
+		if arg.Type == constants.TYPE_STR {
+			return readStrValue(offset1) == readStrValue(offset2)
+		}
+	}
+	size := typeSize(arg, specs, lengths)
+	return bytes.Equal(PROGRAM.Memory[offset1:offset1+size], PROGRAM.Memory[offset2:offset2+size])
+}
+
+// appendStrOffsets appends to `strs` the offsets of the strings held by the
+// values of the type declared by `specs`, located at `offset`, as in
+// `valuesEqual`.
+func appendStrOffsets(strs []int, arg *CXArgument, specs []int, lengths []int, offset int) []int {
+	switch specs[len(specs)-1] {
+	case constants.DECL_ARRAY:
+		eltSpecs := specs[:len(specs)-1]
+		eltLengths := lengths[:len(lengths)-1]
+		size := typeSize(arg, eltSpecs, eltLengths)
+		for c := 0; c < lengths[len(lengths)-1]; c++ {
+			strs = appendStrOffsets(strs, arg, eltSpecs, eltLengths, offset+c*size)
+		}
+	case constants.DECL_STRUCT:
+		strs = appendFieldStrOffsets(strs, arg.CustomType, offset)
+	case constants.DECL_BASIC:
+		if arg.Type == constants.TYPE_STR {
+			strs = append(strs, offset)
+		}
+	}
+	return strs
+}
+
+// appendFieldStrOffsets appends to `strs` the offsets of the strings held by
+// the fields of the instance of `strct` located at `offset`.
+func appendFieldStrOffsets(strs []int, strct *CXStruct, offset int) []int {
+	for _, fld := range strct.Fields {
+		strs = appendStrOffsets(strs, fld, fld.DeclarationSpecifiers, arrayLengths(fld), offset+fld.Offset)
+	}
+	return strs
+}
+
+// readStrValue returns the contents of the string referenced at `offset`.
+func readStrValue(offset int) string {
+	strOffset := helper.Deserialize_i32(PROGRAM.Memory[offset : offset+constants.TYPE_POINTER_SIZE])
+	if strOffset == 0 {
+		return ""
+	}
+	return ReadStringFromObject(strOffset)
+}
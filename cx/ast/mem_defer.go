@@ -0,0 +1,117 @@
+package ast
+
+import (
+	"github.com/skycoin/cx/cx/constants"
+	"github.com/skycoin/cx/cx/helper"
+)
+
+// Offsets of the fields of a deferred call. Deferred calls are objects in
+// the heap holding the function value called and the inputs it receives,
+// chained from the last call deferred by a function call.
+const (
+	deferNextOffset     = constants.OBJECT_HEADER_SIZE
+	deferFunctionOffset = deferNextOffset + constants.TYPE_POINTER_SIZE
+	deferInputsOffset   = deferFunctionOffset + constants.FUNC_SIZE
+)
+
+// inputsSize returns the size of the region of the stack frames of `fn`
+// holding its inputs.
+func inputsSize(fn *CXFunction) int {
+	var size int
+	for _, inp := range fn.Inputs {
+		if end := inp.Offset + GetSize(inp); end > size {
+			size = end
+		}
+	}
+	return size
+}
+
+// DeferCall defers the call to `fn` until `call` returns. The inputs of the
+// deferred call were written to the stack frame starting at `fp`, and
+// `value` is the function value called, if any, which holds the closure
+// environment of `fn`.
+func DeferCall(call *CXCall, fn *CXFunction, fp int, value *CXArgument) {
+	size := inputsSize(fn)
+	obj := make([]byte, deferInputsOffset+size)
+	WriteMemI32(obj, 5, int32(len(obj)))
+
+	// The stack frame is still in use while allocating, so the garbage
+	// collector can update the references held by the inputs.
+	ptr := AllocateSeq(len(obj))
+
+	var env int32
+	if value != nil {
+		env, _ = getFunctionValue(GetFinalOffset(call.FramePointer, value))
+	}
+	WriteMemI32(obj, deferNextOffset, call.Defers)
+	WriteMemI32(obj, deferFunctionOffset+functionEnvOffset, env)
+	WriteMemI32(obj, deferFunctionOffset+functionTagOffset, FunctionTag(fn))
+	copy(obj[deferInputsOffset:], PROGRAM.Memory[fp:fp+size])
+	WriteMemory(ptr, obj)
+
+	call.Defers = int32(ptr)
+}
+
+// callDeferred pushes the call deferred last by `call` to the call stack, if
+// any, and reports whether it did.
+func (call *CXCall) callDeferred(prgrm *CXProgram) bool {
+	obj := int(call.Defers)
+	if obj == 0 {
+		return false
+	}
+	call.Defers = helper.Deserialize_i32(prgrm.Memory[obj+deferNextOffset : obj+deferNextOffset+constants.TYPE_POINTER_SIZE])
+
+	env, tag := getFunctionValue(obj + deferFunctionOffset)
+	fn := FunctionOfTag(tag)
+	newCall := prgrm.pushCall(fn)
+
+	size := inputsSize(fn)
+	WriteMemory(newCall.FramePointer, prgrm.Memory[obj+deferInputsOffset:obj+deferInputsOffset+size])
+	WriteClosureEnv(newCall.FramePointer, fn, env)
+	return true
+}
+
+// markDeferredCalls marks the deferred calls chained from `obj`, and the
+// objects referenced by their inputs and closure environments.
+func markDeferredCalls(prgrm *CXProgram, obj int32) {
+	for int(obj) > prgrm.HeapStartsAt {
+		Mark(prgrm, obj)
+
+		offset := int(obj) + deferFunctionOffset
+		markFunctionValue(prgrm, offset)
+		_, tag := getFunctionValue(offset)
+		for _, inp := range FunctionOfTag(tag).Inputs {
+			markValue(prgrm, int(obj)+deferInputsOffset+inp.Offset, inp)
+		}
+
+		obj = helper.Deserialize_i32(prgrm.Memory[int(obj)+deferNextOffset : int(obj)+deferNextOffset+constants.TYPE_POINTER_SIZE])
+	}
+}
+
+// updateDeferredCalls updates the references to the object moved from
+// `oldAddr` to `newAddr` held by the deferred calls of `call`.
+func updateDeferredCalls(prgrm *CXProgram, call *CXCall, oldAddr, newAddr int32) {
+	if int(oldAddr) <= prgrm.HeapStartsAt {
+		return
+	}
+
+	// The deferred calls are updated, but they weren't moved yet.
+	obj := call.Defers
+	if obj == oldAddr {
+		call.Defers = newAddr
+	}
+	for int(obj) > prgrm.HeapStartsAt {
+		offset := int(obj) + deferFunctionOffset
+		updatePointerTree(prgrm, offset, oldAddr, newAddr, constants.TYPE_FUNC, nil)
+		_, tag := getFunctionValue(offset)
+		for _, inp := range FunctionOfTag(tag).Inputs {
+			updateValue(prgrm, int(obj)+deferInputsOffset+inp.Offset, oldAddr, newAddr, inp)
+		}
+
+		offset = int(obj) + deferNextOffset
+		obj = helper.Deserialize_i32(prgrm.Memory[offset : offset+constants.TYPE_POINTER_SIZE])
+		if obj == oldAddr {
+			updatePointer(prgrm, offset, newAddr)
+		}
+	}
+}
@@ -0,0 +1,41 @@
+package ast
+
+import (
+	"errors"
+
+	"github.com/skycoin/cx/cx/constants"
+	"github.com/skycoin/cx/cx/helper"
+	"github.com/skycoin/skycoin/src/cipher/encoder"
+)
+
+// An error value is a reference to an object in the heap holding its
+// message, laid out as a string. The reference of nil errors is 0.
+
+// ReadError returns the error located at `offset`.
+func ReadError(offset int) error {
+	obj := helper.Deserialize_i32(PROGRAM.Memory[offset : offset+constants.TYPE_POINTER_SIZE])
+	if obj == 0 {
+		return nil
+	}
+	return errors.New(ReadStringFromObject(obj))
+}
+
+// WriteError writes the error `err` to `offset`, allocating its message in
+// the heap if it isn't nil.
+func WriteError(offset int, err error) {
+	if err == nil {
+		WriteI32(offset, 0)
+		return
+	}
+	WriteObject(offset, encoder.Serialize(err.Error()))
+}
+
+// GetPrintableError returns the message of the error located at `offset`,
+// or `<nil>`.
+func GetPrintableError(offset int) string {
+	err := ReadError(offset)
+	if err == nil {
+		return "<nil>"
+	}
+	return err.Error()
+}
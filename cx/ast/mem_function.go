@@ -0,0 +1,247 @@
+package ast
+
+import (
+	"strings"
+
+	"github.com/skycoin/cx/cx/constants"
+	"github.com/skycoin/cx/cx/helper"
+)
+
+// Offsets of the fields of a function value.
+const (
+	functionEnvOffset = 0
+	functionTagOffset = constants.TYPE_POINTER_SIZE
+)
+
+// FunctionTag returns the tag identifying the function `fn` in function
+// values. Tags start at 1, as 0 is the tag of nil functions.
+func FunctionTag(fn *CXFunction) int32 {
+	for p, pkg := range PROGRAM.Packages {
+		for f, pkgFn := range pkg.Functions {
+			if pkgFn == fn {
+				return int32(p<<16|f) + 1
+			}
+		}
+	}
+	panic(constants.CX_RUNTIME_INVALID_ARGUMENT)
+}
+
+// FunctionOfTag returns the function identified by `tag`, or nil if it's
+// the tag of a nil function.
+func FunctionOfTag(tag int32) *CXFunction {
+	if tag == 0 {
+		return nil
+	}
+	tag--
+	return PROGRAM.Packages[tag>>16].Functions[tag&0xffff]
+}
+
+// MakeFunctionValue returns a function value holding the function `fn` and
+// the closure environment referenced by `env`.
+func MakeFunctionValue(fn *CXFunction, env int32) []byte {
+	value := make([]byte, constants.FUNC_SIZE)
+	WriteMemI32(value, functionEnvOffset, env)
+	WriteMemI32(value, functionTagOffset, FunctionTag(fn))
+	return value
+}
+
+// getFunctionValue returns the reference to the closure environment and the
+// tag of the function value located at `offset`.
+func getFunctionValue(offset int) (env int32, tag int32) {
+	env = helper.Deserialize_i32(PROGRAM.Memory[offset+functionEnvOffset : offset+functionEnvOffset+constants.TYPE_POINTER_SIZE])
+	tag = helper.Deserialize_i32(PROGRAM.Memory[offset+functionTagOffset : offset+functionTagOffset+4])
+	return env, tag
+}
+
+// GetFunctionValue returns the function held by the function value located
+// at `offset` and the reference to its closure environment.
+func GetFunctionValue(offset int) (*CXFunction, int32) {
+	env, tag := getFunctionValue(offset)
+	if tag == 0 {
+		// calling a nil function
+		panic(constants.CX_RUNTIME_INVALID_ARGUMENT)
+	}
+	return FunctionOfTag(tag), env
+}
+
+// IsFunctionValueCall checks if `fn` is the caller of function values of a
+// signature. These callers don't have a body, and calls to them are
+// dispatched at runtime to the function held by their first input.
+func (fn *CXFunction) IsFunctionValueCall() bool {
+	if fn.Length > 0 || len(fn.Inputs) == 0 {
+		return false
+	}
+	return fn.Inputs[0].Type == constants.TYPE_FUNC && strings.HasPrefix(fn.Name, constants.TypeNames[constants.TYPE_FUNC]+"(")
+}
+
+// WriteClosureEnv writes the references to the cells of the variables
+// captured by `fn`, held by the closure environment referenced by `env`,
+// to the stack frame starting at `fp`.
+func WriteClosureEnv(fp int, fn *CXFunction, env int32) {
+	for i, capture := range fn.Captures {
+		cell := int(env) + constants.OBJECT_HEADER_SIZE + i*constants.TYPE_POINTER_SIZE
+		WriteMemory(fp+capture.Offset, PROGRAM.Memory[cell:cell+constants.TYPE_POINTER_SIZE])
+	}
+}
+
+// GetPrintableFunction returns the name of the function held by the function
+// value located at `offset`, or `<nil>`.
+func GetPrintableFunction(offset int) string {
+	_, tag := getFunctionValue(offset)
+	if tag == 0 {
+		return "<nil>"
+	}
+	return FunctionOfTag(tag).Name
+}
+
+// holdsReference checks if the values of type `arg` hold references to
+// objects on the heap.
+func holdsReference(arg *CXArgument) bool {
+	return arg.IsPointer || arg.IsSlice || arg.IsMap || arg.Type == constants.TYPE_STR || arg.Type == constants.TYPE_ERROR ||
+		arg.Type == constants.TYPE_INTERFACE || arg.Type == constants.TYPE_FUNC
+}
+
+// FunctionRoot is a function value held outside the memory of the program,
+// such as the handler of an HTTP server. The garbage collector keeps its
+// closure environment alive and updates `Env` when moving it.
+type FunctionRoot struct {
+	Env int32
+	tag int32
+}
+
+// functionRoots are the function values registered by AddFunctionRoot.
+var functionRoots []*FunctionRoot
+
+// AddFunctionRoot copies the function value located at `offset` out of the
+// memory of the program, and registers the copy as a root of the garbage
+// collector.
+func AddFunctionRoot(offset int) *FunctionRoot {
+	env, tag := getFunctionValue(offset)
+	if tag == 0 {
+		// registering a nil function
+		panic(constants.CX_RUNTIME_INVALID_ARGUMENT)
+	}
+	root := &FunctionRoot{Env: env, tag: tag}
+	functionRoots = append(functionRoots, root)
+	return root
+}
+
+// Function returns the function held by `root`.
+func (root *FunctionRoot) Function() *CXFunction {
+	return FunctionOfTag(root.tag)
+}
+
+// markFunctionValue marks the closure environment of the function value
+// located at `offset`, and the cells of the variables captured by it.
+func markFunctionValue(prgrm *CXProgram, offset int) {
+	env, tag := getFunctionValue(offset)
+	markClosureEnv(prgrm, env, tag)
+}
+
+// markClosureEnv marks the closure environment referenced by `env`, which
+// belongs to a function value with tag `tag`, and the cells of the
+// variables captured by it. Closures can reference themselves through their
+// captures, so environments already marked are not traversed again.
+func markClosureEnv(prgrm *CXProgram, env, tag int32) {
+	if int(env) <= prgrm.HeapStartsAt || prgrm.Memory[env] == 1 {
+		return
+	}
+	Mark(prgrm, env)
+
+	for i, capture := range FunctionOfTag(tag).Captures {
+		markCell(prgrm, int(env)+constants.OBJECT_HEADER_SIZE+i*constants.TYPE_POINTER_SIZE, capture)
+	}
+}
+
+// markCell marks the cell referenced by the pointer located at `offset`,
+// which holds the escaped variable `v`, and the objects referenced by the
+// value of the variable.
+func markCell(prgrm *CXProgram, offset int, v *CXArgument) {
+	cell := helper.Deserialize_i32(prgrm.Memory[offset : offset+constants.TYPE_POINTER_SIZE])
+	if int(cell) <= prgrm.HeapStartsAt || prgrm.Memory[cell] == 1 {
+		return
+	}
+	Mark(prgrm, cell)
+	markValue(prgrm, int(cell)+constants.OBJECT_HEADER_SIZE, v)
+}
+
+// markValue marks the objects referenced by the value of the variable `v`
+// located at `offset`.
+func markValue(prgrm *CXProgram, offset int, v *CXArgument) {
+	if holdsReference(v) {
+		MarkObjectsTree(prgrm, offset, v.Type, v.DeclarationSpecifiers[1:])
+		if !v.IsPointer || v.CustomType == nil {
+			return
+		}
+		// Then the fields of the struct instance referenced by the
+		// variable can reference other objects.
+		offset = int(helper.Deserialize_i32(prgrm.Memory[offset:offset+constants.TYPE_POINTER_SIZE])) + constants.OBJECT_HEADER_SIZE
+		if offset <= prgrm.HeapStartsAt+constants.OBJECT_HEADER_SIZE {
+			return
+		}
+	}
+	if v.CustomType != nil {
+		for _, fld := range v.CustomType.Fields {
+			if holdsReference(fld) {
+				MarkObjectsTree(prgrm, offset+fld.Offset, fld.Type, fld.DeclarationSpecifiers[1:])
+			}
+		}
+	}
+}
+
+// updatedCells keeps a record of the closure environments and cells already
+// traversed while updating the references to an object moved by the
+// garbage collector.
+var updatedCells = map[int32]bool{}
+
+// updateFunctionValue updates the references to the object moved from
+// `oldAddr` to `newAddr` held by the closure environment referenced by
+// `env`, which belongs to a function value with tag `tag`.
+func updateFunctionValue(prgrm *CXProgram, env, tag, oldAddr, newAddr int32) {
+	if int(env) <= prgrm.HeapStartsAt || updatedCells[env] {
+		return
+	}
+	updatedCells[env] = true
+
+	for i, capture := range FunctionOfTag(tag).Captures {
+		updateCell(prgrm, int(env)+constants.OBJECT_HEADER_SIZE+i*constants.TYPE_POINTER_SIZE, oldAddr, newAddr, capture)
+	}
+}
+
+// updateCell updates the references to the object moved from `oldAddr` to
+// `newAddr` held by the pointer located at `offset`, which references the
+// cell of the escaped variable `v`, and by the value of the variable.
+func updateCell(prgrm *CXProgram, offset int, oldAddr, newAddr int32, v *CXArgument) {
+	cell := helper.Deserialize_i32(prgrm.Memory[offset : offset+constants.TYPE_POINTER_SIZE])
+	if cell == oldAddr {
+		// The cell is updated, but it wasn't moved yet.
+		updatePointer(prgrm, offset, newAddr)
+	}
+	if int(cell) <= prgrm.HeapStartsAt || updatedCells[cell] {
+		return
+	}
+	updatedCells[cell] = true
+	updateValue(prgrm, int(cell)+constants.OBJECT_HEADER_SIZE, oldAddr, newAddr, v)
+}
+
+// updateValue updates the references to the object moved from `oldAddr` to
+// `newAddr` held by the value of the variable `v` located at `offset`.
+func updateValue(prgrm *CXProgram, offset int, oldAddr, newAddr int32, v *CXArgument) {
+	if holdsReference(v) {
+		obj := helper.Deserialize_i32(prgrm.Memory[offset : offset+constants.TYPE_POINTER_SIZE])
+		updatePointerTree(prgrm, offset, oldAddr, newAddr, v.Type, v.DeclarationSpecifiers[1:])
+		if !v.IsPointer || v.CustomType == nil || int(obj) <= prgrm.HeapStartsAt {
+			return
+		}
+		// Then the fields of the struct instance referenced by the
+		// variable can reference the moved object.
+		offset = int(obj) + constants.OBJECT_HEADER_SIZE
+	}
+	if v.CustomType != nil {
+		for _, fld := range v.CustomType.Fields {
+			if holdsReference(fld) {
+				updatePointerTree(prgrm, offset+fld.Offset, oldAddr, newAddr, fld.Type, fld.DeclarationSpecifiers[1:])
+			}
+		}
+	}
+}
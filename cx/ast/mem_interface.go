@@ -0,0 +1,312 @@
+package ast
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/skycoin/cx/cx/constants"
+	"github.com/skycoin/cx/cx/helper"
+)
+
+// Offsets of the fields of an interface value.
+const (
+	interfacePointerOffset = 0
+	interfaceTagOffset     = constants.TYPE_POINTER_SIZE
+)
+
+// interfacePointerTag is set in the type tags of interfaces holding a
+// reference to a struct instance (`*T`) rather than the instance (`T`).
+const interfacePointerTag = 1 << 30
+
+// interfaceMethodKey identifies the method of a struct type that implements
+// the method of an interface.
+type interfaceMethodKey struct {
+	method *CXFunction
+	strct  *CXStruct
+}
+
+// interfaceMethod is a method resolved by ResolveInterfaceMethod, and the
+// offset of its receiver in the struct instances implementing the interface,
+// which isn't 0 if the method is promoted from an embedded struct.
+type interfaceMethod struct {
+	method *CXFunction
+	offset int32
+}
+
+// interfaceMethods caches the methods resolved by ResolveInterfaceMethod.
+var interfaceMethods = map[interfaceMethodKey]interfaceMethod{}
+
+// IsInterfaceMethod checks if `fn` is the method of an interface. These
+// methods don't have a body, and calls to them are dispatched at runtime to
+// the method of the struct instance held by their receiver.
+func (fn *CXFunction) IsInterfaceMethod() bool {
+	if fn.Length > 0 || len(fn.Inputs) == 0 {
+		return false
+	}
+	iface := fn.Inputs[0].CustomType
+	return iface != nil && iface.IsInterface && strings.HasPrefix(fn.Name, iface.Name+".")
+}
+
+// InterfaceMethodName returns the name of the interface method `fn` without
+// the name of its interface, e.g. `Area` for `Shape.Area`.
+func InterfaceMethodName(fn *CXFunction) string {
+	return fn.Name[len(fn.Inputs[0].CustomType.Name)+1:]
+}
+
+// GetInterfaceMethods returns the methods of the interface `iface`.
+func GetInterfaceMethods(iface *CXStruct) []*CXFunction {
+	var methods []*CXFunction
+	for _, fn := range iface.Package.Functions {
+		if len(fn.Inputs) > 0 && fn.Inputs[0].CustomType == iface && fn.IsInterfaceMethod() {
+			methods = append(methods, fn)
+		}
+	}
+	return methods
+}
+
+// IsUnion checks if `strct` is a union, an interface whose values can only
+// hold instances of its variants.
+func (strct *CXStruct) IsUnion() bool {
+	return strct.Variants != nil
+}
+
+// Implements checks if the struct or interface type `strct` has every method
+// of the interface `iface`, with the same parameters, and returns an error
+// describing the first method that is missing otherwise. Methods promoted
+// from embedded structs count as methods of `strct`. If `iface` is a union,
+// it checks if `strct` is one of its variants instead.
+func Implements(strct, iface *CXStruct) error {
+	if iface.IsUnion() {
+		for _, variant := range iface.Variants {
+			if variant == strct {
+				return nil
+			}
+		}
+		return fmt.Errorf("'%s' is not a variant", strct.Name)
+	}
+	for _, method := range GetInterfaceMethods(iface) {
+		name := InterfaceMethodName(method)
+		impl, _, err := getMethod(strct, name)
+		if err != nil {
+			return err
+		}
+		if !sameParameters(impl.Inputs[1:], method.Inputs[1:]) || !sameParameters(impl.Outputs, method.Outputs) {
+			return fmt.Errorf("wrong type for method '%s'", name)
+		}
+	}
+	return nil
+}
+
+// getMethod returns the method `name` of `strct`, either declared by `strct`
+// or promoted from one of its embedded structs, and the offset of the
+// embedded struct receiving it in the instances of `strct`. Promoted methods
+// are hidden and ambiguous as in GetPromoted.
+func getMethod(strct *CXStruct, name string) (*CXFunction, int, error) {
+	if method, err := strct.Package.GetMethod(strct.Name+"."+name, strct.Name); err == nil {
+		return method, 0, nil
+	}
+
+	path, err := strct.GetPromoted(name)
+	if err != nil {
+		return nil, 0, err
+	}
+	if _, fldErr := strct.GetField(name); fldErr == nil || path == nil {
+		return nil, 0, fmt.Errorf("missing method '%s'", name)
+	}
+
+	var offset int
+	for _, emb := range path {
+		offset += emb.Offset
+	}
+	recv := path[len(path)-1].CustomType
+	method, err := recv.Package.GetMethod(recv.Name+"."+name, recv.Name)
+	if err != nil {
+		// `name` is a promoted field
+		return nil, 0, fmt.Errorf("missing method '%s'", name)
+	}
+	return method, offset, nil
+}
+
+// sameParameters checks if the parameters `a` and `b` have the same types.
+func sameParameters(a, b []*CXArgument) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if GetFormattedType(a[i]) != GetFormattedType(b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// InterfaceTypeTag returns the tag identifying the type `strct`, or `*strct`
+// if `isPointer` is true, in interface values. Tags start at 1, as 0 is the
+// tag of nil interfaces.
+func InterfaceTypeTag(strct *CXStruct, isPointer bool) int32 {
+	for p, pkg := range PROGRAM.Packages {
+		for s, st := range pkg.Structs {
+			if st == strct {
+				tag := int32(p<<16|s) + 1
+				if isPointer {
+					tag |= interfacePointerTag
+				}
+				return tag
+			}
+		}
+	}
+	panic(constants.CX_RUNTIME_INVALID_ARGUMENT)
+}
+
+// InterfaceDynamicType returns the struct type identified by `tag`, or nil
+// if it's the tag of a nil interface, and whether the interface holds a
+// reference to an instance of that type.
+func InterfaceDynamicType(tag int32) (strct *CXStruct, isPointer bool) {
+	if tag == 0 {
+		return nil, false
+	}
+	isPointer = tag&interfacePointerTag != 0
+	tag = tag&^interfacePointerTag - 1
+	return PROGRAM.Packages[tag>>16].Structs[tag&0xffff], isPointer
+}
+
+// GetInterface returns the reference and the type tag of the interface value
+// located at `offset`.
+func GetInterface(offset int) (ptr int32, tag int32) {
+	ptr = helper.Deserialize_i32(PROGRAM.Memory[offset+interfacePointerOffset : offset+interfacePointerOffset+constants.TYPE_POINTER_SIZE])
+	tag = helper.Deserialize_i32(PROGRAM.Memory[offset+interfaceTagOffset : offset+interfaceTagOffset+4])
+	return ptr, tag
+}
+
+// InterfaceValueOffset returns the absolute offset of the struct instance
+// referenced by `ptr`, the reference of an interface value. Struct instances
+// boxed by MakeInterface live on the heap, after an object header.
+func InterfaceValueOffset(ptr int32) int {
+	if int(ptr) >= PROGRAM.HeapStartsAt {
+		return int(ptr) + constants.OBJECT_HEADER_SIZE
+	}
+	return int(ptr)
+}
+
+// MakeInterface returns an interface value holding `arg`, located at
+// `offset`. Struct instances are copied to a new object on the heap,
+// while references to them (`&s` or pointers) are held as they are.
+// If `arg` is an interface, its value is returned unchanged.
+func MakeInterface(arg *CXArgument, offset int) []byte {
+	elt := GetAssignmentElement(arg)
+	value := make([]byte, constants.INTERFACE_SIZE)
+
+	var ptr int32
+	var isPointer bool
+	switch {
+	case arg.PassBy == constants.PASSBY_REFERENCE:
+		ptr = int32(offset)
+		isPointer = true
+	case elt.IsPointer:
+		ptr = helper.Deserialize_i32(PROGRAM.Memory[offset : offset+constants.TYPE_POINTER_SIZE])
+		if ptr == 0 {
+			// a nil pointer makes a nil interface
+			return value
+		}
+		isPointer = true
+	case elt.Type == constants.TYPE_INTERFACE:
+		copy(value, PROGRAM.Memory[offset:offset+constants.INTERFACE_SIZE])
+		return value
+	default:
+		// Instances in the heap are copied before allocating, as the
+		// allocation can trigger the garbage collector and move them.
+		// Other instances are copied after it, as the garbage collector
+		// can update the references in their fields.
+		size := elt.CustomType.Size
+		obj := make([]byte, constants.OBJECT_HEADER_SIZE+size)
+		WriteMemI32(obj, 5, int32(len(obj)))
+		if offset >= PROGRAM.HeapStartsAt {
+			copy(obj[constants.OBJECT_HEADER_SIZE:], PROGRAM.Memory[offset:offset+size])
+		}
+
+		ptr = int32(AllocateSeq(len(obj)))
+		if offset < PROGRAM.HeapStartsAt {
+			copy(obj[constants.OBJECT_HEADER_SIZE:], PROGRAM.Memory[offset:offset+size])
+		}
+		WriteMemory(int(ptr), obj)
+	}
+
+	WriteMemI32(value, interfacePointerOffset, ptr)
+	WriteMemI32(value, interfaceTagOffset, InterfaceTypeTag(elt.CustomType, isPointer))
+	return value
+}
+
+// ResolveInterfaceMethod returns the method called by a call to the interface
+// method `fn` whose receiver is the interface located at `offset`, and the
+// reference to the struct instance that will be its receiver, which is the
+// embedded struct declaring the method if it's promoted.
+func ResolveInterfaceMethod(fn *CXFunction, offset int) (*CXFunction, int32) {
+	ptr, tag := GetInterface(offset)
+	if tag == 0 {
+		// calling a method of a nil interface
+		panic(constants.CX_RUNTIME_INVALID_ARGUMENT)
+	}
+
+	strct, _ := InterfaceDynamicType(tag)
+	key := interfaceMethodKey{method: fn, strct: strct}
+	resolved, found := interfaceMethods[key]
+	if !found {
+		method, offset, err := getMethod(strct, InterfaceMethodName(fn))
+		if err != nil {
+			panic(constants.CX_RUNTIME_INVALID_ARGUMENT)
+		}
+		resolved = interfaceMethod{method: method, offset: int32(offset)}
+		interfaceMethods[key] = resolved
+	}
+
+	// references to the fields of objects on the heap are relative to the
+	// objects' headers, like the references to the objects
+	return resolved.method, ptr + resolved.offset
+}
+
+// WriteInterfaceReceiver writes the receiver of `method`, the struct instance
+// referenced by `ptr`, to the stack frame starting at `fp`. Methods with
+// pointer receivers receive the reference itself.
+func WriteInterfaceReceiver(fp int, method *CXFunction, ptr int32) {
+	recv := method.Inputs[0]
+	if recv.IsPointer {
+		WriteMemI32(PROGRAM.Memory, GetFinalOffset(fp, recv), ptr)
+		return
+	}
+
+	offset := InterfaceValueOffset(ptr)
+	WriteMemory(GetFinalOffset(fp, recv), PROGRAM.Memory[offset:offset+recv.CustomType.Size])
+}
+
+// interfaceObject returns the object on the heap holding the struct instance
+// of the interface located at `offset` and the type of that instance. It
+// returns 0 and nil if the interface is nil or doesn't reference the heap.
+func interfaceObject(offset int) (int32, *CXStruct) {
+	ptr, tag := GetInterface(offset)
+	if tag == 0 || int(ptr) < PROGRAM.HeapStartsAt {
+		return 0, nil
+	}
+	strct, _ := InterfaceDynamicType(tag)
+	return ptr, strct
+}
+
+// GetPrintableInterface returns the struct instance held by the interface
+// located at `offset`, prefixed by `&` if it's held by reference, or `<nil>`.
+func GetPrintableInterface(offset int) string {
+	ptr, tag := GetInterface(offset)
+	if tag == 0 {
+		return "<nil>"
+	}
+
+	strct, isPointer := InterfaceDynamicType(tag)
+	offset = InterfaceValueOffset(ptr)
+	flds := make([]string, len(strct.Fields))
+	for i, fld := range strct.Fields {
+		flds[i] = fmt.Sprintf("%s: %s", fld.ArgDetails.Name, GetPrintableValue(offset, fld))
+	}
+	val := fmt.Sprintf("%s{%s}", strct.Name, strings.Join(flds, ", "))
+	if isPointer {
+		return "&" + val
+	}
+	return val
+}
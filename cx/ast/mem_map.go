@@ -0,0 +1,708 @@
+package ast
+
+import (
+	"fmt"
+	"hash/fnv"
+	"sort"
+	"strings"
+
+	"github.com/skycoin/cx/cx/constants"
+	"github.com/skycoin/cx/cx/helper"
+)
+
+// Offsets of the fields of a map header.
+const (
+	mapLenOffset       = 0
+	mapCapOffset       = 4
+	mapKeySizeOffset   = 8
+	mapValueSizeOffset = 12
+	mapFlagsOffset     = 16
+	mapValueTypeOffset = 20
+	mapKeyTypeOffset   = 24
+)
+
+// IsMapElement checks if `arg` is an element of a map, i.e. if it is a map
+// being indexed.
+func IsMapElement(arg *CXArgument) bool {
+	for _, deref := range arg.DereferenceOperations {
+		if deref == constants.DEREF_MAP || deref == constants.DEREF_MAP_ASSIGN {
+			return true
+		}
+	}
+	return false
+}
+
+// A map is a reference to a handle, an object holding a reference to the
+// storage of the map, the object with its header and entries. Growing a map
+// moves its entries to a new storage and updates the handle, so all the
+// copies of the map, such as the parameters of functions, share its entries.
+const mapHandleSize = constants.OBJECT_HEADER_SIZE + constants.TYPE_POINTER_SIZE
+
+// mapStorage returns the absolute offset of the storage of the map whose
+// handle is located at `handle`, or 0 if the map is nil or has no entries
+// yet.
+func mapStorage(handle int32) int32 {
+	if handle == 0 {
+		return 0
+	}
+	return helper.Deserialize_i32(PROGRAM.Memory[handle+constants.OBJECT_HEADER_SIZE : handle+constants.OBJECT_HEADER_SIZE+constants.TYPE_POINTER_SIZE])
+}
+
+// setMapStorage makes the handle located at `handle` reference the storage
+// located at `storage`.
+func setMapStorage(handle int32, storage int32) {
+	WriteMemI32(PROGRAM.Memory, int(handle)+constants.OBJECT_HEADER_SIZE, storage)
+}
+
+// MakeMapHandle allocates the handle of the map referenced at `ptrOffset` if
+// the map is nil, so the entries inserted in its copies are inserted in it
+// too, and returns the handle.
+func MakeMapHandle(ptrOffset int) int32 {
+	if handle := GetPointerOffset(int32(ptrOffset)); handle != 0 {
+		return handle
+	}
+
+	obj := make([]byte, mapHandleSize)
+	WriteMemI32(obj, 5, int32(len(obj)))
+	handle := int32(AllocateSeq(len(obj)))
+	WriteMemory(int(handle), obj)
+	WriteMemI32(PROGRAM.Memory, ptrOffset, handle)
+	return handle
+}
+
+// GetMapHeader ...
+func GetMapHeader(offset int32) []byte {
+	return PROGRAM.Memory[offset+constants.OBJECT_HEADER_SIZE : offset+constants.OBJECT_HEADER_SIZE+constants.MAP_HEADER_SIZE]
+}
+
+func getMapHeaderField(offset int32, field int) int32 {
+	return helper.Deserialize_i32(GetMapHeader(offset)[field : field+4])
+}
+
+func setMapHeaderField(offset int32, field int, value int32) {
+	WriteMemI32(GetMapHeader(offset), field, value)
+}
+
+// GetMapLen returns the number of entries of the map whose handle is located
+// at `handle`.
+func GetMapLen(handle int32) int32 {
+	return mapLen(mapStorage(handle))
+}
+
+// mapLen returns the number of entries of the map storage located at
+// `offset`.
+func mapLen(offset int32) int32 {
+	if offset == 0 {
+		return 0
+	}
+	return getMapHeaderField(offset, mapLenOffset)
+}
+
+// mapEntrySize returns the size of each entry of the map located at `offset`.
+func mapEntrySize(offset int32) int32 {
+	return constants.MAP_ENTRY_MARK_SIZE + getMapHeaderField(offset, mapKeySizeOffset) + getMapHeaderField(offset, mapValueSizeOffset)
+}
+
+// mapZeroValueOffset returns the absolute offset of the value read from the
+// map located at `offset` when a key is not found.
+func mapZeroValueOffset(offset int32) int {
+	return int(offset) + constants.OBJECT_HEADER_SIZE + constants.MAP_HEADER_SIZE
+}
+
+// mapEntryOffset returns the absolute offset of the entry number `idx` of the
+// map located at `offset`.
+func mapEntryOffset(offset int32, idx int32) int {
+	return mapZeroValueOffset(offset) + int(getMapHeaderField(offset, mapValueSizeOffset)+idx*mapEntrySize(offset))
+}
+
+// mapValueOffset returns the absolute offset of the value of the entry number
+// `idx` of the map located at `offset`.
+func mapValueOffset(offset int32, idx int32) int {
+	return mapEntryOffset(offset, idx) + constants.MAP_ENTRY_MARK_SIZE + int(getMapHeaderField(offset, mapKeySizeOffset))
+}
+
+// mapFlags returns the `MAP_*_IS_POINTER` flags of the map `arg`.
+func mapFlags(arg *CXArgument) int32 {
+	var flags int32
+	if arg.MapKeyType == constants.TYPE_STR || arg.MapKey != nil && len(keyStrOffsets(arg.MapKey)) > 0 {
+		flags |= constants.MAP_KEY_IS_POINTER
+	}
+	if arg.MapValue.IsSlice || arg.MapValue.Type == constants.TYPE_STR {
+		flags |= constants.MAP_VALUE_IS_POINTER
+	}
+	return flags
+}
+
+// mapValueType returns the type tag of the struct values of the map `arg`,
+// or 0 if they aren't struct instances.
+func mapValueType(arg *CXArgument) int32 {
+	if arg.MapValue.IsSlice || arg.MapValue.CustomType == nil {
+		return 0
+	}
+	return InterfaceTypeTag(arg.MapValue.CustomType, false)
+}
+
+// mapKeyType returns the type tag of the struct keys of the map `arg`, or of
+// the elements of its array keys, or 0 if the keys don't hold struct instances.
+func mapKeyType(arg *CXArgument) int32 {
+	if arg.MapKey == nil {
+		return 0
+	}
+	strct := GetAssignmentElement(arg.MapKey).CustomType
+	if strct == nil {
+		return 0
+	}
+	return InterfaceTypeTag(strct, false)
+}
+
+// MapValueSize returns the size of the values of a map, declared by the type
+// specifier `value`.
+func MapValueSize(value *CXArgument) int {
+	switch {
+	case value.IsSlice:
+		return constants.TYPE_POINTER_SIZE
+	case value.CustomType != nil:
+		return value.CustomType.Size
+	}
+	return value.Size
+}
+
+// mapKeySize returns the size of the keys of the map `arg`.
+func mapKeySize(arg *CXArgument) int32 {
+	if arg.MapKey != nil {
+		return int32(ValueSize(arg.MapKey))
+	}
+	return int32(constants.GetArgSize(arg.MapKeyType))
+}
+
+// AllocateMap allocates an empty map object with room for `capacity`
+// entries and returns its absolute offset.
+func AllocateMap(capacity, keySize, valueSize, flags, valueType, keyType int32) int32 {
+	size := constants.OBJECT_HEADER_SIZE + constants.MAP_HEADER_SIZE + valueSize +
+		capacity*(constants.MAP_ENTRY_MARK_SIZE+keySize+valueSize)
+	offset := int32(AllocateSeq(int(size)))
+
+	// The heap can still hold the bytes of collected objects, and unused
+	// entries are recognized by their zeroed mark.
+	obj := PROGRAM.Memory[offset : offset+size]
+	for c := range obj {
+		obj[c] = 0
+	}
+
+	WriteMemI32(GetObjectHeader(offset)[5:9], 0, size)
+	setMapHeaderField(offset, mapCapOffset, capacity)
+	setMapHeaderField(offset, mapKeySizeOffset, keySize)
+	setMapHeaderField(offset, mapValueSizeOffset, valueSize)
+	setMapHeaderField(offset, mapFlagsOffset, flags)
+	setMapHeaderField(offset, mapValueTypeOffset, valueType)
+	setMapHeaderField(offset, mapKeyTypeOffset, keyType)
+
+	return offset
+}
+
+// keyStrOffsets returns the offsets of the strings held by the values of the
+// type of `key`, a key of a map.
+func keyStrOffsets(key *CXArgument) []int {
+	elt := GetAssignmentElement(key)
+	return appendStrOffsets(nil, elt, elt.DeclarationSpecifiers, arrayLengths(elt), 0)
+}
+
+// mapKeyStrOffsets returns the offsets of the strings held by the keys of the
+// map located at `offset`. Keys holding struct instances are instances of the
+// struct type tagged in the map header, or arrays of them. Other keys holding
+// strings are strings or arrays of strings.
+func mapKeyStrOffsets(offset int32) []int {
+	if getMapHeaderField(offset, mapFlagsOffset)&constants.MAP_KEY_IS_POINTER == 0 {
+		return nil
+	}
+
+	keySize := int(getMapHeaderField(offset, mapKeySizeOffset))
+	eltSize := constants.TYPE_POINTER_SIZE
+	eltStrs := []int{0}
+	if tag := getMapHeaderField(offset, mapKeyTypeOffset); tag != 0 {
+		strct, _ := InterfaceDynamicType(tag)
+		eltSize = strct.Size
+		eltStrs = appendFieldStrOffsets(nil, strct, 0)
+	}
+
+	var strs []int
+	for elt := 0; elt < keySize; elt += eltSize {
+		for _, str := range eltStrs {
+			strs = append(strs, elt+str)
+		}
+	}
+	return strs
+}
+
+// appendKeyString appends the string `str`, held by a key, to `data`, the
+// bytes compared and hashed to find the key. Strings are prefixed by their
+// lengths, so different keys holding several strings have different data.
+func appendKeyString(data []byte, str string) []byte {
+	var length [4]byte
+	WriteMemI32(length[:], 0, int32(len(str)))
+	return append(append(data, length[:]...), str...)
+}
+
+// keyData returns the bytes compared and hashed to find `keySlot`, a key
+// holding references to strings at `strs`. Keys are equal if the contents of
+// their strings are, so the references are replaced by these contents.
+func keyData(keySlot []byte, strs []int) string {
+	if len(strs) == 0 {
+		return string(keySlot)
+	}
+
+	var data []byte
+	var c int
+	for _, str := range strs {
+		data = append(data, keySlot[c:str]...)
+		var contents string
+		if strOffset := helper.Deserialize_i32(keySlot[str : str+constants.TYPE_POINTER_SIZE]); strOffset != 0 {
+			contents = ReadStringFromObject(strOffset)
+		}
+		data = appendKeyString(data, contents)
+		c = str + constants.TYPE_POINTER_SIZE
+	}
+	return string(append(data, keySlot[c:]...))
+}
+
+// mapKeyData returns the bytes compared and hashed to find `keySlot`, the
+// key as stored in an entry of the map located at `offset`.
+func mapKeyData(offset int32, keySlot []byte) string {
+	return keyData(keySlot, mapKeyStrOffsets(offset))
+}
+
+// mapKeySlot returns the bytes of the key `key`, as they are stored in a map
+// entry, and the data returned by `mapKeyData` for them.
+func mapKeySlot(fp int, key *CXArgument, offset int) ([]byte, string) {
+	if IsCompositeValue(key) {
+		// struct instances and arrays used as keys are compared by
+		// their bytes and the contents of their strings
+		slot := make([]byte, ValueSize(key))
+		copy(slot, PROGRAM.Memory[offset:offset+len(slot)])
+		return slot, keyData(slot, keyStrOffsets(key))
+	}
+
+	if key.Type == constants.TYPE_STR {
+		var strOffset [constants.TYPE_POINTER_SIZE]byte
+		WriteMemI32(strOffset[:], 0, GetStrOffset(offset, key.ArgDetails.Name))
+		return strOffset[:], string(appendKeyString(nil, ReadStrFromOffset(offset, key)))
+	}
+
+	slot := make([]byte, GetSize(key))
+	copy(slot, PROGRAM.Memory[offset:offset+len(slot)])
+	return slot, string(slot)
+}
+
+func mapHash(key string) int32 {
+	h := fnv.New32a()
+	h.Write([]byte(key))
+	return int32(h.Sum32() & 0x7FFFFFFF)
+}
+
+// mapFind returns the index of the entry of the map located at `offset`
+// holding `key` and true, or the index of the unused entry where `key` would
+// be inserted and false.
+func mapFind(offset int32, key string) (int32, bool) {
+	capacity := getMapHeaderField(offset, mapCapOffset)
+	keySize := int(getMapHeaderField(offset, mapKeySizeOffset))
+
+	for idx := mapHash(key) & (capacity - 1); ; idx = (idx + 1) & (capacity - 1) {
+		entry := mapEntryOffset(offset, idx)
+		if PROGRAM.Memory[entry] == 0 {
+			return idx, false
+		}
+
+		keyStart := entry + constants.MAP_ENTRY_MARK_SIZE
+		if mapKeyData(offset, PROGRAM.Memory[keyStart:keyStart+keySize]) == key {
+			return idx, true
+		}
+	}
+}
+
+// mapGrow moves the entries of the map referenced at `ptrOffset` to a new
+// storage with twice its capacity and returns the offset of the new storage.
+func mapGrow(ptrOffset int) int32 {
+	offset := mapStorage(GetPointerOffset(int32(ptrOffset)))
+	newOffset := AllocateMap(getMapHeaderField(offset, mapCapOffset)*2, getMapHeaderField(offset, mapKeySizeOffset),
+		getMapHeaderField(offset, mapValueSizeOffset), getMapHeaderField(offset, mapFlagsOffset),
+		getMapHeaderField(offset, mapValueTypeOffset), getMapHeaderField(offset, mapKeyTypeOffset))
+
+	// The garbage collector could have moved the map while allocating.
+	handle := GetPointerOffset(int32(ptrOffset))
+	offset = mapStorage(handle)
+
+	capacity := getMapHeaderField(offset, mapCapOffset)
+	keySize := int(getMapHeaderField(offset, mapKeySizeOffset))
+	entrySize := int(mapEntrySize(offset))
+	for c := int32(0); c < capacity; c++ {
+		entry := mapEntryOffset(offset, c)
+		if PROGRAM.Memory[entry] == 0 {
+			continue
+		}
+
+		keyStart := entry + constants.MAP_ENTRY_MARK_SIZE
+		idx, _ := mapFind(newOffset, mapKeyData(offset, PROGRAM.Memory[keyStart:keyStart+keySize]))
+		newEntry := mapEntryOffset(newOffset, idx)
+		copy(PROGRAM.Memory[newEntry:newEntry+entrySize], PROGRAM.Memory[entry:entry+entrySize])
+	}
+	setMapHeaderField(newOffset, mapLenOffset, mapLen(offset))
+
+	setMapStorage(handle, newOffset)
+	return newOffset
+}
+
+// GetMapElementOffset returns the absolute offset of the value stored under
+// the key `key` in the map `arg` referenced at `ptrOffset`. If the key is not
+// found, it is inserted if `assign` is true, and the offset of a zeroed value
+// is returned otherwise. The storages of maps are allocated on their first
+// assignment, as well as the handles of nil maps.
+func GetMapElementOffset(fp int, arg *CXArgument, ptrOffset int, key *CXArgument, assign bool) int {
+	offset := mapStorage(GetPointerOffset(int32(ptrOffset)))
+	if offset == 0 {
+		if !assign {
+			return arg.MapValue.Offset
+		}
+		MakeMapHandle(ptrOffset)
+		offset = AllocateMap(constants.MAP_INIT_CAPACITY, mapKeySize(arg), int32(MapValueSize(arg.MapValue)), mapFlags(arg), mapValueType(arg), mapKeyType(arg))
+		// The garbage collector could have moved the handle while allocating.
+		setMapStorage(GetPointerOffset(int32(ptrOffset)), offset)
+	}
+
+	_, keyData := mapKeySlot(fp, key, GetFinalOffset(fp, key))
+	idx, found := mapFind(offset, keyData)
+	if found {
+		return mapValueOffset(offset, idx)
+	}
+	if !assign {
+		return mapZeroValueOffset(offset)
+	}
+
+	length := mapLen(offset)
+	if (length+1)*4 > getMapHeaderField(offset, mapCapOffset)*3 {
+		offset = mapGrow(ptrOffset)
+		idx, _ = mapFind(offset, keyData)
+	}
+
+	// Reading the key again, as the garbage collector could have moved it.
+	keySlot, _ := mapKeySlot(fp, key, GetFinalOffset(fp, key))
+	entry := mapEntryOffset(offset, idx)
+	PROGRAM.Memory[entry] = 1
+	copy(PROGRAM.Memory[entry+constants.MAP_ENTRY_MARK_SIZE:], keySlot)
+	setMapHeaderField(offset, mapLenOffset, length+1)
+
+	return mapValueOffset(offset, idx)
+}
+
+// MapLookup returns the absolute offset of the value stored under the key
+// `key`, located at `keyOffset`, in the map whose handle is located at
+// `handle`, and whether the key was found. The offset of a zeroed value is
+// returned if it was not.
+func MapLookup(handle int32, key *CXArgument, keyOffset int) (int, bool) {
+	offset := mapStorage(handle)
+	if offset == 0 {
+		return 0, false
+	}
+
+	_, keyData := mapKeySlot(0, key, keyOffset)
+	idx, found := mapFind(offset, keyData)
+	if !found {
+		return mapZeroValueOffset(offset), false
+	}
+	return mapValueOffset(offset, idx), true
+}
+
+// MapDelete removes the key `key`, located at `keyOffset`, from the map whose
+// handle is located at `handle`, if present.
+func MapDelete(handle int32, key *CXArgument, keyOffset int) {
+	offset := mapStorage(handle)
+	if offset == 0 {
+		return
+	}
+
+	_, keyData := mapKeySlot(0, key, keyOffset)
+	idx, found := mapFind(offset, keyData)
+	if !found {
+		return
+	}
+
+	capacity := getMapHeaderField(offset, mapCapOffset)
+	keySize := int(getMapHeaderField(offset, mapKeySizeOffset))
+	entrySize := int(mapEntrySize(offset))
+	clearEntry := func(idx int32) {
+		entry := mapEntryOffset(offset, idx)
+		for c := entry; c < entry+entrySize; c++ {
+			PROGRAM.Memory[c] = 0
+		}
+	}
+	clearEntry(idx)
+
+	// Shifting back the entries that follow the deleted one, so every key
+	// can still be reached from the entry its hash points to.
+	for next := (idx + 1) & (capacity - 1); ; next = (next + 1) & (capacity - 1) {
+		entry := mapEntryOffset(offset, next)
+		if PROGRAM.Memory[entry] == 0 {
+			break
+		}
+
+		keyStart := entry + constants.MAP_ENTRY_MARK_SIZE
+		home := mapHash(mapKeyData(offset, PROGRAM.Memory[keyStart:keyStart+keySize])) & (capacity - 1)
+		if (idx <= next && idx < home && home <= next) || (idx > next && (idx < home || home <= next)) {
+			continue
+		}
+
+		hole := mapEntryOffset(offset, idx)
+		copy(PROGRAM.Memory[hole:hole+entrySize], PROGRAM.Memory[entry:entry+entrySize])
+		clearEntry(next)
+		idx = next
+	}
+
+	setMapHeaderField(offset, mapLenOffset, mapLen(offset)-1)
+}
+
+// MapNextEntry returns the index of the first used entry of the map whose
+// handle is located at `handle` from the entry number `idx`, and the absolute
+// offsets of its key and value, or false if there are no more entries.
+func MapNextEntry(handle int32, idx int32) (int32, int, int, bool) {
+	offset := mapStorage(handle)
+	if offset == 0 {
+		return idx, 0, 0, false
+	}
+
+	capacity := getMapHeaderField(offset, mapCapOffset)
+	for ; idx < capacity; idx++ {
+		entry := mapEntryOffset(offset, idx)
+		if PROGRAM.Memory[entry] != 0 {
+			return idx, entry + constants.MAP_ENTRY_MARK_SIZE, mapValueOffset(offset, idx), true
+		}
+	}
+	return idx, 0, 0, false
+}
+
+// mapReference is a reference to another object stored in an entry of a
+// map, described as the garbage collector expects it.
+type mapReference struct {
+	offset    int
+	baseType  int
+	declSpecs []int
+}
+
+// mapReferences returns the references to other objects held by the map
+// whose handle is located at `handle`, whose values are of the type
+// `valueType` and declared by `valueSpecs`. These are the reference to its
+// storage and the references stored in its entries.
+func mapReferences(handle int32, valueType int, valueSpecs []int) []mapReference {
+	refs := []mapReference{{offset: int(handle) + constants.OBJECT_HEADER_SIZE, baseType: constants.TYPE_UNDEFINED}}
+	offset := mapStorage(handle)
+	if int(offset) <= PROGRAM.HeapStartsAt {
+		return refs
+	}
+	return append(refs, mapEntryReferences(offset, valueType, valueSpecs)...)
+}
+
+// mapEntryReferences returns the references to other objects stored in the
+// entries of the map storage located at `offset`.
+func mapEntryReferences(offset int32, valueType int, valueSpecs []int) []mapReference {
+	flags := getMapHeaderField(offset, mapFlagsOffset)
+	var strct *CXStruct
+	if tag := getMapHeaderField(offset, mapValueTypeOffset); tag != 0 {
+		strct, _ = InterfaceDynamicType(tag)
+	}
+	if flags == 0 && strct == nil {
+		return nil
+	}
+
+	var refs []mapReference
+	keyStrs := mapKeyStrOffsets(offset)
+	capacity := getMapHeaderField(offset, mapCapOffset)
+	keySize := int(getMapHeaderField(offset, mapKeySizeOffset))
+	for c := int32(0); c < capacity; c++ {
+		entry := mapEntryOffset(offset, c)
+		if PROGRAM.Memory[entry] == 0 {
+			continue
+		}
+		keyStart := entry + constants.MAP_ENTRY_MARK_SIZE
+		for _, str := range keyStrs {
+			refs = append(refs, mapReference{offset: keyStart + str, baseType: constants.TYPE_STR})
+		}
+		if flags&constants.MAP_VALUE_IS_POINTER != 0 {
+			refs = append(refs, mapReference{offset: keyStart + keySize, baseType: valueType, declSpecs: valueSpecs})
+		}
+		if strct != nil {
+			for _, fld := range strct.Fields {
+				if IsPointer(fld) {
+					refs = append(refs, mapReference{offset: keyStart + keySize + fld.Offset, baseType: fld.Type, declSpecs: fld.DeclarationSpecifiers[1:]})
+				}
+			}
+		}
+	}
+
+	return refs
+}
+
+// readMapElement returns the Go value of the key or value of type `typ`
+// stored in `data`.
+func readMapElement(typ int, data []byte) interface{} {
+	switch typ {
+	case constants.TYPE_BOOL:
+		return helper.Deserialize_bool(data)
+	case constants.TYPE_STR:
+		if strOffset := helper.Deserialize_i32(data); strOffset != 0 {
+			return ReadStringFromObject(strOffset)
+		}
+		return ""
+	case constants.TYPE_I8:
+		return helper.Deserialize_i8(data)
+	case constants.TYPE_I16:
+		return helper.Deserialize_i16(data)
+	case constants.TYPE_I32:
+		return helper.Deserialize_i32(data)
+	case constants.TYPE_I64:
+		return helper.Deserialize_i64(data)
+	case constants.TYPE_UI8:
+		return helper.Deserialize_ui8(data)
+	case constants.TYPE_UI16:
+		return helper.Deserialize_ui16(data)
+	case constants.TYPE_UI32:
+		return helper.Deserialize_ui32(data)
+	case constants.TYPE_UI64:
+		return helper.Deserialize_ui64(data)
+	case constants.TYPE_F32:
+		return helper.Deserialize_f32(data)
+	case constants.TYPE_F64:
+		return helper.Deserialize_f64(data)
+	default:
+		return data
+	}
+}
+
+// printableMapKey returns the struct instance or array key located at
+// `offset`, whose type is declared by `specs`, as printed in maps.
+func printableMapKey(arg *CXArgument, specs []int, lengths []int, offset int) string {
+	switch specs[len(specs)-1] {
+	case constants.DECL_ARRAY:
+		eltSpecs := specs[:len(specs)-1]
+		eltLengths := lengths[:len(lengths)-1]
+		size := typeSize(arg, eltSpecs, eltLengths)
+		elts := make([]string, lengths[len(lengths)-1])
+		for c := range elts {
+			elts[c] = printableMapKey(arg, eltSpecs, eltLengths, offset+c*size)
+		}
+		return "[" + strings.Join(elts, ", ") + "]"
+	case constants.DECL_STRUCT:
+		flds := make([]string, len(arg.CustomType.Fields))
+		for c, fld := range arg.CustomType.Fields {
+			flds[c] = fmt.Sprintf("%s: %s", fld.ArgDetails.Name, printableMapKey(fld, fld.DeclarationSpecifiers, arrayLengths(fld), offset+fld.Offset))
+		}
+		return "{" + strings.Join(flds, ", ") + "}"
+	}
+	return fmt.Sprintf("%v", readMapElement(arg.Type, PROGRAM.Memory[offset:offset+typeSize(arg, specs, lengths)]))
+}
+
+// printableMapValue returns the slice or struct instance located at `offset`,
+// whose type is declared by `value`, as printed in maps.
+func printableMapValue(value *CXArgument, offset int) string {
+	if value.IsSlice {
+		arg := *value
+		arg.Offset = offset
+		return GetPrintableValue(0, &arg)
+	}
+
+	flds := make([]string, len(value.CustomType.Fields))
+	for c, fld := range value.CustomType.Fields {
+		flds[c] = fmt.Sprintf("%s: %s", fld.ArgDetails.Name, GetPrintableValue(offset, fld))
+	}
+	return "{" + strings.Join(flds, ", ") + "}"
+}
+
+// mapElementLess sorts the keys of maps when printing them.
+func mapElementLess(a, b interface{}) bool {
+	switch x := a.(type) {
+	case bool:
+		return !x && b.(bool)
+	case string:
+		return x < b.(string)
+	case float32:
+		return x < b.(float32)
+	case float64:
+		return x < b.(float64)
+	default:
+		var xs, ys int64
+		var xu, yu uint64
+		switch y := b.(type) {
+		case int8:
+			xs, ys = int64(x.(int8)), int64(y)
+		case int16:
+			xs, ys = int64(x.(int16)), int64(y)
+		case int32:
+			xs, ys = int64(x.(int32)), int64(y)
+		case int64:
+			xs, ys = x.(int64), y
+		case uint8:
+			xu, yu = uint64(x.(uint8)), uint64(y)
+		case uint16:
+			xu, yu = uint64(x.(uint16)), uint64(y)
+		case uint32:
+			xu, yu = uint64(x.(uint32)), uint64(y)
+		case uint64:
+			xu, yu = x.(uint64), y
+		}
+		return xs < ys || xu < yu
+	}
+}
+
+// GetPrintableMap returns the entries of the map `arg`, sorted by key, in
+// the format `map[key:value key:value]`.
+func GetPrintableMap(fp int, arg *CXArgument) string {
+	elt := GetAssignmentElement(arg)
+	offset := mapStorage(GetPointerOffset(int32(GetFinalOffset(fp, arg))))
+	if offset == 0 {
+		return "map[]"
+	}
+
+	type mapEntry struct {
+		key, value interface{}
+	}
+
+	var entries []mapEntry
+	capacity := getMapHeaderField(offset, mapCapOffset)
+	keySize := int(getMapHeaderField(offset, mapKeySizeOffset))
+	valueSize := int(getMapHeaderField(offset, mapValueSizeOffset))
+	for c := int32(0); c < capacity; c++ {
+		entry := mapEntryOffset(offset, c)
+		if PROGRAM.Memory[entry] == 0 {
+			continue
+		}
+
+		keyStart := entry + constants.MAP_ENTRY_MARK_SIZE
+		valueStart := keyStart + keySize
+		var value interface{}
+		if elt.MapValue.IsSlice || elt.MapValue.CustomType != nil {
+			value = printableMapValue(elt.MapValue, valueStart)
+		} else {
+			value = readMapElement(elt.Type, PROGRAM.Memory[valueStart:valueStart+valueSize])
+		}
+		if elt.Enum != nil {
+			value = elt.Enum.MemberName(value.(int32))
+		}
+		var key interface{}
+		if elt.MapKey != nil {
+			key = printableMapKey(elt.MapKey, elt.MapKey.DeclarationSpecifiers, arrayLengths(elt.MapKey), keyStart)
+		} else {
+			key = readMapElement(elt.MapKeyType, PROGRAM.Memory[keyStart:keyStart+keySize])
+		}
+		entries = append(entries, mapEntry{
+			key:   key,
+			value: value,
+		})
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return mapElementLess(entries[i].key, entries[j].key)
+	})
+
+	pairs := make([]string, len(entries))
+	for i, entry := range entries {
+		pairs[i] = fmt.Sprintf("%v:%v", entry.key, entry.value)
+	}
+	return "map[" + strings.Join(pairs, " ") + "]"
+}
@@ -100,6 +100,23 @@ func SliceResizeEx(outputSliceOffset int32, count int32, sizeofElement int) int
 	return int(outputSliceOffset)
 }
 
+// MakeSlice allocates a slice of length `length` and capacity `capacity`,
+// and returns its offset, or 0 if its capacity is 0.
+func MakeSlice(length int32, capacity int32, sizeofElement int) int32 {
+	if capacity == 0 {
+		return 0
+	}
+
+	objectSize := constants.OBJECT_HEADER_SIZE + constants.SLICE_HEADER_SIZE + capacity*int32(sizeofElement)
+	sliceOffset := int32(AllocateSeq(int(objectSize)))
+	WriteMemI32(GetObjectHeader(sliceOffset)[5:9], 0, objectSize)
+
+	sliceHeader := GetSliceHeader(sliceOffset)
+	WriteMemI32(sliceHeader[0:4], 0, capacity)
+	WriteMemI32(sliceHeader[4:8], 0, length)
+	return sliceOffset
+}
+
 // SliceResize ...
 func SliceResize(fp int, out *CXArgument, inp *CXArgument, count int32, sizeofElement int) int {
 	outputSliceOffset := GetSliceOffset(fp, out)
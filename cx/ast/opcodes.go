@@ -10,6 +10,11 @@ var (
 
 	// OpCodes ...
 	OpCodes = map[string]int{}
+
+	// NondeterministicOpCodes holds the natives whose results depend on the
+	// outside world, such as the network or other processes. They are
+	// rejected when running with `cx --deterministic`.
+	NondeterministicOpCodes = map[int]bool{}
 )
 
 var (
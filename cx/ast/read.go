@@ -83,6 +83,20 @@ func ReadStrFromOffset(off int, inp *CXArgument) (out string) {
 	return out
 }
 
+// ReadStrBytes returns the bytes of the string object located at offset
+// `off`, without copying them.
+func ReadStrBytes(off int32) []byte {
+	if off == 0 {
+		return nil
+	}
+	if int(off) > PROGRAM.HeapStartsAt {
+		off += constants.OBJECT_HEADER_SIZE
+	}
+
+	size := helper.Deserialize_i32(PROGRAM.Memory[off : off+constants.STR_HEADER_SIZE])
+	return PROGRAM.Memory[off+constants.STR_HEADER_SIZE : off+constants.STR_HEADER_SIZE+size]
+}
+
 // ReadStringFromObject reads the string located at offset `off`.
 func ReadStringFromObject(off int32) string {
 	var plusOff int32
@@ -105,6 +105,8 @@ func serializeArgument(arg *CXArgument, s *SerializedCXProgram) int {
 		s.Arguments[argOff].DeclarationSpecifiersSize = serializeIntegers(arg.DeclarationSpecifiers, s)
 
 	s.Arguments[argOff].IsSlice = serializeBoolean(arg.IsSlice)
+	s.Arguments[argOff].IsMap = serializeBoolean(arg.IsMap)
+	s.Arguments[argOff].MapKeyType = int64(arg.MapKeyType)
 	s.Arguments[argOff].IsPointer = serializeBoolean(arg.IsPointer)
 	s.Arguments[argOff].IsReference = serializeBoolean(arg.IsReference)
 
@@ -241,6 +243,8 @@ func serializeFunctionArguments(fn *CXFunction, s *SerializedCXProgram) {
 		sFn.InputsOffset, sFn.InputsSize = serializeSliceOfArguments(fn.Inputs, s)
 		sFn.OutputsOffset, sFn.OutputsSize = serializeSliceOfArguments(fn.Outputs, s)
 		sFn.ListOfPointersOffset, sFn.ListOfPointersSize = serializeSliceOfArguments(fn.ListOfPointers, s)
+		sFn.EscapedOffset, sFn.EscapedSize = serializeSliceOfArguments(fn.Escaped, s)
+		sFn.CapturesOffset, sFn.CapturesSize = serializeSliceOfArguments(fn.Captures, s)
 	} else {
 		panic("function reference not found")
 	}
@@ -364,6 +368,7 @@ func serializeStructIntegers(strct *CXStruct, s *SerializedCXProgram) {
 	if off, found := s.StructsMap[strctName]; found {
 		sStrct := &s.Structs[off]
 		sStrct.Size = int64(strct.Size)
+		sStrct.IsInterface = serializeBoolean(strct.IsInterface)
 	} else {
 		panic("struct reference not found")
 	}
@@ -725,6 +730,7 @@ func deserializeStruct(sStrct *serializedStruct, strct *CXStruct, s *SerializedC
 	strct.Name = deserializeString(sStrct.NameOffset, sStrct.NameSize, s)
 	strct.Fields = deserializeArguments(sStrct.FieldsOffset, sStrct.FieldsSize, s, prgrm)
 	strct.Size = int(sStrct.Size)
+	strct.IsInterface = deserializeBool(sStrct.IsInterface)
 	strct.Package = prgrm.Packages[sStrct.PackageOffset]
 }
 
@@ -780,6 +786,8 @@ func deserializeArgument(sArg *serializedArgument, s *SerializedCXProgram, prgrm
 	arg.DeclarationSpecifiers = deserializeIntegers(sArg.DeclarationSpecifiersOffset, sArg.DeclarationSpecifiersSize, s)
 
 	arg.IsSlice = deserializeBool(sArg.IsSlice)
+	arg.IsMap = deserializeBool(sArg.IsMap)
+	arg.MapKeyType = int(sArg.MapKeyType)
 	arg.IsPointer = deserializeBool(sArg.IsPointer)
 	arg.IsReference = deserializeBool(sArg.IsReference)
 	arg.IsStruct = deserializeBool(sArg.IsStruct)
@@ -894,6 +902,8 @@ func deserializeFunction(sFn *serializedFunction, fn *CXFunction, s *SerializedC
 	fn.Inputs = deserializeArguments(sFn.InputsOffset, sFn.InputsSize, s, prgrm)
 	fn.Outputs = deserializeArguments(sFn.OutputsOffset, sFn.OutputsSize, s, prgrm)
 	fn.ListOfPointers = deserializeArguments(sFn.ListOfPointersOffset, sFn.ListOfPointersSize, s, prgrm)
+	fn.Escaped = deserializeArguments(sFn.EscapedOffset, sFn.EscapedSize, s, prgrm)
+	fn.Captures = deserializeArguments(sFn.CapturesOffset, sFn.CapturesSize, s, prgrm)
 	fn.Expressions = deserializeExpressions(sFn.ExpressionsOffset, sFn.ExpressionsSize, s, prgrm)
 	fn.Size = int(sFn.Size)
 	fn.Length = int(sFn.Length)
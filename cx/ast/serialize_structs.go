@@ -75,7 +75,8 @@ type serializedStruct struct {
 	FieldsOffset int64
 	FieldsSize   int64
 
-	Size int64
+	Size        int64
+	IsInterface int64
 
 	PackageOffset int64
 }
@@ -94,6 +95,10 @@ type serializedFunction struct {
 
 	ListOfPointersOffset int64
 	ListOfPointersSize   int64
+	EscapedOffset        int64
+	EscapedSize          int64
+	CapturesOffset       int64
+	CapturesSize         int64
 
 	// We're going to determine this when procesing the expressions. Check serializedExpression type
 	// IsBuiltin                        int64
@@ -141,7 +146,9 @@ type serializedArgument struct {
 	DeclarationSpecifiersOffset int64
 	DeclarationSpecifiersSize   int64
 
-	IsSlice int64
+	IsSlice    int64
+	IsMap      int64
+	MapKeyType int64
 	// IsArray      int64
 	// IsArrayFirst int64
 	IsPointer   int64
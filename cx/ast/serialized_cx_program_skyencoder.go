@@ -187,6 +187,9 @@ func EncodeSizeSerializedCXProgram(obj *SerializedCXProgram) uint64 {
 		// x1.Size
 		i1 += 8
 
+		// x1.IsInterface
+		i1 += 8
+
 		// x1.PackageOffset
 		i1 += 8
 
@@ -248,6 +251,18 @@ func EncodeSizeSerializedCXProgram(obj *SerializedCXProgram) uint64 {
 		// x1.ListOfPointersSize
 		i1 += 8
 
+		// x1.EscapedOffset
+		i1 += 8
+
+		// x1.EscapedSize
+		i1 += 8
+
+		// x1.CapturesOffset
+		i1 += 8
+
+		// x1.CapturesSize
+		i1 += 8
+
 		// x1.CurrentExpressionOffset
 		i1 += 8
 
@@ -362,6 +377,12 @@ func EncodeSizeSerializedCXProgram(obj *SerializedCXProgram) uint64 {
 		// x1.IsSlice
 		i1 += 8
 
+		// x1.IsMap
+		i1 += 8
+
+		// x1.MapKeyType
+		i1 += 8
+
 		// x1.IsPointer
 		i1 += 8
 
@@ -699,6 +720,9 @@ func EncodeSerializedCXProgramToBuffer(buf []byte, obj *SerializedCXProgram) err
 		// x.Size
 		e.Int64(x.Size)
 
+		// x.IsInterface
+		e.Int64(x.IsInterface)
+
 		// x.PackageOffset
 		e.Int64(x.PackageOffset)
 
@@ -776,6 +800,18 @@ func EncodeSerializedCXProgramToBuffer(buf []byte, obj *SerializedCXProgram) err
 		// x.ListOfPointersSize
 		e.Int64(x.ListOfPointersSize)
 
+		// x.EscapedOffset
+		e.Int64(x.EscapedOffset)
+
+		// x.EscapedSize
+		e.Int64(x.EscapedSize)
+
+		// x.CapturesOffset
+		e.Int64(x.CapturesOffset)
+
+		// x.CapturesSize
+		e.Int64(x.CapturesSize)
+
 		// x.CurrentExpressionOffset
 		e.Int64(x.CurrentExpressionOffset)
 
@@ -911,6 +947,12 @@ func EncodeSerializedCXProgramToBuffer(buf []byte, obj *SerializedCXProgram) err
 		// x.IsSlice
 		e.Int64(x.IsSlice)
 
+		// x.IsMap
+		e.Int64(x.IsMap)
+
+		// x.MapKeyType
+		e.Int64(x.MapKeyType)
+
 		// x.IsPointer
 		e.Int64(x.IsPointer)
 
@@ -1615,6 +1657,15 @@ func DecodeSerializedCXProgram(buf []byte, obj *SerializedCXProgram) (uint64, er
 					obj.Structs[z1].Size = i
 				}
 
+				{
+					// obj.Structs[z1].IsInterface
+					i, err := d.Int64()
+					if err != nil {
+						return 0, err
+					}
+					obj.Structs[z1].IsInterface = i
+				}
+
 				{
 					// obj.Structs[z1].PackageOffset
 					i, err := d.Int64()
@@ -1809,6 +1860,42 @@ func DecodeSerializedCXProgram(buf []byte, obj *SerializedCXProgram) (uint64, er
 					obj.Functions[z1].ListOfPointersSize = i
 				}
 
+				{
+					// obj.Functions[z1].EscapedOffset
+					i, err := d.Int64()
+					if err != nil {
+						return 0, err
+					}
+					obj.Functions[z1].EscapedOffset = i
+				}
+
+				{
+					// obj.Functions[z1].EscapedSize
+					i, err := d.Int64()
+					if err != nil {
+						return 0, err
+					}
+					obj.Functions[z1].EscapedSize = i
+				}
+
+				{
+					// obj.Functions[z1].CapturesOffset
+					i, err := d.Int64()
+					if err != nil {
+						return 0, err
+					}
+					obj.Functions[z1].CapturesOffset = i
+				}
+
+				{
+					// obj.Functions[z1].CapturesSize
+					i, err := d.Int64()
+					if err != nil {
+						return 0, err
+					}
+					obj.Functions[z1].CapturesSize = i
+				}
+
 				{
 					// obj.Functions[z1].CurrentExpressionOffset
 					i, err := d.Int64()
@@ -2159,6 +2246,24 @@ func DecodeSerializedCXProgram(buf []byte, obj *SerializedCXProgram) (uint64, er
 					obj.Arguments[z1].IsSlice = i
 				}
 
+				{
+					// obj.Arguments[z1].IsMap
+					i, err := d.Int64()
+					if err != nil {
+						return 0, err
+					}
+					obj.Arguments[z1].IsMap = i
+				}
+
+				{
+					// obj.Arguments[z1].MapKeyType
+					i, err := d.Int64()
+					if err != nil {
+						return 0, err
+					}
+					obj.Arguments[z1].MapKeyType = i
+				}
+
 				{
 					// obj.Arguments[z1].IsPointer
 					i, err := d.Int64()
@@ -11,7 +11,7 @@ func GetSize(arg *CXArgument) int {
 	derefCount := len(arg.DereferenceOperations)
 	if derefCount > 0 {
 		deref := arg.DereferenceOperations[derefCount-1]
-		if deref == constants.DEREF_SLICE || deref == constants.DEREF_ARRAY {
+		if deref == constants.DEREF_SLICE || deref == constants.DEREF_ARRAY || deref == constants.DEREF_MAP || deref == constants.DEREF_MAP_ASSIGN {
 			return arg.Size
 		}
 	}
@@ -2,96 +2,89 @@ package ast
 
 import (
 	"fmt"
+	"strings"
 )
 
+// Traceback levels, selected with the CXTRACEBACK environment variable.
+const (
+	TRACEBACK_NONE   = "none"   // only the error header
+	TRACEBACK_SINGLE = "single" // the call where the error happened
+	TRACEBACK_ALL    = "all"    // every active call
+)
+
+// tracebackMaxFrames is the number of frames printed before eliding the
+// middle of a deep call stack, e.g. after a stack overflow.
+const tracebackMaxFrames = 100
+
 func stackValueHeader(fileName string, fileLine int) string {
 	return fmt.Sprintf("%s:%d", fileName, fileLine)
 }
 
-// PrintStack ...
-func (cxprogram *CXProgram) PrintStack() {
-	fmt.Println()
-	fmt.Println("===Callstack===")
-
-	// we're going backwards in the stack
-	fp := cxprogram.StackPointer
-
-	for c := cxprogram.CallCounter; c >= 0; c-- {
-		op := cxprogram.CallStack[c].Operator
-		fp -= op.Size
-
-		var dupNames []string
-
-		fmt.Printf(">>> %s()\n", op.Name)
-
-		for _, inp := range op.Inputs {
-			fmt.Println("ProgramInput")
-			fmt.Printf("\t%s : %s() : %s\n", stackValueHeader(inp.ArgDetails.FileName, inp.ArgDetails.FileLine), op.Name, GetPrintableValue(fp, inp))
-
-			dupNames = append(dupNames, inp.ArgDetails.Package.Name+inp.ArgDetails.Name)
-		}
+// PrintTraceback prints the active CX calls, innermost first, in a Go-style
+// traceback: the function with its argument values, followed by the file
+// and line of the expression being executed in that call. `level` is one of
+// the TRACEBACK_* constants.
+func (cxprogram *CXProgram) PrintTraceback(level string) {
+	if level == TRACEBACK_NONE || cxprogram.CallCounter < 0 {
+		return
+	}
 
-		for _, out := range op.Outputs {
-			fmt.Println("ProgramOutput")
-			fmt.Printf("\t%s : %s() : %s\n", stackValueHeader(out.ArgDetails.FileName, out.ArgDetails.FileLine), op.Name, GetPrintableValue(fp, out))
+	last := cxprogram.CallCounter
+	if last >= len(cxprogram.CallStack) {
+		last = len(cxprogram.CallStack) - 1
+	}
+	first := 0
+	if level == TRACEBACK_SINGLE {
+		first = last
+	}
 
-			dupNames = append(dupNames, out.ArgDetails.Package.Name+out.ArgDetails.Name)
+	fmt.Println()
+	fmt.Println()
+	for c := last; c >= first; c-- {
+		frames := last - c
+		if last-first+1 > tracebackMaxFrames && frames == tracebackMaxFrames/2 {
+			fmt.Printf("...%d frames elided...\n", last-first+1-tracebackMaxFrames)
+			c = first + tracebackMaxFrames/2
+			continue
 		}
+		cxprogram.printTracebackFrame(&cxprogram.CallStack[c])
+	}
+}
 
-		// fmt.Println("Expressions")
-		exprs := ""
-		for _, expr := range op.Expressions {
-			for _, inp := range expr.Inputs {
-				if inp.ArgDetails.Name == "" || expr.Operator == nil {
-					continue
-				}
-				var dup bool
-				for _, name := range dupNames {
-					if name == inp.ArgDetails.Package.Name+inp.ArgDetails.Name {
-						dup = true
-						break
-					}
-				}
-				if dup {
-					continue
-				}
-
-				// fmt.Println("\t", inp.Name, "\t", ":", "\t", GetPrintableValue(fp, inp))
-				// exprs += fmt.Sprintln("\t", stackValueHeader(inp.FileName, inp.FileLine), "\t", ":", "\t", GetPrintableValue(fp, inp))
-
-				exprs += fmt.Sprintf("\t%s : %s() : %s\n", stackValueHeader(inp.ArgDetails.FileName, inp.ArgDetails.FileLine), ExprOpName(expr), GetPrintableValue(fp, inp))
-
-				dupNames = append(dupNames, inp.ArgDetails.Package.Name+inp.ArgDetails.Name)
-			}
-
-			for _, out := range expr.Outputs {
-				if out.ArgDetails.Name == "" || expr.Operator == nil {
-					continue
-				}
-				var dup bool
-				for _, name := range dupNames {
-					if name == out.ArgDetails.Package.Name+out.ArgDetails.Name {
-						dup = true
-						break
-					}
-				}
-				if dup {
-					continue
-				}
+func (cxprogram *CXProgram) printTracebackFrame(call *CXCall) {
+	fn := call.Operator
+	if fn == nil {
+		return
+	}
 
-				// fmt.Println("\t", out.Name, "\t", ":", "\t", GetPrintableValue(fp, out))
-				// exprs += fmt.Sprintln("\t", stackValueHeader(out.FileName, out.FileLine), ":", GetPrintableValue(fp, out))
+	name := fn.Name
+	if fn.Package != nil {
+		name = fn.Package.Name + "." + fn.Name
+	}
 
-				exprs += fmt.Sprintf("\t%s : %s() : %s\n", stackValueHeader(out.ArgDetails.FileName, out.ArgDetails.FileLine), ExprOpName(expr), GetPrintableValue(fp, out))
+	args := make([]string, 0, len(fn.Inputs))
+	for _, inp := range fn.Inputs {
+		args = append(args, fmt.Sprintf("%s=%s", inp.ArgDetails.Name, tracebackValue(call.FramePointer, inp)))
+	}
+	fmt.Printf("%s(%s)\n", name, strings.Join(args, ", "))
 
-				dupNames = append(dupNames, out.ArgDetails.Package.Name+out.ArgDetails.Name)
-			}
-		}
+	fileName, fileLine := fn.FileName, fn.FileLine
+	if call.Line < len(fn.Expressions) {
+		expr := fn.Expressions[call.Line]
+		fileName, fileLine = expr.FileName, expr.FileLine
+	}
+	fmt.Printf("\t%s\n", stackValueHeader(fileName, fileLine))
+}
 
-		if len(exprs) > 0 {
-			fmt.Println("Expressions\n", exprs)
+// tracebackValue returns the printable value of `arg`, or a placeholder if
+// the value cannot be read, e.g. because the error corrupted it.
+func tracebackValue(fp int, arg *CXArgument) (value string) {
+	defer func() {
+		if r := recover(); r != nil {
+			value = "?"
 		}
-	}
+	}()
+	return GetPrintableValue(fp, arg)
 }
 
 // TODO: Deprecate
@@ -236,6 +236,9 @@ func getNonCollectionValue(fp int, arg, elt *CXArgument, typ string) string {
 	case "i16":
 		return fmt.Sprintf("%v", ReadI16(fp, elt))
 	case "i32":
+		if elt.Enum != nil {
+			return elt.Enum.MemberName(ReadI32(fp, elt))
+		}
 		return fmt.Sprintf("%v", ReadI32(fp, elt))
 	case "i64":
 		return fmt.Sprintf("%v", ReadI64(fp, elt))
@@ -252,6 +255,15 @@ func getNonCollectionValue(fp int, arg, elt *CXArgument, typ string) string {
 	case "f64":
 		return fmt.Sprintf("%v", ReadF64(fp, elt))
 	default:
+		if elt.Type == constants.TYPE_INTERFACE {
+			return GetPrintableInterface(fp)
+		}
+		if elt.Type == constants.TYPE_FUNC {
+			return GetPrintableFunction(fp)
+		}
+		if elt.Type == constants.TYPE_ERROR {
+			return GetPrintableError(fp)
+		}
 		// then it's a struct
 		var val string
 		val = "{"
@@ -284,6 +296,9 @@ func ReadSliceElements(fp int, arg, elt *CXArgument, sliceData []byte, size int,
 	case "i16":
 		return fmt.Sprintf("%v", helper.Deserialize_i16(sliceData[:constants.I16_SIZE]))
 	case "i32":
+		if elt.Enum != nil {
+			return elt.Enum.MemberName(helper.Deserialize_i32(sliceData[:constants.I32_SIZE]))
+		}
 		return fmt.Sprintf("%v", helper.Deserialize_i32(sliceData[:constants.I32_SIZE]))
 	case "i64":
 		return fmt.Sprintf("%v", helper.Deserialize_i64(sliceData[:constants.I64_SIZE]))
@@ -300,6 +315,15 @@ func ReadSliceElements(fp int, arg, elt *CXArgument, sliceData []byte, size int,
 	case "f64":
 		return fmt.Sprintf("%v", helper.Deserialize_f64(sliceData[:constants.F64_SIZE]))
 	default:
+		if elt.Type == constants.TYPE_INTERFACE {
+			return GetPrintableInterface(fp)
+		}
+		if elt.Type == constants.TYPE_FUNC {
+			return GetPrintableFunction(fp)
+		}
+		if elt.Type == constants.TYPE_ERROR {
+			return GetPrintableError(fp)
+		}
 		// then it's a struct
 		var val string
 		val = "{"
@@ -332,6 +356,22 @@ func GetPrintableValue(fp int, arg *CXArgument) string {
 		typ = constants.TypeNames[elt.Type]
 	}
 
+	if elt.IsMap && !IsMapElement(elt) {
+		return GetPrintableMap(fp, arg)
+	}
+
+	if elt.Type == constants.TYPE_INTERFACE && len(elt.Lengths) == 0 {
+		return GetPrintableInterface(GetFinalOffset(fp, arg))
+	}
+
+	if elt.Type == constants.TYPE_FUNC && len(elt.Lengths) == 0 {
+		return GetPrintableFunction(GetFinalOffset(fp, arg))
+	}
+
+	if elt.Type == constants.TYPE_ERROR && len(elt.Lengths) == 0 {
+		return GetPrintableError(GetFinalOffset(fp, arg))
+	}
+
 	if len(elt.Lengths) > 0 {
 		var val string
 		if len(elt.Lengths) == 1 {
@@ -553,12 +593,21 @@ func IsPointer(sym *CXArgument) bool {
 	// if (sym.IsPointer || sym.IsSlice) && sym.ArgDetails.Name != "" {
 	// 	return true
 	// }
-	if (sym.IsPointer || sym.IsSlice) && sym.ArgDetails.Name != "" && len(sym.Fields) == 0 {
+	if (sym.IsPointer || sym.IsSlice || sym.IsMap) && sym.ArgDetails.Name != "" && len(sym.Fields) == 0 {
 		return true
 	}
 	if sym.Type == constants.TYPE_STR && sym.ArgDetails.Name != "" && len(sym.Fields) == 0 {
 		return true
 	}
+	if sym.Type == constants.TYPE_ERROR && sym.ArgDetails.Name != "" && len(sym.Fields) == 0 {
+		return true
+	}
+	if sym.Type == constants.TYPE_INTERFACE && sym.ArgDetails.Name != "" && len(sym.Fields) == 0 {
+		return true
+	}
+	if sym.Type == constants.TYPE_FUNC && sym.ArgDetails.Name != "" && len(sym.Fields) == 0 {
+		return true
+	}
 	// if (sym.Type == TYPE_STR && sym.Name != "") {
 	// 	return true
 	// }
@@ -638,11 +687,17 @@ func GetFormattedName(arg *CXArgument, includePkg bool) string {
 
 // formatParameters returns a string containing a list of the formatted types of
 // each of `params`, enclosed in parethesis. This function is used only when
-// formatting functions as first-class objects.
+// formatting functions as first-class objects. Variadic parameters are
+// formatted as `...T`, so the types of variadic functions don't match the
+// function types, which can't be variadic.
 func formatParameters(params []*CXArgument) string {
 	types := "("
 	for i, param := range params {
-		types += GetFormattedType(param)
+		if param.IsVariadic {
+			types += "..." + strings.TrimPrefix(GetFormattedType(param), "[]")
+		} else {
+			types += GetFormattedType(param)
+		}
 		if i != len(params)-1 {
 			types += ", "
 		}
@@ -654,6 +709,57 @@ func formatParameters(params []*CXArgument) string {
 
 // GetFormattedType builds a string with the CXGO type representation of `arg`.
 func GetFormattedType(arg *CXArgument) string {
+	return formatType(arg, true)
+}
+
+// GetFormattedBasicType is like GetFormattedType, but enum types are
+// represented by their basic type, `i32`, and named types by their
+// underlying types.
+func GetFormattedBasicType(arg *CXArgument) string {
+	return formatType(arg, false)
+}
+
+// ReceiverTypeName returns the name of the type of the receiver `arg` of a
+// method, which is a struct or a named type.
+func ReceiverTypeName(arg *CXArgument) string {
+	if arg.Named != nil {
+		return arg.Named.Name
+	}
+	if arg.CustomType != nil {
+		return arg.CustomType.Name
+	}
+	return ""
+}
+
+// IsNamedType checks if `arg` is of the named type `arg.Named` itself, or of
+// a pointer to it if `orPointer` is true, rather than of a type built from it.
+func IsNamedType(arg *CXArgument, orPointer bool) bool {
+	if arg.Named == nil {
+		return false
+	}
+	specs := arg.DeclarationSpecifiers
+	if orPointer && len(specs) > 0 && specs[len(specs)-1] == constants.DECL_POINTER {
+		specs = specs[:len(specs)-1]
+	}
+	return len(specs) == len(arg.Named.Underlying.DeclarationSpecifiers) && hasNamedSpecifiers(specs, arg.Named)
+}
+
+// hasNamedSpecifiers checks if `specs` start with the declaration specifiers
+// of the named type `named`.
+func hasNamedSpecifiers(specs []int, named *CXNamedType) bool {
+	namedSpecs := named.Underlying.DeclarationSpecifiers
+	if len(specs) < len(namedSpecs) {
+		return false
+	}
+	for i, spec := range namedSpecs {
+		if specs[i] != spec {
+			return false
+		}
+	}
+	return true
+}
+
+func formatType(arg *CXArgument, withEnums bool) string {
 	typ := ""
 	elt := GetAssignmentElement(arg)
 
@@ -661,7 +767,7 @@ func GetFormattedType(arg *CXArgument) string {
 	// used for cases like [5]*[3]i32, where we jump to another decl spec
 	arrDeclCount := len(arg.Lengths) - 1
 	// looping declaration specifiers
-	for _, spec := range elt.DeclarationSpecifiers {
+	for i, spec := range elt.DeclarationSpecifiers {
 		switch spec {
 		case constants.DECL_POINTER:
 			typ = "*" + typ
@@ -672,48 +778,46 @@ func GetFormattedType(arg *CXArgument) string {
 			arrDeclCount--
 		case constants.DECL_SLICE:
 			typ = "[]" + typ
+		case constants.DECL_MAP:
+			typ = fmt.Sprintf("map[%s]%s", formatMapKeyType(elt), typ)
 		case constants.DECL_INDEXING:
 		default:
 			// base type
 			if elt.CustomType != nil {
 				// then it's custom type
 				typ += elt.CustomType.Name
+			} else if elt.Enum != nil && withEnums {
+				typ += elt.Enum.Name
 			} else {
 				// then it's basic type
 				typ += constants.TypeNames[elt.Type]
 
 				// If it's a function, let's add the inputs and outputs.
 				if elt.Type == constants.TYPE_FUNC {
-					if elt.IsLocalDeclaration {
-						// Then it's a local variable, which can be assigned to a
-						// lambda function, for example.
-						typ += formatParameters(elt.Inputs)
-						typ += formatParameters(elt.Outputs)
-					} else {
-						// Then it refers to a named function defined in a package.
-						pkg, err := PROGRAM.GetPackage(arg.ArgDetails.Package.Name)
-						if err != nil {
-							println(CompilationError(elt.ArgDetails.FileName, elt.ArgDetails.FileLine), err.Error())
-							os.Exit(constants.CX_COMPILATION_ERROR)
-						}
-
-						fn, err := pkg.GetFunction(elt.ArgDetails.Name)
-						if err == nil {
-							// println(CompilationError(elt.FileName, elt.FileLine), err.ProgramError())
-							// os.Exit(CX_COMPILATION_ERROR)
-							// Adding list of inputs and outputs types.
-							typ += formatParameters(fn.Inputs)
-							typ += formatParameters(fn.Outputs)
-						}
-					}
+					typ += formatParameters(elt.Inputs)
+					typ += formatParameters(elt.Outputs)
 				}
 			}
 		}
+
+		if withEnums && elt.Named != nil && i+1 == len(elt.Named.Underlying.DeclarationSpecifiers) &&
+			hasNamedSpecifiers(elt.DeclarationSpecifiers, elt.Named) {
+			// then the type built so far is the named type
+			typ = elt.Named.Name
+		}
 	}
 
 	return typ
 }
 
+// formatMapKeyType returns the type of the keys of the map `arg`.
+func formatMapKeyType(arg *CXArgument) string {
+	if arg.MapKey != nil {
+		return GetFormattedType(arg.MapKey)
+	}
+	return constants.TypeNames[arg.MapKeyType]
+}
+
 // SignatureStringOfStruct returns the signature string of a struct.
 func SignatureStringOfStruct(s *CXStruct) string {
 	fields := ""
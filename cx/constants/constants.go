@@ -6,6 +6,7 @@ const STACK_OVERFLOW_ERROR = "stack overflow"
 const HEAP_EXHAUSTED_ERROR = "heap exhausted"
 const MAIN_FUNC = "main"
 const SYS_INIT_FUNC = "*init"
+const PKG_INIT_FUNC = "init"
 const MAIN_PKG = "main"
 const STDLIB_PKG = "stdlib"
 const OS_PKG = "os"
@@ -43,12 +44,46 @@ var MAX_HEAP_SIZE = 67108864 // 64 Mb
 var MIN_HEAP_FREE_RATIO float32 = 0.4
 var MAX_HEAP_FREE_RATIO float32 = 0.7
 
+// ARRAY_BOUNDS_CHECK enables the runtime checks of the indexes of arrays,
+// which can be disabled in release builds.
+var ARRAY_BOUNDS_CHECK = true
+
 const NULL_HEAP_ADDRESS_OFFSET = 4
 const NULL_HEAP_ADDRESS = 0
+
+// NULL_STACK_ADDRESS_OFFSET is where the stack starts. The first bytes aren't
+// used, so no variable has the address of nil.
+const NULL_STACK_ADDRESS_OFFSET = 4
+
 const STR_HEADER_SIZE = 4
 const TYPE_POINTER_SIZE = 4
 const SLICE_HEADER_SIZE = 8
 
+// Map objects start with a header holding their length, capacity, key size,
+// value size, flags and the type tags of their struct values and keys,
+// followed by a zeroed value returned when a key is not found, and then by
+// the entries.
+// Each entry is a used mark followed by the key and the value.
+const MAP_HEADER_SIZE = 28
+const MAP_INIT_CAPACITY = 8
+const MAP_ENTRY_MARK_SIZE = 1
+
+// Flags of map objects, telling the garbage collector which parts of the
+// entries are references to other objects. Keys are flagged if they are
+// strings or hold strings.
+const (
+	MAP_KEY_IS_POINTER   = 1
+	MAP_VALUE_IS_POINTER = 2
+)
+
+// An interface value is a reference to the value it holds followed by a tag
+// identifying the struct type of that value. The tag of nil interfaces is 0.
+const INTERFACE_SIZE = 8
+
+// A function value is a reference to the environment of the closure it holds
+// followed by a tag identifying its function. The tag of nil functions is 0.
+const FUNC_SIZE = 8
+
 const MAX_UINT32 = ^uint32(0)
 const MIN_UINT32 = 0
 const MAX_INT32 = int(MAX_UINT32 >> 1)
@@ -110,6 +145,8 @@ const (
 	CX_RUNTIME_INVALID_ARGUMENT
 	CX_RUNTIME_SLICE_INDEX_OUT_OF_RANGE
 	CX_RUNTIME_NOT_IMPLEMENTED
+	CX_RUNTIME_ARRAY_INDEX_OUT_OF_RANGE
+	CX_RUNTIME_NIL_POINTER_DEREFERENCE
 )
 
 var ErrorStrings map[int]string = map[int]string{
@@ -124,6 +161,8 @@ var ErrorStrings map[int]string = map[int]string{
 	CX_RUNTIME_INVALID_ARGUMENT:         "CX_RUNTIME_INVALID_ARGUMENT",
 	CX_RUNTIME_SLICE_INDEX_OUT_OF_RANGE: "CX_RUNTIME_SLICE_INDEX_OUT_OF_RANGE",
 	CX_RUNTIME_NOT_IMPLEMENTED:          "CX_RUNTIME_NOT_IMPLEMENTED",
+	CX_RUNTIME_ARRAY_INDEX_OUT_OF_RANGE: "CX_RUNTIME_ARRAY_INDEX_OUT_OF_RANGE",
+	CX_RUNTIME_NIL_POINTER_DEREFERENCE:  "CX_RUNTIME_NIL_POINTER_DEREFERENCE",
 }
 
 const (
@@ -136,6 +175,7 @@ const (
 	DECL_INDEXING        // 6
 	DECL_BASIC           // 7
 	DECL_FUNC            // 8
+	DECL_MAP             // 9
 )
 
 /*
@@ -213,12 +253,14 @@ const (
 */
 
 const (
-	DEREF_UNUSED  = iota //reserve zero value, if this value appears, program should crash; should be assert
-	DEREF_ARRAY          // 1
-	DEREF_FIELD          // 2
-	DEREF_POINTER        // 3
-	DEREF_DEREF          // 4
-	DEREF_SLICE          // 5
+	DEREF_UNUSED     = iota //reserve zero value, if this value appears, program should crash; should be assert
+	DEREF_ARRAY             // 1
+	DEREF_FIELD             // 2
+	DEREF_POINTER           // 3
+	DEREF_DEREF             // 4
+	DEREF_SLICE             // 5
+	DEREF_MAP               // 6
+	DEREF_MAP_ASSIGN        // 7
 )
 
 const (
@@ -238,12 +280,14 @@ const (
 	TYPE_UI32
 	TYPE_UI64
 	TYPE_FUNC
+	TYPE_ERROR
 
 	TYPE_CUSTOM
 	TYPE_POINTER
 	TYPE_ARRAY
 	TYPE_SLICE
 	TYPE_IDENTIFIER
+	TYPE_INTERFACE
 	TYPE_COUNT
 )
 
@@ -266,6 +310,7 @@ var TypeCodes map[string]int = map[string]int{
 	"ui64":   TYPE_UI64,
 	"und":    TYPE_UNDEFINED,
 	"func":   TYPE_FUNC,
+	"error":  TYPE_ERROR,
 }
 
 var TypeNames map[int]string = map[int]string{
@@ -285,6 +330,8 @@ var TypeNames map[int]string = map[int]string{
 	TYPE_UI32:       "ui32",
 	TYPE_UI64:       "ui64",
 	TYPE_FUNC:       "func",
+	TYPE_ERROR:      "error",
+	TYPE_INTERFACE:  "interface",
 	TYPE_UNDEFINED:  "und",
 }
 
@@ -303,10 +350,14 @@ func GetArgSize(typ int) int {
 		return 1
 	case TYPE_I16, TYPE_UI16:
 		return 2
-	case TYPE_STR, TYPE_I32, TYPE_UI32, TYPE_F32, TYPE_AFF:
+	case TYPE_STR, TYPE_ERROR, TYPE_I32, TYPE_UI32, TYPE_F32, TYPE_AFF:
 		return 4
 	case TYPE_I64, TYPE_UI64, TYPE_F64:
 		return 8
+	case TYPE_INTERFACE:
+		return INTERFACE_SIZE
+	case TYPE_FUNC:
+		return FUNC_SIZE
 	default:
 		return 4
 		//return -1 // should be panic
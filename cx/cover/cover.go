@@ -0,0 +1,176 @@
+// Package cover reads, writes and renders line coverage profiles of CX
+// programs, as produced by `cx --coverprofile=cover.out`.
+//
+// A profile is a text file starting with a "mode: count" line, followed by
+// one "file:line count" line per coverable source line:
+//
+//	mode: count
+//	tests/test-i32.cx:12 1
+//	tests/test-i32.cx:13 0
+package cover
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Mode is the only mode supported by CX coverage profiles.
+const Mode = "count"
+
+// Profile holds the execution count of every coverable line, per file.
+type Profile struct {
+	Files map[string]map[int]int64
+}
+
+// NewProfile returns an empty profile.
+func NewProfile() *Profile {
+	return &Profile{Files: make(map[string]map[int]int64)}
+}
+
+// AddLine registers `line` of `file` as coverable, adding `count`
+// executions to it.
+func (prof *Profile) AddLine(file string, line int, count int64) {
+	lines, ok := prof.Files[file]
+	if !ok {
+		lines = make(map[int]int64)
+		prof.Files[file] = lines
+	}
+	lines[line] += count
+}
+
+// Merge adds the counts of `other` to `prof`.
+func (prof *Profile) Merge(other *Profile) {
+	for file, lines := range other.Files {
+		for line, count := range lines {
+			prof.AddLine(file, line, count)
+		}
+	}
+}
+
+// FileNames returns the names of the files in the profile, sorted.
+func (prof *Profile) FileNames() []string {
+	names := make([]string, 0, len(prof.Files))
+	for name := range prof.Files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Coverage returns the number of covered lines and the number of coverable
+// lines of `file`.
+func (prof *Profile) Coverage(file string) (covered int, total int) {
+	for _, count := range prof.Files[file] {
+		if count > 0 {
+			covered++
+		}
+		total++
+	}
+	return covered, total
+}
+
+// TotalCoverage returns the percentage of covered lines in the profile.
+func (prof *Profile) TotalCoverage() float64 {
+	var covered, total int
+	for file := range prof.Files {
+		c, t := prof.Coverage(file)
+		covered += c
+		total += t
+	}
+	return percent(covered, total)
+}
+
+// Write writes the profile to `w`.
+func (prof *Profile) Write(w io.Writer) error {
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "mode: %s\n", Mode)
+	for _, file := range prof.FileNames() {
+		lines := prof.Files[file]
+		nums := make([]int, 0, len(lines))
+		for line := range lines {
+			nums = append(nums, line)
+		}
+		sort.Ints(nums)
+		for _, line := range nums {
+			fmt.Fprintf(bw, "%s:%d %d\n", file, line, lines[line])
+		}
+	}
+	return bw.Flush()
+}
+
+// Read parses a profile written by `Write`.
+func Read(r io.Reader) (*Profile, error) {
+	prof := NewProfile()
+	scanner := bufio.NewScanner(r)
+	lineNo := 0
+	for scanner.Scan() {
+		lineNo++
+		text := strings.TrimSpace(scanner.Text())
+		if lineNo == 1 {
+			if text != "mode: "+Mode {
+				return nil, fmt.Errorf("line %d: unsupported coverage mode %q", lineNo, text)
+			}
+			continue
+		}
+		if text == "" {
+			continue
+		}
+
+		space := strings.LastIndexByte(text, ' ')
+		colon := strings.LastIndexByte(text, ':')
+		if space < 0 || colon < 0 || colon > space {
+			return nil, fmt.Errorf("line %d: malformed coverage line %q", lineNo, text)
+		}
+		line, err := strconv.Atoi(text[colon+1 : space])
+		if err != nil {
+			return nil, fmt.Errorf("line %d: malformed line number: %v", lineNo, err)
+		}
+		count, err := strconv.ParseInt(text[space+1:], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: malformed count: %v", lineNo, err)
+		}
+		prof.AddLine(text[:colon], line, count)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return prof, nil
+}
+
+// ReadFile reads the profile stored in `filename`.
+func ReadFile(filename string) (*Profile, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return Read(f)
+}
+
+// MergeIntoFile adds the counts of `prof` to the profile stored in
+// `filename`, creating the file if it does not exist yet. This way several
+// runs, e.g. all the programs of a test suite, accumulate into one profile.
+func MergeIntoFile(filename string, prof *Profile) error {
+	merged := NewProfile()
+	if previous, err := ReadFile(filename); err == nil {
+		merged.Merge(previous)
+	} else if !os.IsNotExist(err) {
+		return err
+	}
+	merged.Merge(prof)
+
+	f, err := os.Create(filename)
+	if err != nil {
+		return err
+	}
+	if err := merged.Write(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
@@ -0,0 +1,63 @@
+package cover_test
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/skycoin/cx/cx/cover"
+)
+
+func TestCover_ReadWrite(t *testing.T) {
+	prof := cover.NewProfile()
+	prof.AddLine("a.cx", 3, 2)
+	prof.AddLine("a.cx", 4, 0)
+	prof.AddLine("b.cx", 1, 1)
+
+	var buf bytes.Buffer
+	if err := prof.Write(&buf); err != nil {
+		t.Fatalf("want no error, got %v", err)
+	}
+	want := "mode: count\na.cx:3 2\na.cx:4 0\nb.cx:1 1\n"
+	if buf.String() != want {
+		t.Errorf("got %q, want %q", buf.String(), want)
+	}
+
+	read, err := cover.Read(&buf)
+	if err != nil {
+		t.Fatalf("want no error, got %v", err)
+	}
+	if covered, total := read.Coverage("a.cx"); covered != 1 || total != 2 {
+		t.Errorf("got coverage %d/%d, want 1/2", covered, total)
+	}
+}
+
+func TestCover_MergeIntoFile(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "cover.out")
+
+	prof := cover.NewProfile()
+	prof.AddLine("a.cx", 3, 2)
+	prof.AddLine("a.cx", 4, 0)
+	for i := 0; i < 2; i++ {
+		if err := cover.MergeIntoFile(filename, prof); err != nil {
+			t.Fatalf("want no error, got %v", err)
+		}
+	}
+
+	merged, err := cover.ReadFile(filename)
+	if err != nil {
+		t.Fatalf("want no error, got %v", err)
+	}
+	if got := merged.Files["a.cx"][3]; got != 4 {
+		t.Errorf("got count %d, want 4", got)
+	}
+	if got := merged.TotalCoverage(); got != 50 {
+		t.Errorf("got coverage %v, want 50", got)
+	}
+}
+
+func TestCover_ReadBadMode(t *testing.T) {
+	if _, err := cover.Read(bytes.NewBufferString("mode: set\n")); err == nil {
+		t.Errorf("want error for unsupported mode")
+	}
+}
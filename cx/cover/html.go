@@ -0,0 +1,105 @@
+package cover
+
+import (
+	"bufio"
+	"fmt"
+	"html/template"
+	"io"
+	"os"
+)
+
+type htmlLine struct {
+	Number int
+	Source string
+	Count  int64
+	Class  string
+}
+
+type htmlFile struct {
+	Name     string
+	Coverage string
+	Lines    []htmlLine
+	Err      string
+}
+
+// WriteHTML renders the sources of every file in the profile, highlighting
+// covered and uncovered lines.
+func WriteHTML(w io.Writer, prof *Profile) error {
+	var files []htmlFile
+	for _, name := range prof.FileNames() {
+		covered, total := prof.Coverage(name)
+		file := htmlFile{
+			Name:     name,
+			Coverage: fmt.Sprintf("%.1f%%", percent(covered, total)),
+		}
+
+		lines, err := readLines(name)
+		if err != nil {
+			file.Err = err.Error()
+		}
+		counts := prof.Files[name]
+		for i, src := range lines {
+			line := htmlLine{Number: i + 1, Source: src, Class: "none"}
+			if count, ok := counts[line.Number]; ok {
+				line.Count = count
+				line.Class = "uncovered"
+				if count > 0 {
+					line.Class = "covered"
+				}
+			}
+			file.Lines = append(file.Lines, line)
+		}
+		files = append(files, file)
+	}
+
+	return htmlTemplate.Execute(w, files)
+}
+
+func percent(covered, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return 100 * float64(covered) / float64(total)
+}
+
+func readLines(filename string) ([]string, error) {
+	f, err := os.Open(filename)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var lines []string
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		lines = append(lines, scanner.Text())
+	}
+	return lines, scanner.Err()
+}
+
+var htmlTemplate = template.Must(template.New("cover").Parse(`<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>CX coverage</title>
+<style>
+body { background: #fff; font-family: monospace; }
+h2 { font-size: 14px; margin-top: 24px; }
+table { border-collapse: collapse; }
+td { padding: 0 8px; white-space: pre; }
+td.num, td.count { color: #888; text-align: right; }
+tr.covered td.src { background: #d6f5d6; }
+tr.uncovered td.src { background: #f5d6d6; }
+</style>
+</head>
+<body>
+{{range .}}
+<h2>{{.Name}} ({{.Coverage}})</h2>
+{{if .Err}}<p>{{.Err}}</p>{{end}}
+<table>
+{{range .Lines}}<tr class="{{.Class}}"><td class="num">{{.Number}}</td><td class="count">{{if ne .Class "none"}}{{.Count}}{{end}}</td><td class="src">{{.Source}}</td></tr>
+{{end}}</table>
+{{end}}
+</body>
+</html>
+`))
@@ -14,6 +14,12 @@ import (
 // TODO: We probably dont need this? HTTPHandle can work in another way
 //TODO: Is Callback actually "CallFunction" ?
 func Callback(cxprogram *ast.CXProgram, fn *ast.CXFunction, inputs [][]byte) (outputs [][]byte) {
+	return CallbackClosure(cxprogram, fn, 0, inputs)
+}
+
+// CallbackClosure is like Callback, but `fn` is the function of a closure
+// whose environment is referenced by `env`.
+func CallbackClosure(cxprogram *ast.CXProgram, fn *ast.CXFunction, env int32, inputs [][]byte) (outputs [][]byte) {
 	line := cxprogram.CallStack[cxprogram.CallCounter].Line
 	previousCall := cxprogram.CallCounter
 	cxprogram.CallCounter++
@@ -21,6 +27,7 @@ func Callback(cxprogram *ast.CXProgram, fn *ast.CXFunction, inputs [][]byte) (ou
 	newCall.Operator = fn
 	newCall.Line = 0
 	newCall.FramePointer = cxprogram.StackPointer
+	newCall.Defers = 0
 	cxprogram.StackPointer += newCall.Operator.Size
 	newFP := newCall.FramePointer
 
@@ -32,6 +39,7 @@ func Callback(cxprogram *ast.CXProgram, fn *ast.CXFunction, inputs [][]byte) (ou
 	for i, inp := range inputs {
 		ast.WriteMemory(ast.GetFinalOffset(newFP, newCall.Operator.Inputs[i]), inp)
 	}
+	ast.WriteClosureEnv(newFP, fn, env)
 
 	var nCalls = 0
 
@@ -0,0 +1,72 @@
+package execute
+
+import (
+	"path/filepath"
+
+	"github.com/skycoin/cx/cx/ast"
+	"github.com/skycoin/cx/cx/cover"
+)
+
+// Coverage is the active coverage counter. It is nil unless coverage was
+// requested, e.g. by using `cx --coverprofile=cover.out`.
+var Coverage *Cover
+
+// Cover counts how many times each `CXExpression` is executed.
+type Cover struct {
+	filename string
+	counts   map[*ast.CXExpression]int64
+	written  bool
+}
+
+// NewCover returns a coverage counter whose profile is merged into
+// `filename` by `Flush`.
+func NewCover(filename string) *Cover {
+	return &Cover{
+		filename: filename,
+		counts:   make(map[*ast.CXExpression]int64),
+	}
+}
+
+// count records the execution of the current expression of `call`.
+func (cov *Cover) count(call *ast.CXCall) {
+	if call.Line < call.Operator.Length {
+		cov.counts[call.Operator.Expressions[call.Line]]++
+	}
+}
+
+// Profile returns the line coverage of every expression of `cxprogram`. A
+// line holding several expressions counts the executions of the most
+// executed one. File names are made absolute, so profiles of programs run
+// from different directories can be merged and rendered.
+func (cov *Cover) Profile(cxprogram *ast.CXProgram) *cover.Profile {
+	prof := cover.NewProfile()
+	for _, pkg := range cxprogram.Packages {
+		for _, fn := range pkg.Functions {
+			for _, expr := range fn.Expressions {
+				if expr.FileName == "" || expr.FileLine <= 0 {
+					continue
+				}
+				fileName, err := filepath.Abs(expr.FileName)
+				if err != nil {
+					fileName = expr.FileName
+				}
+				prof.AddLine(fileName, expr.FileLine, 0)
+				lines := prof.Files[fileName]
+				if count := cov.counts[expr]; count > lines[expr.FileLine] {
+					lines[expr.FileLine] = count
+				}
+			}
+		}
+	}
+	return prof
+}
+
+// Flush merges the coverage of `cxprogram` into the profile file. Only the
+// first call has an effect, so it is safe to call it from several exit paths.
+func (cov *Cover) Flush(cxprogram *ast.CXProgram) error {
+	if cov.written {
+		return nil
+	}
+	cov.written = true
+	return cover.MergeIntoFile(cov.filename, cov.Profile(cxprogram))
+}
@@ -5,8 +5,6 @@ import (
 	"github.com/skycoin/cx/cx/ast"
 	"github.com/skycoin/cx/cx/constants"
 	"github.com/skycoin/skycoin/src/cipher/encoder"
-	"math/rand"
-	"time"
 )
 
 // Only called in this file
@@ -27,7 +25,39 @@ func ToCall(cxprogram *ast.CXProgram) *ast.CXExpression {
 
 func RunCxAst(cxprogram *ast.CXProgram, untilEnd bool, nCalls *int, untilCall int) error {
 	defer ast.RuntimeError()
-	var err error
+	if Tracer != nil {
+		// Flushing before `ast.RuntimeError` exits, so the trace also
+		// covers programs ending in a runtime error.
+		defer Tracer.Flush()
+	}
+	if Coverage != nil && untilCall < 0 {
+		defer Coverage.Flush(cxprogram)
+	}
+	if Profiler != nil && untilCall < 0 {
+		defer Profiler.Flush()
+	}
+
+	for {
+		r, err := runCxAst(cxprogram, untilEnd, nCalls, untilCall)
+		if r == nil {
+			if cxprogram.Panic != nil && (cxprogram.Terminated || cxprogram.CallCounter <= untilCall) {
+				// then the panic wasn't recovered from. Panics don't
+				// unwind the calls to natives calling CX functions.
+				cxprogram.RaisePanic()
+			}
+			return err
+		}
+		// the deferred calls of the unwound calls are run next
+		cxprogram.StartPanic(r)
+	}
+}
+
+// runCxAst runs `cxprogram` like RunCxAst, until the first runtime error,
+// returning the value it panicked with.
+func runCxAst(cxprogram *ast.CXProgram, untilEnd bool, nCalls *int, untilCall int) (r interface{}, err error) {
+	defer func() {
+		r = recover()
+	}()
 
 	var inputs []ast.CXValue
 	var outputs []ast.CXValue
@@ -49,7 +79,7 @@ func RunCxAst(cxprogram *ast.CXProgram, untilEnd bool, nCalls *int, untilCall in
 				cxprogram.CallStack[0].Operator = nil
 				cxprogram.CallCounter = 0
 				fmt.Println("in:terminated")
-				return err
+				return nil, err
 			}
 
 			if call.Line >= call.Operator.Length && cxprogram.CallCounter != 0 {
@@ -75,7 +105,7 @@ func RunCxAst(cxprogram *ast.CXProgram, untilEnd bool, nCalls *int, untilCall in
 					cxprogram.CallStack[0].Operator = nil
 					cxprogram.CallCounter = 0
 					fmt.Println("in:terminated")
-					return err
+					return nil, err
 				}
 			}
 
@@ -83,13 +113,37 @@ func RunCxAst(cxprogram *ast.CXProgram, untilEnd bool, nCalls *int, untilCall in
 			*nCalls--
 		}
 
-		err = call.Ccall(cxprogram, &inputs, &outputs)
+		err = ccall(cxprogram, call, &inputs, &outputs)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
 
-	return nil
+	return nil, nil
+}
+
+// ccall executes the current expression of `call`, recording it if a
+// `Tracer`, a `Profiler` or `Coverage` is active.
+func ccall(cxprogram *ast.CXProgram, call *ast.CXCall, inputs *[]ast.CXValue, outputs *[]ast.CXValue) error {
+	if Profiler != nil {
+		Profiler.sample(cxprogram)
+	}
+	if Coverage != nil {
+		Coverage.count(call)
+	}
+	callCounter := cxprogram.CallCounter
+
+	var err error
+	if Tracer != nil {
+		err = Tracer.step(cxprogram, call, inputs, outputs)
+	} else {
+		err = call.Ccall(cxprogram, inputs, outputs)
+	}
+
+	if Profiler != nil && cxprogram.CallCounter > callCounter {
+		Profiler.countCall(cxprogram)
+	}
+	return err
 }
 
 // RunCompiled ...
@@ -99,7 +153,6 @@ func RunCompiled(cxprogram *ast.CXProgram, nCalls int, args []string) error {
 		panic(err)
 	}
 	cxprogram.EnsureMinimumHeapSize()
-	rand.Seed(time.Now().UTC().UnixNano())
 
 	var untilEnd bool
 	if nCalls == 0 {
@@ -117,12 +170,13 @@ func RunCompiled(cxprogram *ast.CXProgram, nCalls int, args []string) error {
 			if fn, err := mod.SelectFunction(constants.SYS_INIT_FUNC); err == nil {
 				// *init function
 				mainCall := MakeCall(fn)
+				mainCall.FramePointer = constants.NULL_STACK_ADDRESS_OFFSET
 				cxprogram.CallStack[0] = mainCall
-				cxprogram.StackPointer = fn.Size
+				cxprogram.StackPointer = mainCall.FramePointer + fn.Size
 
 				for !cxprogram.Terminated {
 					call := &cxprogram.CallStack[cxprogram.CallCounter]
-					err = call.Ccall(cxprogram, &inputs, &outputs)
+					err = ccall(cxprogram, call, &inputs, &outputs)
 					if err != nil {
 						return err
 					}
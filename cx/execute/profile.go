@@ -0,0 +1,339 @@
+package execute
+
+import (
+	"compress/gzip"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/skycoin/cx/cx/ast"
+)
+
+// Profiler is the active CX profiler. It is nil unless a profile was
+// requested, e.g. by using `cx --profile=cx.pprof`.
+var Profiler *Profile
+
+// Profile attributes the time spent running a CX program and the number of
+// calls to CX functions to the CX call stack, instead of to the Go functions
+// of the interpreter. It is written in the `profile.proto` format used by
+// `pprof`, so it can be explored with `pprof -http=:8080 cx.pprof`.
+type Profile struct {
+	filename string
+	written  bool
+
+	period time.Duration
+	tick   int32 // set to 1 by the ticker when a sample is due
+	ticker *time.Ticker
+	done   chan struct{}
+
+	start      time.Time
+	stop       time.Time
+	lastSample time.Time
+
+	locations map[profileLocation]uint64
+	functions map[*ast.CXFunction]uint64
+	samples   map[string]*profileSample
+	order     []string // keys of `samples` in insertion order
+}
+
+type profileLocation struct {
+	fn   *ast.CXFunction
+	line int
+}
+
+type profileSample struct {
+	locations []uint64
+	values    [3]int64 // calls, samples, cpu nanoseconds
+}
+
+// NewProfile creates a profile taking `rate` samples per second, which is
+// written to `filename` by `Flush`. Sampling starts right away.
+func NewProfile(filename string, rate int) *Profile {
+	if rate <= 0 {
+		rate = 100
+	}
+	prof := &Profile{
+		filename:  filename,
+		period:    time.Second / time.Duration(rate),
+		done:      make(chan struct{}),
+		locations: make(map[profileLocation]uint64),
+		functions: make(map[*ast.CXFunction]uint64),
+		samples:   make(map[string]*profileSample),
+	}
+	prof.start = time.Now()
+	prof.lastSample = prof.start
+	prof.ticker = time.NewTicker(prof.period)
+
+	// `Stop` clears `prof.ticker`, so the goroutine keeps its own reference.
+	ticks := prof.ticker.C
+	go func() {
+		for {
+			select {
+			case <-ticks:
+				atomic.StoreInt32(&prof.tick, 1)
+			case <-prof.done:
+				return
+			}
+		}
+	}()
+
+	return prof
+}
+
+// Stop stops sampling.
+func (prof *Profile) Stop() {
+	if prof.ticker == nil {
+		return
+	}
+	prof.stop = time.Now()
+	prof.ticker.Stop()
+	prof.ticker = nil
+	close(prof.done)
+}
+
+// Flush stops sampling and writes the profile to its file. Only the first
+// call has an effect, so it is safe to call it from several exit paths.
+func (prof *Profile) Flush() error {
+	if prof.written {
+		return nil
+	}
+	prof.written = true
+	prof.Stop()
+
+	f, err := os.Create(prof.filename)
+	if err != nil {
+		return err
+	}
+	if err := prof.Write(f); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// sample records the current CX call stack if a sample is due.
+func (prof *Profile) sample(cxprogram *ast.CXProgram) {
+	if atomic.LoadInt32(&prof.tick) == 0 {
+		return
+	}
+	atomic.StoreInt32(&prof.tick, 0)
+
+	now := time.Now()
+	elapsed := now.Sub(prof.lastSample)
+	prof.lastSample = now
+
+	values := prof.stack(cxprogram)
+	if values == nil {
+		return
+	}
+	values[1]++
+	values[2] += int64(elapsed)
+}
+
+// countCall records a call to the CX function on top of the call stack.
+func (prof *Profile) countCall(cxprogram *ast.CXProgram) {
+	if values := prof.stack(cxprogram); values != nil {
+		values[0]++
+	}
+}
+
+// stack returns the values of the sample associated to the current CX call
+// stack, creating it if needed.
+func (prof *Profile) stack(cxprogram *ast.CXProgram) *[3]int64 {
+	if cxprogram.CallCounter < 0 {
+		return nil
+	}
+
+	locations := make([]uint64, 0, cxprogram.CallCounter+1)
+	var key strings.Builder
+	for c := cxprogram.CallCounter; c >= 0; c-- {
+		call := &cxprogram.CallStack[c]
+		if call.Operator == nil {
+			continue
+		}
+		id := prof.location(call)
+		locations = append(locations, id)
+		key.WriteString(strconv.FormatUint(id, 10))
+		key.WriteByte(',')
+	}
+	if len(locations) == 0 {
+		return nil
+	}
+
+	smpl, ok := prof.samples[key.String()]
+	if !ok {
+		smpl = &profileSample{locations: locations}
+		prof.samples[key.String()] = smpl
+		prof.order = append(prof.order, key.String())
+	}
+	return &smpl.values
+}
+
+// location returns the location id of the expression being executed by `call`.
+func (prof *Profile) location(call *ast.CXCall) uint64 {
+	fn := call.Operator
+	line := fn.FileLine
+	if call.Line < fn.Length {
+		line = fn.Expressions[call.Line].FileLine
+	}
+
+	loc := profileLocation{fn: fn, line: line}
+	if id, ok := prof.locations[loc]; ok {
+		return id
+	}
+	id := uint64(len(prof.locations) + 1)
+	prof.locations[loc] = id
+
+	if _, ok := prof.functions[fn]; !ok {
+		prof.functions[fn] = uint64(len(prof.functions) + 1)
+	}
+	return id
+}
+
+// Write writes the profile to `w` as a gzip compressed `profile.proto`. The
+// profile must be stopped first.
+func (prof *Profile) Write(w io.Writer) error {
+	var strs []string
+	strIndex := make(map[string]int64)
+	str := func(s string) int64 {
+		if i, ok := strIndex[s]; ok {
+			return i
+		}
+		strIndex[s] = int64(len(strs))
+		strs = append(strs, s)
+		return strIndex[s]
+	}
+	// The first entry of the string table must be the empty string.
+	str("")
+
+	var buf protoBuffer
+
+	// sample_type
+	for _, typ := range [][2]string{{"calls", "count"}, {"samples", "count"}, {"cpu", "nanoseconds"}} {
+		var vt protoBuffer
+		vt.int64(1, str(typ[0]))
+		vt.int64(2, str(typ[1]))
+		buf.message(1, vt)
+	}
+
+	// sample
+	for _, key := range prof.order {
+		smpl := prof.samples[key]
+		var s protoBuffer
+		s.packedUint64(1, smpl.locations)
+		s.packedInt64(2, smpl.values[:])
+		buf.message(2, s)
+	}
+
+	// location
+	locs := make([]profileLocation, len(prof.locations))
+	for loc, id := range prof.locations {
+		locs[id-1] = loc
+	}
+	for i, loc := range locs {
+		var line protoBuffer
+		line.uint64(1, prof.functions[loc.fn])
+		line.int64(2, int64(loc.line))
+
+		var l protoBuffer
+		l.uint64(1, uint64(i+1))
+		l.message(4, line)
+		buf.message(4, l)
+	}
+
+	// function
+	fns := make([]*ast.CXFunction, len(prof.functions))
+	for fn, id := range prof.functions {
+		fns[id-1] = fn
+	}
+	for i, fn := range fns {
+		name := traceFunctionName(fn)
+		var f protoBuffer
+		f.uint64(1, uint64(i+1))
+		f.int64(2, str(name))
+		f.int64(3, str(name))
+		f.int64(4, str(fn.FileName))
+		f.int64(5, int64(fn.FileLine))
+		buf.message(5, f)
+	}
+
+	// time_nanos, duration_nanos, period_type and period
+	var periodType protoBuffer
+	periodType.int64(1, str("cpu"))
+	periodType.int64(2, str("nanoseconds"))
+
+	var tail protoBuffer
+	tail.int64(9, prof.start.UnixNano())
+	tail.int64(10, int64(prof.stop.Sub(prof.start)))
+	tail.message(11, periodType)
+	tail.int64(12, int64(prof.period))
+
+	// string_table
+	for _, s := range strs {
+		buf.bytes(6, []byte(s))
+	}
+	buf = append(buf, tail...)
+
+	zw := gzip.NewWriter(w)
+	if _, err := zw.Write(buf); err != nil {
+		return err
+	}
+	return zw.Close()
+}
+
+// protoBuffer is a minimal protocol buffers encoder, enough to write the
+// messages of `profile.proto`.
+type protoBuffer []byte
+
+func (b *protoBuffer) varint(x uint64) {
+	for x >= 0x80 {
+		*b = append(*b, byte(x)|0x80)
+		x >>= 7
+	}
+	*b = append(*b, byte(x))
+}
+
+func (b *protoBuffer) key(field int, wireType int) {
+	b.varint(uint64(field)<<3 | uint64(wireType))
+}
+
+func (b *protoBuffer) uint64(field int, x uint64) {
+	if x == 0 {
+		return
+	}
+	b.key(field, 0)
+	b.varint(x)
+}
+
+func (b *protoBuffer) int64(field int, x int64) {
+	b.uint64(field, uint64(x))
+}
+
+func (b *protoBuffer) bytes(field int, data []byte) {
+	b.key(field, 2)
+	b.varint(uint64(len(data)))
+	*b = append(*b, data...)
+}
+
+func (b *protoBuffer) message(field int, msg protoBuffer) {
+	b.bytes(field, msg)
+}
+
+func (b *protoBuffer) packedUint64(field int, xs []uint64) {
+	var packed protoBuffer
+	for _, x := range xs {
+		packed.varint(x)
+	}
+	b.bytes(field, packed)
+}
+
+func (b *protoBuffer) packedInt64(field int, xs []int64) {
+	var packed protoBuffer
+	for _, x := range xs {
+		packed.varint(uint64(x))
+	}
+	b.bytes(field, packed)
+}
@@ -0,0 +1,104 @@
+package execute
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/binary"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/skycoin/cx/cx/ast"
+)
+
+// protoFields decodes the top level fields of a protocol buffers message,
+// returning the varint values and the length delimited values by field.
+func protoFields(t *testing.T, msg []byte) (map[int][]uint64, map[int][][]byte) {
+	varints := make(map[int][]uint64)
+	bytesFields := make(map[int][][]byte)
+	for len(msg) > 0 {
+		key, n := binary.Uvarint(msg)
+		msg = msg[n:]
+		field := int(key >> 3)
+		switch key & 7 {
+		case 0:
+			x, n := binary.Uvarint(msg)
+			msg = msg[n:]
+			varints[field] = append(varints[field], x)
+		case 2:
+			size, n := binary.Uvarint(msg)
+			msg = msg[n:]
+			bytesFields[field] = append(bytesFields[field], msg[:size])
+			msg = msg[size:]
+		default:
+			t.Fatalf("unexpected wire type %d", key&7)
+		}
+	}
+	return varints, bytesFields
+}
+
+func TestProfile_Flush(t *testing.T) {
+	pkg := ast.MakePackage("main")
+	fn := ast.MakeFunction("main", "main.cx", 3)
+	pkg.AddFunction(fn)
+	fn.Expressions = []*ast.CXExpression{ast.MakeExpression(nil, "main.cx", 4)}
+	fn.Length = 1
+
+	prgrm := &ast.CXProgram{CallStack: []ast.CXCall{{Operator: fn}}}
+
+	filename := filepath.Join(t.TempDir(), "cx.pprof")
+	prof := NewProfile(filename, 1000)
+	prof.countCall(prgrm)
+	time.Sleep(10 * time.Millisecond)
+	prof.sample(prgrm)
+	for i := 0; i < 2; i++ {
+		if err := prof.Flush(); err != nil {
+			t.Fatalf("want no error, got %v", err)
+		}
+	}
+
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		t.Fatalf("want no error, got %v", err)
+	}
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		t.Fatalf("want no error, got %v", err)
+	}
+	msg, err := ioutil.ReadAll(zr)
+	if err != nil {
+		t.Fatalf("want no error, got %v", err)
+	}
+
+	varints, bytesFields := protoFields(t, msg)
+	if got := len(bytesFields[1]); got != 3 {
+		t.Errorf("got %d sample types, want 3", got)
+	}
+	if got := len(bytesFields[2]); got != 1 {
+		t.Fatalf("got %d samples, want 1", got)
+	}
+	_, sample := protoFields(t, bytesFields[2][0])
+	var values []uint64
+	for packed := sample[2][0]; len(packed) > 0; {
+		x, n := binary.Uvarint(packed)
+		packed = packed[n:]
+		values = append(values, x)
+	}
+	if len(values) != 3 || values[0] != 1 || values[1] != 1 || values[2] == 0 {
+		t.Errorf("got sample values %v, want 1 call, 1 sample and some cpu time", values)
+	}
+
+	strs := make(map[string]bool)
+	for _, s := range bytesFields[6] {
+		strs[string(s)] = true
+	}
+	if !strs["main.main"] || !strs["main.cx"] {
+		t.Errorf("got string table %v, want function and file names", strs)
+	}
+
+	want := uint64(prof.stop.Sub(prof.start))
+	if got := varints[10]; len(got) != 1 || got[0] != want {
+		t.Errorf("got duration %v, want %d", got, want)
+	}
+}
@@ -0,0 +1,175 @@
+package execute
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/skycoin/cx/cx/ast"
+)
+
+// Tracer is the active execution tracer. It is nil unless a trace was
+// requested, e.g. by using `cx --trace=file.jsonl`.
+var Tracer *Trace
+
+// TraceRecord is a single executed expression as written to a trace file.
+// A trace file holds one JSON encoded record per line.
+type TraceRecord struct {
+	Function string   `json:"function"` // Function containing the expression, as "pkg.fn"
+	File     string   `json:"file"`     // Source file of the expression
+	Line     int      `json:"line"`     // Source line of the expression
+	Operator string   `json:"op"`       // Name of the operator being called
+	Inputs   []string `json:"inputs"`   // Printable values of the inputs before the call
+	Outputs  []string `json:"outputs"`  // Printable values of the outputs after the call
+}
+
+// Trace records every expression executed by `RunCxAst` as a `TraceRecord`.
+type Trace struct {
+	w         *bufio.Writer
+	packages  map[string]bool
+	functions map[string]bool
+
+	// pending holds the calls to CX functions that have not returned yet.
+	pending []tracedCall
+}
+
+// NewTrace returns a tracer writing to `w`. `packages` and `functions` are
+// comma separated filters; if non-empty, only expressions inside matching
+// packages or functions are recorded. Functions can be given as "fn" or
+// "pkg.fn".
+func NewTrace(w io.Writer, packages, functions string) *Trace {
+	return &Trace{
+		w:         bufio.NewWriter(w),
+		packages:  parseTraceFilter(packages),
+		functions: parseTraceFilter(functions),
+	}
+}
+
+func parseTraceFilter(filter string) map[string]bool {
+	if filter == "" {
+		return nil
+	}
+	names := make(map[string]bool)
+	for _, name := range strings.Split(filter, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names[name] = true
+		}
+	}
+	return names
+}
+
+// Flush writes any buffered records to the underlying writer.
+func (trace *Trace) Flush() error {
+	return trace.w.Flush()
+}
+
+func (trace *Trace) matches(fn *ast.CXFunction) bool {
+	if trace.packages != nil && (fn.Package == nil || !trace.packages[fn.Package.Name]) {
+		return false
+	}
+	if trace.functions != nil && !trace.functions[fn.Name] && !trace.functions[traceFunctionName(fn)] {
+		return false
+	}
+	return true
+}
+
+// tracedCall is a call to a CX function whose record can only be completed
+// once the callee returns.
+type tracedCall struct {
+	record *TraceRecord
+	expr   *ast.CXExpression
+	fp     int
+}
+
+// step runs a single `Ccall` and records the executed expression.
+func (trace *Trace) step(cxprogram *ast.CXProgram, call *ast.CXCall, inputs *[]ast.CXValue, outputs *[]ast.CXValue) error {
+	callCounter := cxprogram.CallCounter
+	returning := call.Line >= call.Operator.Length
+	fp := call.FramePointer
+
+	var record *TraceRecord
+	var expr *ast.CXExpression
+	if !returning && trace.matches(call.Operator) {
+		expr = call.Operator.Expressions[call.Line]
+		record = &TraceRecord{
+			Function: traceFunctionName(call.Operator),
+			File:     expr.FileName,
+			Line:     expr.FileLine,
+			Inputs:   make([]string, 0, len(expr.Inputs)),
+		}
+		for _, inp := range expr.Inputs {
+			record.Inputs = append(record.Inputs, tracePrintableValue(fp, inp))
+		}
+	}
+
+	if err := call.Ccall(cxprogram, inputs, outputs); err != nil {
+		return err
+	}
+
+	switch {
+	case returning:
+		// The expression that called the finished function is now complete.
+		last := len(trace.pending) - 1
+		if last < 0 {
+			return nil
+		}
+		caller := trace.pending[last]
+		trace.pending = trace.pending[:last]
+		if caller.record != nil {
+			trace.write(caller.record, caller.fp, caller.expr)
+		}
+	case cxprogram.CallCounter > callCounter:
+		// A CX function was called; its outputs are written when it returns.
+		trace.pending = append(trace.pending, tracedCall{record: record, expr: expr, fp: fp})
+	case record != nil:
+		trace.write(record, fp, expr)
+	}
+	return nil
+}
+
+func (trace *Trace) write(record *TraceRecord, fp int, expr *ast.CXExpression) {
+	// The operator is resolved after the call, as `Ccall` replaces untyped
+	// operators such as `add` by their typed version.
+	record.Operator = traceOperatorName(expr)
+	record.Outputs = make([]string, 0, len(expr.Outputs))
+	for _, out := range expr.Outputs {
+		record.Outputs = append(record.Outputs, tracePrintableValue(fp, out))
+	}
+
+	line, err := json.Marshal(record)
+	if err != nil {
+		panic(err)
+	}
+	trace.w.Write(line)
+	trace.w.WriteByte('\n')
+}
+
+func traceFunctionName(fn *ast.CXFunction) string {
+	if fn.Package == nil {
+		return fn.Name
+	}
+	return fn.Package.Name + "." + fn.Name
+}
+
+func traceOperatorName(expr *ast.CXExpression) string {
+	if expr.Operator == nil {
+		return "declaration"
+	}
+	if expr.Operator.IsBuiltin {
+		return ast.OpNames[expr.Operator.OpCode]
+	}
+	return traceFunctionName(expr.Operator)
+}
+
+// tracePrintableValue is like `ast.GetPrintableValue`, but it never makes the
+// traced program fail, e.g. when a value is read before it was initialized.
+func tracePrintableValue(fp int, arg *ast.CXArgument) (value string) {
+	defer func() {
+		if r := recover(); r != nil {
+			value = fmt.Sprintf("<unreadable: %v>", r)
+		}
+	}()
+	return ast.GetPrintableValue(fp, arg)
+}
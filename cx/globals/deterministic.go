@@ -0,0 +1,34 @@
+package globals
+
+import (
+	"math/rand"
+	"time"
+)
+
+// Deterministic is set when running with `cx --deterministic`. In that mode
+// random numbers come from a seeded generator, time functions read
+// `VirtualClock` and natives that depend on the outside world are rejected.
+var Deterministic bool
+
+// Rand is the generator used by the `rand` functions of every type.
+var Rand = rand.New(rand.NewSource(time.Now().UTC().UnixNano()))
+
+// VirtualClock is the time, in nanoseconds, returned by the time functions
+// in deterministic mode. It only advances when the program calls `time.Sleep`.
+var VirtualClock int64
+
+// SetDeterministic enables deterministic mode, seeding `Rand` with `seed`.
+func SetDeterministic(seed int64) {
+	Deterministic = true
+	Rand = rand.New(rand.NewSource(seed))
+	VirtualClock = 0
+}
+
+// UnixNano returns the current Unix time in nanoseconds, which is
+// `VirtualClock` in deterministic mode.
+func UnixNano() int64 {
+	if Deterministic {
+		return VirtualClock
+	}
+	return time.Now().UnixNano()
+}
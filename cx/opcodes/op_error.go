@@ -0,0 +1,35 @@
+package opcodes
+
+import (
+	"errors"
+
+	"github.com/skycoin/cx/cx/ast"
+	"github.com/skycoin/cx/cx/constants"
+)
+
+// opErrorNew implements `error.New(msg)`, returning an error with the
+// message `msg`. Each call returns a distinct error.
+func opErrorNew(inputs []ast.CXValue, outputs []ast.CXValue) {
+	outputs[0].Set_error(errors.New(inputs[0].Get_str()))
+}
+
+// opErrorError implements `err.Error()`, returning the message of `err`,
+// which can't be nil.
+func opErrorError(inputs []ast.CXValue, outputs []ast.CXValue) {
+	err := inputs[0].Get_error()
+	if err == nil {
+		panic(constants.CX_RUNTIME_INVALID_ARGUMENT)
+	}
+	outputs[0].Set_str(err.Error())
+}
+
+// opErrorEq implements `==` for errors, which are equal if they're the
+// same error, or both nil.
+func opErrorEq(inputs []ast.CXValue, outputs []ast.CXValue) {
+	outputs[0].Set_bool(inputs[0].Get_i32() == inputs[1].Get_i32())
+}
+
+// opErrorUneq implements `!=` for errors.
+func opErrorUneq(inputs []ast.CXValue, outputs []ast.CXValue) {
+	outputs[0].Set_bool(inputs[0].Get_i32() != inputs[1].Get_i32())
+}
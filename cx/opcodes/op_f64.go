@@ -3,8 +3,8 @@ package opcodes
 import (
 	"fmt"
 	"github.com/skycoin/cx/cx/ast"
+	"github.com/skycoin/cx/cx/globals"
 	"math"
-	"math/rand"
 	"strconv"
 )
 
@@ -167,7 +167,7 @@ func opF64Uneq(inputs []ast.CXValue, outputs []ast.CXValue) {
 
 // The built-in rand function returns a pseudo-random number in [0.0,1.0) from the default Source.
 func opF64Rand(inputs []ast.CXValue, outputs []ast.CXValue) {
-	outputs[0].Set_f64(rand.Float64())
+	outputs[0].Set_f64(globals.Rand.Float64())
 }
 
 // The built-in acos function returns the arc cosine of the operand.
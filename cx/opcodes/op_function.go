@@ -0,0 +1,51 @@
+package opcodes
+
+import (
+	"github.com/skycoin/cx/cx/ast"
+	"github.com/skycoin/cx/cx/constants"
+	"github.com/skycoin/cx/cx/helper"
+)
+
+// opFunctionCell allocates the cell on the heap holding a local captured by a
+// closure, and writes the reference to it to its output. The first input is
+// the size of the local, and the optional second input is its initial value.
+func opFunctionCell(inputs []ast.CXValue, outputs []ast.CXValue) {
+	size := int(inputs[0].Get_i32())
+	obj := make([]byte, constants.OBJECT_HEADER_SIZE+size)
+	ast.WriteMemI32(obj, 5, int32(len(obj)))
+
+	// The initial value is copied after allocating, as the garbage
+	// collector can update the references it holds.
+	cell := ast.AllocateSeq(len(obj))
+	if len(inputs) > 1 {
+		copy(obj[constants.OBJECT_HEADER_SIZE:], ast.PROGRAM.Memory[inputs[1].Offset:inputs[1].Offset+size])
+	}
+	ast.WriteMemory(cell, obj)
+	outputs[0].Set_i32(int32(cell))
+}
+
+// opFunctionClosure creates the function value of a function literal. The
+// first input is the function value of the literal without an environment,
+// and the rest are the references to the cells of the variables it captures,
+// which are copied to a new closure environment.
+func opFunctionClosure(inputs []ast.CXValue, outputs []ast.CXValue) {
+	value := make([]byte, constants.FUNC_SIZE)
+	copy(value, ast.PROGRAM.Memory[inputs[0].Offset:inputs[0].Offset+constants.FUNC_SIZE])
+
+	if cells := inputs[1:]; len(cells) > 0 {
+		obj := make([]byte, constants.OBJECT_HEADER_SIZE+len(cells)*constants.TYPE_POINTER_SIZE)
+		ast.WriteMemI32(obj, 5, int32(len(obj)))
+
+		// The references are copied after allocating, as the garbage
+		// collector can move the cells.
+		env := ast.AllocateSeq(len(obj))
+		for i, cell := range cells {
+			ref := helper.Deserialize_i32(ast.PROGRAM.Memory[cell.Offset : cell.Offset+constants.TYPE_POINTER_SIZE])
+			ast.WriteMemI32(obj, constants.OBJECT_HEADER_SIZE+i*constants.TYPE_POINTER_SIZE, ref)
+		}
+		ast.WriteMemory(env, obj)
+		ast.WriteMemI32(value, 0, int32(env))
+	}
+
+	outputs[0].Set_bytes(value)
+}
@@ -2,10 +2,10 @@ package opcodes
 
 import (
 	"fmt"
-	"math/rand"
 	"strconv"
 
 	"github.com/skycoin/cx/cx/ast"
+	"github.com/skycoin/cx/cx/globals"
 )
 
 // The built-in str function returns the base 10 string representation of operand 1.
@@ -14,6 +14,18 @@ func opI32ToStr(inputs []ast.CXValue, outputs []ast.CXValue) {
 	outputs[0].Set_str(outV0)
 }
 
+// The built-in enum.str function returns the name of the enum member given
+// by operand 1. Each enum calls it through its own `str` conversion, whose
+// input is of the enum type, e.g. `Color.str(c)`.
+func opEnumToStr(inputs []ast.CXValue, outputs []ast.CXValue) {
+	value := inputs[0].Get_i32()
+	if enum := inputs[0].Expr.Operator.Inputs[0].Enum; enum != nil {
+		outputs[0].Set_str(enum.MemberName(value))
+		return
+	}
+	outputs[0].Set_str(strconv.FormatInt(int64(value), 10))
+}
+
 // The built-in i8 function returns operand 1 casted from type i32 to type i8.
 func opI32ToI8(inputs []ast.CXValue, outputs []ast.CXValue) {
 	outV0 := int8(inputs[0].Get_i32())
@@ -163,7 +175,7 @@ func opI32Rand(inputs []ast.CXValue, outputs []ast.CXValue) {
 	r := int(maximum - minimum)
 	outV0 := int32(0)
 	if r > 0 {
-		outV0 = int32(rand.Intn(r) + int(minimum))
+		outV0 = int32(globals.Rand.Intn(r) + int(minimum))
 	}
 
 	outputs[0].Set_i32(outV0)
@@ -3,7 +3,7 @@ package opcodes
 import (
 	"fmt"
 	"github.com/skycoin/cx/cx/ast"
-	"math/rand"
+	"github.com/skycoin/cx/cx/globals"
 	"strconv"
 )
 
@@ -162,7 +162,7 @@ func opI8Rand(inputs []ast.CXValue, outputs []ast.CXValue) {
 	r := int(maximum - minimum)
 	outV0 := int8(0)
 	if r > 0 {
-		outV0 = int8(rand.Intn(r) + int(minimum))
+		outV0 = int8(globals.Rand.Intn(r) + int(minimum))
 	}
 
     outputs[0].Set_i8(outV0)
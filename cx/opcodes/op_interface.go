@@ -0,0 +1,64 @@
+package opcodes
+
+import (
+	"github.com/skycoin/cx/cx/ast"
+	"github.com/skycoin/cx/cx/constants"
+)
+
+// opInterfaceBox implements the conversion of a struct instance, or of a
+// reference to it, to an interface.
+func opInterfaceBox(inputs []ast.CXValue, outputs []ast.CXValue) {
+	outputs[0].Set_bytes(ast.MakeInterface(inputs[0].Arg, inputs[0].Offset))
+}
+
+// opInterfaceAssert implements the type assertions `x.(T)` and
+// `v, ok := x.(T)`. The asserted type `T` is the type of the first output.
+// If the interface doesn't hold a `T`, the assertion panics unless its
+// result is checked with `ok`.
+func opInterfaceAssert(inputs []ast.CXValue, outputs []ast.CXValue) {
+	ptr, tag := ast.GetInterface(inputs[0].Offset)
+	strct, isPointer := ast.InterfaceDynamicType(tag)
+	out := ast.GetAssignmentElement(outputs[0].Arg)
+
+	var value []byte
+	switch {
+	case strct == nil:
+	case out.Type == constants.TYPE_INTERFACE:
+		if ast.Implements(strct, out.CustomType) == nil {
+			value = ast.PROGRAM.Memory[inputs[0].Offset : inputs[0].Offset+constants.INTERFACE_SIZE]
+		}
+	case out.CustomType == strct && out.IsPointer && isPointer:
+		var ref [constants.TYPE_POINTER_SIZE]byte
+		ast.WriteMemI32(ref[:], 0, ptr)
+		value = ref[:]
+	case out.CustomType == strct && !out.IsPointer && !isPointer:
+		offset := ast.InterfaceValueOffset(ptr)
+		value = ast.PROGRAM.Memory[offset : offset+strct.Size]
+	}
+
+	ok := value != nil
+	if !ok && len(outputs) == 1 {
+		panic(constants.CX_RUNTIME_INVALID_ARGUMENT)
+	}
+	if !ok {
+		value = make([]byte, ast.GetSize(outputs[0].Arg))
+	}
+	outputs[0].Set_bytes(value)
+
+	if len(outputs) > 1 {
+		outputs[1].Set_bool(ok)
+	}
+}
+
+// opInterfaceIsNil implements `x == nil` for interfaces, which are nil if
+// they don't hold a value. The second input is the `nil` literal.
+func opInterfaceIsNil(inputs []ast.CXValue, outputs []ast.CXValue) {
+	_, tag := ast.GetInterface(inputs[0].Offset)
+	outputs[0].Set_bool(tag == 0)
+}
+
+// opInterfaceNotNil implements `x != nil` for interfaces.
+func opInterfaceNotNil(inputs []ast.CXValue, outputs []ast.CXValue) {
+	_, tag := ast.GetInterface(inputs[0].Offset)
+	outputs[0].Set_bool(tag != 0)
+}
@@ -0,0 +1,26 @@
+package opcodes
+
+import (
+	"github.com/skycoin/cx/cx/ast"
+)
+
+// opMapDelete removes a key from a map, if present.
+func opMapDelete(inputs []ast.CXValue, outputs []ast.CXValue) {
+	mapOffset := ast.GetPointerOffset(int32(inputs[0].Offset))
+	ast.MapDelete(mapOffset, inputs[1].Arg, inputs[1].Offset)
+}
+
+// opMapLookup implements `v, ok := m[k]`. It reads the value stored under a
+// key of a map, or its zero value, and whether the key was found.
+func opMapLookup(inputs []ast.CXValue, outputs []ast.CXValue) {
+	mapOffset := ast.GetPointerOffset(int32(inputs[0].Offset))
+	valueOffset, found := ast.MapLookup(mapOffset, inputs[1].Arg, inputs[1].Offset)
+
+	value := make([]byte, ast.GetSize(outputs[0].Arg))
+	if valueOffset != 0 {
+		copy(value, ast.PROGRAM.Memory[valueOffset:valueOffset+len(value)])
+	}
+	copy(ast.PROGRAM.Memory[outputs[0].Offset:outputs[0].Offset+len(value)], value)
+
+	outputs[1].Set_bool(found)
+}
@@ -11,13 +11,14 @@ import (
 //TODO: Comment this out
 //TODO: Delete this, probably not needed
 func EscapeAnalysis(input *ast.CXValue) int32 {
-	heapOffset := ast.AllocateSeq(input.Arg.TotalSize + constants.OBJECT_HEADER_SIZE)
+	size := input.Arg.TotalSize + constants.OBJECT_HEADER_SIZE
+	heapOffset := ast.AllocateSeq(size)
 
 	byts := input.Get_bytes()
 
 	// creating a header for this object
 	var header = make([]byte, constants.OBJECT_HEADER_SIZE)
-	ast.WriteMemI32(header, 5, int32(len(byts)))
+	ast.WriteMemI32(header, 5, int32(size))
 
 	obj := append(header, byts...)
 	ast.WriteMemory(heapOffset, obj)
@@ -50,6 +51,29 @@ func opIdentity(inputs []ast.CXValue, outputs []ast.CXValue) {
 	//outputs[0].Used = int8(outputs[0].Type)
 }
 
+// opTypeConversion converts a value of a named type to its underlying type,
+// or the other way around. Both types share the representation of the value,
+// so it is copied as is.
+func opTypeConversion(inputs []ast.CXValue, outputs []ast.CXValue) {
+	switch ast.GetAssignmentElement(inputs[0].Arg).PassBy {
+	case constants.PASSBY_VALUE:
+		outputs[0].Set_bytes(inputs[0].Get_bytes())
+	case constants.PASSBY_REFERENCE:
+		outputs[0].Set_i32(int32(inputs[0].Offset))
+	}
+}
+
+// opValueEqual implements `==` for struct instances and arrays, which are
+// compared field by field and element by element.
+func opValueEqual(inputs []ast.CXValue, outputs []ast.CXValue) {
+	outputs[0].Set_bool(ast.ValuesEqual(inputs[0].Arg, inputs[0].Offset, inputs[1].Offset))
+}
+
+// opValueUnequal implements `!=` for struct instances and arrays.
+func opValueUnequal(inputs []ast.CXValue, outputs []ast.CXValue) {
+	outputs[0].Set_bool(!ast.ValuesEqual(inputs[0].Arg, inputs[0].Offset, inputs[1].Offset))
+}
+
 func opGoto(inputs []ast.CXValue, outputs []ast.CXValue) {
 	call := ast.PROGRAM.GetCurrentCall()
 	expr := call.Operator.Expressions[call.Line]
@@ -0,0 +1,67 @@
+package opcodes
+
+import (
+	"unicode/utf8"
+
+	"github.com/skycoin/cx/cx/ast"
+	"github.com/skycoin/cx/cx/constants"
+)
+
+// opRangeNext implements the iterations of `for k, v := range x`. Its
+// inputs are `x`, the position of the next element in it and the end of
+// `x`, which is -1 before the first iteration. Its outputs are whether
+// there's a next element, the position of the element that follows it, the
+// end of `x`, and the key and value of the element. The positions of arrays
+// and slices are indexes, the ones of strings are byte offsets, as their
+// values are UTF-8 runes, and the ones of maps are entries.
+func opRangeNext(inputs []ast.CXValue, outputs []ast.CXValue) {
+	elt := ast.GetAssignmentElement(inputs[0].Arg)
+	pos := inputs[1].Get_i32()
+	end := inputs[2].Get_i32()
+	valueSize := ast.GetSize(outputs[4].Arg)
+
+	var data []byte
+	switch {
+	case elt.IsMap:
+		idx, keyOffset, valueOffset, ok := ast.MapNextEntry(ast.GetPointerOffset(int32(inputs[0].Offset)), pos)
+		outputs[0].Set_bool(ok)
+		if !ok {
+			return
+		}
+		keySize := ast.GetSize(outputs[3].Arg)
+		outputs[1].Set_i32(idx + 1)
+		outputs[2].Set_i32(end)
+		outputs[3].Set_bytes(ast.PROGRAM.Memory[keyOffset : keyOffset+keySize])
+		outputs[4].Set_bytes(ast.PROGRAM.Memory[valueOffset : valueOffset+valueSize])
+		return
+	case elt.IsSlice:
+		data = ast.GetSliceData(ast.GetPointerOffset(int32(inputs[0].Offset)), valueSize)
+	case len(elt.Lengths) > 0:
+		data = ast.PROGRAM.Memory[inputs[0].Offset : inputs[0].Offset+elt.Lengths[0]*valueSize]
+	case elt.Type == constants.TYPE_STR:
+		str := ast.ReadStrBytes(ast.GetStrOffset(inputs[0].Offset, inputs[0].Arg.ArgDetails.Name))
+		outputs[0].Set_bool(int(pos) < len(str))
+		if int(pos) >= len(str) {
+			return
+		}
+		r, size := utf8.DecodeRune(str[pos:])
+		outputs[1].Set_i32(pos + int32(size))
+		outputs[2].Set_i32(end)
+		outputs[3].Set_i32(pos)
+		outputs[4].Set_i32(r)
+		return
+	}
+
+	// the elements appended to a slice while ranging over it aren't visited
+	if end < 0 {
+		end = int32(len(data) / valueSize)
+	}
+	outputs[0].Set_bool(pos < end)
+	if pos >= end {
+		return
+	}
+	outputs[1].Set_i32(pos + 1)
+	outputs[2].Set_i32(end)
+	outputs[3].Set_i32(pos)
+	outputs[4].Set_bytes(data[int(pos)*valueSize : int(pos+1)*valueSize])
+}
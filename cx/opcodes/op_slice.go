@@ -11,7 +11,9 @@ func opSliceLen(inputs []ast.CXValue, outputs []ast.CXValue) {
 	elt := ast.GetAssignmentElement(inputs[0].Arg)
 
 	var sliceLen int32
-	if elt.IsSlice || elt.Type == constants.TYPE_AFF { //TODO: FIX
+	if elt.IsMap && !ast.IsMapElement(elt) {
+		sliceLen = ast.GetMapLen(ast.GetPointerOffset(int32(inputs[0].Offset)))
+	} else if elt.IsSlice || elt.Type == constants.TYPE_AFF { //TODO: FIX
 		sliceOffset := ast.GetPointerOffset(int32(inputs[0].Offset))
 		if sliceOffset > 0 {
 			sliceLen = helper.Deserialize_i32(ast.GetSliceHeader(sliceOffset)[4:8])
@@ -46,10 +48,17 @@ func opSliceAppend(inputs []ast.CXValue, outputs []ast.CXValue) {
 
 	eltInp0 := ast.GetAssignmentElement(inp0)
 	eltOut0 := ast.GetAssignmentElement(out0)
-	if inp0.Type != inp1.Type || inp0.Type != out0.Type || !eltInp0.IsSlice || !eltOut0.IsSlice {
+	if inp0.Type != out0.Type || !eltInp0.IsSlice || !eltOut0.IsSlice {
 		panic(constants.CX_RUNTIME_INVALID_ARGUMENT)
 	}
 
+	// Struct instances appended to a slice of interfaces are boxed.
+	isInterface := inp0.Type == constants.TYPE_INTERFACE
+	eltSize := inp1.Size
+	if isInterface {
+		eltSize = constants.INTERFACE_SIZE
+	}
+
 	var inputSliceLen int32
 	inputSliceOffset := ast.GetPointerOffset(int32(inputs[0].Offset))
 	if inputSliceOffset != 0 {
@@ -57,13 +66,22 @@ func opSliceAppend(inputs []ast.CXValue, outputs []ast.CXValue) {
 	}
 
 	// Preparing slice in case more memory is needed for the new element.
-	outputSliceOffset := ast.SliceAppendResize(inputs[0].FramePointer, out0, inp0, inp1.Size, sliceInputsLen)
+	outputSliceOffset := ast.SliceAppendResize(inputs[0].FramePointer, out0, inp0, eltSize, sliceInputsLen)
 
 	// We need to update the address of the output and input, as the final offsets
 	// could be on the heap and they could have been moved by the GC.
 
 	for i, input := range sliceInputs {
 		inp := input.Arg
+		if isInterface {
+			// The resized slice is stored in the output before boxing, as
+			// boxing can trigger the garbage collector.
+			outputs[0].SetSlice(outputSliceOffset)
+			obj := ast.MakeInterface(inp, input.Offset)
+			outputSliceOffset = ast.GetPointerOffset(int32(outputs[0].Offset))
+			ast.SliceAppendWrite(outputSliceOffset, obj, inputSliceLen+int32(i))
+			continue
+		}
 		if inp0.Type != inp.Type {
 			panic(constants.CX_RUNTIME_INVALID_ARGUMENT)
 		}
@@ -166,3 +184,70 @@ func opSliceCopy(inputs []ast.CXValue, outputs []ast.CXValue) {
 	//inputs[1].Used = int8(inputs[1].Type) // TODO: Remove hacked type check
 	outputs[0].Set_i32(int32(count / dstElem.TotalSize))
 }
+
+// opSliceExpression implements the slice expressions `x[lo:hi:max]` of
+// slices, arrays and strings, where an omitted `hi` or `max` is -1. The
+// elements `lo` to `hi` of slices and arrays are copied to a new slice of
+// capacity `max - lo`, as the header of a slice is stored before its
+// elements in its heap object, so slices can't share their elements. The
+// bytes `lo` to `hi` of strings are copied to a new string.
+func opSliceExpression(inputs []ast.CXValue, outputs []ast.CXValue) {
+	elt := ast.GetAssignmentElement(inputs[0].Arg)
+	lo, hi, max := inputs[1].Get_i32(), inputs[2].Get_i32(), inputs[3].Get_i32()
+
+	if elt.Type == constants.TYPE_STR && !elt.IsSlice && len(elt.Lengths) == len(elt.Indexes) {
+		str := inputs[0].Get_str()
+		if hi < 0 {
+			hi = int32(len(str))
+		}
+		if lo < 0 || lo > hi || int(hi) > len(str) {
+			panic(constants.CX_RUNTIME_SLICE_INDEX_OUT_OF_RANGE)
+		}
+		outputs[0].Set_str(str[lo:hi])
+		return
+	}
+
+	sizeofElement := elt.Size
+	var length, capacity int32
+	if elt.IsSlice {
+		if sliceOffset := ast.GetPointerOffset(int32(inputs[0].Offset)); sliceOffset > 0 {
+			sliceHeader := ast.GetSliceHeader(sliceOffset)
+			capacity = helper.Deserialize_i32(sliceHeader[0:4])
+			length = helper.Deserialize_i32(sliceHeader[4:8])
+		}
+	} else {
+		length = int32(elt.Lengths[len(elt.Indexes)])
+		capacity = length
+		// the elements of multidimensional arrays are arrays
+		for _, l := range elt.Lengths[len(elt.Indexes)+1:] {
+			sizeofElement *= l
+		}
+	}
+
+	if hi < 0 {
+		hi = length
+	}
+	if max < 0 {
+		max = capacity
+	}
+	if lo < 0 || lo > hi || hi > max || max > capacity {
+		panic(constants.CX_RUNTIME_SLICE_INDEX_OUT_OF_RANGE)
+	}
+
+	// the allocation can move the elements of the sliced slice
+	outputSliceOffset := ast.MakeSlice(hi-lo, max-lo, sizeofElement)
+
+	var data []byte
+	if elt.IsSlice {
+		sliceOffset := ast.GetPointerOffset(int32(inputs[0].Offset))
+		dataOffset := sliceOffset + constants.OBJECT_HEADER_SIZE + constants.SLICE_HEADER_SIZE
+		data = ast.PROGRAM.Memory[dataOffset : dataOffset+capacity*int32(sizeofElement)]
+	} else {
+		data = ast.PROGRAM.Memory[inputs[0].Offset : inputs[0].Offset+int(capacity)*sizeofElement]
+	}
+	if outputSliceOffset > 0 {
+		copy(ast.GetSliceData(outputSliceOffset, sizeofElement), data[int(lo)*sizeofElement:int(hi)*sizeofElement])
+	}
+
+	outputs[0].SetSlice(outputSliceOffset)
+}
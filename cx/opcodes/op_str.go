@@ -3,89 +3,68 @@ package opcodes
 import (
 	"fmt"
 	"github.com/skycoin/cx/cx/ast"
-	"github.com/skycoin/cx/cx/constants"
 	"strconv"
 	"strings"
 )
 
 func opStrToI8(inputs []ast.CXValue, outputs []ast.CXValue) {
 	outV0, err := strconv.ParseInt(inputs[0].Get_str(), 10, 8)
-	if err != nil {
-		panic(constants.CX_RUNTIME_ERROR)
-	}
-    outputs[0].Set_i8(int8(outV0))
+	outputs[0].Set_i8(int8(outV0))
+	outputs[1].Set_error(err)
 }
 
 func opStrToI16(inputs []ast.CXValue, outputs []ast.CXValue) {
 	outV0, err := strconv.ParseInt(inputs[0].Get_str(), 10, 16)
-	if err != nil {
-		panic(constants.CX_RUNTIME_ERROR)
-	}
-    outputs[0].Set_i16(int16(outV0))
+	outputs[0].Set_i16(int16(outV0))
+	outputs[1].Set_error(err)
 }
 
 func opStrToI32(inputs []ast.CXValue, outputs []ast.CXValue) {
 	outV0, err := strconv.ParseInt(inputs[0].Get_str(), 10, 32)
-	if err != nil {
-		panic(constants.CX_RUNTIME_ERROR)
-	}
-    outputs[0].Set_i32(int32(outV0))
+	outputs[0].Set_i32(int32(outV0))
+	outputs[1].Set_error(err)
 }
 
 func opStrToI64(inputs []ast.CXValue, outputs []ast.CXValue) {
 	outV0, err := strconv.ParseInt(inputs[0].Get_str(), 10, 64)
-	if err != nil {
-		panic(constants.CX_RUNTIME_ERROR)
-	}
-    outputs[0].Set_i64(int64(outV0))
+	outputs[0].Set_i64(int64(outV0))
+	outputs[1].Set_error(err)
 }
 
 func opStrToUI8(inputs []ast.CXValue, outputs []ast.CXValue) {
 	outV0, err := strconv.ParseUint(inputs[0].Get_str(), 10, 8)
-	if err != nil {
-		panic(constants.CX_RUNTIME_ERROR)
-	}
-    outputs[0].Set_ui8(uint8(outV0))
+	outputs[0].Set_ui8(uint8(outV0))
+	outputs[1].Set_error(err)
 }
 
 func opStrToUI16(inputs []ast.CXValue, outputs []ast.CXValue) {
 	outV0, err := strconv.ParseUint(inputs[0].Get_str(), 10, 16)
-	if err != nil {
-		panic(constants.CX_RUNTIME_ERROR)
-	}
-    outputs[0].Set_ui16(uint16(outV0))
+	outputs[0].Set_ui16(uint16(outV0))
+	outputs[1].Set_error(err)
 }
 
 func opStrToUI32(inputs []ast.CXValue, outputs []ast.CXValue) {
 	outV0, err := strconv.ParseUint(inputs[0].Get_str(), 10, 32)
-	if err != nil {
-		panic(constants.CX_RUNTIME_ERROR)
-	}
-    outputs[0].Set_ui32(uint32(outV0))
+	outputs[0].Set_ui32(uint32(outV0))
+	outputs[1].Set_error(err)
 }
 
 func opStrToUI64(inputs []ast.CXValue, outputs []ast.CXValue) {
 	outV0, err := strconv.ParseUint(inputs[0].Get_str(), 10, 64)
-	if err != nil {
-		panic(constants.CX_RUNTIME_ERROR)
-	}
-    outputs[0].Set_ui64(uint64(outV0))
+	outputs[0].Set_ui64(uint64(outV0))
+	outputs[1].Set_error(err)
 }
 
 func opStrToF32(inputs []ast.CXValue, outputs []ast.CXValue) {
 	outV0, err := strconv.ParseFloat(inputs[0].Get_str(), 32)
-	if err != nil {
-		panic(constants.CX_RUNTIME_ERROR)
-	}
-    outputs[0].Set_f32(float32(outV0))
+	outputs[0].Set_f32(float32(outV0))
+	outputs[1].Set_error(err)
 }
 
 func opStrToF64(inputs []ast.CXValue, outputs []ast.CXValue) {
 	outV0, err := strconv.ParseFloat(inputs[0].Get_str(), 64)
-	if err != nil {
-		panic(constants.CX_RUNTIME_ERROR)
-	}
-    outputs[0].Set_f64(float64(outV0))
+	outputs[0].Set_f64(float64(outV0))
+	outputs[1].Set_error(err)
 }
 
 func opStrEq(inputs []ast.CXValue, outputs []ast.CXValue) {
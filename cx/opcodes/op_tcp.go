@@ -2,8 +2,8 @@ package opcodes
 
 import (
 	"context"
+	"errors"
 	"github.com/skycoin/cx/cx/ast"
-	"log"
 	"net"
 	"net/rpc"
 	"time"
@@ -18,6 +18,10 @@ var conn net.Conn
 
 var DefaultServer = rpc.NewServer()
 
+// errNotListening is the error returned when accepting connections before
+// listening.
+var errNotListening = errors.New("tcp: not listening")
+
 func init() {
 	netPkg := ast.MakePackage("tcp")
 
@@ -29,37 +33,37 @@ func init() {
 }
 
 func opTCPDial(inputs []ast.CXValue, outputs []ast.CXValue) {
-	network, address, errorstring := inputs[0].Arg, inputs[1].Arg, outputs[0].Arg
-    fp := inputs[0].FramePointer
-
-	log.Println("network", network)
-	log.Println("address", address)
+	network, address := inputs[0].Get_str(), inputs[1].Get_str()
 
 	var err error
-	conn, err = net.Dial("tcp", "localhost:9000")
-
-	if err != nil {
-		ast.WriteString(fp, err.Error(), errorstring)
+	conn, err = net.Dial(network, address)
+	if err == nil {
+		conn.Close()
 	}
 
-	conn.Close()
+	outputs[0].Set_error(err)
 }
 
 func opTCPClose(inputs []ast.CXValue, outputs []ast.CXValue) {
-	ln.Close()
+	if ln != nil {
+		ln.Close()
+	}
 }
 
 func opTCPAccept(inputs []ast.CXValue, outputs []ast.CXValue) {
-	conn, _ = ln.Accept()
-	conn.Close()
+	err := errNotListening
+	if ln != nil {
+		conn, err = ln.Accept()
+		if err == nil {
+			conn.Close()
+		}
+	}
+
+	outputs[0].Set_error(err)
 }
 
 func opTCPListen(inputs []ast.CXValue, outputs []ast.CXValue) {
-	network, address, errorstring := inputs[0].Arg, inputs[1].Arg, outputs[0].Arg
-    fp := inputs[0].FramePointer
-
-	log.Println("network", network)
-	log.Println("address", address)
+	network, address := inputs[0].Get_str(), inputs[1].Get_str()
 
 	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
 
@@ -67,11 +71,11 @@ func opTCPListen(inputs []ast.CXValue, outputs []ast.CXValue) {
 
 	var err error
 
-	ln, err = lc.Listen(ctx, "tcp", ":9000")
+	ln, err = lc.Listen(ctx, network, address)
 
-	ln.Close()
-
-	if err != nil {
-		ast.WriteString(fp, err.Error(), errorstring)
+	if err == nil {
+		ln.Close()
 	}
+
+	outputs[0].Set_error(err)
 }
@@ -99,3 +99,9 @@ func opPanicIfNot(inputs []ast.CXValue, outputs []ast.CXValue) {
 func opStrError(inputs []ast.CXValue, outputs []ast.CXValue) {
     outputs[0].Set_str(ast.ErrorString(int(inputs[0].Get_i32())))
 }
+
+// opRecover stops the panic unwinding the call stack, returning its error
+// code, if called by a deferred call. It returns CX_SUCCESS otherwise.
+func opRecover(inputs []ast.CXValue, outputs []ast.CXValue) {
+	outputs[0].Set_i32(int32(ast.PROGRAM.Recover()))
+}
@@ -3,8 +3,8 @@ package opcodes
 import (
 	"fmt"
 	"github.com/skycoin/cx/cx/ast"
+	"github.com/skycoin/cx/cx/globals"
 	"math"
-	"math/rand"
 	"strconv"
 )
 
@@ -143,7 +143,7 @@ func opUI16Mod(inputs []ast.CXValue, outputs []ast.CXValue) {
 
 // The built-in rand function returns a pseudo-random number.
 func opUI16Rand(inputs []ast.CXValue, outputs []ast.CXValue) {
-	outV0 := uint16(rand.Int31n(int32(math.MaxUint16)))
+	outV0 := uint16(globals.Rand.Int31n(int32(math.MaxUint16)))
 	outputs[0].Set_ui16(outV0)
 }
 
@@ -3,7 +3,7 @@ package opcodes
 import (
 	"fmt"
 	"github.com/skycoin/cx/cx/ast"
-	"math/rand"
+	"github.com/skycoin/cx/cx/globals"
 	"strconv"
 )
 
@@ -142,7 +142,7 @@ func opUI32Mod(inputs []ast.CXValue, outputs []ast.CXValue) {
 
 // The built-in rand function returns a pseudo-random number.
 func opUI32Rand(inputs []ast.CXValue, outputs []ast.CXValue) {
-	outV0 := rand.Uint32()
+	outV0 := globals.Rand.Uint32()
 	outputs[0].Set_ui32(outV0)
 }
 
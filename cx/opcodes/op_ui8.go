@@ -3,8 +3,8 @@ package opcodes
 import (
 	"fmt"
 	"github.com/skycoin/cx/cx/ast"
+	"github.com/skycoin/cx/cx/globals"
 	"math"
-	"math/rand"
 	"strconv"
 )
 
@@ -143,7 +143,7 @@ func opUI8Mod(inputs []ast.CXValue, outputs []ast.CXValue) {
 
 // The built-in rand function returns a pseudo-random number.
 func opUI8Rand(inputs []ast.CXValue, outputs []ast.CXValue) {
-	outV0 := uint8(rand.Int31n(int32(math.MaxUint8)))
+	outV0 := uint8(globals.Rand.Int31n(int32(math.MaxUint8)))
 	outputs[0].Set_ui8(outV0)
 }
 
@@ -50,6 +50,13 @@ func RegisterFunction(name string, handler ast.OpcodeHandler, inputs []*ast.CXAr
 	globals.OpCodeSystemCounter++
 }
 
+// RegisterNondeterministicFunction registers a native like `RegisterFunction`
+// does, marking it as unavailable in deterministic mode.
+func RegisterNondeterministicFunction(name string, handler ast.OpcodeHandler, inputs []*ast.CXArgument, outputs []*ast.CXArgument) {
+	ast.NondeterministicOpCodes[globals.OpCodeSystemCounter] = true
+	RegisterFunction(name, handler, inputs, outputs)
+}
+
 // RegisterOperator ...
 func RegisterOperator(name string, handler ast.OpcodeHandler, inputs []*ast.CXArgument, outputs []*ast.CXArgument, atomicType int, operator int) {
 	RegisterOpCode(globals.OpCodeSystemCounter, name, handler, inputs, outputs)
@@ -155,5 +162,5 @@ func Param(typCode int) *ast.CXArgument {
 }
 
 func opDebugPrintStack([]ast.CXValue, []ast.CXValue) {
-	ast.PROGRAM.PrintStack()
+	ast.PROGRAM.PrintTraceback(ast.TRACEBACK_ALL)
 }
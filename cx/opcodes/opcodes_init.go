@@ -75,6 +75,20 @@ func RegisterOpcodes() {
 	RegisterOpCode(constants.OP_BOOL_NOT, "bool.not", opBoolNot, In(ast.ConstCxArg_BOOL), Out(ast.ConstCxArg_BOOL))
 
 	RegisterFunction("len", opSliceLen, In(ast.ConstCxArg_UND_TYPE), Out(ast.ConstCxArg_I32))
+	RegisterFunction("delete", opMapDelete, In(ast.ConstCxArg_UND_TYPE, ast.ConstCxArg_UND_TYPE), nil)
+	RegisterFunction("map.lookup", opMapLookup, In(ast.ConstCxArg_UND_TYPE, ast.ConstCxArg_UND_TYPE), Out(ast.ConstCxArg_UND_TYPE, ast.ConstCxArg_BOOL))
+	RegisterFunction("range.next", opRangeNext, In(ast.ConstCxArg_UND_TYPE, ast.ConstCxArg_I32, ast.ConstCxArg_I32), Out(ast.ConstCxArg_BOOL, ast.ConstCxArg_I32, ast.ConstCxArg_I32, ast.ConstCxArg_UND_TYPE, ast.ConstCxArg_UND_TYPE))
+	RegisterFunction("interface.box", opInterfaceBox, In(ast.ConstCxArg_UND_TYPE), Out(ast.ConstCxArg_UND_TYPE))
+	RegisterFunction("interface.assert", opInterfaceAssert, In(ast.ConstCxArg_UND_TYPE), Out(ast.ConstCxArg_UND_TYPE))
+	RegisterFunction("interface.assert.ok", opInterfaceAssert, In(ast.ConstCxArg_UND_TYPE), Out(ast.ConstCxArg_UND_TYPE, ast.ConstCxArg_BOOL))
+	RegisterFunction("interface.isnil", opInterfaceIsNil, In(ast.ConstCxArg_UND_TYPE, ast.ConstCxArg_UND_TYPE), Out(ast.ConstCxArg_BOOL))
+	RegisterFunction("interface.notnil", opInterfaceNotNil, In(ast.ConstCxArg_UND_TYPE, ast.ConstCxArg_UND_TYPE), Out(ast.ConstCxArg_BOOL))
+	RegisterFunction("type.conv", opTypeConversion, In(ast.ConstCxArg_UND_TYPE), Out(ast.ConstCxArg_UND_TYPE))
+	RegisterFunction("value.eq", opValueEqual, In(ast.ConstCxArg_UND_TYPE, ast.ConstCxArg_UND_TYPE), Out(ast.ConstCxArg_BOOL))
+	RegisterFunction("value.uneq", opValueUnequal, In(ast.ConstCxArg_UND_TYPE, ast.ConstCxArg_UND_TYPE), Out(ast.ConstCxArg_BOOL))
+	RegisterFunction("func.cell", opFunctionCell, In(ast.ConstCxArg_I32, ast.ConstCxArg_UND_TYPE), Out(ast.ConstCxArg_I32))
+	RegisterFunction("func.closure", opFunctionClosure, In(ast.ConstCxArg_UND_TYPE), Out(ast.ConstCxArg_UND_TYPE))
+	RegisterFunction("func.call", nil, In(ast.ConstCxArg_UND_TYPE), Out(ast.ConstCxArg_UND_TYPE))
 	RegisterFunction("printf", opPrintf, In(ast.ConstCxArg_UND_TYPE), nil)
 	RegisterFunction("sprintf", opSprintf, In(ast.ConstCxArg_UND_TYPE), Out(ast.ConstCxArg_STR))
 
@@ -113,6 +127,7 @@ func RegisterOpcodes() {
 	RegisterFunction("i16.f64", opI16ToF64, In(ast.ConstCxArg_I16), Out(ast.ConstCxArg_F64))
 
 	RegisterFunction("i32.str", opI32ToStr, In(ast.ConstCxArg_I32), Out(ast.ConstCxArg_STR))
+	RegisterFunction("enum.str", opEnumToStr, In(ast.ConstCxArg_I32), Out(ast.ConstCxArg_STR))
 	RegisterFunction("i32.i8", opI32ToI8, In(ast.ConstCxArg_I32), Out(ast.ConstCXArg_I8))
 	RegisterFunction("i32.i16", opI32ToI16, In(ast.ConstCxArg_I32), Out(ast.ConstCxArg_I16))
 	RegisterFunction("i32.i64", opI32ToI64, In(ast.ConstCxArg_I32), Out(ast.ConstCxArg_I64))
@@ -455,30 +470,37 @@ func RegisterOpcodes() {
 	RegisterOperator("str.eq", opStrEq, In(ast.ConstCxArg_STR, ast.ConstCxArg_STR), Out(ast.ConstCxArg_BOOL), constants.TYPE_STR, constants.OP_EQUAL)
 	RegisterOperator("str.uneq", opStrUneq, In(ast.ConstCxArg_STR, ast.ConstCxArg_STR), Out(ast.ConstCxArg_BOOL), constants.TYPE_STR, constants.OP_UNEQUAL)
 	RegisterOperator("str.concat", opStrConcat, In(ast.ConstCxArg_STR, ast.ConstCxArg_STR), Out(ast.ConstCxArg_STR), constants.TYPE_STR, constants.OP_ADD)
-	RegisterFunction("str.i8", opStrToI8, In(ast.ConstCxArg_STR), Out(ast.ConstCXArg_I8))
-	RegisterFunction("str.i16", opStrToI16, In(ast.ConstCxArg_STR), Out(ast.ConstCxArg_I16))
-	RegisterFunction("str.i32", opStrToI32, In(ast.ConstCxArg_STR), Out(ast.ConstCxArg_I32))
-	RegisterFunction("str.i64", opStrToI64, In(ast.ConstCxArg_STR), Out(ast.ConstCxArg_I64))
-	RegisterFunction("str.ui8", opStrToUI8, In(ast.ConstCxArg_STR), Out(ast.ConstCxArg_UI8))
-	RegisterFunction("str.ui16", opStrToUI16, In(ast.ConstCxArg_STR), Out(ast.ConstCxArg_UI16))
-	RegisterFunction("str.ui32", opStrToUI32, In(ast.ConstCxArg_STR), Out(ast.ConstCxArg_UI32))
-	RegisterFunction("str.ui64", opStrToUI64, In(ast.ConstCxArg_STR), Out(ast.ConstCxArg_UI64))
-	RegisterFunction("str.f32", opStrToF32, In(ast.ConstCxArg_STR), Out(ast.ConstCxArg_F32))
-	RegisterFunction("str.f64", opStrToF64, In(ast.ConstCxArg_STR), Out(ast.ConstCxArg_F64))
+	RegisterFunction("str.i8", opStrToI8, In(ast.ConstCxArg_STR), Out(ast.ConstCXArg_I8, ast.ConstCxArg_ERROR))
+	RegisterFunction("str.i16", opStrToI16, In(ast.ConstCxArg_STR), Out(ast.ConstCxArg_I16, ast.ConstCxArg_ERROR))
+	RegisterFunction("str.i32", opStrToI32, In(ast.ConstCxArg_STR), Out(ast.ConstCxArg_I32, ast.ConstCxArg_ERROR))
+	RegisterFunction("str.i64", opStrToI64, In(ast.ConstCxArg_STR), Out(ast.ConstCxArg_I64, ast.ConstCxArg_ERROR))
+	RegisterFunction("str.ui8", opStrToUI8, In(ast.ConstCxArg_STR), Out(ast.ConstCxArg_UI8, ast.ConstCxArg_ERROR))
+	RegisterFunction("str.ui16", opStrToUI16, In(ast.ConstCxArg_STR), Out(ast.ConstCxArg_UI16, ast.ConstCxArg_ERROR))
+	RegisterFunction("str.ui32", opStrToUI32, In(ast.ConstCxArg_STR), Out(ast.ConstCxArg_UI32, ast.ConstCxArg_ERROR))
+	RegisterFunction("str.ui64", opStrToUI64, In(ast.ConstCxArg_STR), Out(ast.ConstCxArg_UI64, ast.ConstCxArg_ERROR))
+	RegisterFunction("str.f32", opStrToF32, In(ast.ConstCxArg_STR), Out(ast.ConstCxArg_F32, ast.ConstCxArg_ERROR))
+	RegisterFunction("str.f64", opStrToF64, In(ast.ConstCxArg_STR), Out(ast.ConstCxArg_F64, ast.ConstCxArg_ERROR))
 	RegisterFunction("str.print", opStrPrint, In(ast.ConstCxArg_STR), nil)
 	RegisterFunction("str.substr", opStrSubstr, In(ast.ConstCxArg_STR, ast.ConstCxArg_I32, ast.ConstCxArg_I32), Out(ast.ConstCxArg_STR))
 	RegisterFunction("str.index", opStrIndex, In(ast.ConstCxArg_STR, ast.ConstCxArg_STR), Out(ast.ConstCxArg_I32))
 	RegisterFunction("str.lastindex", opStrLastIndex, In(ast.ConstCxArg_STR, ast.ConstCxArg_STR), Out(ast.ConstCxArg_I32))
 	RegisterFunction("str.trimspace", opStrTrimSpace, In(ast.ConstCxArg_STR), Out(ast.ConstCxArg_STR))
 
+	RegisterOperator("error.eq", opErrorEq, In(ast.ConstCxArg_ERROR, ast.ConstCxArg_ERROR), Out(ast.ConstCxArg_BOOL), constants.TYPE_ERROR, constants.OP_EQUAL)
+	RegisterOperator("error.uneq", opErrorUneq, In(ast.ConstCxArg_ERROR, ast.ConstCxArg_ERROR), Out(ast.ConstCxArg_BOOL), constants.TYPE_ERROR, constants.OP_UNEQUAL)
+	RegisterFunction("error.New", opErrorNew, In(ast.ConstCxArg_STR), Out(ast.ConstCxArg_ERROR))
+	RegisterFunction("error.Error", opErrorError, In(ast.ConstCxArg_ERROR), Out(ast.ConstCxArg_STR))
+
 	RegisterFunction("resize", opSliceResize, In(ast.Slice(constants.TYPE_UNDEFINED), ast.ConstCxArg_I32), Out(ast.Slice(constants.TYPE_UNDEFINED)))
 	RegisterFunction("insert", opSliceInsertElement, In(ast.Slice(constants.TYPE_UNDEFINED), ast.Slice(constants.TYPE_UNDEFINED)), Out(ast.Slice(constants.TYPE_UNDEFINED)))
 	RegisterFunction("remove", opSliceRemoveElement, In(ast.Slice(constants.TYPE_UNDEFINED), ast.ConstCxArg_I32), Out(ast.Slice(constants.TYPE_UNDEFINED)))
 	RegisterFunction("copy", opSliceCopy, In(ast.Slice(constants.TYPE_UNDEFINED), ast.Slice(constants.TYPE_UNDEFINED)), Out(ast.ConstCxArg_I32))
+	RegisterFunction("slice.expr", opSliceExpression, In(ast.ConstCxArg_UND_TYPE, ast.ConstCxArg_I32, ast.ConstCxArg_I32, ast.ConstCxArg_I32), Out(ast.ConstCxArg_UND_TYPE))
 
 	RegisterFunction("panicIf", opPanicIf, In(ast.ConstCxArg_BOOL, ast.ConstCxArg_STR), nil)
 	RegisterFunction("panicIfNot", opPanicIfNot, In(ast.ConstCxArg_BOOL, ast.ConstCxArg_STR), nil)
 	RegisterFunction("strerror", opStrError, In(ast.ConstCxArg_I32), Out(ast.ConstCxArg_STR))
+	RegisterFunction("recover", opRecover, nil, Out(ast.ConstCxArg_I32))
 
 	RegisterFunction("aff.print", opAffPrint, In(ast.Slice(constants.TYPE_AFF)), nil)
 	RegisterFunction("aff.query", opAffQuery, In(ast.Slice(constants.TYPE_AFF)), Out(ast.Slice(constants.TYPE_AFF)))
@@ -487,9 +509,9 @@ func RegisterOpcodes() {
 	RegisterFunction("aff.inform", opAffInform, In(ast.Slice(constants.TYPE_AFF), ast.ConstCxArg_I32, ast.Slice(constants.TYPE_AFF)), nil)
 	RegisterFunction("aff.request", opAffRequest, In(ast.Slice(constants.TYPE_AFF), ast.ConstCxArg_I32, ast.Slice(constants.TYPE_AFF)), nil)
 
-	RegisterFunction("tcp.Dial", opTCPDial, In(ast.ConstCxArg_STR, ast.ConstCxArg_STR), Out(ast.ConstCxArg_STR))
-	RegisterFunction("tcp.Listen", opTCPListen, In(ast.ConstCxArg_STR, ast.ConstCxArg_STR), Out(ast.ConstCxArg_STR))
-	RegisterFunction("tcp.Accept", opTCPAccept, In(ast.ConstCxArg_STR, ast.ConstCxArg_STR), Out(ast.ConstCxArg_STR))
+	RegisterNondeterministicFunction("tcp.Dial", opTCPDial, In(ast.ConstCxArg_STR, ast.ConstCxArg_STR), Out(ast.ConstCxArg_ERROR))
+	RegisterNondeterministicFunction("tcp.Listen", opTCPListen, In(ast.ConstCxArg_STR, ast.ConstCxArg_STR), Out(ast.ConstCxArg_ERROR))
+	RegisterNondeterministicFunction("tcp.Accept", opTCPAccept, In(ast.ConstCxArg_STR, ast.ConstCxArg_STR), Out(ast.ConstCxArg_ERROR))
 	RegisterFunction("tcp.Close", opTCPClose, nil, nil)
 
 	//RegisterOpCode(OP_EVOLVE_EVOLVE, "evolve.evolve", opEvolve, In(Slice(TYPE_AFF), Slice(TYPE_AFF), Slice(TYPE_F64), Slice(TYPE_F64), ConstCxArg_I32, ConstCxArg_I32, ConstCxArg_I32, ConstCxArg_F64), nil)
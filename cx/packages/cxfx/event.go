@@ -5,10 +5,10 @@ package cxfx
 import (
 	"github.com/skycoin/cx/cx/ast"
 	"github.com/skycoin/cx/cx/execute"
+	"github.com/skycoin/cx/cx/globals"
 	"github.com/skycoin/cx/cx/helper"
 	//"fmt"
 	"sync"
-	"time"
 )
 
 const (
@@ -101,7 +101,7 @@ func PushEvent(eventType EventType) {
 	event := Event{
 		eventType,
 		ACTION_RELEASE, // TODO : ACTION_NONE
-		uint64(time.Now().UnixNano()),
+		uint64(globals.UnixNano()),
 		0.0,
 		0.0,
 		0,
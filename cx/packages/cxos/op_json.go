@@ -5,6 +5,7 @@ package cxos
 import (
 	"bufio"
 	"encoding/json"
+	"errors"
 	"github.com/skycoin/cx/cx/ast"
 	"github.com/skycoin/cx/cx/util"
 	"io"
@@ -41,6 +42,15 @@ type JSONFile struct {
 var jsons []JSONFile
 var freeJsons []int32
 
+var (
+	// errInvalidJson is the error returned by the natives receiving an
+	// invalid json handle.
+	errInvalidJson = errors.New("invalid json handle")
+	// errJsonTokenType is the error returned when reading the current token
+	// as a value of another type.
+	errJsonTokenType = errors.New("unexpected json token type")
+)
+
 // Open the named json file for reading, returns an i32 identifying the json cxgo.
 func opJsonOpen(inputs []ast.CXValue, outputs []ast.CXValue) {
 	handle := int32(-1)
@@ -71,76 +81,71 @@ func opJsonOpen(inputs []ast.CXValue, outputs []ast.CXValue) {
 	}
 
 	outputs[0].Set_i32(int32(handle))
+	outputs[1].Set_error(err)
 }
 
 // Close json cxgo (and all underlying resources) idendified by it's i32 handle.
 func opJsonClose(inputs []ast.CXValue, outputs []ast.CXValue) {
-	success := false
-    handle := inputs[0].Get_i32()
+	err := errInvalidJson
+	handle := inputs[0].Get_i32()
 	if jsonFile := validJsonFile(handle); jsonFile != nil {
-		if err := jsonFile.file.Close(); err != nil {
-			panic(err)
-		}
+		err = jsonFile.file.Close()
 
 		jsons[handle] = JSONFile{}
 		freeJsons = append(freeJsons, handle)
-		success = true
 	}
 
-	outputs[0].Set_bool(success)
+	outputs[0].Set_error(err)
 }
 
 // More return true if there is another element in the current array or object being parsed.
 func opJsonTokenMore(inputs []ast.CXValue, outputs []ast.CXValue) {
 	more := false
-	success := false
+	err := errInvalidJson
 
 	if jsonFile := validJsonFile(inputs[0].Get_i32()); jsonFile != nil {
 		more = jsonFile.decoder.More()
-		success = true
+		err = nil
 	}
 
 	outputs[0].Set_bool(more)
-	outputs[1].Set_bool(success)
+	outputs[1].Set_error(err)
 }
 
 // Token parses the next token.
 func opJsonTokenNext(inputs []ast.CXValue, outputs []ast.CXValue) {
 	tokenType := int32(JSON_TOKEN_INVALID)
-	success := false
+	err := errInvalidJson
 
 	if jsonFile := validJsonFile(inputs[0].Get_i32()); jsonFile != nil {
-		token, err := jsonFile.decoder.Token()
+		var token json.Token
+		token, err = jsonFile.decoder.Token()
 		if err == io.EOF {
 			tokenType = JSON_TOKEN_NULL
-			success = true
+			err = nil
 		} else if err == nil {
 			jsonFile.token = token
 			switch value := token.(type) {
 			case json.Delim:
 				tokenType = JSON_TOKEN_DELIM
 				jsonFile.tokenDelim = value
-				success = true
 			case bool:
 				tokenType = JSON_TOKEN_BOOL
 				jsonFile.tokenBool = value
-				success = true
 			case float64:
 				tokenType = JSON_TOKEN_F64
 				jsonFile.tokenF64 = value
-				success = true
 			case json.Number:
 				tokenType = JSON_TOKEN_NUMBER
 				jsonFile.tokenNumber = value
-				success = true
 			case string:
 				tokenType = JSON_TOKEN_STR
 				jsonFile.tokenStr = value
-				success = true
 			default:
 				if value == nil {
 					tokenType = JSON_TOKEN_NULL
-					success = true
+				} else {
+					err = errJsonTokenType
 				}
 			}
 		}
@@ -148,108 +153,106 @@ func opJsonTokenNext(inputs []ast.CXValue, outputs []ast.CXValue) {
 	}
 
 	outputs[0].Set_i32(tokenType)
-	outputs[1].Set_bool(success)
+	outputs[1].Set_error(err)
 }
 
 // Type returns the type of the current token.
 func opJsonTokenType(inputs []ast.CXValue, outputs []ast.CXValue) {
 	tokenType := int32(JSON_TOKEN_INVALID)
-	success := false
+	err := errInvalidJson
 
 	if jsonFile := validJsonFile(inputs[0].Get_i32()); jsonFile != nil {
 		tokenType = jsonFile.tokenType
-		success = true
+		err = nil
 	}
 
 	outputs[0].Set_i32(tokenType)
-	outputs[1].Set_bool(success)
+	outputs[1].Set_error(err)
 }
 
 // Delim returns current token as an int32 delimiter.
 func opJsonTokenDelim(inputs []ast.CXValue, outputs []ast.CXValue) {
 	tokenDelim := int32(JSON_TOKEN_INVALID)
-	success := false
 
-	if jsonFile := validJsonFile(inputs[0].Get_i32()); jsonFile != nil {
-		if jsonFile.tokenType == JSON_TOKEN_DELIM {
-			tokenDelim = int32(jsonFile.tokenDelim)
-			success = true
-		}
+	jsonFile, err := jsonToken(inputs[0].Get_i32(), JSON_TOKEN_DELIM)
+	if err == nil {
+		tokenDelim = int32(jsonFile.tokenDelim)
 	}
 
 	outputs[0].Set_i32(tokenDelim)
-	outputs[1].Set_bool(success)
+	outputs[1].Set_error(err)
 }
 
 // Bool returns current token as a bool value.
 func opJsonTokenBool(inputs []ast.CXValue, outputs []ast.CXValue) {
 	tokenBool := false
-	success := false
 
-	if jsonFile := validJsonFile(inputs[0].Get_i32()); jsonFile != nil {
-		if jsonFile.tokenType == JSON_TOKEN_BOOL {
-			tokenBool = jsonFile.tokenBool
-			success = true
-		}
+	jsonFile, err := jsonToken(inputs[0].Get_i32(), JSON_TOKEN_BOOL)
+	if err == nil {
+		tokenBool = jsonFile.tokenBool
 	}
 
 	outputs[0].Set_bool(tokenBool)
-	outputs[1].Set_bool(success)
+	outputs[1].Set_error(err)
 }
 
 // Float64 returns current token as float64 value.
 func opJsonTokenF64(inputs []ast.CXValue, outputs []ast.CXValue) {
 	var tokenF64 float64
-	success := false
+	err := errInvalidJson
 
 	if jsonFile := validJsonFile(inputs[0].Get_i32()); jsonFile != nil {
 		if jsonFile.tokenType == JSON_TOKEN_F64 {
 			tokenF64 = jsonFile.tokenF64
-			success = true
+			err = nil
 		} else if jsonFile.tokenType == JSON_TOKEN_NUMBER {
-			var err error
-			if tokenF64, err = jsonFile.tokenNumber.Float64(); err == nil {
-				success = true
-			}
+			tokenF64, err = jsonFile.tokenNumber.Float64()
+		} else {
+			err = errJsonTokenType
 		}
 	}
 
 	outputs[0].Set_f64(tokenF64)
-	outputs[1].Set_bool(success)
+	outputs[1].Set_error(err)
 }
 
 // Int64 returns current token as int64 value.
 func opJsonTokenI64(inputs []ast.CXValue, outputs []ast.CXValue) {
 	var tokenI64 int64
-	success := false
 
-	if jsonFile := validJsonFile(inputs[0].Get_i32()); jsonFile != nil {
-		if jsonFile.tokenType == JSON_TOKEN_NUMBER {
-			var err error
-			if tokenI64, err = jsonFile.tokenNumber.Int64(); err == nil {
-				success = true
-			}
-		}
+	jsonFile, err := jsonToken(inputs[0].Get_i32(), JSON_TOKEN_NUMBER)
+	if err == nil {
+		tokenI64, err = jsonFile.tokenNumber.Int64()
 	}
 
 	outputs[0].Set_i64(tokenI64)
-	outputs[1].Set_bool(success)
+	outputs[1].Set_error(err)
 }
 
 // Str returns current token as string value.
 func opJsonTokenStr(inputs []ast.CXValue, outputs []ast.CXValue) {
 	var tokenStr string
-	success := false
 
-	if jsonFile := validJsonFile(inputs[0].Get_i32()); jsonFile != nil {
-		if jsonFile.tokenType == JSON_TOKEN_STR {
-			tokenStr = jsonFile.tokenStr
-			success = true
-		}
+	jsonFile, err := jsonToken(inputs[0].Get_i32(), JSON_TOKEN_STR)
+	if err == nil {
+		tokenStr = jsonFile.tokenStr
 	}
 
-    outputs[0].Set_str(tokenStr)
-	outputs[1].Set_bool(success)
+	outputs[0].Set_str(tokenStr)
+	outputs[1].Set_error(err)
+}
+
+// jsonToken returns the json file of `handle` if its current token is of
+// type `tokenType`.
+func jsonToken(handle int32, tokenType int32) (*JSONFile, error) {
+	jsonFile := validJsonFile(handle)
+	if jsonFile == nil {
+		return nil, errInvalidJson
+	}
+	if jsonFile.tokenType != tokenType {
+		return nil, errJsonTokenType
+	}
+	return jsonFile, nil
 }
 
 // helper function used to validate json handle from i32
@@ -5,6 +5,7 @@ package cxos
 import (
 	"bytes"
 	"encoding/binary"
+	"errors"
 	"github.com/skycoin/cx/cx/ast"
 	"github.com/skycoin/cx/cx/constants"
 	"github.com/skycoin/cx/cx/globals"
@@ -34,19 +35,43 @@ func ValidFile(handle int32) *os.File {
 	return nil
 }
 
+// errInvalidFile is the error returned by the natives receiving an invalid
+// file handle.
+var errInvalidFile = errors.New("invalid file handle")
+
+// errRunTimeout is the error returned by os.Run when the command doesn't
+// finish in time.
+var errRunTimeout = errors.New("command timed out")
+
+// readFile reads `value` from the file of `handle` in little-endian order.
+func readFile(handle int32, value interface{}) error {
+	file := ValidFile(handle)
+	if file == nil {
+		return errInvalidFile
+	}
+	return binary.Read(file, binary.LittleEndian, value)
+}
+
+// writeFile writes `value` to the file of `handle` in little-endian order.
+func writeFile(handle int32, value interface{}) error {
+	file := ValidFile(handle)
+	if file == nil {
+		return errInvalidFile
+	}
+	return binary.Write(file, binary.LittleEndian, value)
+}
+
 func opOsLogFile(inputs []ast.CXValue, outputs []ast.CXValue) {
 	util.CXLogFile(inputs[0].Get_bool())
 }
 
 func opOsReadAllText(inputs []ast.CXValue, outputs []ast.CXValue) {
-	success := false
-
-	if byts, err := util.CXReadFile(inputs[0].Get_str()); err == nil {
-        outputs[0].Set_str(string(byts))
-		success = true
+	byts, err := util.CXReadFile(inputs[0].Get_str())
+	if err == nil {
+		outputs[0].Set_str(string(byts))
 	}
 
-	outputs[1].Set_bool(success)
+	outputs[1].Set_error(err)
 }
 
 func getFileHandle(file *os.File) int32 {
@@ -71,343 +96,230 @@ func getFileHandle(file *os.File) int32 {
 
 func opOsOpen(inputs []ast.CXValue, outputs []ast.CXValue) {
 	handle := int32(-1)
-	if file, err := util.CXOpenFile(inputs[0].Get_str()); err == nil {
+	file, err := util.CXOpenFile(inputs[0].Get_str())
+	if err == nil {
 		handle = getFileHandle(file)
 	}
 
 	outputs[0].Set_i32(int32(handle))
+	outputs[1].Set_error(err)
 }
 
 func opOsCreate(inputs []ast.CXValue, outputs []ast.CXValue) {
 	handle := int32(-1)
-	if file, err := util.CXCreateFile(inputs[0].Get_str()); err == nil {
+	file, err := util.CXCreateFile(inputs[0].Get_str())
+	if err == nil {
 		handle = getFileHandle(file)
 	}
 
 	outputs[0].Set_i32(int32(handle))
+	outputs[1].Set_error(err)
 }
 
 func opOsClose(inputs []ast.CXValue, outputs []ast.CXValue) {
-	success := false
+	err := errInvalidFile
 
 	handle := inputs[0].Get_i32()
 	if file := ValidFile(handle); file != nil {
-		if err := file.Close(); err == nil {
-			success = true
-		}
+		err = file.Close()
 
 		openFiles[handle] = nil
 		freeFiles = append(freeFiles, handle)
 	}
 
-	outputs[0].Set_bool(success)
+	outputs[0].Set_error(err)
 }
 
 func opOsSeek(inputs []ast.CXValue, outputs []ast.CXValue) {
 	offset := int64(-1)
+	err := errInvalidFile
 	if file := ValidFile(inputs[0].Get_i32()); file != nil {
-		var err error
 		if offset, err = file.Seek(inputs[1].Get_i64(), int(inputs[2].Get_i32())); err != nil {
 			offset = -1
 		}
 	}
 	outputs[0].Set_i64(offset)
+	outputs[1].Set_error(err)
 }
 
 func opOsReadStr(inputs []ast.CXValue, outputs []ast.CXValue) {
 	var len uint64
 	var value string
-	success := false
-	if file := ValidFile(inputs[0].Get_i32()); file != nil {
-		if err := binary.Read(file, binary.LittleEndian, &len); err == nil {
-			bytes := make([]byte, len)
-			if err := binary.Read(file, binary.LittleEndian, &bytes); err == nil {
-				value = string(bytes)
-				success = true
-			}
+	handle := inputs[0].Get_i32()
+	err := readFile(handle, &len)
+	if err == nil {
+		bytes := make([]byte, len)
+		if err = readFile(handle, &bytes); err == nil {
+			value = string(bytes)
 		}
 	}
-    outputs[0].Set_str(value)
-	outputs[1].Set_bool(success)
+	outputs[0].Set_str(value)
+	outputs[1].Set_error(err)
 }
 
 func opOsReadF64(inputs []ast.CXValue, outputs []ast.CXValue) {
 	var value float64
-	success := false
-	if file := ValidFile(inputs[0].Get_i32()); file != nil {
-		if err := binary.Read(file, binary.LittleEndian, &value); err == nil {
-			success = true
-		}
-	}
+	err := readFile(inputs[0].Get_i32(), &value)
 
 	outputs[0].Set_f64(value)
-	outputs[1].Set_bool(success)
+	outputs[1].Set_error(err)
 }
 
 func opOsReadF32(inputs []ast.CXValue, outputs []ast.CXValue) {
 	var value float32
-	success := false
-	if file := ValidFile(inputs[0].Get_i32()); file != nil {
-		if err := binary.Read(file, binary.LittleEndian, &value); err == nil {
-			success = true
-		}
-	}
+	err := readFile(inputs[0].Get_i32(), &value)
 
     outputs[0].Set_f32(value)
-	outputs[1].Set_bool(success)
+	outputs[1].Set_error(err)
 }
 
 func opOsReadUI64(inputs []ast.CXValue, outputs []ast.CXValue) {
 	var value uint64
-	success := false
-	if file := ValidFile(inputs[0].Get_i32()); file != nil {
-		if err := binary.Read(file, binary.LittleEndian, &value); err == nil {
-			success = true
-		}
-	}
+	err := readFile(inputs[0].Get_i32(), &value)
 
     outputs[0].Set_ui64(value)
-	outputs[1].Set_bool(success)
+	outputs[1].Set_error(err)
 }
 
 func opOsReadUI32(inputs []ast.CXValue, outputs []ast.CXValue) {
 	var value uint32
-	success := false
-	if file := ValidFile(inputs[0].Get_i32()); file != nil {
-		if err := binary.Read(file, binary.LittleEndian, &value); err == nil {
-			success = true
-		}
-	}
+	err := readFile(inputs[0].Get_i32(), &value)
 
     outputs[0].Set_ui32(value)
-	outputs[1].Set_bool(success)
+	outputs[1].Set_error(err)
 }
 
 func opOsReadUI16(inputs []ast.CXValue, outputs []ast.CXValue) {
 	var value uint16
-	success := false
-	if file := ValidFile(inputs[0].Get_i32()); file != nil {
-		if err := binary.Read(file, binary.LittleEndian, &value); err == nil {
-			success = true
-		}
-	}
+	err := readFile(inputs[0].Get_i32(), &value)
 
     outputs[0].Set_ui16(value)
-	outputs[1].Set_bool(success)
+	outputs[1].Set_error(err)
 }
 
 func opOsReadUI8(inputs []ast.CXValue, outputs []ast.CXValue) {
 	var value uint8
-	success := false
-	if file := ValidFile(inputs[0].Get_i32()); file != nil {
-		if err := binary.Read(file, binary.LittleEndian, &value); err == nil {
-			success = true
-		}
-	}
+	err := readFile(inputs[0].Get_i32(), &value)
 
     outputs[0].Set_ui8(value)
-	outputs[1].Set_bool(success)
+	outputs[1].Set_error(err)
 }
 
 func opOsReadI64(inputs []ast.CXValue, outputs []ast.CXValue) {
 	var value int64
-	success := false
-	if file := ValidFile(inputs[0].Get_i32()); file != nil {
-		if err := binary.Read(file, binary.LittleEndian, &value); err == nil {
-			success = true
-		}
-	}
+	err := readFile(inputs[0].Get_i32(), &value)
 
 	outputs[0].Set_i64(value)
-	outputs[1].Set_bool(success)
+	outputs[1].Set_error(err)
 }
 
 func opOsReadI32(inputs []ast.CXValue, outputs []ast.CXValue) {
 	var value int32
-	success := false
-	if file := ValidFile(inputs[0].Get_i32()); file != nil {
-		if err := binary.Read(file, binary.LittleEndian, &value); err == nil {
-			success = true
-		}
-	}
+	err := readFile(inputs[0].Get_i32(), &value)
 
 	outputs[0].Set_i32(value)
-	outputs[1].Set_bool(success)
+	outputs[1].Set_error(err)
 }
 
 func opOsReadI16(inputs []ast.CXValue, outputs []ast.CXValue) {
 	var value int16
-	success := false
-	if file := ValidFile(inputs[0].Get_i32()); file != nil {
-		if err := binary.Read(file, binary.LittleEndian, &value); err == nil {
-			success = true
-		}
-	}
+	err := readFile(inputs[0].Get_i32(), &value)
 
     outputs[0].Set_i16(value)
-	outputs[1].Set_bool(success)
+	outputs[1].Set_error(err)
 }
 
 func opOsReadI8(inputs []ast.CXValue, outputs []ast.CXValue) {
 	var value int8
-	success := false
-	if file := ValidFile(inputs[0].Get_i32()); file != nil {
-		if err := binary.Read(file, binary.LittleEndian, &value); err == nil {
-			success = true
-		}
-	}
+	err := readFile(inputs[0].Get_i32(), &value)
 
     outputs[0].Set_i8(value)
-	outputs[1].Set_bool(success)
+	outputs[1].Set_error(err)
 }
 
 func opOsReadBOOL(inputs []ast.CXValue, outputs []ast.CXValue) {
 	var value bool
-	success := false
-	if file := ValidFile(inputs[0].Get_i32()); file != nil {
-		if err := binary.Read(file, binary.LittleEndian, &value); err == nil {
-			success = true
-		}
-	}
+	err := readFile(inputs[0].Get_i32(), &value)
 
 	outputs[0].Set_bool(value)
-	outputs[1].Set_bool(success)
+	outputs[1].Set_error(err)
 }
 
 func opOsWriteStr(inputs []ast.CXValue, outputs []ast.CXValue) {
-	success := false
-	if file := ValidFile(inputs[0].Get_i32()); file != nil {
-		value := inputs[1].Get_str()
-		len := len(value)
-		if err := binary.Write(file, binary.LittleEndian, uint64(len)); err == nil {
-			if err := binary.Write(file, binary.LittleEndian, []byte(value)); err == nil {
-				success = true
-			}
-		}
+	handle := inputs[0].Get_i32()
+	value := inputs[1].Get_str()
+	err := writeFile(handle, uint64(len(value)))
+	if err == nil {
+		err = writeFile(handle, []byte(value))
 	}
 
-	outputs[0].Set_bool(success)
+	outputs[0].Set_error(err)
 }
 
 func opOsWriteF64(inputs []ast.CXValue, outputs []ast.CXValue) {
-	success := false
-	if file := ValidFile(inputs[0].Get_i32()); file != nil {
-		if err := binary.Write(file, binary.LittleEndian, inputs[1].Get_f64()); err == nil {
-			success = true
-		}
-	}
+	err := writeFile(inputs[0].Get_i32(), inputs[1].Get_f64())
 
-	outputs[0].Set_bool(success)
+	outputs[0].Set_error(err)
 }
 
 func opOsWriteF32(inputs []ast.CXValue, outputs []ast.CXValue) {
-	success := false
-	if file := ValidFile(inputs[0].Get_i32()); file != nil {
-		if err := binary.Write(file, binary.LittleEndian, inputs[1].Get_f32()); err == nil {
-			success = true
-		}
-	}
+	err := writeFile(inputs[0].Get_i32(), inputs[1].Get_f32())
 
-	outputs[0].Set_bool(success)
+	outputs[0].Set_error(err)
 }
 
 func opOsWriteUI64(inputs []ast.CXValue, outputs []ast.CXValue) {
-	success := false
-	if file := ValidFile(inputs[0].Get_i32()); file != nil {
-		if err := binary.Write(file, binary.LittleEndian, inputs[1].Get_ui64()); err == nil {
-			success = true
-		}
-	}
+	err := writeFile(inputs[0].Get_i32(), inputs[1].Get_ui64())
 
-	outputs[0].Set_bool(success)
+	outputs[0].Set_error(err)
 }
 
 func opOsWriteUI32(inputs []ast.CXValue, outputs []ast.CXValue) {
-	success := false
-	if file := ValidFile(inputs[0].Get_i32()); file != nil {
-		if err := binary.Write(file, binary.LittleEndian, inputs[1].Get_i32()); err == nil {
-			success = true
-		}
-	}
+	err := writeFile(inputs[0].Get_i32(), inputs[1].Get_i32())
 
-	outputs[0].Set_bool(success)
+	outputs[0].Set_error(err)
 }
 
 func opOsWriteUI16(inputs []ast.CXValue, outputs []ast.CXValue) {
-	success := false
-	if file := ValidFile(inputs[0].Get_i32()); file != nil {
-		if err := binary.Write(file, binary.LittleEndian, inputs[1].Get_ui16()); err == nil {
-			success = true
-		}
-	}
+	err := writeFile(inputs[0].Get_i32(), inputs[1].Get_ui16())
 
-	outputs[0].Set_bool(success)
+	outputs[0].Set_error(err)
 }
 
 func opOsWriteUI8(inputs []ast.CXValue, outputs []ast.CXValue) {
-	success := false
-	if file := ValidFile(inputs[0].Get_i32()); file != nil {
-		if err := binary.Write(file, binary.LittleEndian, inputs[1].Get_ui8()); err == nil {
-			success = true
-		}
-	}
+	err := writeFile(inputs[0].Get_i32(), inputs[1].Get_ui8())
 
-	outputs[0].Set_bool(success)
+	outputs[0].Set_error(err)
 }
 
 func opOsWriteI64(inputs []ast.CXValue, outputs []ast.CXValue) {
-	success := false
-	if file := ValidFile(inputs[0].Get_i32()); file != nil {
-		if err := binary.Write(file, binary.LittleEndian, inputs[1].Get_i64()); err == nil {
-			success = true
-		}
-	}
+	err := writeFile(inputs[0].Get_i32(), inputs[1].Get_i64())
 
-	outputs[0].Set_bool(success)
+	outputs[0].Set_error(err)
 }
 
 func opOsWriteI32(inputs []ast.CXValue, outputs []ast.CXValue) {
-	success := false
-	if file := ValidFile(inputs[0].Get_i32()); file != nil {
-		if err := binary.Write(file, binary.LittleEndian, inputs[1].Get_i32()); err == nil {
-			success = true
-		}
-	}
+	err := writeFile(inputs[0].Get_i32(), inputs[1].Get_i32())
 
-	outputs[0].Set_bool(success)
+	outputs[0].Set_error(err)
 }
 
 func opOsWriteI16(inputs []ast.CXValue, outputs []ast.CXValue) {
-	success := false
-	if file := ValidFile(inputs[0].Get_i32()); file != nil {
-		if err := binary.Write(file, binary.LittleEndian, inputs[1].Get_i16()); err == nil {
-			success = true
-		}
-	}
+	err := writeFile(inputs[0].Get_i32(), inputs[1].Get_i16())
 
-	outputs[0].Set_bool(success)
+	outputs[0].Set_error(err)
 }
 
 func opOsWriteI8(inputs []ast.CXValue, outputs []ast.CXValue) {
-	success := false
-	if file := ValidFile(inputs[0].Get_i32()); file != nil {
-		if err := binary.Write(file, binary.LittleEndian, inputs[1].Get_i8()); err == nil {
-			success = true
-		}
-	}
+	err := writeFile(inputs[0].Get_i32(), inputs[1].Get_i8())
 
-	outputs[0].Set_bool(success)
+	outputs[0].Set_error(err)
 }
 
 func opOsWriteBOOL(inputs []ast.CXValue, outputs []ast.CXValue) {
-	success := false
-	if file := ValidFile(inputs[0].Get_i32()); file != nil {
-		if err := binary.Write(file, binary.LittleEndian, inputs[1].Get_bool()); err == nil {
-			success = true
-		}
-	}
+	err := writeFile(inputs[0].Get_i32(), inputs[1].Get_bool())
 
-	outputs[0].Set_bool(success)
+	outputs[0].Set_error(err)
 }
 
 func getSlice(inputs []ast.CXValue, outputs []ast.CXValue) (outputSlicePointer int, outputSliceOffset int32, sizeofElement int, count uint64) {
@@ -425,323 +337,223 @@ func getSlice(inputs []ast.CXValue, outputs []ast.CXValue) (outputSlicePointer i
 }
 
 func opOsReadF64Slice(inputs []ast.CXValue, outputs []ast.CXValue) {
-	success := false
-    _, outputSliceOffset, sizeofElement, count := getSlice(inputs, outputs)
+	var err error
+	_, outputSliceOffset, sizeofElement, count := getSlice(inputs, outputs)
 	if count > 0 {
-		if file := ValidFile(inputs[0].Get_i32()); file != nil {
-			values := make([]float64, count)
-			if err := binary.Read(file, binary.LittleEndian, values); err == nil {
-				success = true
-				outputSliceData := ast.GetSliceData(outputSliceOffset, sizeofElement)
-				for i := uint64(0); i < count; i++ {
-					ast.WriteMemF64(outputSliceData, int(i)*sizeofElement, values[i])
-				}
+		values := make([]float64, count)
+		if err = readFile(inputs[0].Get_i32(), values); err == nil {
+			outputSliceData := ast.GetSliceData(outputSliceOffset, sizeofElement)
+			for i := uint64(0); i < count; i++ {
+				ast.WriteMemF64(outputSliceData, int(i)*sizeofElement, values[i])
 			}
 		}
 	}
 
 	outputs[0].SetSlice(outputSliceOffset)
-	outputs[1].Set_bool(success)
+	outputs[1].Set_error(err)
 }
 
 func opOsReadF32Slice(inputs []ast.CXValue, outputs []ast.CXValue) {
-	success := false
+	var err error
 	_, outputSliceOffset, sizeofElement, count := getSlice(inputs, outputs)
 	if count > 0 {
-		if file := ValidFile(inputs[0].Get_i32()); file != nil {
-			values := make([]float32, count)
-			if err := binary.Read(file, binary.LittleEndian, values); err == nil {
-				success = true
-				outputSliceData := ast.GetSliceData(outputSliceOffset, sizeofElement)
-				for i := uint64(0); i < count; i++ {
-					ast.WriteMemF32(outputSliceData, int(i)*sizeofElement, values[i])
-				}
+		values := make([]float32, count)
+		if err = readFile(inputs[0].Get_i32(), values); err == nil {
+			outputSliceData := ast.GetSliceData(outputSliceOffset, sizeofElement)
+			for i := uint64(0); i < count; i++ {
+				ast.WriteMemF32(outputSliceData, int(i)*sizeofElement, values[i])
 			}
 		}
 	}
 
     outputs[0].SetSlice(outputSliceOffset)
-	outputs[1].Set_bool(success)
+	outputs[1].Set_error(err)
 }
 
 func opOsReadUI64Slice(inputs []ast.CXValue, outputs []ast.CXValue) {
-	success := false
+	var err error
 	_, outputSliceOffset, sizeofElement, count := getSlice(inputs, outputs)
 	if count > 0 {
-		if file := ValidFile(inputs[0].Get_i32()); file != nil {
-			values := make([]uint64, count)
-			if err := binary.Read(file, binary.LittleEndian, values); err == nil {
-				success = true
-				outputSliceData := ast.GetSliceData(outputSliceOffset, sizeofElement)
-				for i := uint64(0); i < count; i++ {
-					ast.WriteMemUI64(outputSliceData, int(i)*sizeofElement, values[i])
-				}
+		values := make([]uint64, count)
+		if err = readFile(inputs[0].Get_i32(), values); err == nil {
+			outputSliceData := ast.GetSliceData(outputSliceOffset, sizeofElement)
+			for i := uint64(0); i < count; i++ {
+				ast.WriteMemUI64(outputSliceData, int(i)*sizeofElement, values[i])
 			}
 		}
 	}
 
 	outputs[0].SetSlice(outputSliceOffset)
-	outputs[1].Set_bool(success)
+	outputs[1].Set_error(err)
 }
 
 func opOsReadUI32Slice(inputs []ast.CXValue, outputs []ast.CXValue) {
-	success := false
+	var err error
 	_, outputSliceOffset, sizeofElement, count := getSlice(inputs, outputs)
 	if count > 0 {
-		if file := ValidFile(inputs[0].Get_i32()); file != nil {
-			values := make([]uint32, count)
-			if err := binary.Read(file, binary.LittleEndian, values); err == nil {
-				success = true
-				outputSliceData := ast.GetSliceData(outputSliceOffset, sizeofElement)
-				for i := uint64(0); i < count; i++ {
-					ast.WriteMemUI32(outputSliceData, int(i)*sizeofElement, values[i])
-				}
+		values := make([]uint32, count)
+		if err = readFile(inputs[0].Get_i32(), values); err == nil {
+			outputSliceData := ast.GetSliceData(outputSliceOffset, sizeofElement)
+			for i := uint64(0); i < count; i++ {
+				ast.WriteMemUI32(outputSliceData, int(i)*sizeofElement, values[i])
 			}
 		}
 	}
 
 	outputs[0].SetSlice(outputSliceOffset)
-	outputs[1].Set_bool(success)
+	outputs[1].Set_error(err)
 }
 
 func opOsReadUI16Slice(inputs []ast.CXValue, outputs []ast.CXValue) {
-	success := false
-    _, outputSliceOffset, sizeofElement, count := getSlice(inputs, outputs)
+	var err error
+	_, outputSliceOffset, sizeofElement, count := getSlice(inputs, outputs)
 	if count > 0 {
-		if file := ValidFile(inputs[0].Get_i32()); file != nil {
-			values := make([]uint16, count)
-			if err := binary.Read(file, binary.LittleEndian, values); err == nil {
-				success = true
-				outputSliceData := ast.GetSliceData(outputSliceOffset, sizeofElement)
-				for i := uint64(0); i < count; i++ {
-					ast.WriteMemUI16(outputSliceData, int(i)*sizeofElement, values[i])
-				}
+		values := make([]uint16, count)
+		if err = readFile(inputs[0].Get_i32(), values); err == nil {
+			outputSliceData := ast.GetSliceData(outputSliceOffset, sizeofElement)
+			for i := uint64(0); i < count; i++ {
+				ast.WriteMemUI16(outputSliceData, int(i)*sizeofElement, values[i])
 			}
 		}
 	}
 
 	outputs[0].SetSlice(outputSliceOffset)
-	outputs[1].Set_bool(success)
+	outputs[1].Set_error(err)
 }
 
 func opOsReadUI8Slice(inputs []ast.CXValue, outputs []ast.CXValue) {
-	success := false
+	var err error
 	_, outputSliceOffset, sizeofElement, count := getSlice(inputs, outputs)
 	if count > 0 {
-		if file := ValidFile(inputs[0].Get_i32()); file != nil {
-			values := make([]uint8, count)
-			if err := binary.Read(file, binary.LittleEndian, values); err == nil {
-				success = true
-				outputSliceData := ast.GetSliceData(outputSliceOffset, sizeofElement)
-				for i := uint64(0); i < count; i++ {
-					ast.WriteMemUI8(outputSliceData, int(i)*sizeofElement, values[i])
-				}
+		values := make([]uint8, count)
+		if err = readFile(inputs[0].Get_i32(), values); err == nil {
+			outputSliceData := ast.GetSliceData(outputSliceOffset, sizeofElement)
+			for i := uint64(0); i < count; i++ {
+				ast.WriteMemUI8(outputSliceData, int(i)*sizeofElement, values[i])
 			}
 		}
 	}
 
 	outputs[0].SetSlice(outputSliceOffset)
-	outputs[1].Set_bool(success)
+	outputs[1].Set_error(err)
 }
 
 func opOsReadI64Slice(inputs []ast.CXValue, outputs []ast.CXValue) {
-	success := false
-    _, outputSliceOffset, sizeofElement, count := getSlice(inputs, outputs)
+	var err error
+	_, outputSliceOffset, sizeofElement, count := getSlice(inputs, outputs)
 	if count > 0 {
-		if file := ValidFile(inputs[0].Get_i32()); file != nil {
-			values := make([]int64, count)
-			if err := binary.Read(file, binary.LittleEndian, values); err == nil {
-				success = true
-				outputSliceData := ast.GetSliceData(outputSliceOffset, sizeofElement)
-				for i := uint64(0); i < count; i++ {
-					ast.WriteMemI64(outputSliceData, int(i)*sizeofElement, values[i])
-				}
+		values := make([]int64, count)
+		if err = readFile(inputs[0].Get_i32(), values); err == nil {
+			outputSliceData := ast.GetSliceData(outputSliceOffset, sizeofElement)
+			for i := uint64(0); i < count; i++ {
+				ast.WriteMemI64(outputSliceData, int(i)*sizeofElement, values[i])
 			}
 		}
 	}
 
 	outputs[0].SetSlice(outputSliceOffset)
-	outputs[1].Set_bool(success)
+	outputs[1].Set_error(err)
 }
 
 func opOsReadI32Slice(inputs []ast.CXValue, outputs []ast.CXValue) {
-	success := false
+	var err error
 	_, outputSliceOffset, sizeofElement, count := getSlice(inputs, outputs)
 	if count > 0 {
-		if file := ValidFile(inputs[0].Get_i32()); file != nil {
-			values := make([]int32, count)
-			if err := binary.Read(file, binary.LittleEndian, values); err == nil {
-				success = true
-				outputSliceData := ast.GetSliceData(outputSliceOffset, sizeofElement)
-				for i := uint64(0); i < count; i++ {
-					ast.WriteMemI32(outputSliceData, int(i)*sizeofElement, values[i])
-				}
+		values := make([]int32, count)
+		if err = readFile(inputs[0].Get_i32(), values); err == nil {
+			outputSliceData := ast.GetSliceData(outputSliceOffset, sizeofElement)
+			for i := uint64(0); i < count; i++ {
+				ast.WriteMemI32(outputSliceData, int(i)*sizeofElement, values[i])
 			}
 		}
 	}
 
 	outputs[0].SetSlice(outputSliceOffset)
-	outputs[1].Set_bool(success)
+	outputs[1].Set_error(err)
 }
 
 func opOsReadI16Slice(inputs []ast.CXValue, outputs []ast.CXValue) {
-	success := false
+	var err error
 	_, outputSliceOffset, sizeofElement, count := getSlice(inputs, outputs)
 	if count > 0 {
-		if file := ValidFile(inputs[0].Get_i32()); file != nil {
-			values := make([]int16, count)
-			if err := binary.Read(file, binary.LittleEndian, values); err == nil {
-				success = true
-				outputSliceData := ast.GetSliceData(outputSliceOffset, sizeofElement)
-				for i := uint64(0); i < count; i++ {
-					ast.WriteMemI16(outputSliceData, int(i)*sizeofElement, values[i])
-				}
+		values := make([]int16, count)
+		if err = readFile(inputs[0].Get_i32(), values); err == nil {
+			outputSliceData := ast.GetSliceData(outputSliceOffset, sizeofElement)
+			for i := uint64(0); i < count; i++ {
+				ast.WriteMemI16(outputSliceData, int(i)*sizeofElement, values[i])
 			}
 		}
 	}
 
 	outputs[0].SetSlice(outputSliceOffset)
-	outputs[1].Set_bool(success)
+	outputs[1].Set_error(err)
 }
 
 func opOsReadI8Slice(inputs []ast.CXValue, outputs []ast.CXValue) {
-	success := false
+	var err error
 	_, outputSliceOffset, sizeofElement, count := getSlice(inputs, outputs)
 	if count > 0 {
-		if file := ValidFile(inputs[0].Get_i32()); file != nil {
-			values := make([]int8, count)
-			if err := binary.Read(file, binary.LittleEndian, values); err == nil {
-				success = true
-				outputSliceData := ast.GetSliceData(outputSliceOffset, sizeofElement)
-				for i := uint64(0); i < count; i++ {
-					ast.WriteMemI8(outputSliceData, int(i)*sizeofElement, values[i])
-				}
+		values := make([]int8, count)
+		if err = readFile(inputs[0].Get_i32(), values); err == nil {
+			outputSliceData := ast.GetSliceData(outputSliceOffset, sizeofElement)
+			for i := uint64(0); i < count; i++ {
+				ast.WriteMemI8(outputSliceData, int(i)*sizeofElement, values[i])
 			}
 		}
 	}
 
 	outputs[0].SetSlice(outputSliceOffset)
-	outputs[1].Set_bool(success)
+	outputs[1].Set_error(err)
 }
 
 func opOsWriteF64Slice(inputs []ast.CXValue, outputs []ast.CXValue) {
-	success := false
-	if file := ValidFile(inputs[0].Get_i32()); file != nil {
-		if data := inputs[1].GetSlice_f64(); data != nil {
-			if err := binary.Write(file, binary.LittleEndian, data); err == nil {
-				success = true
-			}
-		}
-	}
-	outputs[0].Set_bool(success)
+	err := writeFile(inputs[0].Get_i32(), inputs[1].GetSlice_f64())
+	outputs[0].Set_error(err)
 }
 
 func opOsWriteF32Slice(inputs []ast.CXValue, outputs []ast.CXValue) {
-	success := false
-	if file := ValidFile(inputs[0].Get_i32()); file != nil {
-		if data := inputs[1].GetSlice_f32(); data != nil {
-			if err := binary.Write(file, binary.LittleEndian, data); err == nil {
-				success = true
-			}
-		}
-	}
-	outputs[0].Set_bool(success)
+	err := writeFile(inputs[0].Get_i32(), inputs[1].GetSlice_f32())
+	outputs[0].Set_error(err)
 }
 
 func opOsWriteUI64Slice(inputs []ast.CXValue, outputs []ast.CXValue) {
-	success := false
-	if file := ValidFile(inputs[0].Get_i32()); file != nil {
-		if data := inputs[1].GetSlice_f64(); data != nil {
-			if err := binary.Write(file, binary.LittleEndian, data); err == nil {
-				success = true
-			}
-		}
-	}
-	outputs[0].Set_bool(success)
+	err := writeFile(inputs[0].Get_i32(), inputs[1].GetSlice_f64())
+	outputs[0].Set_error(err)
 }
 
 func opOsWriteUI32Slice(inputs []ast.CXValue, outputs []ast.CXValue) {
-	success := false
-	if file := ValidFile(inputs[0].Get_i32()); file != nil {
-		if data := inputs[1].GetSlice_ui32(); data != nil {
-			if err := binary.Write(file, binary.LittleEndian, data); err == nil {
-				success = true
-			}
-		}
-	}
-	outputs[0].Set_bool(success)
+	err := writeFile(inputs[0].Get_i32(), inputs[1].GetSlice_ui32())
+	outputs[0].Set_error(err)
 }
 
 func opOsWriteUI16Slice(inputs []ast.CXValue, outputs []ast.CXValue) {
-	success := false
-	if file := ValidFile(inputs[0].Get_i32()); file != nil {
-		if data := inputs[1].GetSlice_ui16(); data != nil {
-			if err := binary.Write(file, binary.LittleEndian, data); err == nil {
-				success = true
-			}
-		}
-	}
-	outputs[0].Set_bool(success)
+	err := writeFile(inputs[0].Get_i32(), inputs[1].GetSlice_ui16())
+	outputs[0].Set_error(err)
 }
 
 func opOsWriteUI8Slice(inputs []ast.CXValue, outputs []ast.CXValue) {
-	success := false
-	if file := ValidFile(inputs[0].Get_i32()); file != nil {
-		if data := inputs[1].GetSlice_ui8(); data != nil {
-			if err := binary.Write(file, binary.LittleEndian, data); err == nil {
-				success = true
-			}
-		}
-	}
-	outputs[0].Set_bool(success)
+	err := writeFile(inputs[0].Get_i32(), inputs[1].GetSlice_ui8())
+	outputs[0].Set_error(err)
 }
 
 func opOsWriteI64Slice(inputs []ast.CXValue, outputs []ast.CXValue) {
-	success := false
-	if file := ValidFile(inputs[0].Get_i32()); file != nil {
-		if data := inputs[1].GetSlice_i64(); data != nil {
-			if err := binary.Write(file, binary.LittleEndian, data); err == nil {
-				success = true
-			}
-		}
-	}
-	outputs[0].Set_bool(success)
+	err := writeFile(inputs[0].Get_i32(), inputs[1].GetSlice_i64())
+	outputs[0].Set_error(err)
 }
 
 func opOsWriteI32Slice(inputs []ast.CXValue, outputs []ast.CXValue) {
-	success := false
-	if file := ValidFile(inputs[0].Get_i32()); file != nil {
-		if data := inputs[1].GetSlice_i32(); data != nil {
-			if err := binary.Write(file, binary.LittleEndian, data); err == nil {
-				success = true
-			}
-		}
-	}
-	outputs[0].Set_bool(success)
+	err := writeFile(inputs[0].Get_i32(), inputs[1].GetSlice_i32())
+	outputs[0].Set_error(err)
 }
 
 func opOsWriteI16Slice(inputs []ast.CXValue, outputs []ast.CXValue) {
-	success := false
-	if file := ValidFile(inputs[0].Get_i32()); file != nil {
-		if data := inputs[1].GetSlice_i16(); data != nil {
-			if err := binary.Write(file, binary.LittleEndian, data); err == nil {
-				success = true
-			}
-		}
-	}
-	outputs[0].Set_bool(success)
+	err := writeFile(inputs[0].Get_i32(), inputs[1].GetSlice_i16())
+	outputs[0].Set_error(err)
 }
 
 func opOsWriteI8Slice(inputs []ast.CXValue, outputs []ast.CXValue) {
-	success := false
-	if file := ValidFile(inputs[0].Get_i32()); file != nil {
-		if data := inputs[1].GetSlice_i8(); data != nil {
-			if err := binary.Write(file, binary.LittleEndian, data); err == nil {
-				success = true
-			}
-		}
-	}
-	outputs[0].Set_bool(success)
+	err := writeFile(inputs[0].Get_i32(), inputs[1].GetSlice_i8())
+	outputs[0].Set_error(err)
 }
 
 func opOsGetWorkingDirectory(inputs []ast.CXValue, outputs []ast.CXValue) {
@@ -756,6 +568,7 @@ func opOsExit(inputs []ast.CXValue, outputs []ast.CXValue) {
 
 func opOsRun(inputs []ast.CXValue, outputs []ast.CXValue) {
 	var runError int32 = OS_RUN_SUCCESS
+	var err error
 
 	command := inputs[0].Get_str()
 	dir := inputs[3].Get_str()
@@ -785,7 +598,7 @@ func opOsRun(inputs []ast.CXValue, outputs []ast.CXValue) {
 		timeout = time.Duration(timeoutMs) * time.Millisecond
 	}
 
-	if err := cmd.Start(); err != nil {
+	if err = cmd.Start(); err != nil {
 		runError = OS_RUN_START_FAILED
 	} else {
 		done := make(chan error)
@@ -795,7 +608,8 @@ func opOsRun(inputs []ast.CXValue, outputs []ast.CXValue) {
 		case <-time.After(timeout):
 			cmd.Process.Kill()
 			runError = OS_RUN_TIMEOUT
-		case err := <-done:
+			err = errRunTimeout
+		case err = <-done:
 			if err != nil {
 				if exiterr, ok := err.(*exec.ExitError); ok {
 					// from stackoverflow
@@ -823,4 +637,5 @@ func opOsRun(inputs []ast.CXValue, outputs []ast.CXValue) {
 	outputs[0].Set_i32(runError)
 	outputs[1].Set_i32(cmdError)
     outputs[2].Set_str(string(stdOutBytes))
+	outputs[3].Set_error(err)
 }
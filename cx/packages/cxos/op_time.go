@@ -4,11 +4,12 @@ package cxos
 
 import (
 	"github.com/skycoin/cx/cx/ast"
+	"github.com/skycoin/cx/cx/globals"
 	"time"
 )
 
 func makeTimestamp() int64 {
-	return time.Now().UnixNano() / (int64(time.Millisecond) / int64(time.Nanosecond))
+	return globals.UnixNano() / (int64(time.Millisecond) / int64(time.Nanosecond))
 }
 
 func opTimeUnixMilli(inputs []ast.CXValue, outputs []ast.CXValue) {
@@ -16,9 +17,17 @@ func opTimeUnixMilli(inputs []ast.CXValue, outputs []ast.CXValue) {
 }
 
 func opTimeUnixNano(inputs []ast.CXValue, outputs []ast.CXValue) {
-    outputs[0].Set_i64(time.Now().UnixNano())
+    outputs[0].Set_i64(globals.UnixNano())
 }
 
 func opTimeSleep(inputs []ast.CXValue, outputs []ast.CXValue) {
-	time.Sleep(time.Duration(inputs[0].Get_i32()) * time.Millisecond)
+	duration := time.Duration(inputs[0].Get_i32()) * time.Millisecond
+	if globals.Deterministic {
+		// Sleeping only advances the virtual clock.
+		if duration > 0 {
+			globals.VirtualClock += int64(duration)
+		}
+		return
+	}
+	time.Sleep(duration)
 }
@@ -79,12 +79,12 @@ func RegisterPackage() {
 
 	ast.PROGRAM.AddPackage(httpPkg)
 
-	opcodes.RegisterFunction("http.Serve", opHTTPServe, opcodes.In(ast.ConstCxArg_STR), opcodes.Out(ast.ConstCxArg_STR))
-	opcodes.RegisterFunction("http.ListenAndServe", opHTTPListenAndServe, opcodes.In(ast.ConstCxArg_STR), opcodes.Out(ast.ConstCxArg_STR))
-	opcodes.RegisterFunction("http.NewRequest", opHTTPNewRequest, opcodes.In(ast.ConstCxArg_STR, ast.ConstCxArg_STR, ast.ConstCxArg_STR), opcodes.Out(ast.ConstCxArg_STR))
-	opcodes.RegisterFunction("http.Do", opHTTPDo, opcodes.In(ast.ConstCxArg_UND_TYPE), opcodes.Out(ast.ConstCxArg_UND_TYPE, ast.ConstCxArg_STR))
+	opcodes.RegisterNondeterministicFunction("http.Serve", opHTTPServe, opcodes.In(ast.ConstCxArg_STR), opcodes.Out(ast.ConstCxArg_ERROR))
+	opcodes.RegisterNondeterministicFunction("http.ListenAndServe", opHTTPListenAndServe, opcodes.In(ast.ConstCxArg_STR), opcodes.Out(ast.ConstCxArg_ERROR))
+	opcodes.RegisterFunction("http.NewRequest", opHTTPNewRequest, opcodes.In(ast.ConstCxArg_STR, ast.ConstCxArg_STR, ast.ConstCxArg_STR), opcodes.Out(ast.ConstCxArg_STR, ast.ConstCxArg_ERROR))
+	opcodes.RegisterNondeterministicFunction("http.Do", opHTTPDo, opcodes.In(ast.ConstCxArg_UND_TYPE), opcodes.Out(ast.ConstCxArg_UND_TYPE, ast.ConstCxArg_ERROR))
 	//opcodes.RegisterFunction("http.DmsgDo", opDMSGDo, opcodes.In(ast.ConstCxArg_UND_TYPE), opcodes.Out(ast.ConstCxArg_STR))
-	opcodes.RegisterFunction("http.Handle", opHTTPHandle,
+	opcodes.RegisterNondeterministicFunction("http.Handle", opHTTPHandle,
 		opcodes.In(
 			ast.ConstCxArg_STR,
 			opcodes.Func(httpPkg, opcodes.In(ast.MakeArgument("ResponseWriter", "", -1).AddType(constants.TypeNames[constants.TYPE_STR]), opcodes.Pointer(opcodes.Struct("http", "Request", "r"))), nil)),
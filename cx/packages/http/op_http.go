@@ -22,19 +22,13 @@ import (
 func opHTTPHandle(inputs []ast.CXValue, outputs []ast.CXValue) {
 
 	//step 3  : specify the input and outout parameters of Handle function.
-	urlstring, functionnamestring := inputs[0].Arg, inputs[1].Arg
+	urlstring := inputs[0].Arg
 	fp := inputs[0].FramePointer
 
-	// Getting handler function.
-	handlerPkg, err := ast.PROGRAM.GetPackage(functionnamestring.ArgDetails.Package.Name)
-
-	if err != nil {
-		panic(err)
-	}
-	handlerFn, err := handlerPkg.GetFunction(functionnamestring.ArgDetails.Name)
-	if err != nil {
-		panic(err)
-	}
+	// Getting handler function, which can be a closure. It's called after
+	// opHTTPHandle returns, so the garbage collector needs to know about it.
+	handler := ast.AddFunctionRoot(inputs[1].Offset)
+	handlerFn := handler.Function()
 
 	http.HandleFunc(ast.ReadStr(fp, urlstring), func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "text/html")
@@ -62,7 +56,7 @@ func opHTTPHandle(inputs []ast.CXValue, outputs []ast.CXValue) {
 		copy(i2, ast.PROGRAM.Memory[i2Off:i2Off+i2Size])
 
 		//PROGRAM.Callback(handlerFn, [][]byte{i1, i2})
-		execute.Callback(ast.PROGRAM, handlerFn, [][]byte{i1, i2})
+		execute.CallbackClosure(ast.PROGRAM, handlerFn, handler.Env, [][]byte{i1, i2})
 		fmt.Fprint(w, ast.ReadStr(callFP, handlerFn.Inputs[0]))
 	})
 }
@@ -82,7 +76,7 @@ func opHTTPListenAndServe(inputs []ast.CXValue, outputs []ast.CXValue) {
 	server = &http.Server{Addr: url}
 
 	err := server.ListenAndServe()
-	outputs[0].Set_str(err.Error())
+	outputs[0].Set_error(err)
 }
 
 func opHTTPServe(inputs []ast.CXValue, outputs []ast.CXValue) {
@@ -92,23 +86,17 @@ func opHTTPServe(inputs []ast.CXValue, outputs []ast.CXValue) {
 	url := inputs[0].Get_str()
 
 	l, err := net.Listen("tcp", url)
-	var errStr string
-	if err != nil {
-		errStr = err.Error()
-	}
-
-	err = http.Serve(l, nil)
-	if err != nil {
-		errStr = err.Error()
+	if err == nil {
+		err = http.Serve(l, nil)
 	}
 
-	outputs[0].Set_str(errStr)
+	outputs[0].Set_error(err)
 }
 
 func opHTTPNewRequest(inputs []ast.CXValue, outputs []ast.CXValue) {
 	// TODO: This whole OP needs rewriting/finishing.
 	// Seems more a prototype.
-	stringmethod, stringurl, stringbody, errorstring := inputs[0].Arg, inputs[1].Arg, inputs[2].Arg, outputs[0].Arg
+	stringmethod, stringurl, stringbody := inputs[0].Arg, inputs[1].Arg, inputs[2].Arg
 
 	fp := inputs[0].FramePointer
 
@@ -119,31 +107,23 @@ func opHTTPNewRequest(inputs []ast.CXValue, outputs []ast.CXValue) {
 
 	//above is an alternative for following 3 lines of code that fail due to URL
 	req, err := http.NewRequest(method, urlString, bytes.NewBuffer([]byte(body)))
-	if err != nil {
-		ast.WriteString(fp, err.Error(), errorstring)
-	}
 
-	var netClient = &http.Client{
-		Timeout: time.Second * 30,
-	}
-	resp, err := netClient.Do(req)
-	if err != nil {
-		ast.WriteString(fp, err.Error(), errorstring)
-	}
-	resp1 := *resp // dereference to exclude pointer issue
-
-	// TODO issue with returning response,
-	// the line where resp is serialized (byts := encoder.Serialize(resp)) throws following error, adding response object content for context:
-	// &{404 Not Found 404 HTTP/1.1 1 1 map[Content-Length:[19] Content-Type:[text/plain; charset=utf-8] Date:[Sun, 27 Oct 2019 22:10:14 GMT] X-Content-Type-Options:[nosniff]] 0xc0000c8700 19 [] false false map[] 0xc000175400 <nil>}
-	// 2019/10/27 23:10:14 invalid type int
-	// error: examples/http-serve-and-request-mine.cx:8, CX_RUNTIME_ERROR, invalid type int
-
-	out1Offset := ast.GetFinalOffset(fp, errorstring)
-
-	// TODO: Used `Response.Status` for now, to avoid getting an error.
-	// This will be rewritten as the whole operator is unfinished.
-	byts := encoder.Serialize(resp1.Status)
-	ast.WriteObject(out1Offset, byts)
+	// TODO: Used `Response.Status` for now, as serializing the whole
+	// response fails. This will be rewritten as the whole operator is
+	// unfinished.
+	var status string
+	if err == nil {
+		var netClient = &http.Client{
+			Timeout: time.Second * 30,
+		}
+		var resp *http.Response
+		if resp, err = netClient.Do(req); err == nil {
+			status = resp.Status
+		}
+	}
+
+	outputs[0].Set_str(status)
+	outputs[1].Set_error(err)
 }
 
 func writeHTTPRequest(fp int, param *ast.CXArgument, request *http.Request) {
@@ -256,7 +236,7 @@ func writeHTTPRequest(fp int, param *ast.CXArgument, request *http.Request) {
 
 func opHTTPDo(inputs []ast.CXValue, outputs []ast.CXValue) {
 
-	reqstruct, respstruct, errorstring := inputs[0].Arg, outputs[0].Arg, outputs[1].Arg
+	reqstruct, respstruct := inputs[0].Arg, outputs[0].Arg
 	fp := inputs[0].FramePointer
 
 	//TODO read req from the inputs
@@ -349,7 +329,7 @@ func opHTTPDo(inputs []ast.CXValue, outputs []ast.CXValue) {
 	}
 	response, err := netClient.Do(&request)
 	if err != nil {
-		ast.WriteString(fp, err.Error(), errorstring)
+		outputs[1].Set_error(err)
 		return
 	}
 
@@ -415,10 +395,10 @@ func opHTTPDo(inputs []ast.CXValue, outputs []ast.CXValue) {
 	ast.WriteMemory(ast.GetFinalOffset(fp, &resp), helper.FromI64(int64(response.ContentLength)))
 	resp.Fields = accessBody
 	body, err := ioutil.ReadAll(response.Body)
-	if err != nil {
-		panic(err)
+	if err == nil {
+		ast.WriteString(fp, string(body), &resp)
 	}
-	ast.WriteString(fp, string(body), &resp)
+	outputs[1].Set_error(err)
 }
 
 /*
@@ -78,12 +78,8 @@ func opRegexpCompile(inputs []ast.CXValue, outputs []ast.CXValue) {
 	// returned error.
 	err := regexpCompile(inputs, outputs)
 
-	// Writing error message to `out1`.
-    var errStr string
-	if err != nil {
-        errStr = err.Error()
-	}
-    outputs[1].Set_str(errStr)
+	// Writing error to `out1`.
+	outputs[1].Set_error(err)
 }
 
 // opRegexpCompile is a wrapper for golang's `regexp`'s `MustCompile`.
@@ -112,6 +112,10 @@ func getOutputType(expr *ast.CXExpression) *ast.CXArgument {
 
 // Assignment handles assignment statements with different operators, like =, :=, +=, *=.
 func Assignment(to []*ast.CXExpression, assignOp string, from []*ast.CXExpression) []*ast.CXExpression {
+	if IsConstantAssignment(to) {
+		return nil
+	}
+
 	idx := len(from) - 1
 
 	// Checking if we're trying to assign stuff from a function call
@@ -138,10 +142,27 @@ func Assignment(to []*ast.CXExpression, assignOp string, from []*ast.CXExpressio
 		if from[idx].Operator == nil {
 			// then it's a literal
 			sym = ast.MakeArgument(to[0].Outputs[0].ArgDetails.Name, CurrentFile, LineNo).AddType(constants.TypeNames[from[idx].Outputs[0].Type])
+			sym.Enum = from[idx].Outputs[0].Enum
+			sym.Named = from[idx].Outputs[0].Named
 		} else {
 			outTypeArg := getOutputType(from[idx])
 
 			sym = ast.MakeArgument(to[0].Outputs[0].ArgDetails.Name, CurrentFile, LineNo).AddType(constants.TypeNames[outTypeArg.Type])
+			sym.Enum = outTypeArg.Enum
+			sym.Named = outTypeArg.Named
+			if outTypeArg.Type == constants.TYPE_FUNC {
+				sym.Inputs = outTypeArg.Inputs
+				sym.Outputs = outTypeArg.Outputs
+			}
+
+			if outTypeArg.CustomType != nil && !outTypeArg.IsSlice && len(outTypeArg.Lengths) == 0 {
+				sym.Type = outTypeArg.Type
+				sym.CustomType = outTypeArg.CustomType
+				sym.Size = outTypeArg.Size
+				sym.TotalSize = outTypeArg.TotalSize
+				sym.IsPointer = outTypeArg.IsPointer
+				sym.DeclarationSpecifiers = append([]int{}, outTypeArg.DeclarationSpecifiers...)
+			}
 
 			if from[idx].IsArrayLiteral() {
 				sym.Size = from[idx].Inputs[0].Size
@@ -156,6 +177,16 @@ func Assignment(to []*ast.CXExpression, assignOp string, from []*ast.CXExpressio
 
 			sym.IsSlice = outTypeArg.IsSlice
 			// sym.IsSlice = from[idx].Operator.ProgramOutput[0].IsSlice
+
+			if outTypeArg.IsMap && !ast.IsMapElement(outTypeArg) {
+				sym.DeclarationSpecifiers = append(sym.DeclarationSpecifiers, constants.DECL_MAP)
+				sym.IsMap = true
+				sym.MapKeyType = outTypeArg.MapKeyType
+				sym.MapKey = outTypeArg.MapKey
+				sym.MapValue = outTypeArg.MapValue
+				sym.IsReference = true
+				sym.TotalSize = constants.TYPE_POINTER_SIZE
+			}
 		}
 		sym.ArgDetails.Package = pkg
 		sym.PreviouslyDeclared = true
@@ -253,3 +284,92 @@ func Assignment(to []*ast.CXExpression, assignOp string, from []*ast.CXExpressio
 		// return append(to, from...)
 	}
 }
+
+// MultipleAssignment handles assignments to several outputs, e.g. `a, b = f()`
+// or `v, ok := m[k]`. `to` holds the expressions of all the outputs but the
+// last one, and `from` the assignment to the last output.
+func MultipleAssignment(to []*ast.CXExpression, from []*ast.CXExpression) []*ast.CXExpression {
+	expr := from[len(from)-1]
+	outs := to[len(to)-1].Outputs
+	expr.Outputs = append(outs, expr.Outputs...)
+
+	if expr.Operator != nil && expr.Operator.OpCode == constants.OP_IDENTITY &&
+		len(expr.Inputs) == 1 && len(expr.Inputs[0].Indexes) == 1 && len(expr.Outputs) == 2 {
+		// Then it's `v, ok = m[k]`, which we convert to `v, ok = map.lookup(m, k)`.
+		mapInp := expr.Inputs[0]
+		key := mapInp.Indexes[0]
+		mapInp.Indexes = nil
+		mapInp.DereferenceOperations = mapInp.DereferenceOperations[:len(mapInp.DereferenceOperations)-1]
+		mapInp.DeclarationSpecifiers = mapInp.DeclarationSpecifiers[:len(mapInp.DeclarationSpecifiers)-1]
+
+		expr.Operator = ast.Natives[ast.OpCodes["map.lookup"]]
+		expr.Inputs = []*ast.CXArgument{mapInp, key}
+	}
+
+	if isTypeAssertion(expr) && len(expr.Outputs) == 2 {
+		// Then it's `v, ok = x.(T)`, which doesn't panic if `x` isn't a `T`.
+		expr.Operator = typeAssertionFunction(expr.Operator.Outputs[0], true)
+	}
+
+	// Checking if it's a short variable declaration, in which case the
+	// last output was already declared by `Assignment`.
+	if !expr.Outputs[len(expr.Outputs)-1].IsShortAssignmentDeclaration ||
+		from[0].Operator != nil || len(from[0].Inputs) > 0 {
+		return from
+	}
+
+	var decls []*ast.CXExpression
+	for i, out := range expr.Outputs {
+		out.PreviouslyDeclared = true
+		out.IsShortAssignmentDeclaration = true
+
+		decl := ast.MakeExpression(nil, CurrentFile, LineNo)
+		decl.Package = expr.Package
+		decl.AddOutput(shortDeclarationSymbol(out.ArgDetails.Name, expr, i))
+
+		if i == len(expr.Outputs)-1 {
+			from[0] = decl
+		} else {
+			decls = append(decls, decl)
+		}
+	}
+
+	return append(decls, from...)
+}
+
+// shortDeclarationSymbol returns the symbol declared by a short variable
+// declaration of `name` as the output number `i` of `expr`. Its type is left
+// undefined if it can only be determined once the inputs of `expr` are
+// processed.
+func shortDeclarationSymbol(name string, expr *ast.CXExpression, i int) *ast.CXArgument {
+	typ := constants.TYPE_UNDEFINED
+	var typArg *ast.CXArgument
+	if expr.Operator != nil && i < len(expr.Operator.Outputs) && expr.Operator.Outputs[i].Type != constants.TYPE_UNDEFINED {
+		typArg = expr.Operator.Outputs[i]
+		typ = typArg.Type
+	}
+
+	sym := ast.MakeArgument(name, CurrentFile, LineNo).AddType(constants.TypeNames[typ])
+	sym.ArgDetails.Package = expr.Package
+	sym.PreviouslyDeclared = true
+	sym.IsShortAssignmentDeclaration = true
+
+	if typArg != nil {
+		sym.Enum = typArg.Enum
+		sym.Named = typArg.Named
+		sym.CustomType = typArg.CustomType
+		sym.Size = typArg.Size
+		sym.TotalSize = typArg.TotalSize
+		sym.Lengths = typArg.Lengths
+		sym.DeclarationSpecifiers = append([]int{}, typArg.DeclarationSpecifiers...)
+		sym.IsSlice = typArg.IsSlice
+		sym.IsMap = typArg.IsMap
+		sym.MapKeyType = typArg.MapKeyType
+		sym.MapKey = typArg.MapKey
+		sym.MapValue = typArg.MapValue
+		sym.IsReference = typArg.IsReference
+		sym.IsPointer = typArg.IsPointer
+	}
+
+	return sym
+}
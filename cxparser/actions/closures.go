@@ -0,0 +1,645 @@
+package actions
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/skycoin/cx/cx/ast"
+	"github.com/skycoin/cx/cx/constants"
+)
+
+// functionLiteral is a function literal whose body is processed once the
+// symbols of the enclosing function are known, as it can capture them.
+type functionLiteral struct {
+	fn      *ast.CXFunction
+	inputs  []*ast.CXArgument
+	outputs []*ast.CXArgument
+	body    []*ast.CXExpression
+	// cells holds, for each variable captured by the literal, the
+	// argument reading the reference to its cell in the enclosing
+	// function.
+	cells []*ast.CXArgument
+}
+
+// functionScope holds the symbols of a function being declared by
+// `FunctionDeclaration`, which the function literals it encloses can
+// capture.
+type functionScope struct {
+	fn      *ast.CXFunction
+	symbols *[]map[string]*ast.CXArgument
+	offset  *int
+	literal *functionLiteral
+	// escaped holds the locals captured by function literals, and cells
+	// the arguments reading the references to their cells.
+	escaped []*ast.CXArgument
+	cells   map[*ast.CXArgument][]*ast.CXArgument
+}
+
+var (
+	// functionLiterals maps the expressions creating closures to the
+	// function literals they hold.
+	functionLiterals = map[*ast.CXExpression]*functionLiteral{}
+	// enclosingFunctions holds the functions enclosing the function
+	// literal being parsed.
+	enclosingFunctions []*ast.CXFunction
+	// literalCounts holds the number of function literals parsed in each
+	// function, used to name them.
+	literalCounts = map[*ast.CXFunction]int{}
+	// functionScopes holds the scopes of the functions being declared,
+	// the innermost last.
+	functionScopes []*functionScope
+	// functionValues holds the function values of named functions,
+	// written to the data segment.
+	functionValues = map[*ast.CXFunction]*ast.CXArgument{}
+)
+
+// FunctionLiteralHeader declares the function of a function literal with
+// parameters `inputs` and `outputs`. The function is named after the
+// function enclosing it, e.g. `main.func1`, and it is the current function
+// while its body is parsed.
+func FunctionLiteralHeader(inputs, outputs []*ast.CXArgument) *ast.CXFunction {
+	pkg, err := AST.GetCurrentPackage()
+	if err != nil {
+		panic(err)
+	}
+
+	parent := pkg.CurrentFunction
+	literalCounts[parent]++
+	name := fmt.Sprintf("func%d", literalCounts[parent])
+	if parent != nil {
+		name = parent.Name + "." + name
+	}
+
+	checkVariadicParameters(inputs, outputs, false, true)
+
+	fn := ast.MakeFunction(name, CurrentFile, LineNo)
+	pkg.AddFunction(fn)
+	for _, inp := range inputs {
+		fn.AddInput(inp)
+	}
+	for _, out := range outputs {
+		fn.AddOutput(out)
+	}
+
+	enclosingFunctions = append(enclosingFunctions, parent)
+	return fn
+}
+
+// FunctionLiteral returns the expression creating a closure of the function
+// literal `fn` with body `body`. The body is processed along with the
+// enclosing function, which gives the expression the references to the
+// cells of the variables captured by the closure.
+func FunctionLiteral(fn *ast.CXFunction, body []*ast.CXExpression) []*ast.CXExpression {
+	pkg := fn.Package
+	pkg.CurrentFunction = enclosingFunctions[len(enclosingFunctions)-1]
+	enclosingFunctions = enclosingFunctions[:len(enclosingFunctions)-1]
+
+	value := WritePrimary(constants.TYPE_FUNC, ast.MakeFunctionValue(fn, 0), false)[0].Outputs[0]
+	value.Inputs = fn.Inputs
+	value.Outputs = fn.Outputs
+
+	expr := ast.MakeExpression(ast.Natives[ast.OpCodes["func.closure"]], CurrentFile, LineNo)
+	expr.Package = pkg
+	expr.AddInput(value)
+
+	functionLiterals[expr] = &functionLiteral{
+		fn:      fn,
+		inputs:  fn.Inputs,
+		outputs: fn.Outputs,
+		body:    body,
+	}
+
+	return []*ast.CXExpression{expr}
+}
+
+// functionValue returns the argument holding the function value of the
+// named function `fn`.
+func functionValue(pkg *ast.CXPackage, fn *ast.CXFunction) *ast.CXArgument {
+	if value, ok := functionValues[fn]; ok {
+		return value
+	}
+
+	value := WritePrimary(constants.TYPE_FUNC, ast.MakeFunctionValue(fn, 0), false)[0].Outputs[0]
+	value.ArgDetails.Name = fn.Name
+	value.ArgDetails.FileName = fn.FileName
+	value.ArgDetails.FileLine = fn.FileLine
+	value.ArgDetails.Package = pkg
+	value.Inputs = fn.Inputs
+	value.Outputs = fn.Outputs
+
+	functionValues[fn] = value
+	return value
+}
+
+// pushFunctionScope makes `fn`, whose symbols are `symbols`, the innermost
+// function being declared.
+func pushFunctionScope(fn *ast.CXFunction, symbols *[]map[string]*ast.CXArgument, offset *int, literal *functionLiteral) *functionScope {
+	scope := &functionScope{
+		fn:      fn,
+		symbols: symbols,
+		offset:  offset,
+		literal: literal,
+		cells:   map[*ast.CXArgument][]*ast.CXArgument{},
+	}
+	functionScopes = append(functionScopes, scope)
+	return scope
+}
+
+// popFunctionScope removes the innermost function being declared.
+func popFunctionScope() {
+	functionScopes = functionScopes[:len(functionScopes)-1]
+}
+
+// ProcessFunctionLiteral declares the function literal held by `expr`, if
+// it creates a closure, and adds the references to the cells of the
+// variables it captures to its inputs.
+func ProcessFunctionLiteral(expr *ast.CXExpression) {
+	literal, ok := functionLiterals[expr]
+	if !ok {
+		return
+	}
+	delete(functionLiterals, expr)
+
+	functionDeclaration(literal.fn, literal.inputs, literal.outputs, literal.body, literal)
+	expr.Inputs = append(expr.Inputs, literal.cells...)
+}
+
+// findSymbol searches for `fullName` in `symbols`, starting from the
+// innermost scope. Unlike `lookupSymbol`, it doesn't consider functions.
+func findSymbol(symbols *[]map[string]*ast.CXArgument, fullName string) *ast.CXArgument {
+	for c := len(*symbols) - 1; c >= 0; c-- {
+		if sym, found := (*symbols)[c][fullName]; found {
+			return sym
+		}
+	}
+	return nil
+}
+
+// captureSymbol checks if `ident` is a local of a function enclosing the
+// function literal whose symbols are `symbols`. If it is, the local is
+// captured by the literal and by the literals in between, and true is
+// returned.
+func captureSymbol(symbols *[]map[string]*ast.CXArgument, pkg *ast.CXPackage, ident string) bool {
+	n := len(functionScopes)
+	if n < 2 || functionScopes[n-1].symbols != symbols || functionScopes[n-1].literal == nil {
+		return false
+	}
+
+	fullName := pkg.Name + "." + ident
+	if findSymbol(symbols, fullName) != nil {
+		return false
+	}
+
+	for c := n - 2; c >= 0; c-- {
+		sym := findSymbol(functionScopes[c].symbols, fullName)
+		if sym == nil {
+			if functionScopes[c].literal == nil {
+				// Only function literals have enclosing functions.
+				return false
+			}
+			continue
+		}
+		if sym.Offset >= AST.StackSize {
+			// Globals aren't captured.
+			return false
+		}
+
+		for d := c + 1; d < n; d++ {
+			sym = functionScopes[d].capture(functionScopes[d-1], sym, fullName)
+		}
+		return true
+	}
+
+	return false
+}
+
+// capture makes the function literal of `scope` capture `sym`, a local of
+// the enclosing function of `outer`. It returns the symbol of the captured
+// variable in the literal.
+func (scope *functionScope) capture(outer *functionScope, sym *ast.CXArgument, fullName string) *ast.CXArgument {
+	if captured, found := (*scope.symbols)[0][fullName]; found {
+		return captured
+	}
+
+	cell := ast.MakeArgument("", sym.ArgDetails.FileName, sym.ArgDetails.FileLine).AddType(constants.TypeNames[constants.TYPE_I32])
+	cell.ArgDetails.Package = sym.ArgDetails.Package
+	cell.Offset = sym.Offset
+	if !outer.isCapture(sym) {
+		// The cell is created by the enclosing function, which gives
+		// the variable its offset once all its locals are known.
+		outer.escape(sym)
+		outer.cells[sym] = append(outer.cells[sym], cell)
+	}
+	scope.literal.cells = append(scope.literal.cells, cell)
+
+	captured := copyArgument(sym)
+	captured.IsLocalDeclaration = true
+	captured.Offset = *scope.offset
+	*scope.offset += constants.TYPE_POINTER_SIZE
+
+	(*scope.symbols)[0][fullName] = captured
+	scope.fn.Captures = append(scope.fn.Captures, captured)
+
+	return captured
+}
+
+// isCapture checks if `sym` is a variable captured by the function of
+// `scope`.
+func (scope *functionScope) isCapture(sym *ast.CXArgument) bool {
+	for _, captured := range scope.fn.Captures {
+		if captured == sym {
+			return true
+		}
+	}
+	return false
+}
+
+// escape marks the local `sym` as captured by a function literal, so it's
+// moved to a cell on the heap.
+func (scope *functionScope) escape(sym *ast.CXArgument) {
+	for _, escaped := range scope.escaped {
+		if escaped == sym {
+			return
+		}
+	}
+	scope.escaped = append(scope.escaped, sym)
+}
+
+// isFunctionType checks if `arg` is a function value.
+func isFunctionType(arg *ast.CXArgument) bool {
+	return ast.GetAssignmentElement(arg).Type == constants.TYPE_FUNC &&
+		strings.HasPrefix(ast.GetFormattedType(arg), constants.TypeNames[constants.TYPE_FUNC]+"(")
+}
+
+// copyFunctionType gives `to` the signature of the function values of
+// type `from`.
+func copyFunctionType(to, from *ast.CXArgument) {
+	to.Type = constants.TYPE_FUNC
+	to.Inputs = from.Inputs
+	to.Outputs = from.Outputs
+	to.Size = constants.FUNC_SIZE
+	to.TotalSize = constants.FUNC_SIZE
+	to.DeclarationSpecifiers = []int{constants.DECL_BASIC}
+}
+
+// ProcessFunctionTypeShortDeclaration gives their type to the variables
+// declared by short variable declarations of function values, e.g.
+// `g := f`, which is only known once `f` was processed. `prevExprs` are
+// the expressions preceding `expr`, which hold the declarations of the
+// variables.
+func ProcessFunctionTypeShortDeclaration(prevExprs []*ast.CXExpression, expr *ast.CXExpression, offset *int) {
+	if expr.Operator == nil || expr.Operator.OpCode != constants.OP_IDENTITY ||
+		len(expr.Inputs) != 1 || len(expr.Outputs) != 1 || !expr.Outputs[0].IsShortAssignmentDeclaration {
+		return
+	}
+
+	inp := expr.Inputs[0]
+	if !isFunctionType(inp) {
+		return
+	}
+
+	if sym := shortDeclarationOf(prevExprs, expr.Outputs[0]); sym != nil {
+		// The declaration was given an offset before knowing its size,
+		// so it is given a new one.
+		copyFunctionType(sym, ast.GetAssignmentElement(inp))
+		sym.Offset = *offset
+		*offset += sym.Size
+	}
+}
+
+// ProcessFunctionFieldCall checks if `expr` is a call to a function value
+// held by a struct field, e.g. `s.f(x)`, which is parsed as a method call.
+// If it is, it's converted to a call to a function value.
+func ProcessFunctionFieldCall(expr *ast.CXExpression, symbols *[]map[string]*ast.CXArgument) {
+	if !expr.IsMethodCall() {
+		return
+	}
+
+	var recv *ast.CXArgument
+	statement := expr.Operator == nil
+	switch {
+	case statement && len(expr.Outputs) > 0:
+		recv = expr.Outputs[0]
+	case expr.Operator == ast.Natives[constants.OP_IDENTITY] && len(expr.Inputs) > 0:
+		recv = expr.Inputs[0]
+	default:
+		return
+	}
+	if len(recv.Fields) == 0 {
+		return
+	}
+
+	pkg := recv.ArgDetails.Package
+	GetGlobalSymbol(symbols, pkg, recv.ArgDetails.Name)
+	sym, err := lookupSymbol(pkg.Name, recv.ArgDetails.Name, symbols)
+	if err != nil {
+		return
+	}
+
+	var fld *ast.CXArgument
+	strct := sym.CustomType
+	for _, nameFld := range recv.Fields {
+		if strct == nil {
+			return
+		}
+		if fld, err = strct.GetField(nameFld.ArgDetails.Name); err != nil {
+			return
+		}
+		strct = fld.CustomType
+	}
+	if !isFunctionType(fld) {
+		return
+	}
+
+	expr.Operator = ast.Natives[ast.OpCodes["func.call"]]
+	expr.ExpressionType = ast.CXEXPR_UNUSED
+	if statement {
+		expr.Inputs = append([]*ast.CXArgument{recv}, expr.Inputs...)
+		expr.Outputs = nil
+	}
+}
+
+// ProcessFunctionValueCall gives the calls to function values the caller
+// of the signature of the called value as operator, and their types to the
+// outputs receiving the results, which are only known once the called
+// value was processed. `prevExprs` are the expressions preceding `expr`,
+// which hold the declarations of the outputs.
+func ProcessFunctionValueCall(prevExprs []*ast.CXExpression, expr *ast.CXExpression, offset *int) {
+	if expr.Operator != ast.Natives[ast.OpCodes["func.call"]] {
+		return
+	}
+
+	callee := expr.Inputs[0]
+	if !isFunctionType(callee) {
+		if callee.Type != constants.TYPE_UNDEFINED && callee.Type != constants.TYPE_IDENTIFIER {
+			// Otherwise it doesn't exist, which was already reported.
+			println(ast.CompilationError(expr.FileName, expr.FileLine), fmt.Sprintf("cannot call non-function '%s' (type '%s')", ast.GetAssignmentElement(callee).ArgDetails.Name, ast.GetFormattedType(callee)))
+		}
+		expr.Operator = nil
+		return
+	}
+
+	typ := ast.GetAssignmentElement(callee)
+	expr.Operator = functionValueCaller(expr.Package, typ)
+
+	for i, out := range expr.Outputs {
+		if i >= len(typ.Outputs) {
+			break
+		}
+		res := typ.Outputs[i]
+		if IsTempVar(out.ArgDetails.Name) {
+			copyParameterType(out, res)
+			continue
+		}
+		if !out.IsShortAssignmentDeclaration {
+			continue
+		}
+		if sym := shortDeclarationOf(prevExprs, out); sym != nil {
+			// The declaration was given an offset before knowing its
+			// type, so it is given a new one.
+			copyParameterType(sym, res)
+			sym.Offset = *offset
+			*offset += ast.GetSize(sym)
+		}
+	}
+}
+
+// copyParameterType gives `to` the type of the parameter `param`.
+func copyParameterType(to, param *ast.CXArgument) {
+	to.Type = param.Type
+	to.CustomType = param.CustomType
+	to.Enum = param.Enum
+	to.Named = param.Named
+	to.Size = param.Size
+	to.TotalSize = param.TotalSize
+	to.Lengths = param.Lengths
+	to.DeclarationSpecifiers = append([]int{}, param.DeclarationSpecifiers...)
+	to.IsSlice = param.IsSlice
+	to.IsMap = param.IsMap
+	to.MapKeyType = param.MapKeyType
+	to.MapKey = param.MapKey
+	to.MapValue = param.MapValue
+	to.IsPointer = param.IsPointer
+	to.IsReference = param.IsReference
+	to.PassBy = param.PassBy
+	to.Inputs = param.Inputs
+	to.Outputs = param.Outputs
+}
+
+// functionValueCaller returns the caller of the function values of type
+// `typ`, which is declared in `pkg` if needed. The caller doesn't have a
+// body: its first input is the called value, and the rest of its inputs
+// and its outputs are the parameters of the signature. Calls to it are
+// dispatched to the function held by the value.
+func functionValueCaller(pkg *ast.CXPackage, typ *ast.CXArgument) *ast.CXFunction {
+	fnType := ast.MakeArgument("", typ.ArgDetails.FileName, typ.ArgDetails.FileLine).AddType(constants.TypeNames[constants.TYPE_FUNC])
+	fnType.ArgDetails.Package = pkg
+	fnType.Inputs = typ.Inputs
+	fnType.Outputs = typ.Outputs
+
+	name := ast.GetFormattedType(fnType)
+	for _, fn := range pkg.Functions {
+		if fn.Name == name {
+			return fn
+		}
+	}
+
+	caller := ast.MakeFunction(name, fnType.ArgDetails.FileName, fnType.ArgDetails.FileLine)
+	caller.Inputs = append([]*ast.CXArgument{fnType}, typ.Inputs...)
+	caller.Outputs = typ.Outputs
+
+	// Declaring the caller doesn't change the current function.
+	current := pkg.CurrentFunction
+	pkg.AddFunction(caller)
+	pkg.CurrentFunction = current
+
+	return caller
+}
+
+// escapeVariables moves the locals of the function of `scope` captured by
+// function literals, and the variables captured by the function itself, to
+// cells on the heap. The variables are then accessed through the
+// references to their cells held by the stack frame. `offset` is the size
+// of the stack frame so far.
+func escapeVariables(scope *functionScope, offset *int) {
+	fn := scope.fn
+	if len(scope.escaped) == 0 && len(fn.Captures) == 0 {
+		return
+	}
+
+	// slots maps the offsets of the variables to the offsets of the
+	// references to their cells, and names the variables, so other
+	// arguments sharing their offsets aren't mistaken for them.
+	slots := map[int]int{}
+	names := map[int]string{}
+	dropped := map[int]bool{}
+	visited := map[*ast.CXArgument]bool{}
+	escaped := map[*ast.CXArgument]bool{}
+
+	for _, inp := range fn.Inputs {
+		// Inputs are written by the calls at their offsets.
+		visited[inp] = true
+	}
+
+	var prologue []*ast.CXExpression
+	for _, sym := range scope.escaped {
+		size := ast.GetSize(sym)
+		slot := *offset
+		*offset += constants.TYPE_POINTER_SIZE
+
+		slots[sym.Offset] = slot
+		names[sym.Offset] = sym.ArgDetails.Name
+		for _, cell := range scope.cells[sym] {
+			cell.Offset = slot
+		}
+
+		v := copyArgument(sym)
+		v.Offset = slot
+		fn.Escaped = append(fn.Escaped, v)
+
+		switch {
+		case isParameter(fn.Inputs, sym):
+			// The cell is initialized with the value of the input.
+			prologue = append(prologue, cellExpression(fn.Package, size, slot, rawArgument(sym, sym.Offset, size)))
+		case isParameter(fn.Outputs, sym):
+			prologue = append(prologue, cellExpression(fn.Package, size, slot, nil))
+			dropped[sym.Offset] = true
+		default:
+			// The declaration of the local creates its cell.
+			for _, expr := range fn.Expressions {
+				if expr.Operator == nil && len(expr.Inputs) == 0 && len(expr.Outputs) == 1 && expr.Outputs[0] == sym {
+					cell := cellExpression(fn.Package, size, slot, nil)
+					expr.Operator, expr.Inputs, expr.Outputs = cell.Operator, cell.Inputs, cell.Outputs
+				}
+			}
+			dropped[sym.Offset] = true
+		}
+	}
+	for _, captured := range fn.Captures {
+		slots[captured.Offset] = captured.Offset
+		names[captured.Offset] = captured.ArgDetails.Name
+		dropped[captured.Offset] = true
+	}
+
+	for _, expr := range fn.Expressions {
+		for _, arg := range append(expr.Inputs, expr.Outputs...) {
+			escapeArgument(arg, slots, names, visited, escaped)
+		}
+	}
+	for _, out := range fn.Outputs {
+		escapeArgument(out, slots, names, visited, escaped)
+	}
+
+	// The references assigned to the variables can point to the stack
+	// frame, which doesn't outlive the variables anymore, so the
+	// referenced values escape too.
+	for _, expr := range fn.Expressions {
+		if expr.Operator == nil || expr.Operator.OpCode != constants.OP_IDENTITY || len(expr.Outputs) != 1 || !escaped[expr.Outputs[0]] {
+			continue
+		}
+		if elt := ast.GetAssignmentElement(expr.Outputs[0]); elt.PassBy == constants.PASSBY_REFERENCE && elt.IsPointer {
+			elt.DoesEscape = true
+		}
+	}
+
+	fn.Expressions = append(prologue, fn.Expressions...)
+	fn.Length = len(fn.Expressions)
+
+	// The garbage collector reaches the values of the variables through
+	// their cells.
+	var ptrs []*ast.CXArgument
+	for _, ptr := range fn.ListOfPointers {
+		if escaped[ptr] || dropped[ptr.Offset] {
+			continue
+		}
+		ptrs = append(ptrs, ptr)
+	}
+	fn.ListOfPointers = ptrs
+}
+
+// isParameter checks if `sym` is one of the parameters `params`.
+func isParameter(params []*ast.CXArgument, sym *ast.CXArgument) bool {
+	for _, param := range params {
+		if param == sym {
+			return true
+		}
+	}
+	return false
+}
+
+// rawArgument returns an argument accessing the `size` bytes at `offset`
+// in the stack frame, as `sym` would before it escaped.
+func rawArgument(sym *ast.CXArgument, offset, size int) *ast.CXArgument {
+	raw := ast.MakeArgument("", sym.ArgDetails.FileName, sym.ArgDetails.FileLine).AddType(constants.TypeNames[constants.TYPE_UNDEFINED])
+	raw.ArgDetails.Package = sym.ArgDetails.Package
+	raw.Offset = offset
+	raw.Size = size
+	raw.TotalSize = size
+	return raw
+}
+
+// cellExpression returns the expression creating a cell of `size` bytes,
+// initialized with `init` if not nil, and writing its reference at `slot`.
+func cellExpression(pkg *ast.CXPackage, size, slot int, init *ast.CXArgument) *ast.CXExpression {
+	var sizeBytes [4]byte
+	ast.WriteMemI32(sizeBytes[:], 0, int32(size))
+
+	expr := ast.MakeExpression(ast.Natives[ast.OpCodes["func.cell"]], CurrentFile, LineNo)
+	expr.Package = pkg
+	expr.AddInput(WritePrimary(constants.TYPE_I32, sizeBytes[:], false)[0].Outputs[0])
+	if init != nil {
+		expr.AddInput(init)
+	}
+
+	out := ast.MakeArgument("", CurrentFile, LineNo).AddType(constants.TypeNames[constants.TYPE_I32])
+	out.ArgDetails.Package = pkg
+	out.Offset = slot
+	expr.AddOutput(out)
+
+	return expr
+}
+
+// escapeArgument makes `arg`, and the indexes it uses, access the variables
+// in `slots` through the references to their cells. `arg` then reads the
+// reference held by the stack frame, dereferences it, and accesses the
+// value of the variable as it did before.
+func escapeArgument(arg *ast.CXArgument, slots map[int]int, names map[int]string, visited, escaped map[*ast.CXArgument]bool) {
+	if visited[arg] {
+		return
+	}
+	visited[arg] = true
+
+	for _, idx := range arg.Indexes {
+		escapeArgument(idx, slots, names, visited, escaped)
+	}
+	for _, fld := range arg.Fields {
+		for _, idx := range fld.Indexes {
+			escapeArgument(idx, slots, names, visited, escaped)
+		}
+	}
+
+	slot, ok := slots[arg.Offset]
+	if !ok || arg.ArgDetails.Name == "" || names[arg.Offset] != arg.ArgDetails.Name {
+		return
+	}
+
+	value := *arg
+	value.Offset = 0
+	value.Fields = nil
+
+	ptr := ast.MakeArgument(arg.ArgDetails.Name, arg.ArgDetails.FileName, arg.ArgDetails.FileLine).AddType(constants.TypeNames[constants.TYPE_I32])
+	ptr.ArgDetails.Package = arg.ArgDetails.Package
+	ptr.DereferenceOperations = []int{constants.DEREF_POINTER}
+	ptr.DereferenceLevels = 1
+
+	arg.Offset = slot
+	arg.Indexes = nil
+	arg.DereferenceOperations = nil
+	arg.DereferenceLevels = 0
+	arg.Fields = append([]*ast.CXArgument{ptr, &value}, arg.Fields...)
+	escaped[arg] = true
+	if arg.PassBy == constants.PASSBY_REFERENCE {
+		// The reference points to the cell.
+		arg.IsInnerReference = true
+	}
+}
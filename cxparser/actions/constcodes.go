@@ -20,6 +20,8 @@ const (
 	CONST_CX_RUNTIME_INVALID_ARGUMENT
 	CONST_CX_RUNTIME_SLICE_INDEX_OUT_OF_RANGE
 	CONST_CX_RUNTIME_NOT_IMPLEMENTED
+	CONST_CX_RUNTIME_ARRAY_INDEX_OUT_OF_RANGE
+	CONST_CX_RUNTIME_NIL_POINTER_DEREFERENCE
 )
 
 // For the cxgo. These shouldn't be used in the runtime for performance reasons
@@ -43,6 +45,8 @@ func init() {
 	AddConstI32(CONST_CX_RUNTIME_INVALID_ARGUMENT, "cx.RUNTIME_INVALID_ARGUMENT", constants.CX_RUNTIME_INVALID_ARGUMENT)
 	AddConstI32(CONST_CX_RUNTIME_SLICE_INDEX_OUT_OF_RANGE, "cx.RUNTIME_SLICE_INDEX_OUT_OF_RANGE", constants.CX_RUNTIME_SLICE_INDEX_OUT_OF_RANGE)
 	AddConstI32(CONST_CX_RUNTIME_NOT_IMPLEMENTED, "cx.RUNTIME_NOT_INPLEMENTED", constants.CX_RUNTIME_NOT_IMPLEMENTED)
+	AddConstI32(CONST_CX_RUNTIME_ARRAY_INDEX_OUT_OF_RANGE, "cx.RUNTIME_ARRAY_INDEX_OUT_OF_RANGE", constants.CX_RUNTIME_ARRAY_INDEX_OUT_OF_RANGE)
+	AddConstI32(CONST_CX_RUNTIME_NIL_POINTER_DEREFERENCE, "cx.RUNTIME_NIL_POINTER_DEREFERENCE", constants.CX_RUNTIME_NIL_POINTER_DEREFERENCE)
 }
 
 // AddConstCode ...
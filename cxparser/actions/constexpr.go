@@ -0,0 +1,631 @@
+package actions
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/skycoin/skycoin/src/cipher/encoder"
+
+	"github.com/skycoin/cx/cx/ast"
+	"github.com/skycoin/cx/cx/constants"
+	"github.com/skycoin/cx/cx/helper"
+	constants2 "github.com/skycoin/cx/cxparser/constants"
+)
+
+// ConstantValue is the value of a constant expression. Constant expressions
+// are folded at compile time, and every use of a constant is replaced by a
+// literal holding its value.
+type ConstantValue struct {
+	Type  int
+	Int   int64 // integers and bools; unsigned integers keep their bits
+	Float float64
+	Str   string
+	Enum  *ast.CXEnum // non-nil for the members of an enum
+}
+
+type constantDeclaration struct {
+	Value    ConstantValue
+	FileName string
+	FileLine int
+}
+
+var (
+	// packageConstants holds the constants declared at package level, by
+	// package name and constant name.
+	packageConstants = map[string]map[string]*constantDeclaration{}
+	// localScopes holds the names declared in the function being parsed,
+	// by block, innermost last. Constants map to their declaration and
+	// parameters and variables to nil, as they shadow the constants of
+	// enclosing blocks. It's nil outside of function bodies.
+	localScopes []map[string]*constantDeclaration
+	// blockEnded tells if the last token read closed a block.
+	blockEnded bool
+	// constantArgs maps the literals that replaced a constant to the name of
+	// the constant, so assignments to constants can be reported.
+	constantArgs = map[*ast.CXArgument]string{}
+)
+
+var invalidConstant = ConstantValue{Type: constants.TYPE_UNDEFINED}
+
+// OpenConstantScope is called at the start of a function declaration.
+// Names declared until CloseConstantScope is called are local to the
+// function.
+func OpenConstantScope() {
+	localScopes = []map[string]*constantDeclaration{{}}
+}
+
+// CloseConstantScope is called at the end of a function declaration.
+func CloseConstantScope() {
+	localScopes = nil
+}
+
+// LexBlockScope is called by the lexers for every token, `lbrace` and
+// `rbrace` telling if it opens or closes a block. Names declared inside a
+// block are local to it. The parsers read a token of lookahead before
+// reducing a statement, so the scope of a block is closed once the token
+// following its closing brace is read.
+func LexBlockScope(lbrace, rbrace bool) {
+	if blockEnded && len(localScopes) > 0 {
+		localScopes = localScopes[:len(localScopes)-1]
+	}
+	blockEnded = rbrace
+	if lbrace {
+		localScopes = append(localScopes, map[string]*constantDeclaration{})
+	}
+}
+
+// DeclareLocalNames records the parameters or variables `args`, declared in
+// the current block, which shadow the constants of enclosing blocks and of
+// the package.
+func DeclareLocalNames(args []*ast.CXArgument) {
+	if len(localScopes) == 0 {
+		return
+	}
+	for _, arg := range args {
+		if arg.ArgDetails.Name != "" {
+			localScopes[len(localScopes)-1][arg.ArgDetails.Name] = nil
+		}
+	}
+}
+
+// DeclareConstant declares the constant `name` in the current function or
+// package. If `typ` is not TYPE_UNDEFINED, numeric values are converted to
+// it.
+func DeclareConstant(name string, line int, typ int, value ConstantValue) {
+	pkg, err := AST.GetCurrentPackage()
+	if err != nil {
+		panic(err)
+	}
+
+	if typ != constants.TYPE_UNDEFINED && value.valid() && value.Type != typ {
+		if isNumericType(typ) && isNumericType(value.Type) {
+			value = ConstantConversion(typ, value)
+		} else {
+			println(ast.CompilationError(CurrentFile, line), fmt.Sprintf("cannot use %s constant as %s constant '%s'", constants.TypeNames[value.Type], constants.TypeNames[typ], name))
+			value = invalidConstant
+		}
+	}
+
+	var scope map[string]*constantDeclaration
+	if len(localScopes) > 0 {
+		scope = localScopes[len(localScopes)-1]
+	} else {
+		scope = packageConstants[pkg.Name]
+		if scope == nil {
+			scope = map[string]*constantDeclaration{}
+			packageConstants[pkg.Name] = scope
+		}
+	}
+
+	// Package constants are declared by both parsing passes, so only a
+	// declaration at a different position is a redeclaration.
+	if prev, ok := scope[name]; ok {
+		if prev == nil {
+			println(ast.CompilationError(CurrentFile, line), fmt.Sprintf("'%s' redeclared in this block", name))
+			return
+		}
+		if prev.FileName != CurrentFile || prev.FileLine != line {
+			println(ast.CompilationError(CurrentFile, line), fmt.Sprintf("'%s' redeclared; previous declaration at %s:%d", name, prev.FileName, prev.FileLine))
+			return
+		}
+
+		// The variables and functions of the package are all declared
+		// when the second parsing pass declares the constant again. The
+		// members of enums are checked by DeclareEnum.
+		if kind := packageNameKind(pkg, name); len(localScopes) == 0 && value.Enum == nil && kind != "" {
+			println(ast.CompilationError(CurrentFile, line), fmt.Sprintf("'%s' redeclared; previously declared as %s", name, kind))
+		}
+	}
+
+	scope[name] = &constantDeclaration{
+		Value:    value,
+		FileName: CurrentFile,
+		FileLine: line,
+	}
+}
+
+// lookupConstant returns the constant `ident` visible from the current
+// block of package `pkg`, unless a parameter or a variable shadows it.
+func lookupConstant(pkg *ast.CXPackage, ident string) (ConstantValue, bool) {
+	for i := len(localScopes) - 1; i >= 0; i-- {
+		if decl, ok := localScopes[i][ident]; ok {
+			if decl == nil {
+				return invalidConstant, false
+			}
+			return decl.Value, true
+		}
+	}
+	return lookupPackageConstant(pkg, ident)
+}
+
+// isLocalName tells if `ident` is a parameter, a variable or a constant
+// visible from the current block.
+func isLocalName(ident string) bool {
+	for _, scope := range localScopes {
+		if _, ok := scope[ident]; ok {
+			return true
+		}
+	}
+	return false
+}
+
+// lookupPackageConstant returns the constant `ident` declared at the
+// package level of `pkg`.
+func lookupPackageConstant(pkg *ast.CXPackage, ident string) (ConstantValue, bool) {
+	if decl, ok := packageConstants[pkg.Name][ident]; ok {
+		return decl.Value, true
+	}
+	return invalidConstant, false
+}
+
+// constantLiteral returns a literal holding the value of the constant
+// `ident`, if there is such a constant in `pkg`.
+func constantLiteral(pkg *ast.CXPackage, ident string) ([]*ast.CXExpression, bool) {
+	value, ok := lookupConstant(pkg, ident)
+	if !ok {
+		return nil, false
+	}
+	if !value.valid() {
+		// The error was already reported. Any value avoids further errors.
+		value = ConstantInt(constants.TYPE_I32, 0)
+	}
+
+	exprs := WritePrimary(value.Type, value.Bytes(), false)
+	exprs[0].Outputs[0].Enum = value.Enum
+	constantArgs[exprs[0].Outputs[0]] = ident
+	return exprs, true
+}
+
+// IsConstantAssignment reports an error if the last expression of `to`,
+// the target of an assignment, is a constant.
+func IsConstantAssignment(to []*ast.CXExpression) bool {
+	if len(to) == 0 || len(to[len(to)-1].Outputs) == 0 {
+		return false
+	}
+	arg := to[len(to)-1].Outputs[0]
+	if name, ok := constantArgs[arg]; ok {
+		println(ast.CompilationError(arg.ArgDetails.FileName, arg.ArgDetails.FileLine), fmt.Sprintf("cannot assign to constant '%s'", name))
+		return true
+	}
+	return false
+}
+
+// DeclareShortVariable records the variable declared by `to`, the target
+// of a short variable declaration. If the variable shadows a constant, `to`
+// was parsed as a literal holding its value, so an identifier is returned
+// instead.
+func DeclareShortVariable(to []*ast.CXExpression) []*ast.CXExpression {
+	if len(to) != 1 || len(to[0].Outputs) != 1 {
+		return to
+	}
+	if name, ok := constantArgs[to[0].Outputs[0]]; ok {
+		to = identifierExpression(to[0].Outputs[0].ArgDetails.Package, name)
+	}
+	DeclareLocalNames(to[0].Outputs)
+	return to
+}
+
+// ConstantIdentifier returns the value of the constant `ident`, declared in
+// the imported package `pkgName` or, if `pkgName` is empty, in the current
+// function or package.
+func ConstantIdentifier(pkgName string, ident string) ConstantValue {
+	pkg, err := AST.GetCurrentPackage()
+	if err != nil {
+		panic(err)
+	}
+
+	name := ident
+	if pkgName != "" {
+		name = pkgName + "." + ident
+		if code, ok := ConstCodes[name]; ok && constants2.IsCorePackage(pkgName) {
+			constant := Constants[code]
+			return constantFromBytes(constant.Type, constant.Value)
+		}
+		imp, err := pkg.GetImport(pkgName)
+		if err != nil {
+			println(ast.CompilationError(CurrentFile, LineNo), fmt.Sprintf("'%s' is not a constant", name))
+			return invalidConstant
+		}
+		if value, ok := lookupPackageConstant(imp, ident); ok {
+			return value
+		}
+	} else if value, ok := lookupConstant(pkg, ident); ok {
+		return value
+	}
+	println(ast.CompilationError(CurrentFile, LineNo), fmt.Sprintf("'%s' is not a constant", name))
+	return invalidConstant
+}
+
+// ConstantLength returns the value of a constant expression used as an
+// array length.
+func ConstantLength(value ConstantValue) int {
+	if !value.valid() {
+		return 1
+	}
+	if !isIntegerType(value.Type) || value.Int < 0 || (isUnsignedType(value.Type) && uint64(value.Int) > math.MaxInt32) {
+		println(ast.CompilationError(CurrentFile, LineNo), "array length must be a non-negative integer constant")
+		return 1
+	}
+	return int(value.Int)
+}
+
+// ConstantInt returns an integer constant of type `typ`.
+func ConstantInt(typ int, value int64) ConstantValue {
+	return ConstantValue{Type: typ, Int: value}.normalize()
+}
+
+// ConstantFloat returns a floating-point constant of type `typ`.
+func ConstantFloat(typ int, value float64) ConstantValue {
+	return ConstantValue{Type: typ, Float: value}.normalize()
+}
+
+// ConstantStr returns a `str` constant.
+func ConstantStr(value string) ConstantValue {
+	return ConstantValue{Type: constants.TYPE_STR, Str: value}
+}
+
+// ConstantBool returns a `bool` constant.
+func ConstantBool(value bool) ConstantValue {
+	c := ConstantValue{Type: constants.TYPE_BOOL}
+	if value {
+		c.Int = 1
+	}
+	return c
+}
+
+// ConstantUnary folds the unary operator `opcode` (OP_NEG or OP_BOOL_NOT).
+func ConstantUnary(opcode int, value ConstantValue) ConstantValue {
+	if !value.valid() {
+		return invalidConstant
+	}
+
+	switch {
+	case opcode == constants.OP_NEG && isIntegerType(value.Type):
+		return ConstantInt(value.Type, -value.Int)
+	case opcode == constants.OP_NEG && isFloatType(value.Type):
+		return ConstantFloat(value.Type, -value.Float)
+	case opcode == constants.OP_BOOL_NOT && value.Type == constants.TYPE_BOOL:
+		return ConstantBool(value.Int == 0)
+	}
+
+	println(ast.CompilationError(CurrentFile, LineNo), fmt.Sprintf("invalid operation on %s constant", constants.TypeNames[value.Type]))
+	return invalidConstant
+}
+
+// ConstantBinary folds the binary operator `opcode`, one of the OP_*
+// operators used by OperatorExpression.
+func ConstantBinary(left ConstantValue, right ConstantValue, opcode int) ConstantValue {
+	if !left.valid() || !right.valid() {
+		return invalidConstant
+	}
+
+	isShift := opcode == constants.OP_BITSHL || opcode == constants.OP_BITSHR
+	if isShift {
+		if !isIntegerType(left.Type) || !isIntegerType(right.Type) {
+			return invalidConstantOperation(left, opcode)
+		}
+		if right.Int < 0 && !isUnsignedType(right.Type) {
+			println(ast.CompilationError(CurrentFile, LineNo), "negative shift count in constant expression")
+			return invalidConstant
+		}
+	} else if left.Type != right.Type {
+		println(ast.CompilationError(CurrentFile, LineNo), fmt.Sprintf("mismatched types %s and %s in constant expression", constants.TypeNames[left.Type], constants.TypeNames[right.Type]))
+		return invalidConstant
+	}
+
+	if opcode > constants.START_OF_COMPARISON_OPERATORS && opcode < constants.END_OF_COMPARISON_OPERATORS {
+		cmp, ok := compareConstants(left, right, opcode)
+		if !ok {
+			return invalidConstantOperation(left, opcode)
+		}
+		switch opcode {
+		case constants.OP_EQUAL:
+			return ConstantBool(cmp == 0)
+		case constants.OP_UNEQUAL:
+			return ConstantBool(cmp != 0)
+		case constants.OP_LT:
+			return ConstantBool(cmp < 0)
+		case constants.OP_GT:
+			return ConstantBool(cmp > 0)
+		case constants.OP_LTEQ:
+			return ConstantBool(cmp <= 0)
+		case constants.OP_GTEQ:
+			return ConstantBool(cmp >= 0)
+		}
+	}
+
+	typ := left.Type
+	switch {
+	case typ == constants.TYPE_BOOL:
+		switch opcode {
+		case constants.OP_BOOL_AND:
+			return ConstantBool(left.Int != 0 && right.Int != 0)
+		case constants.OP_BOOL_OR:
+			return ConstantBool(left.Int != 0 || right.Int != 0)
+		}
+	case typ == constants.TYPE_STR:
+		if opcode == constants.OP_ADD {
+			return ConstantStr(left.Str + right.Str)
+		}
+	case isFloatType(typ):
+		switch opcode {
+		case constants.OP_ADD:
+			return ConstantFloat(typ, left.Float+right.Float)
+		case constants.OP_SUB:
+			return ConstantFloat(typ, left.Float-right.Float)
+		case constants.OP_MUL:
+			return ConstantFloat(typ, left.Float*right.Float)
+		case constants.OP_DIV:
+			if right.Float == 0 {
+				return constantDivisionByZero()
+			}
+			return ConstantFloat(typ, left.Float/right.Float)
+		}
+	case isIntegerType(typ):
+		return integerBinary(left, right, opcode)
+	}
+
+	return invalidConstantOperation(left, opcode)
+}
+
+func integerBinary(left ConstantValue, right ConstantValue, opcode int) ConstantValue {
+	typ := left.Type
+	a, b := left.Int, right.Int
+	unsigned := isUnsignedType(typ)
+
+	switch opcode {
+	case constants.OP_ADD:
+		return ConstantInt(typ, a+b)
+	case constants.OP_SUB:
+		return ConstantInt(typ, a-b)
+	case constants.OP_MUL:
+		return ConstantInt(typ, a*b)
+	case constants.OP_DIV, constants.OP_MOD:
+		if b == 0 {
+			return constantDivisionByZero()
+		}
+		if unsigned {
+			if opcode == constants.OP_DIV {
+				return ConstantInt(typ, int64(uint64(a)/uint64(b)))
+			}
+			return ConstantInt(typ, int64(uint64(a)%uint64(b)))
+		}
+		if opcode == constants.OP_DIV {
+			return ConstantInt(typ, a/b)
+		}
+		return ConstantInt(typ, a%b)
+	case constants.OP_BITAND:
+		return ConstantInt(typ, a&b)
+	case constants.OP_BITOR:
+		return ConstantInt(typ, a|b)
+	case constants.OP_BITXOR:
+		return ConstantInt(typ, a^b)
+	case constants.OP_BITCLEAR:
+		return ConstantInt(typ, a&^b)
+	case constants.OP_BITSHL:
+		return ConstantInt(typ, a<<uint64(b))
+	case constants.OP_BITSHR:
+		if unsigned {
+			return ConstantInt(typ, int64(uint64(a)>>uint64(b)))
+		}
+		return ConstantInt(typ, a>>uint64(b))
+	}
+
+	return invalidConstantOperation(left, opcode)
+}
+
+// compareConstants returns -1, 0 or 1 if `left` is less than, equal to or
+// greater than `right`. Only equality is defined for bools.
+func compareConstants(left ConstantValue, right ConstantValue, opcode int) (int, bool) {
+	switch {
+	case left.Type == constants.TYPE_BOOL:
+		if opcode != constants.OP_EQUAL && opcode != constants.OP_UNEQUAL {
+			return 0, false
+		}
+		return int(left.Int - right.Int), true
+	case left.Type == constants.TYPE_STR:
+		switch {
+		case left.Str < right.Str:
+			return -1, true
+		case left.Str > right.Str:
+			return 1, true
+		}
+		return 0, true
+	case isFloatType(left.Type):
+		switch {
+		case left.Float < right.Float:
+			return -1, true
+		case left.Float > right.Float:
+			return 1, true
+		}
+		return 0, true
+	case isUnsignedType(left.Type):
+		switch {
+		case uint64(left.Int) < uint64(right.Int):
+			return -1, true
+		case uint64(left.Int) > uint64(right.Int):
+			return 1, true
+		}
+		return 0, true
+	case isIntegerType(left.Type):
+		switch {
+		case left.Int < right.Int:
+			return -1, true
+		case left.Int > right.Int:
+			return 1, true
+		}
+		return 0, true
+	}
+	return 0, false
+}
+
+// ConstantConversion converts a numeric constant to the numeric type `typ`.
+func ConstantConversion(typ int, value ConstantValue) ConstantValue {
+	if !value.valid() {
+		return invalidConstant
+	}
+	if value.Type == typ {
+		return value
+	}
+
+	switch {
+	case isIntegerType(typ) && isIntegerType(value.Type):
+		return ConstantInt(typ, value.Int)
+	case isIntegerType(typ) && isFloatType(value.Type):
+		if isUnsignedType(typ) {
+			return ConstantInt(typ, int64(uint64(value.Float)))
+		}
+		return ConstantInt(typ, int64(value.Float))
+	case isFloatType(typ) && isFloatType(value.Type):
+		return ConstantFloat(typ, value.Float)
+	case isFloatType(typ) && isIntegerType(value.Type):
+		if isUnsignedType(value.Type) {
+			return ConstantFloat(typ, float64(uint64(value.Int)))
+		}
+		return ConstantFloat(typ, float64(value.Int))
+	}
+
+	println(ast.CompilationError(CurrentFile, LineNo), fmt.Sprintf("cannot convert %s constant to %s", constants.TypeNames[value.Type], constants.TypeNames[typ]))
+	return invalidConstant
+}
+
+// Bytes returns the serialized value of the constant, as used by
+// WritePrimary.
+func (c ConstantValue) Bytes() []byte {
+	switch c.Type {
+	case constants.TYPE_BOOL:
+		return encoder.Serialize(c.Int != 0)
+	case constants.TYPE_STR:
+		return encoder.Serialize(c.Str)
+	case constants.TYPE_F32:
+		return encoder.Serialize(float32(c.Float))
+	case constants.TYPE_F64:
+		return encoder.Serialize(c.Float)
+	case constants.TYPE_I8:
+		return encoder.Serialize(int8(c.Int))
+	case constants.TYPE_I16:
+		return encoder.Serialize(int16(c.Int))
+	case constants.TYPE_I32:
+		return encoder.Serialize(int32(c.Int))
+	case constants.TYPE_I64:
+		return encoder.Serialize(c.Int)
+	case constants.TYPE_UI8:
+		return encoder.Serialize(uint8(c.Int))
+	case constants.TYPE_UI16:
+		return encoder.Serialize(uint16(c.Int))
+	case constants.TYPE_UI32:
+		return encoder.Serialize(uint32(c.Int))
+	case constants.TYPE_UI64:
+		return encoder.Serialize(uint64(c.Int))
+	}
+	return nil
+}
+
+func (c ConstantValue) valid() bool {
+	return c.Type != constants.TYPE_UNDEFINED
+}
+
+// normalize truncates the value to the size of its type.
+func (c ConstantValue) normalize() ConstantValue {
+	switch c.Type {
+	case constants.TYPE_I8:
+		c.Int = int64(int8(c.Int))
+	case constants.TYPE_I16:
+		c.Int = int64(int16(c.Int))
+	case constants.TYPE_I32:
+		c.Int = int64(int32(c.Int))
+	case constants.TYPE_UI8:
+		c.Int = int64(uint8(c.Int))
+	case constants.TYPE_UI16:
+		c.Int = int64(uint16(c.Int))
+	case constants.TYPE_UI32:
+		c.Int = int64(uint32(c.Int))
+	case constants.TYPE_F32:
+		c.Float = float64(float32(c.Float))
+	}
+	return c
+}
+
+// constantFromBytes decodes a constant registered with AddConstCode.
+func constantFromBytes(typ int, value []byte) ConstantValue {
+	switch typ {
+	case constants.TYPE_BOOL:
+		return ConstantBool(helper.Deserialize_bool(value))
+	case constants.TYPE_F32:
+		return ConstantFloat(typ, float64(helper.Deserialize_f32(value)))
+	case constants.TYPE_F64:
+		return ConstantFloat(typ, helper.Deserialize_f64(value))
+	case constants.TYPE_I8:
+		return ConstantInt(typ, int64(helper.Deserialize_i8(value)))
+	case constants.TYPE_I16:
+		return ConstantInt(typ, int64(helper.Deserialize_i16(value)))
+	case constants.TYPE_I32:
+		return ConstantInt(typ, int64(helper.Deserialize_i32(value)))
+	case constants.TYPE_I64:
+		return ConstantInt(typ, helper.Deserialize_i64(value))
+	case constants.TYPE_UI8:
+		return ConstantInt(typ, int64(helper.Deserialize_ui8(value)))
+	case constants.TYPE_UI16:
+		return ConstantInt(typ, int64(helper.Deserialize_ui16(value)))
+	case constants.TYPE_UI32:
+		return ConstantInt(typ, int64(helper.Deserialize_ui32(value)))
+	case constants.TYPE_UI64:
+		return ConstantInt(typ, int64(helper.Deserialize_ui64(value)))
+	}
+	return invalidConstant
+}
+
+func invalidConstantOperation(value ConstantValue, opcode int) ConstantValue {
+	println(ast.CompilationError(CurrentFile, LineNo), fmt.Sprintf("invalid operation '%s' on %s constants", ast.OpNames[opcode], constants.TypeNames[value.Type]))
+	return invalidConstant
+}
+
+func constantDivisionByZero() ConstantValue {
+	println(ast.CompilationError(CurrentFile, LineNo), "division by zero in constant expression")
+	return invalidConstant
+}
+
+func isIntegerType(typ int) bool {
+	switch typ {
+	case constants.TYPE_I8, constants.TYPE_I16, constants.TYPE_I32, constants.TYPE_I64,
+		constants.TYPE_UI8, constants.TYPE_UI16, constants.TYPE_UI32, constants.TYPE_UI64:
+		return true
+	}
+	return false
+}
+
+func isUnsignedType(typ int) bool {
+	switch typ {
+	case constants.TYPE_UI8, constants.TYPE_UI16, constants.TYPE_UI32, constants.TYPE_UI64:
+		return true
+	}
+	return false
+}
+
+func isFloatType(typ int) bool {
+	return typ == constants.TYPE_F32 || typ == constants.TYPE_F64
+}
+
+func isNumericType(typ int) bool {
+	return isIntegerType(typ) || isFloatType(typ)
+}
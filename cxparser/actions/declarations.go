@@ -3,6 +3,7 @@ package actions
 import (
 	"fmt"
 	"os"
+	"strings"
 
 	constants2 "github.com/skycoin/cx/cxparser/constants"
 
@@ -83,7 +84,7 @@ func DeclareGlobalInPackage(pkg *ast.CXPackage,
 				initializer[len(initializer)-1].Package = glbl.ArgDetails.Package
 
 				//add intialization statements, to array
-				globals.SysInitExprs = append(globals.SysInitExprs, initializer...)
+				globals.SysInitExprs[pkg.Name] = append(globals.SysInitExprs[pkg.Name], initializer...)
 			} else {
 				// then it's an expression
 				declaration_specifiers.ArgDetails.Name = glbl.ArgDetails.Name
@@ -100,7 +101,7 @@ func DeclareGlobalInPackage(pkg *ast.CXPackage,
 					initializer[len(initializer)-1].AddOutput(glbl)
 				}
 				//add intialization statements, to array
-				globals.SysInitExprs = append(globals.SysInitExprs, initializer...)
+				globals.SysInitExprs[pkg.Name] = append(globals.SysInitExprs[pkg.Name], initializer...)
 			}
 		} else {
 			// we keep the last value for now
@@ -141,7 +142,7 @@ func DeclareGlobalInPackage(pkg *ast.CXPackage,
 
 				pkg.AddGlobal(declaration_specifiers)
 				//add intialization statements, to array
-				globals.SysInitExprs = append(globals.SysInitExprs, initializer...)
+				globals.SysInitExprs[pkg.Name] = append(globals.SysInitExprs[pkg.Name], initializer...)
 			} else {
 				// then it's an expression
 				declaration_specifiers.ArgDetails.Name = declarator.ArgDetails.Name
@@ -160,7 +161,7 @@ func DeclareGlobalInPackage(pkg *ast.CXPackage,
 
 				pkg.AddGlobal(declaration_specifiers)
 				//add intialization statements, to array
-				globals.SysInitExprs = append(globals.SysInitExprs, initializer...)
+				globals.SysInitExprs[pkg.Name] = append(globals.SysInitExprs[pkg.Name], initializer...)
 			}
 		} else {
 			// offExpr := WritePrimary(declaration_specifiers.Type, make([]byte, declaration_specifiers.Size), true)
@@ -199,6 +200,10 @@ func DeclareStruct(ident string, strctFlds []*ast.CXArgument) {
 	strct.Fields = nil
 	strct.Size = 0
 	for _, fld := range strctFlds {
+		if fld == nil {
+			// then the type of an embedded field wasn't found, which was reported
+			continue
+		}
 		if _, err := strct.GetField(fld.ArgDetails.Name); err == nil {
 			println(ast.CompilationError(fld.ArgDetails.FileName, fld.ArgDetails.FileLine), "Multiply defined struct field:", fld.ArgDetails.Name)
 		} else {
@@ -207,6 +212,252 @@ func DeclareStruct(ident string, strctFlds []*ast.CXArgument) {
 	}
 }
 
+// EmbeddedField returns a struct field declared with only the type `ident`,
+// whose fields and methods are promoted to the struct declaring it. The
+// field is named after its type.
+func EmbeddedField(ident string, pkgName string, isExternal bool) *ast.CXArgument {
+	fld := DeclarationSpecifiersStruct(ident, pkgName, isExternal, CurrentFile, LineNo)
+	if fld == nil {
+		return nil
+	}
+	if fld.CustomType == nil || fld.Type != constants.TYPE_CUSTOM {
+		println(ast.CompilationError(CurrentFile, LineNo), fmt.Sprintf("embedded field '%s' is not a struct", ident))
+	}
+
+	fld.ArgDetails.Name = ident
+	fld.IsLocalDeclaration = true
+	fld.IsEmbedded = true
+	return fld
+}
+
+// InterfaceMethod is a method in the declaration of an interface.
+type InterfaceMethod struct {
+	Name    string
+	Line    int
+	Inputs  []*ast.CXArgument
+	Outputs []*ast.CXArgument
+}
+
+// DeclareInterface declares the interface `ident`. Its methods are declared
+// as functions without a body named after the interface, e.g. `Shape.Area`,
+// whose receiver is the interface. Calls to them are dispatched at runtime.
+// If `doesProcessMethods` is true, the parameters of the methods are also
+// given their offsets.
+func DeclareInterface(ident string, methods []InterfaceMethod, doesProcessMethods bool) {
+	pkg, err := AST.GetCurrentPackage()
+	if err != nil {
+		panic(err)
+	}
+
+	strct, err := AST.GetStruct(ident, pkg.Name)
+	if err != nil {
+		panic(err)
+	}
+
+	strct.IsInterface = true
+	strct.Fields = nil
+	strct.Size = constants.INTERFACE_SIZE
+
+	for i, method := range methods {
+		for _, prev := range methods[:i] {
+			if prev.Name == method.Name {
+				println(ast.CompilationError(CurrentFile, method.Line), fmt.Sprintf("duplicate method '%s' in interface '%s'", method.Name, ident))
+			}
+		}
+
+		fnName := ident + "." + method.Name
+		fn, err := AST.GetFunction(fnName, pkg.Name)
+		if err != nil {
+			fn = ast.MakeFunction(fnName, CurrentFile, method.Line)
+			pkg.AddFunction(fn)
+		}
+
+		recv := DeclarationSpecifiersStruct(ident, "", false, CurrentFile, method.Line)
+		recv.ArgDetails.Name = MakeGenSym(constants.LOCAL_PREFIX)
+		recv.IsLocalDeclaration = true
+
+		fn.Inputs = []*ast.CXArgument{recv}
+		fn.Outputs = nil
+		for _, inp := range method.Inputs {
+			fn.AddInput(inp)
+		}
+		for _, out := range method.Outputs {
+			if out.ArgDetails.Name == "" {
+				// then it's an unnamed output, e.g. `Area() f64`
+				out.ArgDetails.Name = MakeGenSym(constants.LOCAL_PREFIX)
+				out.IsLocalDeclaration = true
+			}
+			fn.AddOutput(out)
+		}
+
+		if doesProcessMethods {
+			var offset int
+			symbols := &[]map[string]*ast.CXArgument{make(map[string]*ast.CXArgument)}
+			symbolsScope := make(map[string]bool)
+
+			FunctionProcessParameters(symbols, &symbolsScope, &offset, fn, fn.Inputs)
+			FunctionProcessParameters(symbols, &symbolsScope, &offset, fn, fn.Outputs)
+			fn.Size = offset
+		}
+	}
+}
+
+// DeclareUnion declares the union `ident`, whose values hold an instance of
+// one of the struct types `variants`. Unions are interfaces without methods
+// whose values can only be converted from their variants. Errors are only
+// reported if `doesReportErrors` is true, as both parsing passes declare
+// unions.
+func DeclareUnion(ident string, line int, variants []string, doesReportErrors bool) {
+	pkg, err := AST.GetCurrentPackage()
+	if err != nil {
+		panic(err)
+	}
+
+	strct, err := AST.GetStruct(ident, pkg.Name)
+	if err != nil {
+		panic(err)
+	}
+	if !strct.IsInterface || len(strct.Fields) > 0 {
+		if doesReportErrors {
+			println(ast.CompilationError(CurrentFile, line), fmt.Sprintf("'%s' redeclared; previously declared as a struct", ident))
+		}
+		return
+	}
+
+	strct.Variants = make([]*ast.CXStruct, 0, len(variants))
+	for i, name := range variants {
+		variant, err := AST.GetStruct(name, pkg.Name)
+		switch {
+		case err != nil:
+			if doesReportErrors {
+				println(ast.CompilationError(CurrentFile, line), fmt.Sprintf("undefined variant '%s' in union '%s'", name, ident))
+			}
+			continue
+		case variant.IsInterface:
+			if doesReportErrors {
+				println(ast.CompilationError(CurrentFile, line), fmt.Sprintf("invalid variant '%s' in union '%s' (not a struct type)", name, ident))
+			}
+			continue
+		}
+
+		var isDuplicate bool
+		for _, prev := range variants[:i] {
+			isDuplicate = isDuplicate || prev == name
+		}
+		if isDuplicate {
+			if doesReportErrors {
+				println(ast.CompilationError(CurrentFile, line), fmt.Sprintf("duplicate variant '%s' in union '%s'", name, ident))
+			}
+			continue
+		}
+		strct.Variants = append(strct.Variants, variant)
+	}
+}
+
+// enumPositions holds where each enum was declared, as enums are declared
+// by both parsing passes.
+var enumPositions = map[*ast.CXEnum]string{}
+
+// DeclareEnum declares the enum `ident`. Its members are declared as
+// constants of the enum type, numbered from 0.
+func DeclareEnum(ident string, line int, members []string) {
+	pkg, err := AST.GetCurrentPackage()
+	if err != nil {
+		panic(err)
+	}
+
+	if strct, err := pkg.GetStruct(ident); err == nil && strct.Package == pkg {
+		println(ast.CompilationError(CurrentFile, line), fmt.Sprintf("'%s' redeclared; previously declared as a struct", ident))
+		return
+	}
+
+	pos := fmt.Sprintf("%s:%d", CurrentFile, line)
+	enum, err := pkg.GetEnum(ident)
+	if err != nil {
+		enum = &ast.CXEnum{Name: ident}
+		pkg.AddEnum(enum)
+		enumPositions[enum] = pos
+	} else if enumPositions[enum] != pos {
+		println(ast.CompilationError(CurrentFile, line), fmt.Sprintf("'%s' redeclared; previous declaration at %s", ident, enumPositions[enum]))
+		return
+	}
+	// The variables and functions of the package are all declared when
+	// the second parsing pass declares the enum again.
+	isSecondPass := err == nil
+
+	enum.Members = members
+	for i, member := range members {
+		for _, prev := range members[:i] {
+			if prev == member {
+				println(ast.CompilationError(CurrentFile, line), fmt.Sprintf("duplicate member '%s' in enum '%s'", member, ident))
+			}
+		}
+		if kind := packageNameKind(pkg, member); isSecondPass && kind != "" {
+			println(ast.CompilationError(CurrentFile, line), fmt.Sprintf("'%s' redeclared; previously declared as %s", member, kind))
+		}
+		DeclareConstant(member, line, constants.TYPE_I32, ConstantValue{Type: constants.TYPE_I32, Int: int64(i), Enum: enum})
+	}
+}
+
+// packageNameKind returns what the name `name` is declared as at the package
+// level of `pkg`, other than a constant, or "" if it isn't declared.
+func packageNameKind(pkg *ast.CXPackage, name string) string {
+	if _, err := pkg.GetGlobal(name); err == nil {
+		return "a variable"
+	}
+	for _, fn := range pkg.Functions {
+		if fn.Name == name {
+			return "a function"
+		}
+	}
+	return ""
+}
+
+// namedTypePositions holds where each named type was declared, as named
+// types are declared by both parsing passes.
+var namedTypePositions = map[*ast.CXNamedType]string{}
+
+// DeclareNamedType declares the named type `ident`, whose underlying type is
+// `typ`, or the alias `ident` of `typ` if `isAlias` is true.
+func DeclareNamedType(ident string, line int, typ *ast.CXArgument, isAlias bool) {
+	if typ == nil {
+		// then the underlying type wasn't found, which was reported
+		return
+	}
+
+	pkg, err := AST.GetCurrentPackage()
+	if err != nil {
+		panic(err)
+	}
+
+	if strct, err := pkg.GetStruct(ident); err == nil && strct.Package == pkg {
+		println(ast.CompilationError(CurrentFile, line), fmt.Sprintf("'%s' redeclared; previously declared as a struct", ident))
+		return
+	}
+	if _, err := pkg.GetEnum(ident); err == nil {
+		println(ast.CompilationError(CurrentFile, line), fmt.Sprintf("'%s' redeclared; previously declared as an enum", ident))
+		return
+	}
+	if !isAlias && typ.CustomType != nil && len(typ.DeclarationSpecifiers) == 1 {
+		println(ast.CompilationError(CurrentFile, line), fmt.Sprintf("invalid underlying type '%s' of '%s'; use a type alias or embed the struct", typ.CustomType.Name, ident))
+		return
+	}
+
+	pos := fmt.Sprintf("%s:%d", CurrentFile, line)
+	named, err := pkg.GetNamedType(ident)
+	if err != nil {
+		named = &ast.CXNamedType{Name: ident}
+		pkg.AddNamedType(named)
+		namedTypePositions[named] = pos
+	} else if namedTypePositions[named] != pos {
+		println(ast.CompilationError(CurrentFile, line), fmt.Sprintf("'%s' redeclared; previous declaration at %s", ident, namedTypePositions[named]))
+		return
+	}
+
+	named.IsAlias = isAlias
+	named.Underlying = typ
+}
+
 // DeclarePackage() switches the current package in the program.
 //
 func DeclarePackage(ident string) {
@@ -249,10 +500,17 @@ func DeclareImport(name string, currentFile string, lineNo int) {
 		ident = name
 	}
 
+	// The package can be imported by the instances of generics too.
+	if pair := [2]string{pkg.Name, ident}; instanceImports[pair] {
+		delete(instanceImports, pair)
+		importPositions[pair] = importPosition{file: currentFile, line: lineNo}
+	}
+
 	// If the package is already imported, then there is nothing more to be done.
 	if _, err := pkg.GetImport(ident); err == nil {
 		return
 	}
+	importPositions[[2]string{pkg.Name, ident}] = importPosition{file: currentFile, line: lineNo}
 
 	// If the package is already defined in the program, just add it to
 	// the importing package.
@@ -280,6 +538,91 @@ func DeclareImport(name string, currentFile string, lineNo int) {
 	}
 }
 
+// importPosition is the file and line of an import declaration.
+type importPosition struct {
+	file string
+	line int
+}
+
+// importPositions are the positions of the imports, by the names of the
+// importing and the imported packages. Import cycles are reported there.
+var importPositions = map[[2]string]importPosition{}
+
+// instanceImports are the imports of the packages whose types are used by the
+// instances of generics declared in other packages, by the names of the
+// importing and the imported packages. Unless they are declared too, they
+// don't order the initialization of the packages.
+var instanceImports = map[[2]string]bool{}
+
+// AddInstanceImport makes the package `pkgName` import the package
+// `impName`, whose types are used by its instances of generics.
+func AddInstanceImport(pkgName, impName string) {
+	instanceImports[[2]string{pkgName, impName}] = true
+}
+
+// DeclareInstanceImports adds the imports of the instances of generics to
+// the packages of the program.
+func DeclareInstanceImports() {
+	for pair := range instanceImports {
+		pkg, err := AST.GetPackage(pair[0])
+		if err != nil {
+			continue
+		}
+		if imp, err := AST.GetPackage(pair[1]); err == nil {
+			pkg.AddImport(imp)
+		}
+	}
+}
+
+// InitializationOrder returns the packages of `prgrm` in the order they are
+// initialized: every package after the packages it imports, and `main`
+// last. Import cycles are reported, as their packages can't be ordered.
+func InitializationOrder(prgrm *ast.CXProgram) []*ast.CXPackage {
+	var order []*ast.CXPackage
+	initialized := map[string]bool{}
+	// the packages being visited, each one imported by the previous one
+	var path []string
+
+	var visit func(pkg *ast.CXPackage)
+	visit = func(pkg *ast.CXPackage) {
+		for i, name := range path {
+			if name == pkg.Name {
+				cycle := append(append([]string{}, path[i:]...), pkg.Name)
+				pos := importPositions[[2]string{path[len(path)-1], pkg.Name}]
+				println(ast.CompilationError(pos.file, pos.line), fmt.Sprintf("initialization cycle: %s", strings.Join(cycle, " imports ")))
+				return
+			}
+		}
+		if initialized[pkg.Name] {
+			return
+		}
+
+		path = append(path, pkg.Name)
+		for _, imp := range pkg.Imports {
+			// A package can import itself, e.g. lib/json.cx
+			// extending the core package json.
+			if imp != pkg && !instanceImports[[2]string{pkg.Name, imp.Name}] {
+				visit(imp)
+			}
+		}
+		path = path[:len(path)-1]
+
+		initialized[pkg.Name] = true
+		order = append(order, pkg)
+	}
+
+	for _, pkg := range prgrm.Packages {
+		if pkg.Name != constants.MAIN_PKG {
+			visit(pkg)
+		}
+	}
+	if mainPkg, err := prgrm.GetPackage(constants.MAIN_PKG); err == nil {
+		visit(mainPkg)
+	}
+
+	return order
+}
+
 // DeclareLocal() creates a local variable inside a function.
 // If `doesInitialize` is true, then `initializer` contains the initial values
 // of the variable(s).
@@ -288,6 +631,7 @@ func DeclareImport(name string, currentFile string, lineNo int) {
 //
 func DeclareLocal(declarator *ast.CXArgument, declarationSpecifiers *ast.CXArgument,
 	initializer []*ast.CXExpression, doesInitialize bool) []*ast.CXExpression {
+	DeclareLocalNames([]*ast.CXArgument{declarator})
 	if globals2.FoundCompileErrors {
 		return nil
 	}
@@ -477,6 +821,13 @@ func DeclarationSpecifiersStruct(ident string, pkgName string,
 			panic(err)
 		}
 
+		if enum, err := imp.GetEnum(ident); err == nil {
+			return enumDeclarationSpecifiers(enum)
+		}
+		if named, err := imp.GetNamedType(ident); err == nil {
+			return namedDeclarationSpecifiers(named)
+		}
+
 		strct, err := AST.GetStruct(ident, imp.Name)
 		if err != nil {
 			println(ast.CompilationError(currentFile, lineNo), err.Error())
@@ -485,6 +836,9 @@ func DeclarationSpecifiersStruct(ident string, pkgName string,
 
 		arg := ast.MakeArgument("", currentFile, lineNo)
 		arg.Type = constants.TYPE_CUSTOM
+		if strct.IsInterface {
+			arg.Type = constants.TYPE_INTERFACE
+		}
 		arg.CustomType = strct
 		arg.Size = strct.Size
 		arg.TotalSize = strct.Size
@@ -495,14 +849,29 @@ func DeclarationSpecifiersStruct(ident string, pkgName string,
 		return arg
 	} else {
 		// custom type in the current package
+		if enum, err := pkg.GetEnum(ident); err == nil {
+			return enumDeclarationSpecifiers(enum)
+		}
+		if named, err := pkg.GetNamedType(ident); err == nil {
+			return namedDeclarationSpecifiers(named)
+		}
+
 		strct, err := AST.GetStruct(ident, pkg.Name)
 		if err != nil {
+			// error isn't a keyword, so it can be used as an
+			// identifier or redeclared.
+			if ident == constants.TypeNames[constants.TYPE_ERROR] {
+				return DeclarationSpecifiersBasic(constants.TYPE_ERROR)
+			}
 			println(ast.CompilationError(currentFile, lineNo), err.Error())
 			return nil
 		}
 
 		arg := ast.MakeArgument("", currentFile, lineNo)
 		arg.Type = constants.TYPE_CUSTOM
+		if strct.IsInterface {
+			arg.Type = constants.TYPE_INTERFACE
+		}
 		arg.DeclarationSpecifiers = append(arg.DeclarationSpecifiers, constants.DECL_STRUCT)
 		arg.CustomType = strct
 		arg.Size = strct.Size
@@ -512,3 +881,103 @@ func DeclarationSpecifiersStruct(ident string, pkgName string,
 		return arg
 	}
 }
+
+// DeclarationSpecifiersMap returns a type specifier of maps with keys of
+// the type `keySpec` and values of the type `valueSpec`. The keys can be of
+// a builtin type, or struct instances or arrays without pointers.
+func DeclarationSpecifiersMap(keySpec *ast.CXArgument, valueSpec *ast.CXArgument) *ast.CXArgument {
+	if keySpec == nil || valueSpec == nil {
+		return nil
+	}
+
+	switch {
+	case ast.IsCompositeValue(keySpec):
+		if !ast.IsHashable(keySpec) {
+			println(ast.CompilationError(CurrentFile, LineNo), fmt.Sprintf("invalid map key type '%s'; struct and array keys can't hold pointers", ast.GetFormattedType(keySpec)))
+			return nil
+		}
+		valueSpec.MapKey = keySpec
+	case len(keySpec.DeclarationSpecifiers) != 1 || keySpec.DeclarationSpecifiers[0] != constants.DECL_BASIC ||
+		keySpec.Enum != nil || !isMapElementType(keySpec.Type):
+		println(ast.CompilationError(CurrentFile, LineNo), fmt.Sprintf("invalid map key type '%s'", ast.GetFormattedType(keySpec)))
+		return nil
+	case keySpec.Named != nil:
+		// keys of named types are stored as values of their underlying
+		// basic types, but indexed by values of the named types
+		valueSpec.MapKey = keySpec
+	}
+	if !isMapValueType(valueSpec) {
+		println(ast.CompilationError(CurrentFile, LineNo), "invalid map value type: only basic types, str, slices and structs are supported")
+		return nil
+	}
+
+	value := *valueSpec
+	value.DeclarationSpecifiers = append([]int{}, valueSpec.DeclarationSpecifiers...)
+	valueSpec.MapValue = &value
+
+	// The map itself is not a slice, even if its values are.
+	valueSpec.DeclarationSpecifiers = append(valueSpec.DeclarationSpecifiers, constants.DECL_MAP)
+	valueSpec.IsMap = true
+	valueSpec.IsSlice = false
+	valueSpec.PassBy = constants.PASSBY_VALUE
+	valueSpec.MapKeyType = keySpec.Type
+	valueSpec.IsReference = true
+	valueSpec.TotalSize = constants.TYPE_POINTER_SIZE
+
+	return valueSpec
+}
+
+// mapKeySpecifier returns a type specifier of the keys of the map `arg`.
+func mapKeySpecifier(arg *ast.CXArgument) *ast.CXArgument {
+	if arg.MapKey != nil {
+		return arg.MapKey
+	}
+	return DeclarationSpecifiersBasic(arg.MapKeyType)
+}
+
+// isMapElementType checks if the keys or values of maps can be of the
+// builtin type `typ`.
+func isMapElementType(typ int) bool {
+	switch typ {
+	case constants.TYPE_BOOL, constants.TYPE_STR,
+		constants.TYPE_I8, constants.TYPE_I16, constants.TYPE_I32, constants.TYPE_I64,
+		constants.TYPE_UI8, constants.TYPE_UI16, constants.TYPE_UI32, constants.TYPE_UI64,
+		constants.TYPE_F32, constants.TYPE_F64:
+		return true
+	}
+	return false
+}
+
+// isMapValueType checks if the values of maps can be of the type `spec`.
+func isMapValueType(spec *ast.CXArgument) bool {
+	specs := spec.DeclarationSpecifiers
+	switch specs[len(specs)-1] {
+	case constants.DECL_SLICE:
+		return true
+	case constants.DECL_STRUCT:
+		return len(specs) == 1 && spec.Type == constants.TYPE_CUSTOM
+	case constants.DECL_BASIC:
+		return len(specs) == 1 && isMapElementType(spec.Type)
+	}
+	return false
+}
+
+// enumDeclarationSpecifiers returns a type specifier of the type `enum`.
+func enumDeclarationSpecifiers(enum *ast.CXEnum) *ast.CXArgument {
+	arg := DeclarationSpecifiersBasic(constants.TYPE_I32)
+	arg.Enum = enum
+	return arg
+}
+
+// namedDeclarationSpecifiers returns a type specifier of the named type
+// `named`, or of the type it's an alias of.
+func namedDeclarationSpecifiers(named *ast.CXNamedType) *ast.CXArgument {
+	arg := ast.MakeArgument("", CurrentFile, LineNo)
+	copyParameterType(arg, named.Underlying)
+	arg.IndirectionLevels = named.Underlying.IndirectionLevels
+	arg.ArgDetails.Package = named.Underlying.ArgDetails.Package
+	if !named.IsAlias {
+		arg.Named = named
+	}
+	return arg
+}
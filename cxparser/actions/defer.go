@@ -0,0 +1,124 @@
+package actions
+
+import (
+	"fmt"
+
+	"github.com/skycoin/cx/cx/ast"
+	"github.com/skycoin/cx/cx/constants"
+)
+
+var (
+	// deferredCalls holds the expressions calling functions deferred by
+	// `defer` statements.
+	deferredCalls = map[*ast.CXExpression]bool{}
+	// deferredNatives holds the number of calls to natives deferred in
+	// each function, used to name the functions calling them.
+	deferredNatives = map[*ast.CXFunction]int{}
+)
+
+// DeferStatement returns the expressions of the statement `defer call`. The
+// arguments of the call are evaluated by the statement, and the call is
+// made when the function executing the statement returns.
+func DeferStatement(exprs []*ast.CXExpression) []*ast.CXExpression {
+	call := exprs[len(exprs)-1]
+	if (call.Operator == nil && !call.IsMethodCall()) ||
+		(call.Operator != nil && call.Operator.IsBuiltin && ast.IsOperator(call.Operator.OpCode)) {
+		println(ast.CompilationError(call.FileName, call.FileLine), "expression in defer must be function call")
+		return nil
+	}
+
+	deferredCalls[call] = true
+	return exprs
+}
+
+// ProcessDeferredCall makes `expr`, an expression of `fn`, a deferred call
+// if it's the call of a `defer` statement. Natives can't be deferred, so
+// the calls to them are made by functions declared for them, which receive
+// their arguments.
+func ProcessDeferredCall(fn *ast.CXFunction, expr *ast.CXExpression) {
+	if !deferredCalls[expr] {
+		return
+	}
+	delete(deferredCalls, expr)
+	expr.ExpressionType = ast.CXEXPR_DEFER
+
+	if expr.Operator != nil && expr.Operator.IsBuiltin {
+		expr.Operator = deferredNative(fn, expr)
+	}
+}
+
+// deferredNative declares the function calling the native called by
+// `expr`, an expression of `parent` which is deferred.
+func deferredNative(parent *ast.CXFunction, expr *ast.CXExpression) *ast.CXFunction {
+	pkg := parent.Package
+	native := expr.Operator
+
+	deferredNatives[parent]++
+	fn := ast.MakeFunction(fmt.Sprintf("%s.defer%d", parent.Name, deferredNatives[parent]), expr.FileName, expr.FileLine)
+
+	// Declaring the function doesn't change the current function.
+	current := pkg.CurrentFunction
+	pkg.AddFunction(fn)
+	pkg.CurrentFunction = current
+
+	call := ast.MakeExpression(native, expr.FileName, expr.FileLine)
+	call.Package = pkg
+
+	var inputs, outputs []*ast.CXArgument
+	for i, inp := range expr.Inputs {
+		typ := inp
+		if i < len(native.Inputs) && native.Inputs[i].Type != constants.TYPE_UNDEFINED {
+			typ = native.Inputs[i]
+		}
+		param := deferredParameter(pkg, fmt.Sprintf("in%d", i), typ, expr)
+		inputs = append(inputs, param)
+		call.AddInput(deferredIdentifier(param))
+	}
+	for i, out := range native.Outputs {
+		param := deferredParameter(pkg, fmt.Sprintf("out%d", i), out, expr)
+		outputs = append(outputs, param)
+		call.AddOutput(deferredIdentifier(param))
+	}
+
+	for _, inp := range inputs {
+		fn.AddInput(inp)
+	}
+	for _, out := range outputs {
+		fn.AddOutput(out)
+	}
+	functionDeclaration(fn, inputs, outputs, []*ast.CXExpression{call}, nil)
+	return fn
+}
+
+// deferredParameter returns a parameter named `name` of the function
+// calling the native deferred by `expr`, declared in `pkg`, of the type of
+// the argument `arg`. Only basic types and slices of them are supported.
+func deferredParameter(pkg *ast.CXPackage, name string, arg *ast.CXArgument, expr *ast.CXExpression) *ast.CXArgument {
+	elt := ast.GetAssignmentElement(arg)
+	typ := elt.Type
+	isSlice := elt.IsSlice && len(elt.Indexes) == 0
+	if typ == constants.TYPE_UNDEFINED || typ == constants.TYPE_CUSTOM || typ == constants.TYPE_INTERFACE ||
+		typ == constants.TYPE_FUNC || elt.IsMap || (elt.IsPointer && len(elt.DereferenceOperations) == 0) ||
+		(!elt.IsSlice && len(elt.Lengths) > len(elt.Indexes)) {
+		println(ast.CompilationError(expr.FileName, expr.FileLine), fmt.Sprintf("cannot defer call to '%s' with argument of type '%s'", ast.ExprOpName(expr), ast.GetFormattedType(arg)))
+		typ = constants.TYPE_I32
+	}
+
+	param := DeclarationSpecifiersBasic(typ)
+	if isSlice {
+		param = DeclarationSpecifiers(param, []int{0}, constants.DECL_SLICE)
+	}
+	param.ArgDetails.Name = name
+	param.ArgDetails.FileName = expr.FileName
+	param.ArgDetails.FileLine = expr.FileLine
+	param.ArgDetails.Package = pkg
+	return param
+}
+
+// deferredIdentifier returns an argument reading the parameter `param`.
+func deferredIdentifier(param *ast.CXArgument) *ast.CXArgument {
+	arg := ast.MakeArgument(param.ArgDetails.Name, param.ArgDetails.FileName, param.ArgDetails.FileLine)
+	arg.AddType(constants.TypeNames[constants.TYPE_IDENTIFIER])
+	arg.ArgDetails.Package = param.ArgDetails.Package
+	return arg
+}
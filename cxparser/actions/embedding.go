@@ -0,0 +1,84 @@
+package actions
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/skycoin/cx/cx/ast"
+	"github.com/skycoin/cx/cx/constants"
+)
+
+// promoteFields returns the fields `flds`, accessed on an instance of
+// `strct`, with the embedded fields through which promoted fields and
+// methods are reached inserted before them, e.g. the fields of `obj.x` become
+// the fields of `obj.Base.x` if `x` is a field of the struct `Base` embedded
+// in `strct`.
+func promoteFields(strct *ast.CXStruct, flds []*ast.CXArgument) []*ast.CXArgument {
+	for i := 0; i < len(flds) && strct != nil; i++ {
+		fld := flds[i]
+		name := fld.ArgDetails.Name
+
+		if inFld, err := strct.GetField(name); err == nil {
+			strct = inFld.CustomType
+			continue
+		}
+		if _, err := strct.Package.GetMethod(strct.Name+"."+name, strct.Name); err == nil {
+			break
+		}
+
+		path, err := strct.GetPromoted(name)
+		if err != nil {
+			println(ast.CompilationError(fld.ArgDetails.FileName, fld.ArgDetails.FileLine), err.Error())
+			os.Exit(constants.CX_COMPILATION_ERROR)
+		}
+		if path == nil {
+			break
+		}
+
+		embFlds := make([]*ast.CXArgument, len(path))
+		for j, emb := range path {
+			embFld := ast.MakeArgument(emb.ArgDetails.Name, fld.ArgDetails.FileName, fld.ArgDetails.FileLine)
+			embFld.AddType(constants.TypeNames[constants.TYPE_IDENTIFIER]).AddPackage(fld.ArgDetails.Package)
+			embFlds[j] = embFld
+		}
+		flds = append(flds[:i:i], append(embFlds, flds[i:]...)...)
+
+		// the next field is `fld`, which is a field or a method of the
+		// innermost embedded struct
+		i += len(path) - 1
+		strct = path[len(path)-1].CustomType
+	}
+
+	return flds
+}
+
+// fieldMethod returns the method called by accessing `flds` on an instance
+// of `strct`, where the last field is the name of the method. The method is
+// looked up in the type of the field before it, which can be a basic type,
+// e.g. `Error` in `r.err.Error()`.
+func fieldMethod(strct *ast.CXStruct, flds []*ast.CXArgument) *ast.CXFunction {
+	method := flds[len(flds)-1]
+	for i, fld := range flds[:len(flds)-1] {
+		inFld, err := strct.GetField(fld.ArgDetails.Name)
+		if err != nil {
+			break
+		}
+		if inFld.CustomType == nil {
+			if i == len(flds)-2 {
+				if fn := lookupBasicTypeMethod(inFld, method.ArgDetails.Name); fn != nil {
+					return fn
+				}
+			}
+			println(ast.CompilationError(method.ArgDetails.FileName, method.ArgDetails.FileLine), fmt.Sprintf("illegal method call or field access on field '%s' of primitive type '%s'", fld.ArgDetails.Name, constants.TypeNames[inFld.Type]))
+			os.Exit(constants.CX_COMPILATION_ERROR)
+		}
+		strct = inFld.CustomType
+	}
+
+	fn, err := strct.Package.GetMethod(strct.Name+"."+method.ArgDetails.Name, strct.Name)
+	if err != nil {
+		println(ast.CompilationError(method.ArgDetails.FileName, method.ArgDetails.FileLine), err.Error())
+		os.Exit(constants.CX_COMPILATION_ERROR)
+	}
+	return fn
+}
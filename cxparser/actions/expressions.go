@@ -16,6 +16,84 @@ type ReturnExpressions struct {
 	Expressions []*ast.CXExpression
 }
 
+// loopLabel is the label of the statement being parsed, while the statement
+// can still be a loop, i.e. until a token other than `for` follows the label.
+var loopLabel string
+
+// forRead tells if the last token read by the lexer is `for`.
+var forRead bool
+
+// loopLabels are the labels of the loops enclosing the statement being
+// parsed, with the innermost loop last and "" for unlabeled loops.
+var loopLabels []string
+
+// jumpLabels are the labels named by labeled breaks and continues.
+var jumpLabels = map[*ast.CXExpression]string{}
+
+// BeginLoop is called when the lexer reads the `for` keyword of a loop, so
+// the labeled breaks and continues in its body can be checked.
+func BeginLoop() {
+	checkLoopLabel(loopLabel, loopLabels)
+	loopLabels = append(loopLabels, loopLabel)
+	loopLabel = ""
+	forRead = true
+}
+
+// EndLoopLabel is called when the lexer reads any other token, as a label
+// only names a loop if `for` follows it.
+func EndLoopLabel() {
+	loopLabel = ""
+	forRead = false
+}
+
+// StatementLabel is called when the label of a statement is parsed. The
+// parser can read the `for` of a labeled loop before or after it.
+func StatementLabel(label string) {
+	if forRead {
+		checkLoopLabel(label, loopLabels[:len(loopLabels)-1])
+		loopLabels[len(loopLabels)-1] = label
+	} else {
+		loopLabel = label
+	}
+}
+
+// checkLoopLabel throws an error if `label` already names one of the
+// `enclosing` loops, as breaks and continues couldn't tell them apart.
+func checkLoopLabel(label string, enclosing []string) {
+	if label == "" {
+		return
+	}
+	for _, loopLabel := range enclosing {
+		if loopLabel == label {
+			println(ast.CompilationError(CurrentFile, LineNo), fmt.Sprintf("label %s already defined", label))
+			return
+		}
+	}
+}
+
+// endLoop removes the label of the loop being parsed from the loop labels
+// and returns it. If the parser already read the `for` of the next loop, its
+// label is the last one.
+func endLoop() (label string) {
+	i := len(loopLabels) - 1
+	if forRead {
+		i--
+	}
+	if i < 0 {
+		return ""
+	}
+	label = loopLabels[i]
+	loopLabels = append(loopLabels[:i], loopLabels[i+1:]...)
+	return label
+}
+
+// targetsLoop checks if the break or continue `expr` exits or continues the
+// innermost loop enclosing it, whose label is `label`.
+func targetsLoop(expr *ast.CXExpression, label string) bool {
+	target := jumpLabels[expr]
+	return target == "" || target == label
+}
+
 func IterationExpressions(init []*ast.CXExpression, cond []*ast.CXExpression, incr []*ast.CXExpression, statements []*ast.CXExpression) []*ast.CXExpression {
 	jmpFn := ast.Natives[constants.OP_JMP]
 
@@ -55,17 +133,20 @@ func IterationExpressions(init []*ast.CXExpression, cond []*ast.CXExpression, in
 	thenLines := 0
 	elseLines := len(incr) + len(statements) + 1
 
-	// processing possible breaks
+	label := endLoop()
+
+	// processing possible breaks, including the labeled breaks of
+	// enclosed loops that name this loop
 	for i, stat := range statements {
-		if stat.IsBreak() {
-			stat.ThenLines = elseLines - i - 1
+		if stat.IsBreak() && targetsLoop(stat, label) {
+			resolveJump(stat, elseLines-i-1)
 		}
 	}
 
 	// processing possible continues
 	for i, stat := range statements {
-		if stat.IsContinue() {
-			stat.ThenLines = len(statements) - i - 1
+		if stat.IsContinue() && targetsLoop(stat, label) {
+			resolveJump(stat, len(statements)-i-1)
 		}
 	}
 
@@ -100,16 +181,49 @@ func trueJmpExpressions(opcode int) []*ast.CXExpression {
 	return []*ast.CXExpression{expr}
 }
 
-func BreakExpressions() []*ast.CXExpression {
+// resolveJump makes a `break` or `continue` expression jump `lines`
+// expressions forward. The expression becomes a plain jump, so enclosing
+// loops and switches don't resolve it again.
+func resolveJump(expr *ast.CXExpression, lines int) {
+	expr.Operator = ast.Natives[constants.OP_JMP]
+	expr.ThenLines = lines
+}
+
+// BreakExpressions builds a `break` statement, which exits the loop named
+// `label`, or the innermost loop or switch if `label` is empty. The statement
+// is at `line`.
+func BreakExpressions(label string, line int) []*ast.CXExpression {
 	exprs := trueJmpExpressions(constants.OP_BREAK)
+	exprs[0].FileLine = line
+	labelJump(exprs[0], "break", label)
 	return exprs
 }
 
-func ContinueExpressions() []*ast.CXExpression {
+// ContinueExpressions builds a `continue` statement, which continues the
+// loop named `label`, or the innermost loop if `label` is empty. The statement
+// is at `line`.
+func ContinueExpressions(label string, line int) []*ast.CXExpression {
 	exprs := trueJmpExpressions(constants.OP_CONTINUE)
+	exprs[0].FileLine = line
+	labelJump(exprs[0], "continue", label)
 	return exprs
 }
 
+// labelJump makes the break or continue `expr` target the loop named
+// `label`, which must enclose it.
+func labelJump(expr *ast.CXExpression, stmt, label string) {
+	if label == "" {
+		return
+	}
+	for _, loopLabel := range loopLabels {
+		if loopLabel == label {
+			jumpLabels[expr] = label
+			return
+		}
+	}
+	println(ast.CompilationError(expr.FileName, expr.FileLine), fmt.Sprintf("invalid %s label '%s'; no enclosing loop has this label", stmt, label))
+}
+
 func SelectionExpressions(condExprs []*ast.CXExpression, thenExprs []*ast.CXExpression, elseExprs []*ast.CXExpression) []*ast.CXExpression {
 	DefineNewScope(thenExprs)
 	DefineNewScope(elseExprs)
@@ -205,6 +319,17 @@ func IsTempVar(name string) bool {
 	return false
 }
 
+// adoptCompositeType makes the temporary variable `tmp` hold the struct
+// instances or arrays returned as `arg`, so they can be compared.
+func adoptCompositeType(tmp, arg *ast.CXArgument) {
+	if !ast.IsCompositeValue(arg) {
+		return
+	}
+	tmp.DeclarationSpecifiers = append([]int{}, arg.DeclarationSpecifiers...)
+	tmp.CustomType = arg.CustomType
+	tmp.Lengths = arg.Lengths
+}
+
 func OperatorExpression(leftExprs []*ast.CXExpression, rightExprs []*ast.CXExpression, opcode int) (out []*ast.CXExpression) {
 	pkg, err := AST.GetCurrentPackage()
 	if err != nil {
@@ -216,6 +341,8 @@ func OperatorExpression(leftExprs []*ast.CXExpression, rightExprs []*ast.CXExpre
 		name.Size = leftExprs[len(leftExprs)-1].Operator.Outputs[0].Size
 		name.TotalSize = ast.GetSize(leftExprs[len(leftExprs)-1].Operator.Outputs[0])
 		name.Type = leftExprs[len(leftExprs)-1].Operator.Outputs[0].Type
+		adoptCompositeType(name, leftExprs[len(leftExprs)-1].Operator.Outputs[0])
+		adoptNamedType(name, leftExprs[len(leftExprs)-1].Operator.Outputs[0])
 		name.ArgDetails.Package = pkg
 		name.PreviouslyDeclared = true
 
@@ -228,6 +355,8 @@ func OperatorExpression(leftExprs []*ast.CXExpression, rightExprs []*ast.CXExpre
 		name.Size = rightExprs[len(rightExprs)-1].Operator.Outputs[0].Size
 		name.TotalSize = ast.GetSize(rightExprs[len(rightExprs)-1].Operator.Outputs[0])
 		name.Type = rightExprs[len(rightExprs)-1].Operator.Outputs[0].Type
+		adoptCompositeType(name, rightExprs[len(rightExprs)-1].Operator.Outputs[0])
+		adoptNamedType(name, rightExprs[len(rightExprs)-1].Operator.Outputs[0])
 		name.ArgDetails.Package = pkg
 		name.PreviouslyDeclared = true
 
@@ -288,6 +417,10 @@ func UnaryExpression(op string, prevExprs []*ast.CXExpression) []*ast.CXExpressi
 		exprOut.DeclarationSpecifiers = append(exprOut.DeclarationSpecifiers, constants.DECL_DEREF)
 		exprOut.IsReference = false
 	case "&":
+		if name, ok := constantArgs[baseOut]; ok {
+			println(ast.CompilationError(baseOut.ArgDetails.FileName, baseOut.ArgDetails.FileLine), fmt.Sprintf("cannot take address of %s", name))
+			return prevExprs
+		}
 		baseOut.PassBy = constants.PASSBY_REFERENCE
 		exprOut.DeclarationSpecifiers = append(exprOut.DeclarationSpecifiers, constants.DECL_POINTER)
 		if len(baseOut.Fields) == 0 && hasDeclSpec(baseOut, constants.DECL_INDEXING) {
@@ -344,6 +477,8 @@ func AssociateReturnExpressions(idx int, retExprs []*ast.CXExpression) []*ast.CX
 	out := ast.MakeArgument(outParam.ArgDetails.Name, CurrentFile, LineNo)
 	out.AddType(constants.TypeNames[outParam.Type])
 	out.CustomType = outParam.CustomType
+	out.Enum = outParam.Enum
+	out.Named = outParam.Named
 	out.PreviouslyDeclared = true
 
 	if lastExpr.Operator == nil {
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/jinzhu/copier"
 	"github.com/skycoin/cx/cx/ast"
@@ -23,8 +24,16 @@ func FunctionHeader(ident string, receiver []*ast.CXArgument, isMethod bool) *as
 		if len(receiver) > 1 {
 			panic("method has multiple receivers")
 		}
+		if receiver[0].CustomType != nil && receiver[0].CustomType.IsUnion() {
+			println(ast.CompilationError(CurrentFile, LineNo), fmt.Sprintf("invalid receiver type '%s' (union type)", receiver[0].CustomType.Name))
+		} else if receiver[0].CustomType != nil && receiver[0].CustomType.IsInterface {
+			println(ast.CompilationError(CurrentFile, LineNo), fmt.Sprintf("invalid receiver type '%s' (interface type)", receiver[0].CustomType.Name))
+		} else if receiver[0].Named == nil && receiver[0].CustomType == nil ||
+			receiver[0].Named != nil && !ast.IsNamedType(receiver[0], true) {
+			println(ast.CompilationError(CurrentFile, LineNo), fmt.Sprintf("invalid receiver type '%s'", ast.GetFormattedType(receiver[0])))
+		}
 		if pkg, err := AST.GetCurrentPackage(); err == nil {
-			fnName := receiver[0].CustomType.Name + "." + ident
+			fnName := ast.ReceiverTypeName(receiver[0]) + "." + ident
 
 			if fn, err := AST.GetFunction(fnName, pkg.Name); err == nil {
 				fn.AddInput(receiver[0])
@@ -41,6 +50,12 @@ func FunctionHeader(ident string, receiver []*ast.CXArgument, isMethod bool) *as
 		}
 	} else {
 		if pkg, err := AST.GetCurrentPackage(); err == nil {
+			if ident == constants.PKG_INIT_FUNC {
+				// the init functions are declared by the first
+				// pass in the same order
+				ident = initFunctionName(initHeaders[pkg])
+				initHeaders[pkg]++
+			}
 			if fn, err := AST.GetFunction(ident, pkg.Name); err == nil {
 				pkg.CurrentFunction = fn
 				return fn
@@ -60,8 +75,10 @@ func FunctionAddParameters(fn *ast.CXFunction, inputs, outputs []*ast.CXArgument
 		// it must be a method declaration
 		// so we save the first input
 		fn.Inputs = fn.Inputs[:1]
+		checkVariadicParameters(inputs, outputs, true, false)
 	} else {
 		fn.Inputs = nil
+		checkVariadicParameters(inputs, outputs, false, false)
 	}
 
 	// we need to wipe the inputs recognized in the first pass
@@ -95,6 +112,11 @@ func isParseOp(expr *ast.CXExpression) bool {
 // the expression `sa + sb` is not valid if they are struct instances.
 func CheckUndValidTypes(expr *ast.CXExpression) {
 	if expr.Operator != nil && ast.IsOperator(expr.Operator.OpCode) && !IsAllArgsBasicTypes(expr) {
+		if len(expr.Inputs) > 0 && ast.IsCompositeValue(expr.Inputs[0]) || isInterfaceNilComparison(expr) {
+			// already reported by processValueComparison or
+			// processInterfaceNilComparison
+			return
+		}
 		println(ast.CompilationError(CurrentFile, LineNo), fmt.Sprintf("invalid argument types for '%s' operator", ast.OpNames[expr.Operator.OpCode]))
 	}
 }
@@ -114,7 +136,48 @@ func FunctionProcessParameters(symbols *[]map[string]*ast.CXArgument, symbolsSco
 	}
 }
 
+// initHeaders counts the init functions of each package whose headers were
+// parsed by the second pass.
+var initHeaders = map[*ast.CXPackage]int{}
+
+// initFunctionName returns the name of the init function declared after
+// `n` other init functions of its package. The first one is named `init`,
+// and the next ones `init#2`, `init#3`, etc., which aren't identifiers, so
+// they can't be called or redeclared.
+func initFunctionName(n int) string {
+	if n == 0 {
+		return constants.PKG_INIT_FUNC
+	}
+	return fmt.Sprintf("%s#%d", constants.PKG_INIT_FUNC, n+1)
+}
+
+// IsInitFunction checks if `fn` is one of the init functions of its package.
+func IsInitFunction(fn *ast.CXFunction) bool {
+	return fn.Name == constants.PKG_INIT_FUNC || strings.HasPrefix(fn.Name, constants.PKG_INIT_FUNC+"#")
+}
+
+// NextInitFunctionName returns the name of the next init function declared
+// in `pkg` by the first pass, as packages can declare several of them.
+func NextInitFunctionName(pkg *ast.CXPackage) string {
+	n := 0
+	for _, fn := range pkg.Functions {
+		if IsInitFunction(fn) {
+			n++
+		}
+	}
+	return initFunctionName(n)
+}
+
 func FunctionDeclaration(fn *ast.CXFunction, inputs, outputs []*ast.CXArgument, exprs []*ast.CXExpression) {
+	if IsInitFunction(fn) && (len(inputs) > 0 || len(outputs) > 0) {
+		println(ast.CompilationError(fn.FileName, fn.FileLine), "func init must have no arguments and no return values")
+	}
+	functionDeclaration(fn, inputs, outputs, exprs, nil)
+}
+
+// functionDeclaration declares `fn`, which is the function of the function
+// literal `literal` if it's not nil.
+func functionDeclaration(fn *ast.CXFunction, inputs, outputs []*ast.CXArgument, exprs []*ast.CXExpression, literal *functionLiteral) {
 
 	//var exprs []*cxcore.CXExpression = globals.SysInitExprs
 
@@ -146,6 +209,9 @@ func FunctionDeclaration(fn *ast.CXFunction, inputs, outputs []*ast.CXArgument,
 	// local being function constrained variables, and global being global variables
 	var symbolsScope map[string]bool = make(map[string]bool)
 
+	scope := pushFunctionScope(fn, symbols, &offset, literal)
+	defer popFunctionScope()
+
 	FunctionProcessParameters(symbols, &symbolsScope, &offset, fn, fn.Inputs)
 	FunctionProcessParameters(symbols, &symbolsScope, &offset, fn, fn.Outputs)
 
@@ -154,8 +220,16 @@ func FunctionDeclaration(fn *ast.CXFunction, inputs, outputs []*ast.CXArgument,
 			*symbols = append(*symbols, make(map[string]*ast.CXArgument))
 		}
 
+		ProcessFunctionLiteral(expr)
+		ProcessFunctionFieldCall(expr, symbols)
 		ProcessMethodCall(expr, symbols, &offset, true)
 		ProcessExpressionArguments(symbols, &symbolsScope, &offset, fn, expr.Inputs, expr, true)
+		ProcessFunctionValueCall(fn.Expressions[:i], expr, &offset)
+		ProcessMapElementAssignment(fn.Expressions[:i], expr, &offset)
+		ProcessCustomTypeShortDeclaration(fn.Expressions[:i], expr, &offset)
+		ProcessFunctionTypeShortDeclaration(fn.Expressions[:i], expr, &offset)
+		ProcessRangeExpression(expr)
+		ProcessSliceExpression(fn.Expressions[:i], expr, &offset)
 		ProcessExpressionArguments(symbols, &symbolsScope, &offset, fn, expr.Outputs, expr, false)
 
 		ProcessPointerStructs(expr)
@@ -170,27 +244,55 @@ func FunctionDeclaration(fn *ast.CXFunction, inputs, outputs []*ast.CXArgument,
 		//	panic("ATWETEWTASGDFG")
 		//}
 		// process short declaration
-		if len(expr.Outputs) > 0 && len(expr.Inputs) > 0 && expr.Outputs[0].IsShortAssignmentDeclaration && !expr.IsStructLiteral() && !isParseOp(expr) {
+		if len(expr.Outputs) == 1 && len(expr.Inputs) > 0 && expr.Outputs[0].IsShortAssignmentDeclaration && !expr.IsStructLiteral() && !isParseOp(expr) {
 			if expr.IsMethodCall() {
 				fn.Expressions[i-1].Outputs[0].Type = fn.Expressions[i].Operator.Outputs[0].Type
 				fn.Expressions[i].Outputs[0].Type = fn.Expressions[i].Operator.Outputs[0].Type
+				fn.Expressions[i-1].Outputs[0].Enum = fn.Expressions[i].Operator.Outputs[0].Enum
+				fn.Expressions[i-1].Outputs[0].Named = fn.Expressions[i].Operator.Outputs[0].Named
+				fn.Expressions[i].Outputs[0].Enum = fn.Expressions[i].Operator.Outputs[0].Enum
+				fn.Expressions[i].Outputs[0].Named = fn.Expressions[i].Operator.Outputs[0].Named
 			} else {
-				fn.Expressions[i-1].Outputs[0].Type = fn.Expressions[i].Inputs[0].Type
-				fn.Expressions[i].Outputs[0].Type = fn.Expressions[i].Inputs[0].Type
+				// the type is the type of the output of the operator,
+				// unless it adopts the type of its input
+				typArg := fn.Expressions[i].Inputs[0]
+				if op := fn.Expressions[i].Operator; op != nil && op.OpCode != constants.OP_IDENTITY && len(op.Outputs) > 0 && op.Outputs[0].Type != constants.TYPE_UNDEFINED {
+					typArg = op.Outputs[0]
+				} else if operand := enumOperand(fn.Expressions[i]); operand != nil {
+					typArg = operand
+				}
+				fn.Expressions[i-1].Outputs[0].Type = typArg.Type
+				fn.Expressions[i].Outputs[0].Type = typArg.Type
+				fn.Expressions[i-1].Outputs[0].Enum = ast.GetAssignmentElement(typArg).Enum
+				fn.Expressions[i-1].Outputs[0].Named = ast.GetAssignmentElement(typArg).Named
+				fn.Expressions[i].Outputs[0].Enum = ast.GetAssignmentElement(typArg).Enum
+				fn.Expressions[i].Outputs[0].Named = ast.GetAssignmentElement(typArg).Named
+				if typArg.Type == constants.TYPE_FUNC {
+					fn.Expressions[i-1].Outputs[0].Inputs = ast.GetAssignmentElement(typArg).Inputs
+					fn.Expressions[i-1].Outputs[0].Outputs = ast.GetAssignmentElement(typArg).Outputs
+				}
 			}
 		}
 
 		processTestExpression(expr)
+		ProcessInterfaceConversion(expr)
 
 		CheckTypes(expr)
+		CheckVariadicArguments(expr)
 		CheckUndValidTypes(expr)
+		ProcessDeferredCall(fn, expr)
 
 		if expr.IsScopeDel() {
 			*symbols = (*symbols)[:len(*symbols)-1]
 		}
 	}
 
+	escapeVariables(scope, &offset)
+
 	fn.Size = offset
+	// Function values and the sizes of cells can be written to the data
+	// segment while declaring the function.
+	AST.HeapStartsAt = AST.DataSegmentSize + AST.DataSegmentStartsAt
 }
 
 func FunctionCall(exprs []*ast.CXExpression, args []*ast.CXExpression) []*ast.CXExpression {
@@ -201,11 +303,23 @@ func FunctionCall(exprs []*ast.CXExpression, args []*ast.CXExpression) []*ast.CX
 		opPkg := expr.Outputs[0].ArgDetails.Package
 
 		if op, err := AST.GetFunction(opName, opPkg.Name); err == nil {
+			if op.Name == constants.PKG_INIT_FUNC {
+				// init functions are only called when their
+				// packages are initialized
+				println(ast.CompilationError(CurrentFile, LineNo), "cannot call init function")
+			}
 			expr.Operator = op
+		} else if named, err := opPkg.GetNamedType(opName); err == nil && expr.Outputs[0].Fields == nil {
+			// then it's a conversion to a named type, e.g. `Celsius(x)`
+			expr.Operator = typeConversionFunction(namedDeclarationSpecifiers(named))
+		} else if enum, err := opPkg.GetEnum(opName); err == nil && expr.Outputs[0].Fields == nil {
+			// then it's a conversion to an enum, e.g. `Color(i)`
+			expr.Operator = typeConversionFunction(enumDeclarationSpecifiers(enum))
 		} else if expr.Outputs[0].Fields == nil {
-			// then it's not a possible method call
-			println(ast.CompilationError(CurrentFile, LineNo), err.Error())
-			return nil
+			// then it's a call to a function value, such as a
+			// variable or a parameter
+			expr.Operator = ast.Natives[ast.OpCodes["func.call"]]
+			expr.AddInput(expr.Outputs[0])
 		} else {
 			expr.ExpressionType = ast.CXEXPR_METHOD_CALL
 		}
@@ -213,11 +327,30 @@ func FunctionCall(exprs []*ast.CXExpression, args []*ast.CXExpression) []*ast.CX
 		if len(expr.Outputs) > 0 && expr.Outputs[0].Fields == nil {
 			expr.Outputs = nil
 		}
+	} else if expr.Operator == ast.Natives[ast.OpCodes["func.closure"]] && len(expr.Outputs) == 0 {
+		// then it's a call to a function literal, e.g. `func() { ... }()`,
+		// whose closure is held by a temporary variable
+		value := expr.Inputs[0]
+		out := ast.MakeArgument(MakeGenSym(constants.LOCAL_PREFIX), CurrentFile, expr.FileLine).AddType(constants.TypeNames[constants.TYPE_FUNC])
+		out.Inputs = value.Inputs
+		out.Outputs = value.Outputs
+		out.PreviouslyDeclared = true
+		out.ArgDetails.Package = expr.Package
+		expr.AddOutput(out)
+
+		expr = ast.MakeExpression(ast.Natives[ast.OpCodes["func.call"]], CurrentFile, expr.FileLine)
+		expr.Package = out.ArgDetails.Package
+		expr.AddInput(out)
+		exprs = append(exprs, expr)
 	}
 
 	var nestedExprs []*ast.CXExpression
 	for _, inpExpr := range args {
-		if inpExpr.Operator == nil {
+		if inpExpr.Operator == nil && inpExpr.Outputs[0].IsLocalDeclaration {
+			// then it declares a variable used by an argument, such
+			// as the slice of the variadic arguments of a call
+			nestedExprs = append(nestedExprs, inpExpr)
+		} else if inpExpr.Operator == nil {
 			// then it's a literal
 			expr.AddInput(inpExpr.Outputs[0])
 		} else {
@@ -232,6 +365,8 @@ func FunctionCall(exprs []*ast.CXExpression, args []*ast.CXExpression) []*ast.CX
 
 					out.Size = inpExpr.Inputs[0].Size
 					out.TotalSize = ast.GetSize(inpExpr.Inputs[0])
+					out.Inputs = inpExpr.Inputs[0].Inputs
+					out.Outputs = inpExpr.Inputs[0].Outputs
 
 					out.Type = inpExpr.Inputs[0].Type
 					out.PreviouslyDeclared = true
@@ -241,6 +376,15 @@ func FunctionCall(exprs []*ast.CXExpression, args []*ast.CXExpression) []*ast.CX
 
 					out.CustomType = inpExpr.Operator.Outputs[0].CustomType
 
+					out.Enum = inpExpr.Operator.Outputs[0].Enum
+					out.Named = inpExpr.Operator.Outputs[0].Named
+					out.IsMap = inpExpr.Operator.Outputs[0].IsMap
+					out.MapKeyType = inpExpr.Operator.Outputs[0].MapKeyType
+					out.MapKey = inpExpr.Operator.Outputs[0].MapKey
+					out.MapValue = inpExpr.Operator.Outputs[0].MapValue
+					out.Inputs = inpExpr.Operator.Outputs[0].Inputs
+					out.Outputs = inpExpr.Operator.Outputs[0].Outputs
+
 					if inpExpr.Operator.Outputs[0].CustomType != nil {
 						if strct, err := inpExpr.Package.GetStruct(inpExpr.Operator.Outputs[0].CustomType.Name); err == nil {
 							out.Size = strct.Size
@@ -282,13 +426,17 @@ func checkSameNativeType(expr *ast.CXExpression) error {
 		}
 		typ = inp.Type
 	}
-	return nil
+	return checkSameNamedType(expr.Inputs)
 }
 
 func ProcessOperatorExpression(expr *ast.CXExpression) {
 	if expr.Operator != nil && ast.IsOperator(expr.Operator.OpCode) {
-		if err := checkSameNativeType(expr); err != nil {
-			println(ast.CompilationError(CurrentFile, LineNo), err.Error())
+		if isInterfaceNilComparison(expr) {
+			processInterfaceNilComparison(expr)
+		} else if ast.IsCompositeValue(expr.Inputs[0]) {
+			processValueComparison(expr)
+		} else if err := checkSameNativeType(expr); err != nil {
+			println(ast.CompilationError(expr.FileName, expr.FileLine), err.Error())
 		}
 	}
 	if expr.IsUndType() {
@@ -327,6 +475,9 @@ func processTestExpression(expr *ast.CXExpression) {
 		if opCode == constants.OP_ASSERT || opCode == constants.OP_TEST || opCode == constants.OP_PANIC {
 			inp1Type := ast.GetFormattedType(expr.Inputs[0])
 			inp2Type := ast.GetFormattedType(expr.Inputs[1])
+			if isUntypedLiteral(expr.Inputs[1]) && ast.GetFormattedBasicType(expr.Inputs[0]) == inp2Type {
+				inp2Type = inp1Type
+			}
 			if inp1Type != inp2Type {
 				println(ast.CompilationError(CurrentFile, LineNo), fmt.Sprintf("first and second input arguments' types are not equal in '%s' call ('%s' != '%s')", ast.OpNames[expr.Operator.OpCode], inp1Type, inp2Type))
 			}
@@ -342,6 +493,299 @@ func checkIndexType(idx *ast.CXArgument) {
 	}
 }
 
+// checkArrayIndexes throws an error if a constant index of `arg` is out of
+// the bounds of the array it indexes.
+func checkArrayIndexes(arg *ast.CXArgument) {
+	var idxCounter int
+	for _, deref := range arg.DereferenceOperations {
+		switch deref {
+		case constants.DEREF_ARRAY:
+			if idxCounter >= len(arg.Indexes) {
+				return
+			}
+			idx := arg.Indexes[idxCounter]
+			if idx.ArgDetails.Name == "" && idxCounter < len(arg.Lengths) && arg.Lengths[idxCounter] > 0 {
+				// then it's a literal
+				value := constantFromBytes(idx.Type, AST.Memory[idx.Offset:idx.Offset+idx.Size])
+				if value.Int < 0 || value.Int >= int64(arg.Lengths[idxCounter]) {
+					println(ast.CompilationError(idx.ArgDetails.FileName, idx.ArgDetails.FileLine), fmt.Sprintf("invalid array index %d (out of bounds for %d-element array)", value.Int, arg.Lengths[idxCounter]))
+				}
+			}
+			idxCounter++
+		case constants.DEREF_SLICE, constants.DEREF_MAP, constants.DEREF_MAP_ASSIGN:
+			idxCounter++
+		}
+	}
+}
+
+// ProcessMapElementAssignment gives their type to the outputs adopting the
+// type of a map element, such as the variables declared by `v := m[k]` or
+// `v, ok := m[k]`, or the temporary variable holding `m[k] + 1`. This type is
+// only known once the map `m` was processed, and it must be set before the
+// outputs are given an offset. `prevExprs` are the expressions preceding
+// `expr`, which hold the declarations of the variables.
+func ProcessMapElementAssignment(prevExprs []*ast.CXExpression, expr *ast.CXExpression, offset *int) {
+	if expr.Operator == nil || len(expr.Inputs) == 0 || len(expr.Outputs) == 0 {
+		return
+	}
+
+	mapInp := expr.Inputs[0]
+	switch {
+	case expr.Operator == ast.Natives[ast.OpCodes["map.lookup"]]:
+		if !mapInp.IsMap || ast.IsMapElement(mapInp) {
+			println(ast.CompilationError(expr.FileName, expr.FileLine), fmt.Sprintf("'%s' is not a map", mapInp.ArgDetails.Name))
+			return
+		}
+	case expr.Operator.OpCode == constants.OP_IDENTITY || ast.IsArithmeticOperator(expr.Operator.OpCode):
+		if !mapInp.IsMap || !ast.IsMapElement(mapInp) {
+			return
+		}
+	default:
+		return
+	}
+
+	out := expr.Outputs[0]
+	if IsTempVar(out.ArgDetails.Name) {
+		out.Type = mapInp.Type
+		out.Size = mapInp.Size
+		out.TotalSize = mapInp.Size
+		return
+	}
+
+	if !out.IsShortAssignmentDeclaration {
+		return
+	}
+
+	if sym := shortDeclarationOf(prevExprs, out); sym != nil {
+		// The declaration was given an offset before knowing its size,
+		// so it is given a new one.
+		sym.Type = mapInp.Type
+		sym.Enum = mapInp.Enum
+		sym.Named = mapInp.Named
+		sym.Size = mapInp.Size
+		sym.TotalSize = mapInp.Size
+		sym.Offset = *offset
+		*offset += sym.Size
+	}
+}
+
+// ProcessCustomTypeShortDeclaration gives their type to the variables
+// declared by short variable declarations of struct instances and
+// interfaces, e.g. `v := x`, which is only known once `x` was processed.
+// `prevExprs` are the expressions preceding `expr`, which hold the
+// declarations of the variables.
+func ProcessCustomTypeShortDeclaration(prevExprs []*ast.CXExpression, expr *ast.CXExpression, offset *int) {
+	if expr.Operator == nil || expr.Operator.OpCode != constants.OP_IDENTITY ||
+		len(expr.Inputs) != 1 || len(expr.Outputs) != 1 || !expr.Outputs[0].IsShortAssignmentDeclaration {
+		return
+	}
+
+	inp := expr.Inputs[0]
+	elt := ast.GetAssignmentElement(inp)
+	if elt.CustomType == nil || inp.PassBy == constants.PASSBY_REFERENCE || ast.GetFormattedType(inp) != elt.CustomType.Name {
+		return
+	}
+
+	if sym := shortDeclarationOf(prevExprs, expr.Outputs[0]); sym != nil {
+		// The declaration was given an offset before knowing its size,
+		// so it is given a new one.
+		sym.Type = elt.Type
+		sym.CustomType = elt.CustomType
+		sym.Size = elt.CustomType.Size
+		sym.TotalSize = elt.CustomType.Size
+		sym.DeclarationSpecifiers = []int{constants.DECL_STRUCT}
+		sym.Offset = *offset
+		*offset += sym.Size
+	}
+}
+
+// shortDeclarationOf returns the variable declared by the short variable
+// declaration of `out` among `prevExprs`, or nil if there's none.
+func shortDeclarationOf(prevExprs []*ast.CXExpression, out *ast.CXArgument) *ast.CXArgument {
+	for c := len(prevExprs) - 1; c >= 0; c-- {
+		decl := prevExprs[c]
+		if decl.Operator != nil || len(decl.Inputs) > 0 || len(decl.Outputs) != 1 ||
+			decl.Outputs[0].ArgDetails.Name != out.ArgDetails.Name || !decl.Outputs[0].IsShortAssignmentDeclaration {
+			continue
+		}
+		return decl.Outputs[0]
+	}
+	return nil
+}
+
+// ProcessInterfaceConversion checks the conversions of struct instances to
+// interfaces, which happen when they're assigned or appended to interfaces,
+// and the type assertions of interfaces. Assignments are converted to
+// `interface.box`, which boxes the instance in an interface value. Struct
+// instances passed as interface arguments are checked by
+// `checkMatchParamTypes` and boxed by the call.
+func ProcessInterfaceConversion(expr *ast.CXExpression) {
+	if expr.Operator == nil || !expr.Operator.IsBuiltin || len(expr.Inputs) == 0 {
+		return
+	}
+
+	switch {
+	case expr.Operator.OpCode == constants.OP_IDENTITY:
+		if len(expr.Inputs) != 1 || len(expr.Outputs) != 1 {
+			return
+		}
+		if iface := interfaceConversion(expr.Outputs[0], expr.Inputs[0]); iface != nil {
+			checkImplements(expr.Inputs[0], iface)
+			expr.Operator = ast.Natives[ast.OpCodes["interface.box"]]
+			// `s = &r` marks `s` as passed by reference, but the
+			// interface holds the reference
+			expr.Outputs[0].PassBy = constants.PASSBY_VALUE
+		}
+	case expr.Operator.OpCode == constants.OP_APPEND:
+		slc := ast.GetAssignmentElement(expr.Inputs[0])
+		if slc.Type != constants.TYPE_INTERFACE || ast.GetFormattedType(expr.Inputs[0]) != "[]"+slc.CustomType.Name {
+			return
+		}
+		for _, inp := range expr.Inputs[1:] {
+			if elt := ast.GetAssignmentElement(inp); elt.CustomType != nil && elt.CustomType != slc.CustomType {
+				checkImplements(inp, slc.CustomType)
+			}
+		}
+	case isTypeAssertion(expr):
+		x := ast.GetAssignmentElement(expr.Inputs[0])
+		if x.Type != constants.TYPE_INTERFACE || ast.GetFormattedType(expr.Inputs[0]) != x.CustomType.Name {
+			println(ast.CompilationError(expr.FileName, expr.FileLine), fmt.Sprintf("invalid type assertion: '%s' is of non-interface type '%s'", x.ArgDetails.Name, ast.GetFormattedType(expr.Inputs[0])))
+			return
+		}
+
+		typ := expr.Operator.Outputs[0]
+		name := ast.GetFormattedType(typ)
+		if typ.CustomType == nil || (name != typ.CustomType.Name && name != "*"+typ.CustomType.Name) ||
+			(typ.Type == constants.TYPE_INTERFACE && name != typ.CustomType.Name) {
+			println(ast.CompilationError(expr.FileName, expr.FileLine), fmt.Sprintf("invalid type assertion: '%s' is not a struct or an interface type", name))
+			return
+		}
+		if x.CustomType.IsUnion() {
+			if typ.Type != constants.TYPE_INTERFACE && (name != typ.CustomType.Name || ast.Implements(typ.CustomType, x.CustomType) != nil) {
+				println(ast.CompilationError(expr.FileName, expr.FileLine), fmt.Sprintf("impossible type assertion: '%s' is not a variant of union '%s'", name, x.CustomType.Name))
+			}
+			checkExhaustiveTypeSwitch(expr, x.CustomType)
+		} else if typ.Type != constants.TYPE_INTERFACE {
+			if err := ast.Implements(typ.CustomType, x.CustomType); err != nil {
+				println(ast.CompilationError(expr.FileName, expr.FileLine), fmt.Sprintf("impossible type assertion: '%s' does not implement '%s' (%s)", name, x.CustomType.Name, err))
+			}
+		}
+	}
+}
+
+// interfaceConversion returns the interface `from` is converted to when it's
+// assigned to `to`, or nil if `to` isn't an interface or `from` isn't a
+// struct instance, a reference to it, or an interface of another type.
+func interfaceConversion(to, from *ast.CXArgument) *ast.CXStruct {
+	toElt, fromElt := ast.GetAssignmentElement(to), ast.GetAssignmentElement(from)
+	if toElt.Type != constants.TYPE_INTERFACE || fromElt.CustomType == nil || fromElt.CustomType == toElt.CustomType ||
+		ast.GetFormattedType(to) != toElt.CustomType.Name {
+		return nil
+	}
+	if typ := ast.GetFormattedType(from); typ != fromElt.CustomType.Name && typ != "*"+fromElt.CustomType.Name {
+		return nil
+	}
+	return toElt.CustomType
+}
+
+// checkImplements throws an error if the type of `from`, which is converted
+// to the interface `iface`, doesn't implement it, or if it isn't one of the
+// variants of `iface` if it's a union.
+func checkImplements(from *ast.CXArgument, iface *ast.CXStruct) {
+	strct := ast.GetAssignmentElement(from).CustomType
+	if iface.IsUnion() {
+		// unions hold instances of their variants, not references to them
+		if typ := ast.GetFormattedType(from); typ != strct.Name || ast.Implements(strct, iface) != nil {
+			println(ast.CompilationError(from.ArgDetails.FileName, from.ArgDetails.FileLine), fmt.Sprintf("cannot use '%s' as union '%s' (not a variant)", typ, iface.Name))
+		}
+		return
+	}
+	if err := ast.Implements(strct, iface); err != nil {
+		println(ast.CompilationError(from.ArgDetails.FileName, from.ArgDetails.FileLine), fmt.Sprintf("'%s' does not implement '%s' (%s)", strct.Name, iface.Name, err))
+	}
+}
+
+// processValueComparison checks the comparison `expr` of struct instances or
+// arrays, and makes it call the natives comparing them.
+func processValueComparison(expr *ast.CXExpression) {
+	typ := ast.GetFormattedType(expr.Inputs[0])
+	if len(expr.Inputs) > 1 {
+		if typ2 := ast.GetFormattedType(expr.Inputs[1]); typ != typ2 {
+			println(ast.CompilationError(expr.FileName, expr.FileLine), fmt.Sprintf("mismatched types '%s' and '%s'", typ, typ2))
+			return
+		}
+	}
+
+	switch expr.Operator.OpCode {
+	case constants.OP_EQUAL, constants.OP_UNEQUAL:
+	default:
+		println(ast.CompilationError(expr.FileName, expr.FileLine), fmt.Sprintf("invalid operation: '%s' values can only be compared with '==' and '!='", typ))
+		return
+	}
+	if !ast.IsComparable(expr.Inputs[0]) {
+		println(ast.CompilationError(expr.FileName, expr.FileLine), fmt.Sprintf("invalid operation: '%s' values can't be compared", typ))
+		return
+	}
+
+	if expr.Operator.OpCode == constants.OP_EQUAL {
+		expr.Operator = ast.Natives[ast.OpCodes["value.eq"]]
+	} else {
+		expr.Operator = ast.Natives[ast.OpCodes["value.uneq"]]
+	}
+}
+
+// isNilLiteral checks if `arg` is the literal `nil`.
+func isNilLiteral(arg *ast.CXArgument) bool {
+	return arg.Type == constants.TYPE_ERROR && arg.ArgDetails.Name == "" && len(arg.DeclarationSpecifiers) <= 1
+}
+
+// isInterfaceValue checks if `arg` is an interface value, and not a slice,
+// an array or a reference of interfaces.
+func isInterfaceValue(arg *ast.CXArgument) bool {
+	elt := ast.GetAssignmentElement(arg)
+	return elt.Type == constants.TYPE_INTERFACE && elt.CustomType != nil && ast.GetFormattedType(arg) == elt.CustomType.Name
+}
+
+// isInterfaceNilComparison checks if `expr` compares an interface value
+// with `nil`.
+func isInterfaceNilComparison(expr *ast.CXExpression) bool {
+	if len(expr.Inputs) != 2 {
+		return false
+	}
+	return isInterfaceValue(expr.Inputs[0]) && isNilLiteral(expr.Inputs[1]) ||
+		isNilLiteral(expr.Inputs[0]) && isInterfaceValue(expr.Inputs[1])
+}
+
+// processInterfaceNilComparison makes the comparison `expr` of an interface
+// value with `nil` call the natives checking if the interface holds a value.
+func processInterfaceNilComparison(expr *ast.CXExpression) {
+	if isNilLiteral(expr.Inputs[0]) {
+		expr.Inputs[0], expr.Inputs[1] = expr.Inputs[1], expr.Inputs[0]
+	}
+
+	switch expr.Operator.OpCode {
+	case constants.OP_EQUAL:
+		expr.Operator = ast.Natives[ast.OpCodes["interface.isnil"]]
+	case constants.OP_UNEQUAL:
+		expr.Operator = ast.Natives[ast.OpCodes["interface.notnil"]]
+	default:
+		println(ast.CompilationError(expr.FileName, expr.FileLine), fmt.Sprintf("invalid operation: '%s' values can only be compared to nil with '==' and '!='", ast.GetFormattedType(expr.Inputs[0])))
+	}
+}
+
+// checkMapKeyType checks if `idx` can be used as a key of the map `arg`.
+// Literals can be used as keys of named types with their underlying types.
+func checkMapKeyType(arg *ast.CXArgument, idx *ast.CXArgument) {
+	typ := ast.GetFormattedType(idx)
+	key := mapKeySpecifier(arg)
+	if isUntypedLiteral(idx) && key.Named != nil && typ == ast.GetFormattedBasicType(key) {
+		return
+	}
+	if keyTyp := ast.GetFormattedType(key); typ != keyTyp {
+		println(ast.CompilationError(idx.ArgDetails.FileName, idx.ArgDetails.FileLine), fmt.Sprintf("wrong map key type; expected '%s', got '%s'", keyTyp, typ))
+	}
+}
+
 // ProcessExpressionArguments performs a series of checks and processes to an expresion's inputs and outputs.
 // Some of these checks are: checking if a an input has not been declared, assign a relative offset to the argument,
 // and calculate the correct size of the argument.
@@ -371,17 +815,40 @@ func ProcessExpressionArguments(symbols *[]map[string]*ast.CXArgument, symbolsSc
 
 		ProcessSlice(arg)
 
-		for _, idx := range arg.Indexes {
+		if !isInput {
+			// Assigning to a map element, or to one of its fields or
+			// elements, inserts its key if missing.
+			for _, elt := range append([]*ast.CXArgument{arg}, arg.Fields...) {
+				for i, deref := range elt.DereferenceOperations {
+					if deref == constants.DEREF_MAP {
+						elt.DereferenceOperations[i] = constants.DEREF_MAP_ASSIGN
+					}
+				}
+			}
+		}
+
+		for i, idx := range arg.Indexes {
 			UpdateSymbolsTable(symbols, idx, offset, true)
 			GiveOffset(symbols, idx, offset, true)
-			checkIndexType(idx)
+			if arg.IsMap && i == 0 {
+				checkMapKeyType(arg, idx)
+			} else {
+				checkIndexType(idx)
+			}
 		}
 		for _, fld := range arg.Fields {
-			for _, idx := range fld.Indexes {
+			for i, idx := range fld.Indexes {
 				UpdateSymbolsTable(symbols, idx, offset, true)
 				GiveOffset(symbols, idx, offset, true)
+				if fld.IsMap && i == 0 {
+					checkMapKeyType(fld, idx)
+				}
 			}
 		}
+		checkArrayIndexes(arg)
+		for _, fld := range arg.Fields {
+			checkArrayIndexes(fld)
+		}
 
 		SetFinalSize(symbols, arg)
 
@@ -389,6 +856,28 @@ func ProcessExpressionArguments(symbols *[]map[string]*ast.CXArgument, symbolsSc
 	}
 }
 
+// mapOfElement returns a copy of `sym`, an element of a map or of a map
+// struct field, that refers to the map itself.
+func mapOfElement(sym *ast.CXArgument) *ast.CXArgument {
+	tmp := ast.CXArgument{}
+	copier.Copy(&tmp, sym)
+	elt := &tmp
+	if len(sym.Fields) > 0 {
+		fld := ast.CXArgument{}
+		copier.Copy(&fld, sym.Fields[len(sym.Fields)-1])
+		tmp.Fields = append([]*ast.CXArgument{}, sym.Fields...)
+		tmp.Fields[len(tmp.Fields)-1] = &fld
+		elt = &fld
+	}
+
+	elt.Indexes = nil
+	elt.DereferenceOperations = nil
+	elt.DeclarationSpecifiers = append(append([]int{}, elt.MapValue.DeclarationSpecifiers...), constants.DECL_MAP)
+	elt.Type = elt.MapValue.Type
+	elt.IsSlice = false
+	return &tmp
+}
+
 // isPointerAdded checks if `sym` has already been added to `fn.ListOfPointers`.
 func isPointerAdded(fn *ast.CXFunction, sym *ast.CXArgument) (found bool) {
 	for _, ptr := range fn.ListOfPointers {
@@ -427,6 +916,12 @@ func AddPointer(fn *ast.CXFunction, sym *ast.CXArgument) {
 	// If `sym` has no fields, then we check if `sym` is a pointer and
 	// we add it if it is.
 
+	// Map element:
+	// The map itself is added instead, so the garbage collector can
+	// reach the objects referenced by its entries.
+	if elt := ast.GetAssignmentElement(sym); elt.IsMap && ast.IsMapElement(elt) {
+		sym = mapOfElement(sym)
+	}
 	// Field symbol:
 	// Checking if it is a pointer candidate and if it was already
 	// added to the list.
@@ -490,9 +985,31 @@ func ProcessGoTos(fn *ast.CXFunction, exprs []*ast.CXExpression) {
 
 func checkMatchParamTypes(expr *ast.CXExpression, expected, received []*ast.CXArgument, isInputs bool) {
 	for i, inp := range expected {
+		if isInputs && !expr.Operator.IsBuiltin {
+			if iface := interfaceConversion(expected[i], received[i]); iface != nil {
+				// struct instances are converted to the interfaces they implement
+				checkImplements(received[i], iface)
+				continue
+			}
+		}
+
 		expectedType := ast.GetFormattedType(expected[i])
 		receivedType := ast.GetFormattedType(received[i])
 
+		if expr.Operator.IsBuiltin && ast.GetAssignmentElement(expected[i]).Enum == nil && ast.GetAssignmentElement(expected[i]).Named == nil &&
+			(ast.GetAssignmentElement(received[i]).Enum == nil || isTypeConversion(expr)) {
+			// natives accept values of named types where their underlying
+			// type is expected, e.g. `f64.sqrt(t)`, but enum values need an
+			// explicit conversion, e.g. `i32.str(i32(c))`
+			receivedType = ast.GetFormattedBasicType(received[i])
+		}
+
+		if isUntypedLiteral(received[i]) && ast.GetFormattedBasicType(expected[i]) == receivedType {
+			// literals can be used as values of named types, e.g.
+			// `setTemp(36.6)` where a `Celsius` is expected
+			receivedType = expectedType
+		}
+
 		if expr.IsMethodCall() && expected[i].IsPointer && i == 0 {
 			// if method receiver is pointer, remove *
 			if expectedType[0] == '*' {
@@ -510,7 +1027,9 @@ func checkMatchParamTypes(expr *ast.CXExpression, expected, received []*ast.CXAr
 				opName = expr.Operator.Name
 			}
 
-			if isInputs {
+			if isInputs && isTypeConversion(expr) {
+				println(ast.CompilationError(received[i].ArgDetails.FileName, received[i].ArgDetails.FileLine), fmt.Sprintf("cannot convert value of type '%s' to type '%s'", ast.GetFormattedType(received[i]), ast.GetFormattedType(expr.Operator.Outputs[0])))
+			} else if isInputs {
 				println(ast.CompilationError(received[i].ArgDetails.FileName, received[i].ArgDetails.FileLine), fmt.Sprintf("function '%s' expected input argument of type '%s'; '%s' was provided", opName, expectedType, receivedType))
 			} else {
 				println(ast.CompilationError(expr.Outputs[i].ArgDetails.FileName, expr.Outputs[i].ArgDetails.FileLine), fmt.Sprintf("function '%s' expected receiving variable of type '%s'; '%s' was provided", opName, expectedType, receivedType))
@@ -528,6 +1047,10 @@ func checkMatchParamTypes(expr *ast.CXExpression, expected, received []*ast.CXAr
 
 			// We use `isInputs` to only print the error once.
 			// Otherwise we'd print the error twice: once for the input and again for the output
+			if inpType != outType && isUntypedLiteral(expr.Inputs[0]) && ast.GetFormattedBasicType(expr.Outputs[0]) == inpType {
+				inpType = outType
+			}
+
 			if inpType != outType && isInputs {
 				println(ast.CompilationError(received[i].ArgDetails.FileName, received[i].ArgDetails.FileLine), fmt.Sprintf("cannot assign value of type '%s' to identifier '%s' of type '%s'", inpType, ast.GetAssignmentElement(expr.Outputs[0]).ArgDetails.Name, outType))
 			}
@@ -562,7 +1085,8 @@ func CheckTypes(expr *ast.CXExpression) {
 		}
 
 		// checking if number of expr.ProgramOutput matches number of Operator.ProgramOutput
-		if len(expr.Outputs) != len(expr.Operator.Outputs) {
+		// the outputs of deferred calls are discarded
+		if len(expr.Outputs) != len(expr.Operator.Outputs) && !deferredCalls[expr] {
 			var plural1 string
 			var plural2 string = "s"
 			var plural3 string = "were"
@@ -616,7 +1140,48 @@ func CheckTypes(expr *ast.CXExpression) {
 		checkMatchParamTypes(expr, expr.Operator.Inputs, expr.Inputs, true)
 
 		// checking outputs matching operator's outputs
-		checkMatchParamTypes(expr, expr.Operator.Outputs, expr.Outputs, false)
+		if !deferredCalls[expr] {
+			checkMatchParamTypes(expr, expr.Operator.Outputs, expr.Outputs, false)
+		}
+
+		checkEnumArithmetic(expr)
+	}
+}
+
+// enumOperand returns the first operand of the arithmetic operation `expr`
+// holding an enum value, as the result is of its type, or nil if there's no
+// such operand.
+func enumOperand(expr *ast.CXExpression) *ast.CXArgument {
+	if expr.Operator == nil || !ast.IsArithmeticOperator(expr.Operator.OpCode) {
+		return nil
+	}
+	for _, inp := range expr.Inputs {
+		if ast.GetAssignmentElement(inp).Enum != nil {
+			return inp
+		}
+	}
+	return nil
+}
+
+// checkEnumArithmetic checks that the result of the arithmetic operation
+// `expr` on enum values, e.g. `c + 1`, is received by a variable of the
+// enum type.
+func checkEnumArithmetic(expr *ast.CXExpression) {
+	operand := enumOperand(expr)
+	if operand == nil {
+		return
+	}
+
+	typ := ast.GetFormattedType(operand)
+	for _, out := range expr.Outputs {
+		if IsTempVar(out.ArgDetails.Name) && out.Enum == nil {
+			// then the result is passed to another expression
+			out.Enum = ast.GetAssignmentElement(operand).Enum
+			continue
+		}
+		if outTyp := ast.GetFormattedType(out); outTyp != typ {
+			println(ast.CompilationError(out.ArgDetails.FileName, out.ArgDetails.FileLine), fmt.Sprintf("cannot assign value of type '%s' to identifier '%s' of type '%s'", typ, ast.GetAssignmentElement(out).ArgDetails.Name, outTyp))
+		}
 	}
 }
 
@@ -643,7 +1208,7 @@ func ProcessReferenceAssignment(expr *ast.CXExpression) {
 		elt := ast.GetAssignmentElement(out)
 		if elt.PassBy == constants.PASSBY_REFERENCE &&
 			!hasDeclSpec(elt, constants.DECL_POINTER) &&
-			elt.Type != constants.TYPE_STR && !elt.IsSlice {
+			elt.Type != constants.TYPE_STR && elt.Type != constants.TYPE_INTERFACE && !elt.IsSlice {
 			println(ast.CompilationError(CurrentFile, LineNo), "invalid reference assignment", elt.ArgDetails.Name)
 		}
 	}
@@ -678,7 +1243,7 @@ func ProcessSliceAssignment(expr *ast.CXExpression) {
 		inp = ast.GetAssignmentElement(expr.Inputs[0])
 		out = ast.GetAssignmentElement(expr.Outputs[0])
 
-		if inp.IsSlice && out.IsSlice && len(inp.Indexes) == 0 && len(out.Indexes) == 0 {
+		if isWholeSlice(inp) && isWholeSlice(out) {
 			out.PassBy = constants.PASSBY_VALUE
 		}
 	}
@@ -689,13 +1254,23 @@ func ProcessSliceAssignment(expr *ast.CXExpression) {
 
 			// we want to pass by value if we're sending the slice as a whole (no indexing)
 			// unless it's a pointer to the slice
-			if assignElt.IsSlice && len(assignElt.Indexes) == 0 && !hasDeclSpec(assignElt, constants.DECL_POINTER) {
+			if isWholeSlice(assignElt) && !hasDeclSpec(assignElt, constants.DECL_POINTER) {
 				assignElt.PassBy = constants.PASSBY_VALUE
 			}
 		}
 	}
 }
 
+// isWholeSlice checks if `elt` is a slice that isn't indexed. The key of a
+// map element holding a slice doesn't index the slice.
+func isWholeSlice(elt *ast.CXArgument) bool {
+	indexes := len(elt.Indexes)
+	if ast.IsMapElement(elt) {
+		indexes--
+	}
+	return elt.IsSlice && indexes == 0
+}
+
 // lookupSymbol searches for `ident` in `symbols`, starting from the innermost scope.
 func lookupSymbol(pkgName, ident string, symbols *[]map[string]*ast.CXArgument) (*ast.CXArgument, error) {
 	fullName := pkgName + "." + ident
@@ -718,12 +1293,9 @@ func lookupSymbol(pkgName, ident string, symbols *[]map[string]*ast.CXArgument)
 	if err != nil {
 		return nil, notFound
 	}
-	// Then we found a function by that name. Let's create a `cxcore.CXArgument` of
-	// type `func` with that name.
-	fnArg := ast.MakeArgument(ident, fn.FileName, fn.FileLine).AddType(constants.TypeNames[constants.TYPE_FUNC])
-	fnArg.ArgDetails.Package = pkg
-
-	return fnArg, nil
+	// Then we found a function by that name, and `ident` is its function
+	// value.
+	return functionValue(pkg, fn), nil
 }
 
 // UpdateSymbolsTable adds `sym` to the innermost scope (last element of slice) in `symbols`.
@@ -785,12 +1357,12 @@ func ProcessMethodCall(expr *ast.CXExpression, symbols *[]map[string]*ast.CXArgu
 				}
 				// then we found an output
 				if len(out.Fields) > 0 {
-					strct := argOut.CustomType
-
-					if fn, err := strct.Package.GetMethod(strct.Name+"."+out.Fields[len(out.Fields)-1].ArgDetails.Name, strct.Name); err == nil {
+					if fn := namedTypeMethod(argOut, out.Fields); fn != nil {
 						expr.Operator = fn
 					} else {
-						panic("")
+						strct := argOut.CustomType
+						out.Fields = promoteFields(strct, out.Fields)
+						expr.Operator = fieldMethod(strct, out.Fields)
 					}
 
 					expr.Inputs = append([]*ast.CXArgument{out}, expr.Inputs...)
@@ -804,18 +1376,13 @@ func ProcessMethodCall(expr *ast.CXExpression, symbols *[]map[string]*ast.CXArgu
 				if len(inp.Fields) > 0 {
 					strct := argInp.CustomType
 
-					for _, fld := range inp.Fields {
-						if inFld, err := strct.GetField(fld.ArgDetails.Name); err == nil {
-							if inFld.CustomType != nil {
-								strct = inFld.CustomType
-							}
-						}
-					}
-
-					if fn, err := strct.Package.GetMethod(strct.Name+"."+inp.Fields[len(inp.Fields)-1].ArgDetails.Name, strct.Name); err == nil {
+					if fn := namedTypeMethod(argInp, inp.Fields); fn != nil {
 						expr.Operator = fn
+					} else if strct == nil {
+						expr.Operator = basicTypeMethod(argInp, inp.Fields[len(inp.Fields)-1].ArgDetails.Name)
 					} else {
-						panic(err)
+						inp.Fields = promoteFields(strct, inp.Fields)
+						expr.Operator = fieldMethod(strct, inp.Fields)
 					}
 
 					inp.Fields = inp.Fields[:len(inp.Fields)-1]
@@ -826,20 +1393,21 @@ func ProcessMethodCall(expr *ast.CXExpression, symbols *[]map[string]*ast.CXArgu
 					}
 
 					strct := argOut.CustomType
-
-					if strct == nil {
-						println(ast.CompilationError(argOut.ArgDetails.FileName, argOut.ArgDetails.FileLine), fmt.Sprintf("illegal method call or field access on identifier '%s' of primitive type '%s'", argOut.ArgDetails.Name, constants.TypeNames[argOut.Type]))
-						os.Exit(constants.CX_COMPILATION_ERROR)
+					namedFn := namedTypeMethod(argOut, out.Fields)
+					if namedFn == nil && strct != nil {
+						out.Fields = promoteFields(strct, out.Fields)
 					}
 
 					expr.Inputs = append(expr.Outputs[:1], expr.Inputs...)
 
 					expr.Outputs = expr.Outputs[:len(expr.Outputs)-1]
 
-					if fn, err := strct.Package.GetMethod(strct.Name+"."+out.Fields[len(out.Fields)-1].ArgDetails.Name, strct.Name); err == nil {
-						expr.Operator = fn
+					if namedFn != nil {
+						expr.Operator = namedFn
+					} else if strct == nil {
+						expr.Operator = basicTypeMethod(argOut, out.Fields[len(out.Fields)-1].ArgDetails.Name)
 					} else {
-						panic(err)
+						expr.Operator = fieldMethod(strct, out.Fields)
 					}
 
 					out.Fields = out.Fields[:len(out.Fields)-1]
@@ -859,16 +1427,17 @@ func ProcessMethodCall(expr *ast.CXExpression, symbols *[]map[string]*ast.CXArgu
 			// then we found an output
 			if len(out.Fields) > 0 {
 				strct := argOut.CustomType
-
-				if strct == nil {
-					println(ast.CompilationError(argOut.ArgDetails.FileName, argOut.ArgDetails.FileLine), fmt.Sprintf("illegal method call or field access on identifier '%s' of primitive type '%s'", argOut.ArgDetails.Name, constants.TypeNames[argOut.Type]))
-					os.Exit(constants.CX_COMPILATION_ERROR)
+				namedFn := namedTypeMethod(argOut, out.Fields)
+				if namedFn == nil && strct != nil {
+					out.Fields = promoteFields(strct, out.Fields)
 				}
 
-				if fn, err := strct.Package.GetMethod(strct.Name+"."+out.Fields[len(out.Fields)-1].ArgDetails.Name, strct.Name); err == nil {
-					expr.Operator = fn
+				if namedFn != nil {
+					expr.Operator = namedFn
+				} else if strct == nil {
+					expr.Operator = basicTypeMethod(argOut, out.Fields[len(out.Fields)-1].ArgDetails.Name)
 				} else {
-					panic("")
+					expr.Operator = fieldMethod(strct, out.Fields)
 				}
 
 				expr.Inputs = append([]*ast.CXArgument{out}, expr.Inputs...)
@@ -881,12 +1450,37 @@ func ProcessMethodCall(expr *ast.CXExpression, symbols *[]map[string]*ast.CXArgu
 		}
 
 		// checking if receiver is sent as pointer or not
-		if expr.Operator.Inputs[0].IsPointer {
+		if specs := expr.Operator.Inputs[0].DeclarationSpecifiers; expr.Operator.Inputs[0].IsPointer &&
+			(len(specs) == 0 || specs[len(specs)-1] == constants.DECL_POINTER) {
 			expr.Inputs[0].PassBy = constants.PASSBY_REFERENCE
 		}
 	}
 }
 
+// basicTypeMethod returns the native called by calling the method `name` on
+// `arg`, of a basic type. Only errors have methods, which are the natives
+// named `error.name`, e.g. `err.Error()` calls `error.Error(err)`.
+func basicTypeMethod(arg *ast.CXArgument, name string) *ast.CXFunction {
+	if fn := lookupBasicTypeMethod(arg, name); fn != nil {
+		return fn
+	}
+	println(ast.CompilationError(arg.ArgDetails.FileName, arg.ArgDetails.FileLine), fmt.Sprintf("illegal method call or field access on identifier '%s' of primitive type '%s'", arg.ArgDetails.Name, constants.TypeNames[arg.Type]))
+	os.Exit(constants.CX_COMPILATION_ERROR)
+	return nil
+}
+
+// lookupBasicTypeMethod is like basicTypeMethod, but returns nil if there's
+// no such method.
+func lookupBasicTypeMethod(arg *ast.CXArgument, name string) *ast.CXFunction {
+	if fn := namedTypeMethod(arg, []*ast.CXArgument{ast.MakeArgument(name, "", 0)}); fn != nil {
+		return fn
+	}
+	if opCode, ok := ast.OpCodes["error."+name]; ok && arg.Type == constants.TYPE_ERROR && len(arg.DeclarationSpecifiers) <= 1 {
+		return ast.Natives[opCode]
+	}
+	return nil
+}
+
 func GiveOffset(symbols *[]map[string]*ast.CXArgument, sym *ast.CXArgument, offset *int, shouldExist bool) {
 	if sym.ArgDetails.Name != "" {
 		if !sym.IsLocalDeclaration {
@@ -911,6 +1505,7 @@ func ProcessTempVariable(expr *ast.CXExpression) {
 			arg.Size = expr.Inputs[0].Size
 			arg.TotalSize = expr.Inputs[0].TotalSize
 			arg.PreviouslyDeclared = true
+			adoptNamedType(arg, ast.GetAssignmentElement(expr.Inputs[0]))
 		}
 	}
 }
@@ -929,7 +1524,7 @@ func CopyArgFields(sym *ast.CXArgument, arg *ast.CXArgument) {
 			for c := 0; c < len(elt.DeclarationSpecifiers); c++ {
 				switch elt.DeclarationSpecifiers[c] {
 				case constants.DECL_INDEXING:
-					if declSpec[len(declSpec)-1] == constants.DECL_ARRAY || declSpec[len(declSpec)-1] == constants.DECL_SLICE {
+					if declSpec[len(declSpec)-1] == constants.DECL_ARRAY || declSpec[len(declSpec)-1] == constants.DECL_SLICE || declSpec[len(declSpec)-1] == constants.DECL_MAP {
 						declSpec = declSpec[:len(declSpec)-1]
 					} else {
 						println(ast.CompilationError(sym.ArgDetails.FileName, sym.ArgDetails.FileLine), "invalid indexing")
@@ -958,7 +1553,7 @@ func CopyArgFields(sym *ast.CXArgument, arg *ast.CXArgument) {
 				// also we could be removing
 				switch spec {
 				case constants.DECL_INDEXING:
-					if declSpec[len(declSpec)-1] == constants.DECL_ARRAY || declSpec[len(declSpec)-1] == constants.DECL_SLICE {
+					if declSpec[len(declSpec)-1] == constants.DECL_ARRAY || declSpec[len(declSpec)-1] == constants.DECL_SLICE || declSpec[len(declSpec)-1] == constants.DECL_MAP {
 						declSpec = declSpec[:len(declSpec)-1]
 					} else {
 						println(ast.CompilationError(sym.ArgDetails.FileName, sym.ArgDetails.FileLine), "invalid indexing")
@@ -985,7 +1580,21 @@ func CopyArgFields(sym *ast.CXArgument, arg *ast.CXArgument) {
 	}
 
 	sym.IsSlice = arg.IsSlice
+	sym.IsMap = arg.IsMap
+	sym.MapKeyType = arg.MapKeyType
+	sym.MapKey = arg.MapKey
+	sym.MapValue = arg.MapValue
 	sym.CustomType = arg.CustomType
+	sym.Enum = arg.Enum
+	sym.Named = arg.Named
+	if arg.Type == constants.TYPE_FUNC {
+		sym.Inputs = arg.Inputs
+		sym.Outputs = arg.Outputs
+	}
+
+	if arg.IsMap {
+		processMapIndexing(sym)
+	}
 
 	// FIXME: In other processes like ProcessSymbolFields the symbol is assigned with lengths.
 	// If we already have some lengths, we skip this. This needs to be fixed in the redesign of the cxgo.
@@ -1053,15 +1662,68 @@ func CopyArgFields(sym *ast.CXArgument, arg *ast.CXArgument) {
 			sym.TotalSize = arg.TotalSize
 		}
 	}
+	if sym.IsMap && ast.IsMapElement(sym) {
+		if isWholeSlice(sym) {
+			sym.TotalSize = constants.TYPE_POINTER_SIZE
+		} else {
+			sym.TotalSize = sym.Size
+		}
+	}
+}
+
+// processMapIndexing changes the dereferences of `sym`, a map or a map
+// struct field, to a map lookup if it's indexed. Same as with slices, `m[k]`
+// is parsed as an array indexing, and only its first index is a key: the
+// others index the slice held by the map.
+func processMapIndexing(sym *ast.CXArgument) {
+	if !hasDerefOp(sym, constants.DEREF_ARRAY) {
+		return
+	}
+	isKey := true
+	for i, deref := range sym.DereferenceOperations {
+		if deref != constants.DEREF_ARRAY {
+			continue
+		}
+		if isKey {
+			sym.DereferenceOperations[i] = constants.DEREF_MAP
+			isKey = false
+		} else {
+			sym.DereferenceOperations[i] = constants.DEREF_SLICE
+		}
+	}
+	if declSpec := sym.DeclarationSpecifiers; declSpec[len(declSpec)-1] == constants.DECL_MAP {
+		// The declaration specifiers were copied from the map.
+		sym.DeclarationSpecifiers = append([]int{}, declSpec[:len(declSpec)-1]...)
+	}
+	sym.IsSlice = sym.MapValue.IsSlice
+
+	if sym.MapValue.Offset == 0 {
+		// Reading a nil map doesn't allocate it, so it reads a zeroed
+		// value from the data segment.
+		zero := make([]byte, ast.MapValueSize(sym.MapValue))
+		sym.MapValue.Offset = WritePrimary(constants.TYPE_UI8, zero, false)[0].Outputs[0].Offset
+	}
 }
 
 func ProcessSymbolFields(sym *ast.CXArgument, arg *ast.CXArgument) {
 	if len(sym.Fields) > 0 {
+		if arg.CustomType == nil && len(sym.Fields) == 1 {
+			// then it can be a method call on a basic type, such as
+			// `err.Error()`, used as an argument
+			if fn := lookupBasicTypeMethod(arg, sym.Fields[0].ArgDetails.Name); fn != nil {
+				sym.Fields[0].Type = fn.Outputs[0].Type
+				return
+			}
+		}
 		if arg.CustomType == nil || len(arg.CustomType.Fields) == 0 {
 			println(ast.CompilationError(sym.ArgDetails.FileName, sym.ArgDetails.FileLine), fmt.Sprintf("'%s' has no fields", sym.ArgDetails.Name))
 			return
 		}
 
+		// fields and methods promoted from embedded structs are
+		// accessed through the embedded fields
+		sym.Fields = promoteFields(arg.CustomType, sym.Fields)
+
 		// checking if fields do exist in their CustomType
 		// and assigning that CustomType to the sym.Field
 		strct := arg.CustomType
@@ -1102,6 +1764,14 @@ func ProcessSymbolFields(sym *ast.CXArgument, arg *ast.CXArgument) {
 					nameFld.DereferenceLevels = sym.DereferenceLevels
 					nameFld.IsPointer = fld.IsPointer
 					nameFld.CustomType = fld.CustomType
+					nameFld.Enum = fld.Enum
+					nameFld.Named = fld.Named
+					nameFld.IsMap = fld.IsMap
+					nameFld.MapKeyType = fld.MapKeyType
+					nameFld.MapKey = fld.MapKey
+					nameFld.MapValue = fld.MapValue
+					nameFld.Inputs = fld.Inputs
+					nameFld.Outputs = fld.Outputs
 
 					sym.Lengths = fld.Lengths
 
@@ -1119,6 +1789,9 @@ func ProcessSymbolFields(sym *ast.CXArgument, arg *ast.CXArgument) {
 						nameFld.DereferenceOperations = append([]int{constants.DEREF_POINTER}, nameFld.DereferenceOperations...)
 						nameFld.DereferenceLevels++
 					}
+					if fld.IsMap {
+						processMapIndexing(nameFld)
+					}
 
 					nameFld.PassBy = fld.PassBy
 					nameFld.IsSlice = fld.IsSlice
@@ -1158,6 +1831,10 @@ func SetFinalSize(symbols *[]map[string]*ast.CXArgument, sym *ast.CXArgument) {
 
 // GetGlobalSymbol tries to retrieve `ident` from `symPkg`'s globals if `ident` is not found in the local scope.
 func GetGlobalSymbol(symbols *[]map[string]*ast.CXArgument, symPkg *ast.CXPackage, ident string) {
+	if captureSymbol(symbols, symPkg, ident) {
+		// Then it's a local of a function enclosing a function literal.
+		return
+	}
 	_, err := lookupSymbol(symPkg.Name, ident, symbols)
 	if err != nil {
 		if glbl, err := symPkg.GetGlobal(ident); err == nil {
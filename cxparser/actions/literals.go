@@ -60,6 +60,8 @@ func SliceLiteralExpression(typSpec int, exprs []*ast.CXExpression) []*ast.CXExp
 				outArg := getOutputType(expr)
 				out.AddType(constants.TypeNames[outArg.Type])
 				out.CustomType = outArg.CustomType
+				out.Enum = outArg.Enum
+				out.Named = outArg.Named
 				out.Size = outArg.Size
 				out.TotalSize = ast.GetSize(outArg)
 				out.PreviouslyDeclared = true
@@ -110,6 +112,62 @@ func SliceLiteralExpression(typSpec int, exprs []*ast.CXExpression) []*ast.CXExp
 	return result
 }
 
+// MapLiteralExpression handles map literal expressions by converting them to
+// a series of assignments to the elements of a temporary map. `entries` holds
+// the key and value expressions of each entry, one after the other.
+func MapLiteralExpression(keySpec *ast.CXArgument, valueSpec *ast.CXArgument, entries [][]*ast.CXExpression) []*ast.CXExpression {
+	var result []*ast.CXExpression
+
+	pkg, err := AST.GetCurrentPackage()
+	if err != nil {
+		panic(err)
+	}
+
+	if DeclarationSpecifiersMap(keySpec, valueSpec) == nil {
+		return nil
+	}
+
+	makeMapSym := func(name string) *ast.CXArgument {
+		sym := ast.MakeArgument(name, CurrentFile, LineNo).AddType(constants.TypeNames[valueSpec.Type])
+		sym.Enum = valueSpec.Enum
+		sym.Named = valueSpec.Named
+		sym = DeclarationSpecifiersMap(keySpec, sym)
+		sym.ArgDetails.Package = pkg
+		sym.PreviouslyDeclared = true
+		return sym
+	}
+
+	symName := MakeGenSym(constants.LOCAL_PREFIX)
+
+	// adding the declaration
+	mapVarExpr := ast.MakeExpression(nil, CurrentFile, LineNo)
+	mapVarExpr.Package = pkg
+	mapVarExpr.AddOutput(makeMapSym(symName))
+
+	result = append(result, mapVarExpr)
+
+	for c := 0; c+1 < len(entries); c += 2 {
+		sym := ast.MakeArgument(symName, CurrentFile, LineNo).AddType(constants.TypeNames[valueSpec.Type])
+		sym.ArgDetails.Package = pkg
+
+		symExpr := ast.MakeExpression(nil, CurrentFile, LineNo)
+		symExpr.Package = pkg
+		symExpr.AddOutput(sym)
+
+		to := PostfixExpressionArray([]*ast.CXExpression{symExpr}, entries[c])
+		result = append(result, Assignment(to, "=", entries[c+1])...)
+	}
+
+	symExpr := ast.MakeExpression(ast.Natives[constants.OP_IDENTITY], CurrentFile, LineNo)
+	symExpr.Package = pkg
+	symExpr.AddOutput(makeMapSym(MakeGenSym(constants.LOCAL_PREFIX)))
+	symExpr.AddInput(makeMapSym(symName))
+
+	result = append(result, symExpr)
+
+	return result
+}
+
 func PrimaryStructLiteral(ident string, strctFlds []*ast.CXExpression) []*ast.CXExpression {
 	var result []*ast.CXExpression
 
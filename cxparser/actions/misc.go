@@ -225,23 +225,32 @@ func AffordanceStructs(pkg *ast.CXPackage, currentFile string, lineNo int) {
 
 func PrimaryIdentifier(ident string) []*ast.CXExpression {
 	if pkg, err := AST.GetCurrentPackage(); err == nil {
-		arg := ast.MakeArgument(ident, CurrentFile, LineNo) // fix: line numbers in errors sometimes report +1 or -1. Issue #195
-		arg.AddType(constants.TypeNames[constants.TYPE_IDENTIFIER])
-		// arg.Typ = "ident"
-		arg.ArgDetails.Name = ident
-		arg.ArgDetails.Package = pkg
-
-		// expr := &cxcore.CXExpression{ProgramOutput: []*cxcore.CXArgument{arg}}
-		expr := ast.MakeExpression(nil, CurrentFile, LineNo)
-		expr.Outputs = []*ast.CXArgument{arg}
-		expr.Package = pkg
-
-		return []*ast.CXExpression{expr}
+		if exprs, ok := constantLiteral(pkg, ident); ok {
+			return exprs
+		}
+		return identifierExpression(pkg, ident)
 	} else {
 		panic(err)
 	}
 }
 
+// identifierExpression returns an expression reading the variable `ident`
+// of package `pkg`.
+func identifierExpression(pkg *ast.CXPackage, ident string) []*ast.CXExpression {
+	arg := ast.MakeArgument(ident, CurrentFile, LineNo) // fix: line numbers in errors sometimes report +1 or -1. Issue #195
+	arg.AddType(constants.TypeNames[constants.TYPE_IDENTIFIER])
+	// arg.Typ = "ident"
+	arg.ArgDetails.Name = ident
+	arg.ArgDetails.Package = pkg
+
+	// expr := &cxcore.CXExpression{ProgramOutput: []*cxcore.CXArgument{arg}}
+	expr := ast.MakeExpression(nil, CurrentFile, LineNo)
+	expr.Outputs = []*ast.CXArgument{arg}
+	expr.Package = pkg
+
+	return []*ast.CXExpression{expr}
+}
+
 // IsArgBasicType returns true if `arg`'s type is a basic type, false otherwise.
 func IsArgBasicType(arg *ast.CXArgument) bool {
 	switch arg.Type {
@@ -256,7 +265,8 @@ func IsArgBasicType(arg *ast.CXArgument) bool {
 		constants.TYPE_UI8,
 		constants.TYPE_UI16,
 		constants.TYPE_UI32,
-		constants.TYPE_UI64:
+		constants.TYPE_UI64,
+		constants.TYPE_ERROR:
 		return true
 	}
 	return false
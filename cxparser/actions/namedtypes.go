@@ -0,0 +1,95 @@
+package actions
+
+import (
+	"fmt"
+
+	"github.com/skycoin/cx/cx/ast"
+)
+
+// namedTypeMethod returns the method called by accessing the fields `flds`
+// on `arg`, where the last field is the name of the method, if the receiver
+// is of a named type, or a pointer to one, declaring such a method. It
+// returns nil otherwise.
+func namedTypeMethod(arg *ast.CXArgument, flds []*ast.CXArgument) *ast.CXFunction {
+	for _, fld := range flds[:len(flds)-1] {
+		if arg.CustomType == nil {
+			return nil
+		}
+		inFld, err := arg.CustomType.GetField(fld.ArgDetails.Name)
+		if err != nil {
+			return nil
+		}
+		arg = inFld
+	}
+
+	if !ast.IsNamedType(arg, true) {
+		return nil
+	}
+
+	named := arg.Named
+	name := flds[len(flds)-1].ArgDetails.Name
+	if fn, err := named.Package.GetMethod(named.Name+"."+name, named.Name); err == nil {
+		return fn
+	}
+	return nil
+}
+
+// isUntypedLiteral checks if `arg` is a literal of a basic type, such as
+// `36.6` or `"name"`, which can be used as a value of any named type with
+// that underlying type.
+func isUntypedLiteral(arg *ast.CXArgument) bool {
+	return arg.ArgDetails.Name == "" && arg.Named == nil && arg.Enum == nil && arg.CustomType == nil && len(arg.Fields) == 0
+}
+
+// checkSameNamedType checks that the operands `inps` of an operator don't mix
+// values of different named types, or values of a named type and values of
+// its underlying type, other than literals.
+func checkSameNamedType(inps []*ast.CXArgument) error {
+	var named *ast.CXArgument
+	for _, inp := range inps {
+		if isUntypedLiteral(inp) {
+			continue
+		}
+		if named == nil {
+			named = inp
+			continue
+		}
+		if typ1, typ2 := ast.GetFormattedType(named), ast.GetFormattedType(inp); typ1 != typ2 {
+			return fmt.Errorf("mismatched types '%s' and '%s'", typ1, typ2)
+		}
+	}
+	return nil
+}
+
+// typeConversionFunction returns the `type.conv` native with its output typed
+// as `typ`, the type converted to, and its input typed as the underlying
+// type of `typ`, so only values of named types or enums sharing that
+// underlying type can be converted.
+func typeConversionFunction(typ *ast.CXArgument) *ast.CXFunction {
+	inp := ast.MakeArgument("", typ.ArgDetails.FileName, typ.ArgDetails.FileLine)
+	copyParameterType(inp, typ)
+	inp.ArgDetails.Package = typ.ArgDetails.Package
+	inp.Named = nil
+	inp.Enum = nil
+
+	fn := *ast.Natives[ast.OpCodes["type.conv"]]
+	fn.Inputs = []*ast.CXArgument{inp}
+	fn.Outputs = []*ast.CXArgument{typ}
+	return &fn
+}
+
+// isTypeConversion checks if `expr` is a type conversion.
+func isTypeConversion(expr *ast.CXExpression) bool {
+	return expr.Operator != nil && expr.Operator.IsBuiltin && expr.Operator.OpCode == ast.OpCodes["type.conv"]
+}
+
+// adoptNamedType gives the temporary variable `tmp`, holding the value of
+// `arg`, the named type of `arg`, if any, so operations on the temporary
+// variable are checked like operations on `arg`.
+func adoptNamedType(tmp, arg *ast.CXArgument) {
+	if !ast.IsNamedType(arg, false) {
+		return
+	}
+	tmp.Named = arg.Named
+	tmp.DeclarationSpecifiers = append([]int{}, arg.Named.Underlying.DeclarationSpecifiers...)
+}
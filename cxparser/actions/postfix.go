@@ -22,10 +22,16 @@ func PostfixExpressionArray(prevExprs []*ast.CXExpression, postExprs []*ast.CXEx
 
 		out.DeclarationSpecifiers = prevExpr.Operator.Outputs[0].DeclarationSpecifiers
 		out.CustomType = prevExpr.Operator.Outputs[0].CustomType
+		out.Enum = prevExpr.Operator.Outputs[0].Enum
+		out.Named = prevExpr.Operator.Outputs[0].Named
 		out.Size = prevExpr.Operator.Outputs[0].Size
 		out.TotalSize = prevExpr.Operator.Outputs[0].TotalSize
 		out.Lengths = prevExpr.Operator.Outputs[0].Lengths
 		out.IsSlice = prevExpr.Operator.Outputs[0].IsSlice
+		out.IsMap = prevExpr.Operator.Outputs[0].IsMap
+		out.MapKeyType = prevExpr.Operator.Outputs[0].MapKeyType
+		out.MapKey = prevExpr.Operator.Outputs[0].MapKey
+		out.MapValue = prevExpr.Operator.Outputs[0].MapValue
 		out.PreviouslyDeclared = true
 
 		prevExpr.AddOutput(out)
@@ -34,10 +40,16 @@ func PostfixExpressionArray(prevExprs []*ast.CXExpression, postExprs []*ast.CXEx
 
 		inp.DeclarationSpecifiers = prevExpr.Operator.Outputs[0].DeclarationSpecifiers
 		inp.CustomType = prevExpr.Operator.Outputs[0].CustomType
+		inp.Enum = prevExpr.Operator.Outputs[0].Enum
+		inp.Named = prevExpr.Operator.Outputs[0].Named
 		inp.Size = prevExpr.Operator.Outputs[0].Size
 		inp.TotalSize = prevExpr.Operator.Outputs[0].TotalSize
 		inp.Lengths = prevExpr.Operator.Outputs[0].Lengths
 		inp.IsSlice = prevExpr.Operator.Outputs[0].IsSlice
+		inp.IsMap = prevExpr.Operator.Outputs[0].IsMap
+		inp.MapKeyType = prevExpr.Operator.Outputs[0].MapKeyType
+		inp.MapKey = prevExpr.Operator.Outputs[0].MapKey
+		inp.MapValue = prevExpr.Operator.Outputs[0].MapValue
 		inp.PreviouslyDeclared = true
 
 		useExpr := ast.MakeExpression(nil, prevExpr.FileName, prevExpr.FileLine)
@@ -83,6 +95,8 @@ func PostfixExpressionArray(prevExprs []*ast.CXExpression, postExprs []*ast.CXEx
 			idxSym := ast.MakeArgument(MakeGenSym(constants.LOCAL_PREFIX), CurrentFile, LineNo).AddType(constants.TypeNames[postExprs[len(postExprs)-1].Operator.Outputs[0].Type])
 			idxSym.Size = postExprs[len(postExprs)-1].Operator.Outputs[0].Size
 			idxSym.TotalSize = ast.GetSize(postExprs[len(postExprs)-1].Operator.Outputs[0])
+			idxSym.Enum = postExprs[len(postExprs)-1].Operator.Outputs[0].Enum
+			idxSym.Named = postExprs[len(postExprs)-1].Operator.Outputs[0].Named
 
 			idxSym.ArgDetails.Package = postExprs[len(postExprs)-1].Package
 			idxSym.PreviouslyDeclared = true
@@ -122,6 +136,97 @@ func PostfixExpressionNative(typCode int, opStrCode string) []*ast.CXExpression
 	return []*ast.CXExpression{expr}
 }
 
+// enumStrFunctions holds the `str` conversion of each enum.
+var enumStrFunctions = map[*ast.CXEnum]*ast.CXFunction{}
+
+// enumTypeArgs maps the arguments naming an enum of another package, e.g.
+// `colors.Color`, to the enum, whose function follows them.
+var enumTypeArgs = map[*ast.CXArgument]*ast.CXEnum{}
+
+// enumFunction makes the last expression of `prevExprs`, which names the
+// enum `enum`, call its function `ident`. Enums only have the function `str`.
+func enumFunction(prevExprs []*ast.CXExpression, enum *ast.CXEnum, ident string) []*ast.CXExpression {
+	if ident != "str" {
+		// the error is reported, and parsing goes on as if it were `str`
+		println(ast.CompilationError(CurrentFile, LineNo), fmt.Sprintf("enum '%s' has no function '%s'", enum.Name, ident))
+	}
+	prevExprs[len(prevExprs)-1].Outputs = nil
+	prevExprs[len(prevExprs)-1].Operator = enumStrFunction(enum)
+	return prevExprs
+}
+
+// enumStrFunction returns the `str` conversion of `enum`: the `enum.str`
+// native with its input typed as `enum`, so only values of the enum can be
+// converted and the native knows the names of the members.
+func enumStrFunction(enum *ast.CXEnum) *ast.CXFunction {
+	if fn, ok := enumStrFunctions[enum]; ok {
+		return fn
+	}
+
+	fn := *ast.Natives[ast.OpCodes["enum.str"]]
+	inp := ast.NewCXArgument(constants.TYPE_I32)
+	inp.Enum = enum
+	fn.Inputs = []*ast.CXArgument{inp}
+
+	enumStrFunctions[enum] = &fn
+	return &fn
+}
+
+// PostfixExpressionTypeAssertion builds the type assertion `x.(T)`, where
+// `prevExprs` evaluate `x` and `typ` is `T`.
+func PostfixExpressionTypeAssertion(prevExprs []*ast.CXExpression, typ *ast.CXArgument) []*ast.CXExpression {
+	pkg, err := AST.GetCurrentPackage()
+	if err != nil {
+		panic(err)
+	}
+	if typ == nil {
+		return nil
+	}
+
+	expr := ast.MakeExpression(typeAssertionFunction(typ, false), CurrentFile, LineNo)
+	expr.Package = pkg
+
+	return FunctionCall([]*ast.CXExpression{expr}, prevExprs)
+}
+
+// typeAssertionFunction returns the `interface.assert` native with its output
+// typed as `typ`, the asserted type. If `withOk` is true, it returns the
+// `interface.assert.ok` native instead, used by `v, ok := x.(T)`.
+func typeAssertionFunction(typ *ast.CXArgument, withOk bool) *ast.CXFunction {
+	name := "interface.assert"
+	if withOk {
+		name = "interface.assert.ok"
+	}
+
+	fn := *ast.Natives[ast.OpCodes[name]]
+	fn.Outputs = append([]*ast.CXArgument{typ}, fn.Outputs[1:]...)
+	return &fn
+}
+
+// PostfixExpressionConversion builds the conversion `T(x)` of a value of a
+// named type to its underlying basic type `T`, where `typSpec` is `T` and
+// `args` evaluate `x`.
+func PostfixExpressionConversion(typSpec int, args []*ast.CXExpression) []*ast.CXExpression {
+	pkg, err := AST.GetCurrentPackage()
+	if err != nil {
+		panic(err)
+	}
+
+	typ := ast.MakeArgument("", CurrentFile, LineNo).AddType(constants.TypeNames[typSpec])
+	typ.ArgDetails.Package = pkg
+
+	expr := ast.MakeExpression(typeConversionFunction(typ), CurrentFile, LineNo)
+	expr.Package = pkg
+
+	return FunctionCall([]*ast.CXExpression{expr}, args)
+}
+
+// isTypeAssertion checks if `expr` is a type assertion.
+func isTypeAssertion(expr *ast.CXExpression) bool {
+	return expr.Operator != nil && expr.Operator.IsBuiltin &&
+		(expr.Operator.OpCode == ast.OpCodes["interface.assert"] || expr.Operator.OpCode == ast.OpCodes["interface.assert.ok"])
+}
+
 func PostfixExpressionEmptyFunCall(prevExprs []*ast.CXExpression) []*ast.CXExpression {
 	if prevExprs[len(prevExprs)-1].Outputs != nil && len(prevExprs[len(prevExprs)-1].Outputs[0].Fields) > 0 {
 		// then it's a method call or function in field
@@ -147,10 +252,10 @@ func PostfixExpressionEmptyFunCall(prevExprs []*ast.CXExpression) []*ast.CXExpre
 		prevExprs[0].Inputs = nil
 	}
 
-	return FunctionCall(prevExprs, nil)
+	return variadicCall(FunctionCall(prevExprs, nil), false)
 }
 
-func PostfixExpressionFunCall(prevExprs []*ast.CXExpression, args []*ast.CXExpression) []*ast.CXExpression {
+func PostfixExpressionFunCall(prevExprs []*ast.CXExpression, args []*ast.CXExpression, isSpread bool) []*ast.CXExpression {
 	if prevExprs[len(prevExprs)-1].Outputs != nil && len(prevExprs[len(prevExprs)-1].Outputs[0].Fields) > 0 {
 		// then it's a method
 		// prevExprs[len(prevExprs) - 1].IsMethodCall = true
@@ -167,10 +272,14 @@ func PostfixExpressionFunCall(prevExprs []*ast.CXExpression, args []*ast.CXExpre
 		prevExprs[0].Inputs = nil
 	}
 
-	return FunctionCall(prevExprs, args)
+	return variadicCall(FunctionCall(prevExprs, args), isSpread)
 }
 
 func PostfixExpressionIncDec(prevExprs []*ast.CXExpression, isInc bool) []*ast.CXExpression {
+	if IsConstantAssignment(prevExprs) {
+		return nil
+	}
+
 	pkg, err := AST.GetCurrentPackage()
 	if err != nil {
 		panic(err)
@@ -198,9 +307,23 @@ func PostfixExpressionIncDec(prevExprs []*ast.CXExpression, isInc bool) []*ast.C
 	return exprs
 }
 
+// isPredeclaredError tells if `ident` names the type error, which isn't a
+// keyword and can be shadowed by variables.
+func isPredeclaredError(pkg *ast.CXPackage, ident string) bool {
+	if ident != constants.TypeNames[constants.TYPE_ERROR] || isLocalName(ident) {
+		return false
+	}
+	_, err := pkg.GetGlobal(ident)
+	return err != nil
+}
+
 // PostfixExpressionField handles the dot notation that can follow an identifier.
 // Examples are: `foo.bar`, `foo().bar`, `pkg.foo`
 func PostfixExpressionField(prevExprs []*ast.CXExpression, ident string) []*ast.CXExpression {
+	if len(prevExprs) == 0 {
+		// The error was already reported.
+		return nil
+	}
 	lastExpr := prevExprs[len(prevExprs)-1]
 
 	// Then it's a function call, e.g. foo().fld
@@ -214,6 +337,8 @@ func PostfixExpressionField(prevExprs []*ast.CXExpression, ident string) []*ast.
 		out := ast.MakeArgument(symName, lastExpr.FileName, lastExpr.FileLine).AddType(constants.TypeNames[opOut.Type])
 		out.DeclarationSpecifiers = opOut.DeclarationSpecifiers
 		out.CustomType = opOut.CustomType
+		out.Enum = opOut.Enum
+		out.Named = opOut.Named
 		out.Size = opOut.Size
 		out.TotalSize = opOut.TotalSize
 		// out.IsArray = opOut.IsArray
@@ -230,6 +355,8 @@ func PostfixExpressionField(prevExprs []*ast.CXExpression, ident string) []*ast.
 		inp := ast.MakeArgument(symName, lastExpr.FileName, lastExpr.FileLine).AddType(constants.TypeNames[opOut.Type])
 		inp.DeclarationSpecifiers = opOut.DeclarationSpecifiers
 		inp.CustomType = opOut.CustomType
+		inp.Enum = opOut.Enum
+		inp.Named = opOut.Named
 		inp.Size = opOut.Size
 		inp.TotalSize = opOut.TotalSize
 		inp.ArgDetails.Package = lastExpr.Package
@@ -246,6 +373,12 @@ func PostfixExpressionField(prevExprs []*ast.CXExpression, ident string) []*ast.
 
 	left := lastExpr.Outputs[0]
 
+	if enum, ok := enumTypeArgs[left]; ok {
+		// then it's a function of an enum of another package, e.g.
+		// `colors.Color.str(c)`
+		return enumFunction(prevExprs, enum, ident)
+	}
+
 	// If the left already is a rest (e.g. "var" in "pkg.var"), then
 	// it can't be a package name and we propagate the property to
 	//  the right side.
@@ -292,7 +425,11 @@ func PostfixExpressionField(prevExprs []*ast.CXExpression, ident string) []*ast.
 
 		left.ArgDetails.Package = imp
 
-		if glbl, err := imp.GetGlobal(ident); err == nil {
+		if val, ok := constantLiteral(imp, ident); ok {
+			// then it's a constant
+			prevExprs[len(prevExprs)-1].Outputs[0] = val[0].Outputs[0]
+			return prevExprs
+		} else if glbl, err := imp.GetGlobal(ident); err == nil {
 			// then it's a global
 			// prevExprs[len(prevExprs)-1].ProgramOutput[0] = glbl
 			prevExprs[len(prevExprs)-1].Outputs[0].ArgDetails.Name = glbl.ArgDetails.Name
@@ -302,6 +439,10 @@ func PostfixExpressionField(prevExprs []*ast.CXExpression, ident string) []*ast.
 			prevExprs[len(prevExprs)-1].Outputs[0].TotalSize = glbl.TotalSize
 			prevExprs[len(prevExprs)-1].Outputs[0].IsPointer = glbl.IsPointer
 			prevExprs[len(prevExprs)-1].Outputs[0].IsSlice = glbl.IsSlice
+			prevExprs[len(prevExprs)-1].Outputs[0].IsMap = glbl.IsMap
+			prevExprs[len(prevExprs)-1].Outputs[0].MapKeyType = glbl.MapKeyType
+			prevExprs[len(prevExprs)-1].Outputs[0].MapKey = glbl.MapKey
+			prevExprs[len(prevExprs)-1].Outputs[0].MapValue = glbl.MapValue
 			prevExprs[len(prevExprs)-1].Outputs[0].IsStruct = glbl.IsStruct
 			prevExprs[len(prevExprs)-1].Outputs[0].ArgDetails.Package = glbl.ArgDetails.Package
 		} else if fn, err := imp.GetFunction(ident); err == nil {
@@ -309,13 +450,30 @@ func PostfixExpressionField(prevExprs []*ast.CXExpression, ident string) []*ast.
 			// not sure about this next line
 			prevExprs[len(prevExprs)-1].Outputs = nil
 			prevExprs[len(prevExprs)-1].Operator = fn
+		} else if named, err := imp.GetNamedType(ident); err == nil {
+			// then it's a conversion to a named type, e.g. `pkg.Celsius(x)`
+			prevExprs[len(prevExprs)-1].Outputs = nil
+			prevExprs[len(prevExprs)-1].Operator = typeConversionFunction(namedDeclarationSpecifiers(named))
+		} else if enum, err := imp.GetEnum(ident); err == nil {
+			// then it's an enum, whose function follows
+			enumTypeArgs[left] = enum
 		} else if strct, err := AST.GetStruct(ident, imp.Name); err == nil {
 			prevExprs[len(prevExprs)-1].Outputs[0].CustomType = strct
 		} else {
-			// panic(err)
-			fmt.Println(err)
+			println(ast.CompilationError(CurrentFile, LineNo), fmt.Sprintf("identifier '%s' does not exist in package '%s'", ident, imp.Name))
+			os.Exit(constants.CX_COMPILATION_ERROR)
+		}
+	} else if enum, err := pkg.GetEnum(left.ArgDetails.Name); err == nil {
+		// then it's a conversion of an enum value, e.g. `Color.str(c)`
+		return enumFunction(prevExprs, enum, ident)
+	} else if isPredeclaredError(pkg, left.ArgDetails.Name) {
+		// then it's a native of the type error, e.g. `error.New`
+		name := left.ArgDetails.Name + "." + ident
+		if _, ok := ast.OpCodes[name]; !ok {
+			println(ast.CompilationError(CurrentFile, LineNo), fmt.Sprintf("function '%s' does not exist", name))
 			return nil
 		}
+		left.ArgDetails.Name = name
 	} else {
 		// then left is not a package name
 		if constants2.IsCorePackage(left.ArgDetails.Name) {
@@ -0,0 +1,257 @@
+package actions
+
+import (
+	"fmt"
+
+	"github.com/skycoin/cx/cx/ast"
+	"github.com/skycoin/cx/cx/constants"
+	"github.com/skycoin/skycoin/src/cipher/encoder"
+)
+
+// rangeLoop holds the variables of a range loop whose types depend on the
+// type of the ranged value.
+type rangeLoop struct {
+	value *ast.CXArgument // The copy of the ranged value
+	key   *ast.CXArgument
+	elt   *ast.CXArgument
+}
+
+// rangeLoops holds the range loops keyed by the expression copying their
+// ranged value. The type of the value is only known once their function is
+// processed.
+var rangeLoops = map[*ast.CXExpression]*rangeLoop{}
+
+// RangeExpressions returns the expressions of the loop
+// `for key, value := range subject { statements }`, where `keyExprs` and
+// `valueExprs` can be nil. The loop is lowered to the jumps of a `for`
+// loop, whose condition is a call to `range.next`, which assigns the key
+// and value of the next element of `subject`. The subject and its length
+// are evaluated once, before the loop. It can be an array, a slice, a
+// string or a map, as CX doesn't have channels.
+func RangeExpressions(keyExprs, valueExprs []*ast.CXExpression, assignOp string, subjectExprs, statements []*ast.CXExpression) []*ast.CXExpression {
+	pkg, err := AST.GetCurrentPackage()
+	if err != nil {
+		panic(err)
+	}
+
+	// LineNo is already past the body of the loop
+	last := subjectExprs[len(subjectExprs)-1]
+	line := last.FileLine
+
+	if keyExprs != nil && assignOp != ":=" {
+		println(ast.CompilationError(CurrentFile, line), "the variables of a range clause must be declared with ':='")
+		return nil
+	}
+	keyName, ok := rangeVariableName(keyExprs, line)
+	if !ok {
+		return nil
+	}
+	valueName, ok := rangeVariableName(valueExprs, line)
+	if !ok {
+		return nil
+	}
+
+	if last.IsMethodCall() {
+		println(ast.CompilationError(CurrentFile, line), "method calls are not supported as range values")
+		return nil
+	}
+
+	if last.IsArrayLiteral() {
+		// the output of array literals only has the lengths of the array
+		out := last.Outputs[0]
+		for range out.Lengths {
+			out.DeclarationSpecifiers = append(out.DeclarationSpecifiers, constants.DECL_ARRAY)
+		}
+	}
+
+	value := ast.MakeArgument(MakeGenSym(constants.LOCAL_PREFIX), CurrentFile, line)
+	value.ArgDetails.Package = pkg
+	value.PreviouslyDeclared = true
+	if last.Operator == nil || len(last.Outputs) > 0 {
+		// then it's a variable or a literal, which is copied
+		expr := ast.MakeExpression(ast.Natives[constants.OP_IDENTITY], CurrentFile, line)
+		expr.Package = pkg
+		expr.AddInput(last.Outputs[0])
+		expr.AddOutput(value)
+		if last.Operator == nil {
+			subjectExprs[len(subjectExprs)-1] = expr
+		} else {
+			subjectExprs = append(subjectExprs, expr)
+		}
+		last = expr
+	} else {
+		last.AddOutput(value)
+	}
+
+	keyDecl, key := rangeVariable(keyName, constants.TYPE_I32, line)
+	valueDecl, elt := rangeVariable(valueName, constants.TYPE_I32, line)
+	okDecl, more := rangeVariable("", constants.TYPE_BOOL, line)
+	posDecl, pos := rangeVariable("", constants.TYPE_I32, line)
+	endDecl, end := rangeVariable("", constants.TYPE_I32, line)
+	rangeLoops[last] = &rangeLoop{value: value, key: key, elt: elt}
+
+	// the end of the value is set by the first iteration
+	init := append(subjectExprs, keyDecl, valueDecl, okDecl, posDecl, endDecl)
+	init = append(init, Assignment(PrimaryIdentifier(pos.ArgDetails.Name), "=", WritePrimary(constants.TYPE_I32, encoder.Serialize(int32(0)), false))...)
+	init = append(init, Assignment(PrimaryIdentifier(end.ArgDetails.Name), "=", WritePrimary(constants.TYPE_I32, encoder.Serialize(int32(-1)), false))...)
+
+	next := ast.MakeExpression(ast.Natives[ast.OpCodes["range.next"]], CurrentFile, line)
+	next.Package = pkg
+	for _, inp := range []*ast.CXArgument{value, pos, end} {
+		next.AddInput(PrimaryIdentifier(inp.ArgDetails.Name)[0].Outputs[0])
+	}
+	for _, out := range []*ast.CXArgument{more, pos, end, key, elt} {
+		next.AddOutput(PrimaryIdentifier(out.ArgDetails.Name)[0].Outputs[0])
+	}
+
+	return IterationExpressions(init, []*ast.CXExpression{next}, nil, statements)
+}
+
+// rangeVariableName returns the name of the variable declared by `exprs`,
+// the left side of a range clause, or "" if there's no variable or it's the
+// blank identifier.
+func rangeVariableName(exprs []*ast.CXExpression, line int) (string, bool) {
+	if exprs == nil {
+		return "", true
+	}
+
+	last := exprs[len(exprs)-1]
+	if len(exprs) != 1 || last.Operator != nil || len(last.Outputs) != 1 {
+		println(ast.CompilationError(CurrentFile, line), "invalid variable in range clause")
+		return "", false
+	}
+	arg := last.Outputs[0]
+	if arg.ArgDetails.Name == "" || len(arg.Fields) > 0 || len(arg.Indexes) > 0 || len(arg.DereferenceOperations) > 0 {
+		println(ast.CompilationError(CurrentFile, line), "invalid variable in range clause")
+		return "", false
+	}
+
+	if arg.ArgDetails.Name == "_" {
+		return "", true
+	}
+	return arg.ArgDetails.Name, true
+}
+
+// rangeVariable declares the variable `name` of a range loop, or a
+// temporary variable if `name` is "". Its type is `typ` until the type of
+// the ranged value is known.
+func rangeVariable(name string, typ int, line int) (*ast.CXExpression, *ast.CXArgument) {
+	if name == "" {
+		name = MakeGenSym(constants.LOCAL_PREFIX)
+	}
+	sym := DeclarationSpecifiersBasic(typ)
+	sym.ArgDetails.Name = name
+	sym.IsLocalDeclaration = true
+	sym.PreviouslyDeclared = true
+
+	pkg, err := AST.GetCurrentPackage()
+	if err != nil {
+		panic(err)
+	}
+	sym.ArgDetails.Package = pkg
+
+	decl := ast.MakeExpression(nil, CurrentFile, line)
+	decl.Package = pkg
+	decl.AddOutput(sym)
+	return decl, sym
+}
+
+// ProcessRangeExpression sets the types of the variables of the range loop
+// whose ranged value is copied by `expr`, once the inputs of `expr` are
+// processed.
+func ProcessRangeExpression(expr *ast.CXExpression) {
+	loop, found := rangeLoops[expr]
+	if !found {
+		return
+	}
+	delete(rangeLoops, expr)
+
+	var typ *ast.CXArgument
+	if out := expr.Operator.Outputs[0]; expr.Operator.OpCode != constants.OP_IDENTITY && out.Type != constants.TYPE_UNDEFINED {
+		typ = rangeType(out, out.DeclarationSpecifiers, out.Lengths)
+	} else {
+		inp := ast.GetAssignmentElement(expr.Inputs[0])
+		var lengths []int
+		if len(inp.Indexes) < len(inp.Lengths) {
+			lengths = inp.Lengths[len(inp.Indexes):]
+		}
+		typ = rangeType(inp, inp.DeclarationSpecifiers, lengths)
+	}
+	setRangeType(loop.value, typ)
+
+	specs := typ.DeclarationSpecifiers
+	if expr.Operator.OpCode == constants.OP_IDENTITY && typ.Type == constants.TYPE_STR && len(specs) == 1 &&
+		ast.GetAssignmentElement(expr.Inputs[0]).ArgDetails.Name == "" {
+		// the copy of a string literal points to it, as in assignments
+		loop.value.PassBy = constants.PASSBY_REFERENCE
+	}
+	var key, elt *ast.CXArgument
+	switch specs[len(specs)-1] {
+	case constants.DECL_ARRAY, constants.DECL_SLICE:
+		key = DeclarationSpecifiersBasic(constants.TYPE_I32)
+		elt = rangeType(typ, specs[:len(specs)-1], typ.Lengths[1:])
+	case constants.DECL_MAP:
+		key = mapKeySpecifier(typ)
+		elt = rangeType(typ, specs[:len(specs)-1], nil)
+	default:
+		if typ.Type == constants.TYPE_STR && len(specs) == 1 {
+			// the values of strings are runes
+			key = DeclarationSpecifiersBasic(constants.TYPE_I32)
+			elt = DeclarationSpecifiersBasic(constants.TYPE_I32)
+			break
+		}
+		println(ast.CompilationError(expr.FileName, expr.FileLine), fmt.Sprintf("cannot range over value of type '%s'", ast.GetFormattedType(typ)))
+		return
+	}
+	setRangeType(loop.key, key)
+	setRangeType(loop.elt, elt)
+}
+
+// rangeType returns a type specifier of the type made of the declaration
+// specifiers `specs`, whose base type is the one of `base`, and whose
+// arrays have the lengths `lengths`.
+func rangeType(base *ast.CXArgument, specs []int, lengths []int) *ast.CXArgument {
+	var typ *ast.CXArgument
+	switch {
+	case base.CustomType != nil:
+		typ = ast.MakeArgument("", CurrentFile, LineNo)
+		typ.Type = base.Type
+		typ.CustomType = base.CustomType
+		typ.Size = base.CustomType.Size
+		typ.TotalSize = base.CustomType.Size
+		typ.DeclarationSpecifiers = []int{constants.DECL_STRUCT}
+	case base.Type == constants.TYPE_FUNC:
+		typ = ast.MakeArgument("", CurrentFile, LineNo).AddType(constants.TypeNames[constants.TYPE_FUNC])
+		typ.Inputs = base.Inputs
+		typ.Outputs = base.Outputs
+	default:
+		typ = DeclarationSpecifiersBasic(base.Type)
+		typ.Enum = base.Enum
+		typ.Named = base.Named
+	}
+
+	specs = specs[1:]
+	var arrays int
+	for arrays < len(specs) && specs[arrays] == constants.DECL_ARRAY {
+		arrays++
+	}
+	if arrays > 0 {
+		typ = DeclarationSpecifiers(typ, append([]int{}, lengths[len(lengths)-arrays:]...), constants.DECL_ARRAY)
+	}
+	for _, spec := range specs[arrays:] {
+		switch spec {
+		case constants.DECL_POINTER, constants.DECL_SLICE:
+			typ = DeclarationSpecifiers(typ, []int{0}, spec)
+		case constants.DECL_MAP:
+			typ = DeclarationSpecifiersMap(mapKeySpecifier(base), typ)
+		}
+	}
+
+	return typ
+}
+
+// setRangeType gives the type `typ` to `sym`, a variable of a range loop.
+func setRangeType(sym, typ *ast.CXArgument) {
+	copyParameterType(sym, typ)
+	sym.IndirectionLevels = typ.IndirectionLevels
+}
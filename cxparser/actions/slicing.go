@@ -0,0 +1,118 @@
+package actions
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/skycoin/cx/cx/ast"
+	"github.com/skycoin/cx/cx/constants"
+	"github.com/skycoin/skycoin/src/cipher/encoder"
+)
+
+// sliceExpressions holds the calls to `slice.expr` keyed to whether their
+// slice expression has a maximum, as in `s[lo:hi:max]`.
+var sliceExpressions = map[*ast.CXExpression]bool{}
+
+// PostfixExpressionSlice returns the expressions of the slice expression
+// `prevExprs[lo:hi:max]`, where `lo`, `hi` and `max` are nil if they're
+// omitted. It is lowered to a call to `slice.expr`, whose omitted `lo` is 0
+// and whose omitted `hi` and `max` are -1, the length and capacity of the
+// sliced value.
+func PostfixExpressionSlice(prevExprs, lo, hi, max []*ast.CXExpression) []*ast.CXExpression {
+	pkg, err := AST.GetCurrentPackage()
+	if err != nil {
+		panic(err)
+	}
+
+	prevExpr := prevExprs[len(prevExprs)-1]
+	if prevExpr.IsMethodCall() {
+		println(ast.CompilationError(prevExpr.FileName, prevExpr.FileLine), "method calls are not supported as sliced values")
+		return nil
+	}
+
+	if lo == nil {
+		lo = WritePrimary(constants.TYPE_I32, encoder.Serialize(int32(0)), false)
+	}
+	if hi == nil {
+		hi = WritePrimary(constants.TYPE_I32, encoder.Serialize(int32(-1)), false)
+	}
+	hasMax := max != nil
+	if !hasMax {
+		max = WritePrimary(constants.TYPE_I32, encoder.Serialize(int32(-1)), false)
+	}
+
+	expr := ast.MakeExpression(ast.Natives[ast.OpCodes["slice.expr"]], CurrentFile, LineNo)
+	expr.Package = pkg
+	sliceExpressions[expr] = hasMax
+
+	args := prevExprs[:len(prevExprs):len(prevExprs)]
+	if prevExpr.Operator != nil && len(prevExpr.Outputs) == 0 {
+		// then it's a function call, whose output is held by a
+		// temporary variable of the type of the output
+		out := ast.MakeArgument(MakeGenSym(constants.LOCAL_PREFIX), prevExpr.FileName, prevExpr.FileLine)
+		copyParameterType(out, prevExpr.Operator.Outputs[0])
+		out.ArgDetails.Package = pkg
+		out.PreviouslyDeclared = true
+		prevExpr.AddOutput(out)
+
+		args = append(args, PrimaryIdentifier(out.ArgDetails.Name)...)
+	}
+	args = append(args, lo...)
+	args = append(args, hi...)
+	args = append(args, max...)
+
+	return FunctionCall([]*ast.CXExpression{expr}, args)
+}
+
+// ProcessSliceExpression sets the type of the output of `expr`, a call to
+// `slice.expr`, once its inputs are processed. Slicing a slice returns a
+// slice of the same type, slicing an array returns a slice of its elements,
+// and slicing a string returns a string.
+func ProcessSliceExpression(prevExprs []*ast.CXExpression, expr *ast.CXExpression, offset *int) {
+	hasMax, found := sliceExpressions[expr]
+	if !found {
+		return
+	}
+	delete(sliceExpressions, expr)
+
+	inp := ast.GetAssignmentElement(expr.Inputs[0])
+	var lengths []int
+	if len(inp.Indexes) < len(inp.Lengths) {
+		lengths = inp.Lengths[len(inp.Indexes):]
+	}
+	typ := rangeType(inp, inp.DeclarationSpecifiers, lengths)
+
+	specs := typ.DeclarationSpecifiers
+	switch specs[len(specs)-1] {
+	case constants.DECL_SLICE:
+	case constants.DECL_ARRAY:
+		elt := rangeType(typ, specs[:len(specs)-1], typ.Lengths[1:])
+		typ = DeclarationSpecifiers(elt, []int{0}, constants.DECL_SLICE)
+	default:
+		if typ.Type != constants.TYPE_STR || len(specs) != 1 {
+			println(ast.CompilationError(expr.FileName, expr.FileLine), fmt.Sprintf("cannot slice value of type '%s'", ast.GetFormattedType(typ)))
+			return
+		}
+		if hasMax {
+			println(ast.CompilationError(expr.FileName, expr.FileLine), "3-index slice of string")
+			return
+		}
+	}
+
+	if len(expr.Outputs) == 0 {
+		return
+	}
+	out := expr.Outputs[0]
+	if out.IsShortAssignmentDeclaration {
+		if sym := shortDeclarationOf(prevExprs, out); sym != nil {
+			// The declaration was given an offset before knowing its size,
+			// so it is given a new one.
+			setRangeType(sym, typ)
+			sym.Offset = *offset
+			*offset += sym.TotalSize
+		}
+	} else if strings.HasPrefix(out.ArgDetails.Name, constants.LOCAL_PREFIX) {
+		// then it's the temporary variable of a nested call
+		setRangeType(out, typ)
+	}
+}
@@ -1,7 +1,12 @@
 package actions
 
 import (
+	"fmt"
+	"strings"
+
 	"github.com/skycoin/cx/cx/ast"
+	"github.com/skycoin/cx/cx/constants"
+	"github.com/skycoin/cx/cx/helper"
 )
 
 // used for selection_statement to layout its outputs
@@ -40,3 +45,320 @@ func SelectionStatement(predExprs []*ast.CXExpression, thenExprs []*ast.CXExpres
 
 	panic("")
 }
+
+// SwitchCase is a `case` or `default` clause of a switch statement. The
+// cases of type switches list types instead of values.
+type SwitchCase struct {
+	Values    [][]*ast.CXExpression
+	Types     []*ast.CXArgument
+	Body      []*ast.CXExpression
+	IsDefault bool
+}
+
+// SwitchStatement lowers a switch statement to a chain of if/else-if/else
+// jumps. If `tagExprs` is nil it's a tagless switch and every case value is
+// a condition; otherwise each case value is compared to the tag, which is
+// evaluated only once. Only the first matching case runs, there's no
+// fallthrough, and a `break` in a case exits the switch.
+func SwitchStatement(tagExprs []*ast.CXExpression, cases []SwitchCase) []*ast.CXExpression {
+	var exprs []*ast.CXExpression
+	var tag []*ast.CXExpression
+	if tagExprs != nil {
+		exprs, tag = switchTag(tagExprs)
+	}
+
+	var selects []SelectStatement
+	var defaultExprs []*ast.CXExpression
+	var hasDefault bool
+	seen := make(map[string]bool)
+
+	for _, cas := range cases {
+		if cas.IsDefault {
+			if hasDefault {
+				println(ast.CompilationError(CurrentFile, LineNo), "multiple defaults in switch")
+			}
+			hasDefault = true
+			defaultExprs = cas.Body
+			continue
+		}
+
+		var condExprs []*ast.CXExpression
+		for _, value := range cas.Values {
+			if key := switchCaseKey(value); key != "" {
+				if seen[key] {
+					arg := value[0].Outputs[0]
+					println(ast.CompilationError(arg.ArgDetails.FileName, arg.ArgDetails.FileLine), "duplicate case in switch")
+				}
+				seen[key] = true
+			}
+
+			if tag != nil {
+				value = OperatorExpression(tag, value, constants.OP_EQUAL)
+			}
+			if condExprs == nil {
+				condExprs = value
+			} else {
+				condExprs = OperatorExpression(condExprs, value, constants.OP_BOOL_OR)
+			}
+		}
+
+		selects = append(selects, SelectStatement{
+			Condition: condExprs,
+			Then:      cas.Body,
+		})
+	}
+
+	return append(exprs, switchBody(selects, defaultExprs)...)
+}
+
+// TypeSwitchStatement lowers the type switch `switch v := x.(type)` to a
+// chain of if/else-if/else jumps checking the comma-ok type assertions of
+// `x` to the types of each case. `x` is evaluated only once. In the body of
+// a case listing a single type `v` holds the value of `x` as that type, and
+// in the rest of cases it holds `x`. `name` is "" if `v` isn't declared.
+func TypeSwitchStatement(name string, subjectExprs []*ast.CXExpression, cases []SwitchCase) []*ast.CXExpression {
+	pkg, err := AST.GetCurrentPackage()
+	if err != nil {
+		panic(err)
+	}
+
+	exprs, subject := switchTag(subjectExprs)
+	x := subject[len(subject)-1].Outputs[0]
+
+	var selects []SelectStatement
+	var defaultExprs []*ast.CXExpression
+	var hasDefault bool
+	var firstAssert *ast.CXExpression
+	var types []*ast.CXArgument
+	seen := make(map[string]bool)
+
+	for _, cas := range cases {
+		if cas.IsDefault {
+			if hasDefault {
+				println(ast.CompilationError(CurrentFile, LineNo), "multiple defaults in switch")
+			}
+			hasDefault = true
+			defaultExprs = typeSwitchBody(name, x, nil, cas.Body)
+			continue
+		}
+
+		var condExprs []*ast.CXExpression
+		var value *ast.CXArgument
+		for _, typ := range cas.Types {
+			if typ == nil {
+				// then the type doesn't exist
+				continue
+			}
+
+			key := ast.GetFormattedType(typ)
+			if seen[key] {
+				println(ast.CompilationError(typ.ArgDetails.FileName, typ.ArgDetails.FileLine), fmt.Sprintf("duplicate case '%s' in type switch", key))
+			}
+			seen[key] = true
+
+			// `value, ok := x.(T)`
+			var valueDecl, okDecl *ast.CXExpression
+			valueDecl, value = typeSwitchVariable(typ)
+			okDecl, ok := typeSwitchVariable(DeclarationSpecifiersBasic(constants.TYPE_BOOL))
+
+			assert := ast.MakeExpression(typeAssertionFunction(typ, true), CurrentFile, LineNo)
+			assert.Package = pkg
+			assert.AddInput(x)
+			assert.AddOutput(PrimaryIdentifier(value.ArgDetails.Name)[0].Outputs[0])
+			assert.AddOutput(PrimaryIdentifier(ok.ArgDetails.Name)[0].Outputs[0])
+			exprs = append(exprs, valueDecl, okDecl, assert)
+			if firstAssert == nil {
+				firstAssert = assert
+			}
+			types = append(types, typ)
+
+			cond := PrimaryIdentifier(ok.ArgDetails.Name)
+			if condExprs == nil {
+				condExprs = cond
+			} else {
+				condExprs = OperatorExpression(condExprs, cond, constants.OP_BOOL_OR)
+			}
+		}
+		if condExprs == nil {
+			continue
+		}
+
+		if len(cas.Types) > 1 {
+			value = nil
+		}
+		selects = append(selects, SelectStatement{
+			Condition: condExprs,
+			Then:      typeSwitchBody(name, x, value, cas.Body),
+		})
+	}
+
+	if firstAssert != nil && !hasDefault {
+		typeSwitchCases[firstAssert] = types
+	}
+
+	return append(exprs, switchBody(selects, defaultExprs)...)
+}
+
+// typeSwitchCases holds the types of the cases of the type switches without
+// a default case, keyed by their first type assertion. The type of their
+// subject is only known once their function is processed, and switches on
+// unions need to list all of their variants.
+var typeSwitchCases = map[*ast.CXExpression][]*ast.CXArgument{}
+
+// checkExhaustiveTypeSwitch throws an error if `expr` is the first type
+// assertion of a type switch on the union `union` that doesn't have a case
+// for each of its variants or a default case.
+func checkExhaustiveTypeSwitch(expr *ast.CXExpression, union *ast.CXStruct) {
+	types, found := typeSwitchCases[expr]
+	if !found {
+		return
+	}
+	delete(typeSwitchCases, expr)
+
+	var missing []string
+	for _, variant := range union.Variants {
+		var isCovered bool
+		for _, typ := range types {
+			isCovered = isCovered || (typ.CustomType == variant && ast.GetFormattedType(typ) == variant.Name)
+		}
+		if !isCovered {
+			missing = append(missing, fmt.Sprintf("'%s'", variant.Name))
+		}
+	}
+	if len(missing) > 0 {
+		println(ast.CompilationError(expr.FileName, expr.FileLine), fmt.Sprintf("non-exhaustive switch on union '%s': missing cases for %s", union.Name, strings.Join(missing, ", ")))
+	}
+}
+
+// typeSwitchVariable declares a temporary variable of type `typ`, holding
+// the result of a type assertion of a type switch. It returns the
+// declaration and the variable.
+func typeSwitchVariable(typ *ast.CXArgument) (*ast.CXExpression, *ast.CXArgument) {
+	pkg, err := AST.GetCurrentPackage()
+	if err != nil {
+		panic(err)
+	}
+
+	sym := copyArgument(typ)
+	sym.ArgDetails.Name = MakeGenSym(constants.LOCAL_PREFIX)
+	sym.ArgDetails.Package = pkg
+	sym.IsLocalDeclaration = true
+	sym.PreviouslyDeclared = true
+
+	decl := ast.MakeExpression(nil, CurrentFile, LineNo)
+	decl.Package = pkg
+	decl.AddOutput(sym)
+	return decl, sym
+}
+
+// copyArgument returns a copy of `arg` that doesn't share its details, so
+// the copy can be renamed.
+func copyArgument(arg *ast.CXArgument) *ast.CXArgument {
+	cpy := *arg
+	details := *arg.ArgDetails
+	cpy.ArgDetails = &details
+	return &cpy
+}
+
+// typeSwitchBody returns the body of a case of a type switch, declaring the
+// variable `name` that holds `value`, the asserted value of the subject `x`,
+// or `x` itself if `value` is nil.
+func typeSwitchBody(name string, x, value *ast.CXArgument, body []*ast.CXExpression) []*ast.CXExpression {
+	if name == "" {
+		return body
+	}
+
+	if value == nil {
+		// `name := x`
+		from := ast.MakeExpression(nil, CurrentFile, LineNo)
+		from.Package = x.ArgDetails.Package
+		from.Outputs = []*ast.CXArgument{x}
+		return append(Assignment(PrimaryIdentifier(name), ":=", []*ast.CXExpression{from}), body...)
+	}
+
+	// `var name T = value`
+	declarator := ast.MakeArgument(name, CurrentFile, LineNo)
+	return append(DeclareLocal(declarator, copyArgument(value), PrimaryIdentifier(value.ArgDetails.Name), true), body...)
+}
+
+// switchBody chains the cases of a switch statement, where `selects` are
+// the cases and `defaultExprs` the body of the default case, and resolves
+// the breaks in their bodies to the end of the chain.
+func switchBody(selects []SelectStatement, defaultExprs []*ast.CXExpression) []*ast.CXExpression {
+	var body []*ast.CXExpression
+	if len(selects) > 0 {
+		body = SelectionStatement(selects[0].Condition, selects[0].Then, selects[1:], defaultExprs, SEL_ELSEIFELSE)
+	} else {
+		DefineNewScope(defaultExprs)
+		body = defaultExprs
+	}
+
+	// processing possible breaks; labeled breaks exit loops
+	for i, expr := range body {
+		if expr.IsBreak() && jumpLabels[expr] == "" {
+			resolveJump(expr, len(body)-i-1)
+		}
+	}
+
+	return body
+}
+
+// switchTag returns the expressions evaluating the tag of a switch
+// statement, and an expression reading its value that can be compared to
+// every case.
+func switchTag(tagExprs []*ast.CXExpression) (exprs []*ast.CXExpression, tag []*ast.CXExpression) {
+	pkg, err := AST.GetCurrentPackage()
+	if err != nil {
+		panic(err)
+	}
+
+	last := tagExprs[len(tagExprs)-1]
+	if last.IsMethodCall() {
+		println(ast.CompilationError(CurrentFile, LineNo), "method calls are not supported as switch tags")
+		return nil, tagExprs
+	}
+	if last.Operator == nil {
+		// then it's a literal or a variable, which can still need the
+		// preceding expressions, e.g. to evaluate an index
+		return tagExprs[:len(tagExprs)-1], tagExprs[len(tagExprs)-1:]
+	}
+
+	if len(last.Outputs) < 1 {
+		out := last.Operator.Outputs[0]
+		value := ast.MakeArgument(MakeGenSym(constants.LOCAL_PREFIX), CurrentFile, LineNo).AddType(constants.TypeNames[resolveTypeForUnd(last)])
+		value.Type = out.Type
+		value.CustomType = out.CustomType
+		value.Size = out.Size
+		value.TotalSize = ast.GetSize(out)
+		adoptCompositeType(value, out)
+		adoptNamedType(value, out)
+		value.ArgDetails.Package = pkg
+		value.PreviouslyDeclared = true
+		last.AddOutput(value)
+	}
+
+	expr := ast.MakeExpression(nil, CurrentFile, LineNo)
+	expr.Package = pkg
+	expr.Outputs = []*ast.CXArgument{last.Outputs[0]}
+
+	return tagExprs, []*ast.CXExpression{expr}
+}
+
+// switchCaseKey returns a key identifying the value of a case if it is a
+// literal, or "" otherwise. Two literal cases with the same key are
+// duplicates.
+func switchCaseKey(exprs []*ast.CXExpression) string {
+	if len(exprs) != 1 || exprs[0].Operator != nil || len(exprs[0].Outputs) != 1 {
+		return ""
+	}
+	arg := exprs[0].Outputs[0]
+	if arg.ArgDetails.Name != "" || len(arg.Indexes) > 0 {
+		return ""
+	}
+
+	size := arg.TotalSize
+	if arg.Type == constants.TYPE_STR {
+		size = constants.STR_HEADER_SIZE + int(helper.Deserialize_i32(AST.Memory[arg.Offset:arg.Offset+constants.STR_HEADER_SIZE]))
+	}
+	return fmt.Sprintf("%d:%x", arg.Type, AST.Memory[arg.Offset:arg.Offset+size])
+}
@@ -0,0 +1,138 @@
+package actions
+
+import (
+	"fmt"
+
+	"github.com/skycoin/cx/cx/ast"
+	"github.com/skycoin/cx/cx/constants"
+)
+
+// variadicAppends holds the expressions appending the variadic arguments of
+// calls to their slice, keyed to the called functions.
+var variadicAppends = map[*ast.CXExpression]*ast.CXFunction{}
+
+// VariadicParameterDeclaration returns the parameter `name ...typ`, which
+// is a slice of `typ` holding the arguments following the other
+// parameters.
+func VariadicParameterDeclaration(name, typ *ast.CXArgument) *ast.CXArgument {
+	param := DeclarationSpecifiers(typ, []int{0}, constants.DECL_SLICE)
+	param.ArgDetails.Name = name.ArgDetails.Name
+	param.ArgDetails.Package = name.ArgDetails.Package
+	param.IsLocalDeclaration = true
+	param.IsVariadic = true
+	return param
+}
+
+// VariadicTypeDeclaration returns the type `...typ` of the last input of a
+// function type. It's reported, as calls to function values don't pass
+// the variadic arguments in a slice.
+func VariadicTypeDeclaration(typ *ast.CXArgument) *ast.CXArgument {
+	println(ast.CompilationError(CurrentFile, LineNo), "function types cannot be variadic")
+	return DeclarationSpecifiers(typ, []int{0}, constants.DECL_SLICE)
+}
+
+// checkVariadicParameters throws an error if a parameter of a function
+// other than its last input is variadic, or if the function is a method
+// or a function literal, whose calls don't pass the variadic arguments.
+func checkVariadicParameters(inputs, outputs []*ast.CXArgument, isMethod, isLiteral bool) {
+	for i, param := range inputs {
+		if !param.IsVariadic {
+			continue
+		}
+
+		if i != len(inputs)-1 {
+			println(ast.CompilationError(param.ArgDetails.FileName, param.ArgDetails.FileLine), "can only use ... with final parameter in list")
+		} else if isMethod {
+			println(ast.CompilationError(param.ArgDetails.FileName, param.ArgDetails.FileLine), "methods cannot be variadic")
+		} else if isLiteral {
+			println(ast.CompilationError(param.ArgDetails.FileName, param.ArgDetails.FileLine), "function literals cannot be variadic")
+		}
+	}
+	for _, param := range outputs {
+		if param.IsVariadic {
+			println(ast.CompilationError(param.ArgDetails.FileName, param.ArgDetails.FileLine), "can only use ... with final parameter in list")
+		}
+	}
+}
+
+// isVariadic returns true if `fn` is a function whose last parameter is
+// variadic.
+func isVariadic(fn *ast.CXFunction) bool {
+	return fn != nil && !fn.IsBuiltin && len(fn.Inputs) > 0 && fn.Inputs[len(fn.Inputs)-1].IsVariadic
+}
+
+// variadicCall returns the expressions of `exprs`, a function call, which
+// pass the arguments of a variadic function in a slice. The slice holds
+// the arguments following the other parameters, unless `isSpread` is true,
+// where the last argument is the slice, as in `f(xs...)`.
+func variadicCall(exprs []*ast.CXExpression, isSpread bool) []*ast.CXExpression {
+	call := exprs[len(exprs)-1]
+	if !isVariadic(call.Operator) {
+		if isSpread && call.Operator != nil {
+			println(ast.CompilationError(call.FileName, call.FileLine), fmt.Sprintf("cannot use ... in call to non-variadic function '%s'", ast.ExprOpName(call)))
+		} else if isSpread {
+			println(ast.CompilationError(call.FileName, call.FileLine), "cannot use ... in call to method")
+		}
+		return exprs
+	}
+
+	params := call.Operator.Inputs
+	n := len(params) - 1
+	if isSpread || len(call.Inputs) < n {
+		// then the slice is passed as it is, or the missing arguments
+		// are reported by CheckTypes
+		return exprs
+	}
+
+	slc := ast.MakeArgument(MakeGenSym(constants.LOCAL_PREFIX), call.FileName, call.FileLine)
+	copyParameterType(slc, params[n])
+	slc.ArgDetails.Package = call.Package
+	slc.IsLocalDeclaration = true
+	slc.PreviouslyDeclared = true
+
+	decl := ast.MakeExpression(nil, call.FileName, call.FileLine)
+	decl.Package = call.Package
+	decl.AddOutput(slc)
+	prev := append(exprs[:len(exprs)-1:len(exprs)-1], decl)
+
+	if args := call.Inputs[n:]; len(args) > 0 {
+		appendExpr := ast.MakeExpression(ast.Natives[constants.OP_APPEND], call.FileName, call.FileLine)
+		appendExpr.Package = call.Package
+		appendExpr.AddInput(PrimaryIdentifier(slc.ArgDetails.Name)[0].Outputs[0])
+		for _, arg := range args {
+			appendExpr.AddInput(arg)
+		}
+		appendExpr.AddOutput(PrimaryIdentifier(slc.ArgDetails.Name)[0].Outputs[0])
+		variadicAppends[appendExpr] = call.Operator
+		prev = append(prev, appendExpr)
+	}
+
+	call.Inputs = append(call.Inputs[:n:n], slc)
+	return append(prev, call)
+}
+
+// CheckVariadicArguments checks the types of the variadic arguments
+// appended to their slice by `expr`.
+func CheckVariadicArguments(expr *ast.CXExpression) {
+	fn, found := variadicAppends[expr]
+	if !found {
+		return
+	}
+	delete(variadicAppends, expr)
+
+	param := fn.Inputs[len(fn.Inputs)-1]
+	specs := param.DeclarationSpecifiers
+	elt := rangeType(param, specs[:len(specs)-1], param.Lengths[1:])
+	expectedType := ast.GetFormattedType(elt)
+	for _, arg := range expr.Inputs[1:] {
+		if iface := interfaceConversion(elt, arg); iface != nil {
+			// struct instances are converted to the interfaces they implement
+			checkImplements(arg, iface)
+			continue
+		}
+
+		if receivedType := ast.GetFormattedType(arg); receivedType != expectedType {
+			println(ast.CompilationError(arg.ArgDetails.FileName, arg.ArgDetails.FileLine), fmt.Sprintf("function '%s' expected input argument of type '%s'; '%s' was provided", fn.Name, expectedType, receivedType))
+		}
+	}
+}
@@ -42,15 +42,25 @@ func ParseSourceCode(sourceCode []*os.File, fileNames []string) {
 		sourceCodeStrings[i] = tmp.String()
 	}
 
+	/*
+		Generic functions and structs are monomorphized before parsing:
+		their instances are added to the source code as ordinary
+		functions and structs.
+	*/
+	sourceCodeStrings, parseErrors := ExpandGenerics(sourceCodeStrings, fileNames)
+	if parseErrors > 0 {
+		// The instances with errors aren't declared.
+		profiling.CleanupAndExit(constants.CX_COMPILATION_ERROR)
+	}
+
 	/*
 		We need to traverse the elements by hierarchy first add all the
 		packages and structs at the same time then add globals, as these
 		can be of a custom type (and it could be imported) the signatures
 		of functions and methods are added in the cxpartialparsing.y pass
 	*/
-	parseErrors := 0
 	if len(sourceCode) > 0 {
-		parseErrors = Preliminarystage(sourceCodeStrings, fileNames)
+		parseErrors += Preliminarystage(sourceCodeStrings, fileNames)
 	}
 
 	//package level program
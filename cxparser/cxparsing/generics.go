@@ -0,0 +1,619 @@
+package cxparsering
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"text/scanner"
+
+	"github.com/skycoin/cx/cx/ast"
+	"github.com/skycoin/cx/cx/constants"
+	"github.com/skycoin/cx/cxparser/actions"
+)
+
+// maxInstantiationDepth limits the number of nested instantiations, e.g.
+// of a generic function instantiating itself with a different type.
+const maxInstantiationDepth = 64
+
+// genericToken is a token of CX source code, as read by `tokenizeSource`.
+type genericToken struct {
+	tok    rune
+	text   string
+	offset int // byte offset of the token
+	end    int // byte offset after the token
+	line   int
+}
+
+// typeParam is a type parameter of a generic declaration. `constraint`
+// holds the types it can be instantiated with, or nil if it accepts any
+// type.
+type typeParam struct {
+	name       string
+	constraint []string
+}
+
+// genericDecl is a generic function or struct, or a method of a generic
+// struct, e.g. `func Max[T i32|f64](a T, b T) (c T)`.
+type genericDecl struct {
+	name    string
+	pkg     string
+	file    int
+	line    int
+	text    string
+	params  []typeParam
+	methods []*genericDecl
+	// end is the byte offset after the declaration, where its
+	// instances are added.
+	end int
+	// isMethod tells if the declaration is a method, whose type
+	// parameters are named in its receiver.
+	isMethod bool
+	isStruct bool
+}
+
+// genericsExpander monomorphizes the generic functions and structs declared
+// in a group of source files.
+type genericsExpander struct {
+	srcNames []string
+	// generics maps the full names of the generic declarations, e.g.
+	// `main.Max`, to their declarations.
+	generics map[string]*genericDecl
+	// types holds the names of the types declared in each package,
+	// including the instances of generic structs.
+	types map[string]map[string]bool
+	// ends holds the generic declarations of each source file, in the
+	// order of their ends. instances holds the declarations of the
+	// instances added after them, by their ends, and instantiated the
+	// full names of the instances.
+	ends         [][]*genericDecl
+	instances    []map[int]*strings.Builder
+	instantiated map[string]bool
+	depth        int
+	errors       int
+}
+
+// ExpandGenerics monomorphizes the generic functions and structs declared in
+// `srcStrs`. The generic declarations are removed from the source code,
+// their instantiations, e.g. `Max[i32]` or `Stack[str]`, are replaced by the
+// names of ordinary functions and structs, and the declarations of these
+// instances are added after the generic declarations, in their packages.
+// It returns the resulting source code and the number of errors found.
+func ExpandGenerics(srcStrs, srcNames []string) ([]string, int) {
+	exp := &genericsExpander{
+		srcNames:     srcNames,
+		generics:     map[string]*genericDecl{},
+		types:        map[string]map[string]bool{},
+		ends:         make([][]*genericDecl, len(srcStrs)),
+		instances:    make([]map[int]*strings.Builder, len(srcStrs)),
+		instantiated: map[string]bool{},
+	}
+
+	tokens := make([][]genericToken, len(srcStrs))
+	for i, src := range srcStrs {
+		tokens[i] = tokenizeSource(src)
+	}
+	// Generic structs are collected first, as their methods are
+	// identified by their receivers.
+	for i := range srcStrs {
+		exp.collectDeclarations(srcStrs, tokens, i, true)
+	}
+	for i := range srcStrs {
+		exp.collectDeclarations(srcStrs, tokens, i, false)
+	}
+	if len(exp.generics) == 0 {
+		return srcStrs, 0
+	}
+
+	// The source files are rewritten in segments ending with generic
+	// declarations, as the instances are added between them.
+	segments := make([][]string, len(srcStrs))
+	for i, src := range srcStrs {
+		ends := exp.ends[i]
+		sort.Slice(ends, func(a, b int) bool { return ends[a].end < ends[b].end })
+
+		start, pkg := 0, ""
+		for _, decl := range ends {
+			segments[i] = append(segments[i], exp.rewrite(src[start:decl.end], pkg, i, lineAt(src, start)))
+			start, pkg = decl.end, decl.pkg
+		}
+		segments[i] = append(segments[i], exp.rewrite(src[start:], pkg, i, lineAt(src, start)))
+	}
+
+	expanded := make([]string, len(srcStrs))
+	for i, src := range srcStrs {
+		var out strings.Builder
+		for j, segment := range segments[i] {
+			out.WriteString(segment)
+			if j == len(exp.ends[i]) {
+				break
+			}
+			end := exp.ends[i][j].end
+			if instances := exp.instances[i][end]; instances != nil {
+				out.WriteString(instances.String())
+				// The lines of the rest of the source file are
+				// restored.
+				fmt.Fprintf(&out, "//line %d\n", lineAt(src, end))
+			}
+		}
+		expanded[i] = out.String()
+	}
+
+	return expanded, exp.errors
+}
+
+// lineAt returns the number of the line of `src` at the byte offset `offset`.
+func lineAt(src string, offset int) int {
+	return strings.Count(src[:offset], "\n") + 1
+}
+
+// tokenizeSource splits `src` into tokens, skipping comments.
+func tokenizeSource(src string) []genericToken {
+	var s scanner.Scanner
+	s.Init(strings.NewReader(src))
+	s.Mode = scanner.GoTokens
+	s.Error = func(*scanner.Scanner, string) {}
+
+	var tokens []genericToken
+	for tok := s.Scan(); tok != scanner.EOF; tok = s.Scan() {
+		tokens = append(tokens, genericToken{
+			tok:    tok,
+			text:   s.TokenText(),
+			offset: s.Position.Offset,
+			end:    s.Pos().Offset,
+			line:   s.Position.Line,
+		})
+	}
+	return tokens
+}
+
+// closing returns the index of the token closing the bracket, parenthesis or
+// brace opened by `tokens[open]`.
+func closing(tokens []genericToken, open int) int {
+	depth := 0
+	for i := open; i < len(tokens); i++ {
+		switch tokens[i].text {
+		case "[", "(", "{":
+			depth++
+		case "]", ")", "}":
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return len(tokens) - 1
+}
+
+// splitList splits `tokens` by the commas outside of brackets, parentheses
+// and braces.
+func splitList(tokens []genericToken) [][]genericToken {
+	var list [][]genericToken
+	depth, start := 0, 0
+	for i, tok := range tokens {
+		switch tok.text {
+		case "[", "(", "{":
+			depth++
+		case "]", ")", "}":
+			depth--
+		case ",":
+			if depth == 0 {
+				list = append(list, tokens[start:i])
+				start = i + 1
+			}
+		}
+	}
+	if start < len(tokens) {
+		list = append(list, tokens[start:])
+	}
+	return list
+}
+
+// typeText returns the type written by `tokens`, without superfluous
+// spaces, e.g. `[]i32` or `map[str]i32`.
+func typeText(tokens []genericToken) string {
+	var text strings.Builder
+	for i, tok := range tokens {
+		if i > 0 && isWord(tokens[i-1]) && isWord(tok) {
+			text.WriteByte(' ')
+		}
+		text.WriteString(tok.text)
+	}
+	return text.String()
+}
+
+// isWord checks if `tok` is an identifier or a literal.
+func isWord(tok genericToken) bool {
+	return tok.tok == scanner.Ident || tok.tok == scanner.Int || tok.tok == scanner.Float
+}
+
+// collectDeclarations collects the generic declarations of the source file
+// `file`, and removes them from `srcStrs`. If `structs` is true, only
+// generic structs are collected, and otherwise generic functions and
+// methods.
+func (exp *genericsExpander) collectDeclarations(srcStrs []string, tokens [][]genericToken, file int, structs bool) {
+	toks := tokens[file]
+
+	var pkg string
+	var spans [][2]int
+	for i := 0; i < len(toks); i++ {
+		tok := toks[i]
+		if tok.text == "package" && i+1 < len(toks) {
+			// A source file can declare several packages.
+			pkg = toks[i+1].text
+			continue
+		}
+		if tok.text == "{" {
+			// Only top-level declarations are considered.
+			i = closing(toks, i)
+			continue
+		}
+		if i+2 >= len(toks) {
+			break
+		}
+		if structs && (tok.text == "type" || tok.text == "enum" || tok.text == "union") && toks[i+1].tok == scanner.Ident && toks[i+2].text != "[" {
+			exp.declareType(pkg, toks[i+1].text)
+		}
+
+		var decl *genericDecl
+		var params []genericToken
+		switch {
+		case structs && tok.text == "type" && toks[i+1].tok == scanner.Ident && toks[i+2].text == "[":
+			end := closing(toks, i+2)
+			if end+1 >= len(toks) || toks[end+1].text != "struct" {
+				// Then it's an array type.
+				continue
+			}
+			decl = &genericDecl{name: toks[i+1].text, isStruct: true}
+			params = toks[i+3 : end]
+		case !structs && tok.text == "func" && toks[i+1].tok == scanner.Ident && toks[i+2].text == "[":
+			decl = &genericDecl{name: toks[i+1].text}
+			params = toks[i+3 : closing(toks, i+2)]
+		case !structs && tok.text == "func" && toks[i+1].text == "(":
+			recv := toks[i+2 : closing(toks, i+1)]
+			for j := 0; j+1 < len(recv); j++ {
+				strct, ok := exp.generics[pkg+"."+recv[j].text]
+				if !ok || !strct.isStruct || recv[j+1].text != "[" {
+					continue
+				}
+				decl = &genericDecl{name: strct.name, isMethod: true}
+				params = recv[j+2 : closing(recv, j+1)]
+				strct.methods = append(strct.methods, decl)
+				break
+			}
+		}
+		if decl == nil {
+			continue
+		}
+
+		decl.pkg = pkg
+		decl.file = file
+		decl.line = tok.line
+		decl.params = exp.typeParams(params, file)
+
+		// The declaration ends with its body.
+		end := i
+		for end < len(toks) && toks[end].text != "{" {
+			if toks[end].text == "(" || toks[end].text == "[" {
+				end = closing(toks, end)
+			}
+			end++
+		}
+		end = closing(toks, end)
+		decl.text = srcStrs[file][tok.offset:toks[end].end]
+		decl.end = toks[end].end
+		exp.ends[file] = append(exp.ends[file], decl)
+
+		if !decl.isMethod {
+			exp.generics[pkg+"."+decl.name] = decl
+		}
+		spans = append(spans, [2]int{tok.offset, toks[end].end})
+		i = end
+	}
+
+	// The declarations are blanked, keeping the lines of the rest of the
+	// source code.
+	src := []byte(srcStrs[file])
+	for _, span := range spans {
+		for c := span[0]; c < span[1]; c++ {
+			if src[c] != '\n' {
+				src[c] = ' '
+			}
+		}
+	}
+	srcStrs[file] = string(src)
+}
+
+// typeParams returns the type parameters declared by `tokens`, e.g.
+// `T i32|f64, U`. As in Go, `K, V any` declares two parameters with the
+// same constraint.
+func (exp *genericsExpander) typeParams(tokens []genericToken, file int) []typeParam {
+	var params []typeParam
+	pending := 0
+	for _, param := range splitList(tokens) {
+		if len(param) == 0 || param[0].tok != scanner.Ident {
+			exp.errorf(file, tokens[0].line, "invalid type parameter list")
+			return nil
+		}
+		params = append(params, typeParam{name: param[0].text})
+		if len(param) == 1 {
+			pending++
+			continue
+		}
+
+		var constraint []string
+		if typ := typeText(param[1:]); typ != "any" {
+			var start int
+			for i, tok := range param[1:] {
+				if tok.text == "|" {
+					constraint = append(constraint, typeText(param[1+start:1+i]))
+					start = i + 1
+				}
+			}
+			constraint = append(constraint, typeText(param[1+start:]))
+		}
+		for j := len(params) - 1 - pending; j < len(params); j++ {
+			params[j].constraint = constraint
+		}
+		pending = 0
+	}
+	return params
+}
+
+// declareType records the type `name` declared in the package `pkg`.
+func (exp *genericsExpander) declareType(pkg, name string) {
+	if exp.types[pkg] == nil {
+		exp.types[pkg] = map[string]bool{}
+	}
+	exp.types[pkg][name] = true
+}
+
+// errorf reports a compilation error in the source file `file`.
+func (exp *genericsExpander) errorf(file, line int, format string, a ...interface{}) {
+	var srcName string
+	if file < len(exp.srcNames) {
+		srcName = exp.srcNames[file]
+	}
+	println(ast.CompilationError(srcName, line), fmt.Sprintf(format, a...))
+	exp.errors++
+}
+
+// lookup returns the generic declaration named by `tokens[i]`, which
+// belongs to the package `pkg` unless it's qualified, e.g. `lib.Max`.
+func (exp *genericsExpander) lookup(tokens []genericToken, i int, pkg string) *genericDecl {
+	if i > 0 && tokens[i-1].text == "." {
+		if i < 2 || tokens[i-2].tok != scanner.Ident {
+			return nil
+		}
+		// Then it's either a qualified name or a field.
+		return exp.generics[tokens[i-2].text+"."+tokens[i].text]
+	}
+	return exp.generics[pkg+"."+tokens[i].text]
+}
+
+// rewrite replaces the instantiations of generics in `src`, which belongs to
+// the package `pkg` unless it declares another one, and starts at the line
+// `line` of the source file `file`, by the names of their instances.
+func (exp *genericsExpander) rewrite(src, pkg string, file, line int) string {
+	tokens := tokenizeSource(src)
+
+	var out strings.Builder
+	last := 0
+	for i := 0; i < len(tokens); i++ {
+		tok := tokens[i]
+		if tok.text == "package" && i+1 < len(tokens) {
+			pkg = tokens[i+1].text
+			i++
+			continue
+		}
+		if tok.tok != scanner.Ident {
+			continue
+		}
+		decl := exp.lookup(tokens, i, pkg)
+		if decl == nil {
+			continue
+		}
+		if i+1 >= len(tokens) || tokens[i+1].text != "[" {
+			if !decl.isStruct && i+1 < len(tokens) && tokens[i+1].text == "(" {
+				exp.errorf(file, line+tok.line-1, "missing type arguments for generic function '%s'", decl.name)
+			}
+			continue
+		}
+
+		end := closing(tokens, i+1)
+		var args []string
+		resolved := true
+		for _, arg := range splitList(tokens[i+2 : end]) {
+			argSrc := src[arg[0].offset:arg[len(arg)-1].end]
+			argLine := line + arg[0].line - 1
+			typ, ok := exp.qualifyType(tokenizeSource(exp.rewrite(argSrc, pkg, file, argLine)), pkg, decl.pkg, file, argLine)
+			args = append(args, typ)
+			resolved = resolved && ok
+		}
+
+		out.WriteString(src[last:tok.offset])
+		if resolved {
+			out.WriteString(exp.instantiate(decl, args, file, line+tok.line-1))
+		} else {
+			out.WriteString(instanceName(decl.name, args))
+		}
+		last = tokens[end].end
+		i = end
+	}
+	out.WriteString(src[last:])
+
+	return out.String()
+}
+
+// qualifyType returns the type written by `tokens` in the package `pkg` as
+// it's written in the package `declPkg`, which declares the instance using
+// it, e.g. `main.Point` for the type `Point` of `main` used by an instance
+// declared in `lib`. The packages of the types used by the instance are
+// imported by `declPkg`. Unknown types are reported, so no instance refers
+// to them.
+func (exp *genericsExpander) qualifyType(tokens []genericToken, pkg, declPkg string, file, line int) (string, bool) {
+	var qualified []genericToken
+	for i := 0; i < len(tokens); i++ {
+		tok := tokens[i]
+		if tok.tok != scanner.Ident {
+			qualified = append(qualified, tok)
+			continue
+		}
+
+		typPkg, name := pkg, tok.text
+		if i+2 < len(tokens) && tokens[i+1].text == "." {
+			typPkg, name = tok.text, tokens[i+2].text
+			i += 2
+		} else if _, isBasic := constants.TypeCodes[name]; isBasic || name == "map" {
+			qualified = append(qualified, tok)
+			continue
+		} else if !exp.types[pkg][name] {
+			if _, err := actions.AST.GetStruct(name, pkg); err != nil {
+				exp.errorf(file, line, "unknown type '%s'", name)
+				return typeText(tokens), false
+			}
+		}
+
+		if typPkg != declPkg {
+			actions.AddInstanceImport(declPkg, typPkg)
+			qualified = append(qualified, genericToken{tok: scanner.Ident, text: typPkg}, genericToken{text: "."})
+		}
+		qualified = append(qualified, genericToken{tok: scanner.Ident, text: name})
+	}
+	return typeText(qualified), true
+}
+
+// instanceName returns the name of the instance of the generic `name` with
+// type arguments `args`, e.g. `Max__i32` or `Pair__str__slice_i32`.
+func instanceName(name string, args []string) string {
+	var mangled strings.Builder
+	mangled.WriteString(name)
+	for _, arg := range args {
+		mangled.WriteString("__")
+		for i := 0; i < len(arg); i++ {
+			switch c := arg[i]; {
+			case c == '[' && i+1 < len(arg) && arg[i+1] == ']':
+				mangled.WriteString("slice_")
+				i++
+			case c == '[':
+				mangled.WriteString("array")
+			case c == '*':
+				mangled.WriteString("ptr_")
+			case c == '_' || c >= '0' && c <= '9' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z':
+				mangled.WriteByte(c)
+			default:
+				mangled.WriteByte('_')
+			}
+		}
+	}
+	return mangled.String()
+}
+
+// instantiate declares the instance of the generic `decl` with type
+// arguments `args`, instantiated at the line `line` of the source file
+// `file`, if it wasn't declared yet. It returns the name of the instance.
+func (exp *genericsExpander) instantiate(decl *genericDecl, args []string, file, line int) string {
+	name := instanceName(decl.name, args)
+	if len(args) != len(decl.params) {
+		exp.errorf(file, line, "wrong number of type arguments for '%s': expected %d, got %d", decl.name, len(decl.params), len(args))
+		return name
+	}
+	for i, param := range decl.params {
+		if param.constraint == nil {
+			continue
+		}
+		var satisfied bool
+		for _, typ := range param.constraint {
+			satisfied = satisfied || typ == args[i]
+		}
+		if !satisfied {
+			exp.errorf(file, line, "type '%s' does not satisfy '%s' (type parameter '%s' of '%s')", args[i], strings.Join(param.constraint, "|"), param.name, decl.name)
+			return name
+		}
+	}
+
+	if exp.instantiated[decl.pkg+"."+name] {
+		return name
+	}
+	exp.instantiated[decl.pkg+"."+name] = true
+	if decl.isStruct {
+		exp.declareType(decl.pkg, name)
+	}
+
+	if exp.depth >= maxInstantiationDepth {
+		exp.errorf(file, line, "instantiation of '%s' is nested too deeply", decl.name)
+		return name
+	}
+	exp.depth++
+	exp.declareInstance(decl, name, args)
+	for _, method := range decl.methods {
+		exp.declareInstance(method, name, args)
+	}
+	exp.depth--
+
+	return name
+}
+
+// declareInstance adds the declaration of `decl` with its type parameters
+// substituted by `args` after the generic declaration. The generic is
+// renamed to `name`, and its type parameter list is removed.
+func (exp *genericsExpander) declareInstance(decl *genericDecl, name string, args []string) {
+	tokens := tokenizeSource(decl.text)
+
+	// The type parameter list follows the name of the generic, which is
+	// named in the receiver of methods.
+	listStart, listEnd := 2, closing(tokens, 2)
+	if decl.isMethod {
+		for i := 2; i+1 < closing(tokens, 1); i++ {
+			if tokens[i].text == decl.name && tokens[i+1].text == "[" {
+				listStart, listEnd = i+1, closing(tokens, i+1)
+				break
+			}
+		}
+	}
+	if len(decl.params) != len(args) {
+		exp.errorf(decl.file, decl.line, "wrong number of type parameters for '%s': expected %d, got %d", decl.name, len(args), len(decl.params))
+		return
+	}
+
+	substitutes := map[string]string{}
+	for i, param := range decl.params {
+		substitutes[param.name] = args[i]
+	}
+
+	var text strings.Builder
+	last := 0
+	for i, tok := range tokens {
+		switch {
+		case i == listStart-1:
+			text.WriteString(decl.text[last:tok.offset])
+			text.WriteString(name)
+			last = tokens[listEnd].end
+		case i > listStart-1 && i <= listEnd:
+			// The type parameter list is dropped.
+		case tok.tok == scanner.Ident && substitutes[tok.text] != "":
+			if i > 0 && (tokens[i-1].text == "." || isWord(tokens[i-1]) && tokens[i-1].end == tok.offset) {
+				// Then it's a field or the suffix of a literal,
+				// e.g. `1.0D`.
+				continue
+			}
+			text.WriteString(decl.text[last:tok.offset])
+			text.WriteString(substitutes[tok.text])
+			last = tok.end
+		}
+	}
+	text.WriteString(decl.text[last:])
+
+	instance := exp.rewrite(text.String(), decl.pkg, decl.file, decl.line)
+
+	// The line directive keeps the lines of the generic declaration in
+	// the errors found in the instance.
+	if exp.instances[decl.file] == nil {
+		exp.instances[decl.file] = map[int]*strings.Builder{}
+	}
+	instances := exp.instances[decl.file][decl.end]
+	if instances == nil {
+		instances = &strings.Builder{}
+		exp.instances[decl.file][decl.end] = instances
+	}
+	fmt.Fprintf(instances, "\n//line %d\n%s\n", decl.line, instance)
+}
@@ -2,6 +2,7 @@ package cxparsering
 
 import (
 	"bufio"
+	"fmt"
 	"path/filepath"
 	"regexp"
 	"strings"
@@ -30,6 +31,11 @@ func Preliminarystage(srcStrs, srcNames []string) int {
 	rePkgName := regexp.MustCompile(`(^|[\s])package\s+([_a-zA-Z][_a-zA-Z0-9]*)`)
 	reStrct := regexp.MustCompile("type")
 	reStrctName := regexp.MustCompile(`(^|[\s])type\s+([_a-zA-Z][_a-zA-Z0-9]*)?\s`)
+	reInterface := regexp.MustCompile(`(^|[\s])type\s+[_a-zA-Z][_a-zA-Z0-9]*\s+interface([\s{]|$)`)
+	reNamedType := regexp.MustCompile(`(^|[\s])type\s+[_a-zA-Z][_a-zA-Z0-9]*\s+[^\s]`)
+	reStrctType := regexp.MustCompile(`(^|[\s])type\s+[_a-zA-Z][_a-zA-Z0-9]*\s+(struct|interface)([\s{]|$)`)
+	reUnion := regexp.MustCompile("union")
+	reUnionName := regexp.MustCompile(`^\s*union\s+([_a-zA-Z][_a-zA-Z0-9]*)`)
 
 	reGlbl := regexp.MustCompile("var")
 	reGlblName := regexp.MustCompile(`(^|[\s])var\s([_a-zA-Z][_a-zA-Z0-9]*)`)
@@ -101,13 +107,46 @@ func Preliminarystage(srcStrs, srcNames []string) int {
 					continue
 				}
 
-				if match := reStrctName.FindStringSubmatch(string(line)); match != nil {
+				// Named types and type aliases are declared by the
+				// first pass of the parser.
+				isNamedType := reNamedType.Match(line) && !reStrctType.Match(line)
+
+				if match := reStrctName.FindStringSubmatch(string(line)); match != nil && !isNamedType {
 					if prePkg == nil {
 						println(ast.CompilationError(srcName, lineno),
 							"No package defined")
 					} else if _, err := cxpartialparsing.Program.GetStruct(match[len(match)-1], prePkg.Name); err != nil {
 						// then it hasn't been added
 						strct := ast.MakeStruct(match[len(match)-1])
+						// Interfaces are identified before parsing, as
+						// the size of their values is always the same.
+						if reInterface.Match(line) {
+							strct.IsInterface = true
+							strct.Size = constants.INTERFACE_SIZE
+						}
+						prePkg.AddStruct(strct)
+					}
+				}
+			}
+
+			// 1c. Identify all the unions, which are interfaces
+			if loc := reUnion.FindIndex(line); loc != nil {
+				if (commentLoc != nil && commentLoc[0] < loc[0]) ||
+					(multiCommentOpenLoc != nil && multiCommentOpenLoc[0] < loc[0]) ||
+					(multiCommentCloseLoc != nil && multiCommentCloseLoc[0] > loc[0]) {
+					// then it's commented out
+					continue
+				}
+
+				if match := reUnionName.FindStringSubmatch(string(line)); match != nil {
+					if prePkg == nil {
+						println(ast.CompilationError(srcName, lineno),
+							"No package defined")
+					} else if _, err := cxpartialparsing.Program.GetStruct(match[len(match)-1], prePkg.Name); err != nil {
+						// then it hasn't been added
+						strct := ast.MakeStruct(match[len(match)-1])
+						strct.IsInterface = true
+						strct.Size = constants.INTERFACE_SIZE
 						prePkg.AddStruct(strct)
 					}
 				}
@@ -266,6 +305,10 @@ func Preliminarystage(srcStrs, srcNames []string) int {
 	}
 	profiling.StopProfile("2. globals")
 
+	// The instances of generics can use the types of packages not
+	// imported by their own packages.
+	actions.DeclareInstanceImports()
+
 	profiling.StartProfile("3. cxpartialparsing")
 
 	for i, source := range srcStrs {
@@ -273,6 +316,7 @@ func Preliminarystage(srcStrs, srcNames []string) int {
 		source = source + "\n"
 		if len(srcNames) > 0 {
 			cxpartialparsing.CurrentFileName = srcNames[i]
+			actions.CurrentFile = srcNames[i]
 		}
 		/*
 			passone
@@ -285,6 +329,8 @@ func Preliminarystage(srcStrs, srcNames []string) int {
 	return parseErrors
 }
 
+// AddInitFunction adds the `*init` function to the `main` package, which
+// initializes the packages of `prgrm` before `main` is called.
 func AddInitFunction(prgrm *ast.CXProgram) error {
 	mainPkg, err := prgrm.GetPackage(constants.MAIN_PKG)
 	if err != nil {
@@ -294,11 +340,46 @@ func AddInitFunction(prgrm *ast.CXProgram) error {
 	initFn := ast.MakeFunction(constants.SYS_INIT_FUNC, actions.CurrentFile, actions.LineNo)
 	mainPkg.AddFunction(initFn)
 
+	// The globals of each package are initialized and then its `init`
+	// functions are called in the order they're declared, after the
+	// packages it imports.
+	var exprs []*ast.CXExpression
+	for _, pkg := range actions.InitializationOrder(prgrm) {
+		exprs = append(exprs, globals.SysInitExprs[pkg.Name]...)
+		for _, fn := range pkg.Functions {
+			if actions.IsInitFunction(fn) {
+				expr := ast.MakeExpression(fn, actions.CurrentFile, actions.LineNo)
+				expr.Package = mainPkg
+				exprs = append(exprs, expr)
+			}
+		}
+	}
+
 	//Init Expressions
-	actions.FunctionDeclaration(initFn, nil, nil, globals.SysInitExprs)
+	actions.FunctionDeclaration(initFn, nil, nil, exprs)
 
 	if _, err := mainPkg.SelectFunction(constants.MAIN_FUNC); err != nil {
 		return err
 	}
 	return nil
 }
+
+// CheckDeterministic reports a compilation error for every call to a native
+// that is not allowed in deterministic mode, e.g. `os.Run` or `http.Do`.
+// Natives can't be used as function values, so they can only be reached
+// through function values or closures by calling them in the bodies of
+// functions or function literals, which are checked too.
+func CheckDeterministic(prgrm *ast.CXProgram) {
+	for _, pkg := range prgrm.Packages {
+		for _, fn := range pkg.Functions {
+			for _, expr := range fn.Expressions {
+				if expr.Operator == nil || !expr.Operator.IsBuiltin {
+					continue
+				}
+				if ast.NondeterministicOpCodes[expr.Operator.OpCode] {
+					println(ast.CompilationError(expr.FileName, expr.FileLine), fmt.Sprintf("'%s' is not allowed in deterministic mode", ast.OpNames[expr.Operator.OpCode]))
+				}
+			}
+		}
+	}
+}
@@ -18,6 +18,12 @@ func (yylex *Lexer) Lex(lval *yySymType) int {
 	yylex.next()
 	lval.scancopy(yylex.tok)
 	actions.LineNo = lval.line
+	if lval.yys == FOR {
+		actions.BeginLoop()
+	} else {
+		actions.EndLoopLabel()
+	}
+	actions.LexBlockScope(lval.yys == LBRACE, lval.yys == RBRACE)
 	return lval.yys
 }
 
@@ -52,10 +58,13 @@ func (lval *yySymType) scancopy(tok *yySymType) {
 	lval.ReturnExpressions = tok.ReturnExpressions
 	lval.SelectStatement = tok.SelectStatement
 	lval.SelectStatements = tok.SelectStatements
+	lval.SwitchCase = tok.SwitchCase
+	lval.SwitchCases = tok.SwitchCases
 	lval.argument = tok.argument
 	lval.arguments = tok.arguments
 	lval.arrayArguments = tok.arrayArguments
 	lval.bool = tok.bool
+	lval.constant = tok.constant
 	lval.expression = tok.expression
 	lval.expressions = tok.expressions
 	lval.f32 = tok.f32
@@ -7,6 +7,7 @@ var KeywordMap map[string]int = map[string]int{
 	"if":        IF,
 	"else":      ELSE,
 	"for":       FOR,
+	"range":     RANGE,
 	"struct":    STRUCT,
 	"import":    IMPORT,
 	"return":    RETURN,
@@ -34,6 +35,10 @@ var KeywordMap map[string]int = map[string]int{
 	"break":     BREAK,
 	"continue":  CONTINUE,
 	"type":      TYPE,
+	"map":       MAP,
+	"interface": INTERFACE,
+	"defer":     DEFER,
+	"nil":       NIL,
 	":dl":       DSTATE,
 	":dLocals":  DSTATE,
 	":ds":       DSTACK,
@@ -1,16 +1,17 @@
 // Code generated by goyacc - DO NOT EDIT.
 
-package parsingcompletor
+
+	package parsingcompletor
 
 import __yyfmt__ "fmt"
 
-import (
-	"github.com/skycoin/cx/cx/ast"
-	"github.com/skycoin/cx/cx/constants"
-	"github.com/skycoin/cx/cxparser/actions"
-	"github.com/skycoin/skycoin/src/cipher/encoder"
-	"strconv"
-)
+	import (
+		"strconv"
+		"github.com/skycoin/skycoin/src/cipher/encoder"
+		"github.com/skycoin/cx/cx/ast"
+		"github.com/skycoin/cx/cx/constants"
+		"github.com/skycoin/cx/cxparser/actions"
+	)
 
 /*
 This is a machine generated file
@@ -23,48 +24,56 @@ build-parser: ## Generate lexer and parser for CX grammar
 - parsingcompletor.go is output
 - cxparser/cxparsingcompletor/parsingcompletor.y is input
 */
-
-// var actions.AST = MakeProgram(CALLSTACK_SIZE, STACK_SIZE, INIT_HEAP_SIZE)
-
-func Parse(lexer *Lexer) int {
-	return yyParse(lexer)
-}
+        
+	// var actions.AST = MakeProgram(CALLSTACK_SIZE, STACK_SIZE, INIT_HEAP_SIZE)
+	
+	func Parse (lexer *Lexer) int {
+		return yyParse(lexer)
+	}
 
 type yySymType struct {
-	yys     int
-	i       int
-	i8      int8
-	i16     int16
-	i32     int32
-	i64     int64
-	ui8     uint8
-	ui16    uint16
-	ui32    uint32
-	ui64    uint64
-	f32     float32
-	f64     float64
-	tok     string
-	bool    bool
-	string  string
+	yys    int
+	i int
+	i8 int8
+	i16 int16
+	i32 int32
+	i64 int64
+	ui8 uint8
+	ui16 uint16
+	ui32 uint32
+	ui64 uint64
+	f32 float32
+	f64 float64
+	tok string
+	bool bool
+	string string
 	stringA []string
 	ints    []int
 
+	constant actions.ConstantValue
+
 	line int
 
-	argument  *ast.CXArgument
+	argument *ast.CXArgument
 	arguments []*ast.CXArgument
 
-	expression  *ast.CXExpression
+	expression *ast.CXExpression
 	expressions []*ast.CXExpression
 
-	SelectStatement  actions.SelectStatement
+	SelectStatement actions.SelectStatement
 	SelectStatements []actions.SelectStatement
 
+	SwitchCase actions.SwitchCase
+	SwitchCases []actions.SwitchCase
+
 	ReturnExpressions actions.ReturnExpressions
 
 	arrayArguments [][]*ast.CXExpression
 
-	function *ast.CXFunction
+	InterfaceMethod actions.InterfaceMethod
+	InterfaceMethods []actions.InterfaceMethod
+
+    function *ast.CXFunction
 }
 
 type yyXError struct {
@@ -72,395 +81,440 @@ type yyXError struct {
 }
 
 const (
-	yyDefault              = 57487
-	yyEofCode              = 57344
-	ADDR                   = 57486
-	ADD_ASSIGN             = 57439
-	ADD_OP                 = 57399
-	AFF                    = 57481
-	AFFVAR                 = 57406
-	AND                    = 57397
-	AND_ASSIGN             = 57440
-	AND_OP                 = 57437
-	ASSIGN                 = 57379
-	BASICTYPE              = 57470
-	BITANDEQ               = 57425
-	BITCLEAR_OP            = 57416
-	BITOREQ                = 57427
-	BITOR_OP               = 57415
-	BITXOREQ               = 57426
-	BITXOR_OP              = 57414
-	BOOL                   = 57449
-	BOOLEAN_LITERAL        = 57346
-	BREAK                  = 57467
-	BYTE_LITERAL           = 57347
-	CAFF                   = 57482
-	CASE                   = 57464
-	CASSIGN                = 57380
-	CLAUSES                = 57475
-	COLON                  = 57389
-	COMMA                  = 57367
-	COMMENT                = 57369
-	CONST                  = 57463
-	CONTINUE               = 57468
-	DEC_OP                 = 57428
-	DEF                    = 57472
-	DEFAULT                = 57465
-	DIVEQ                  = 57420
-	DIV_ASSIGN             = 57444
-	DIV_OP                 = 57402
-	DOUBLE_LITERAL         = 57356
-	DPROGRAM               = 57479
-	DSTACK                 = 57478
-	DSTATE                 = 57480
-	ELSE                   = 57373
-	ENUM                   = 57462
-	EQUAL                  = 57388
-	EQUALWORD              = 57391
-	EQ_OP                  = 57435
-	EXP                    = 57412
-	EXPEQ                  = 57422
-	EXPR                   = 57473
-	F32                    = 57450
-	F64                    = 57451
-	FIELD                  = 57474
-	FLOAT_LITERAL          = 57355
-	FOR                    = 57374
-	FUNC                   = 57357
-	GE_OP                  = 57433
-	GOTO                   = 57383
-	GTEQ_OP                = 57386
-	GTHANEQ                = 57394
-	GTHANWORD              = 57392
-	GT_OP                  = 57384
-	I16                    = 57453
-	I32                    = 57454
-	I64                    = 57455
-	I8                     = 57452
-	IDENTIFIER             = 57365
-	IF                     = 57372
-	IMPORT                 = 57381
-	INC_OP                 = 57429
-	INFER                  = 57484
-	INT_LITERAL            = 57349
-	LBRACE                 = 57361
-	LBRACK                 = 57363
-	LEFTSHIFT              = 57410
-	LEFTSHIFTEQ            = 57423
-	LEFT_ASSIGN            = 57441
-	LEFT_OP                = 57431
-	LE_OP                  = 57434
-	LONG_LITERAL           = 57350
-	LPAREN                 = 57359
-	LTEQ_OP                = 57387
-	LTHANEQ                = 57395
-	LTHANWORD              = 57393
-	LT_OP                  = 57385
-	MINUSEQ                = 57418
-	MINUSMINUS             = 57408
-	MOD_ASSIGN             = 57442
-	MOD_OP                 = 57403
-	MULTEQ                 = 57419
-	MUL_ASSIGN             = 57443
-	MUL_OP                 = 57401
-	NEG_OP                 = 57405
-	NEW                    = 57390
-	NEWLINE                = 57378
-	NE_OP                  = 57436
-	NOT                    = 57413
-	OBJECT                 = 57476
-	OBJECTS                = 57477
-	OP                     = 57358
-	OR                     = 57398
-	OR_ASSIGN              = 57445
-	OR_OP                  = 57438
-	PACKAGE                = 57371
-	PERIOD                 = 57368
-	PLUSEQ                 = 57417
-	PLUSPLUS               = 57407
-	PTR_OP                 = 57430
-	RBRACE                 = 57362
-	RBRACK                 = 57364
-	REF_OP                 = 57404
-	REM                    = 57471
-	REMAINDER              = 57409
-	REMAINDEREQ            = 57421
-	RETURN                 = 57382
-	RIGHTSHIFT             = 57411
-	RIGHTSHIFTEQ           = 57424
-	RIGHT_ASSIGN           = 57446
-	RIGHT_OP               = 57432
-	RPAREN                 = 57360
-	SEMICOLON              = 57377
-	SHORT_LITERAL          = 57348
-	STR                    = 57456
-	STRING_LITERAL         = 57370
-	STRUCT                 = 57376
-	SUB_ASSIGN             = 57447
-	SUB_OP                 = 57400
-	SWITCH                 = 57466
-	TAG                    = 57483
-	TYPE                   = 57469
-	TYPSTRUCT              = 57375
-	UI16                   = 57458
-	UI32                   = 57459
-	UI64                   = 57460
-	UI8                    = 57457
-	UNEQUAL                = 57396
-	UNION                  = 57461
-	UNSIGNED_BYTE_LITERAL  = 57351
-	UNSIGNED_INT_LITERAL   = 57353
-	UNSIGNED_LONG_LITERAL  = 57354
-	UNSIGNED_SHORT_LITERAL = 57352
-	VALUE                  = 57485
-	VAR                    = 57366
-	XOR_ASSIGN             = 57448
-	yyErrCode              = 57345
+	yyDefault               = 57495
+	yyEofCode               = 57344
+	ADDR                    = 57492
+	ADD_ASSIGN              = 57439
+	ADD_OP                  = 57399
+	AFF                     = 57487
+	AFFVAR                  = 57406
+	AND                     = 57397
+	AND_ASSIGN              = 57440
+	AND_OP                  = 57437
+	ASSIGN                  = 57379
+	BASICTYPE               = 57476
+	BITANDEQ                = 57425
+	BITCLEAR_OP             = 57416
+	BITOREQ                 = 57427
+	BITOR_OP                = 57415
+	BITXOREQ                = 57426
+	BITXOR_OP               = 57414
+	BOOL                    = 57449
+	BOOLEAN_LITERAL         = 57346
+	BREAK                   = 57467
+	BYTE_LITERAL            = 57347
+	CAFF                    = 57488
+	CASE                    = 57464
+	CASSIGN                 = 57380
+	CLAUSES                 = 57481
+	COLON                   = 57389
+	COMMA                   = 57367
+	COMMENT                 = 57369
+	CONST                   = 57463
+	CONTINUE                = 57468
+	DEC_OP                  = 57428
+	DEF                     = 57478
+	DEFAULT                 = 57465
+	DEFER                   = 57471
+	DIVEQ                   = 57420
+	DIV_ASSIGN              = 57444
+	DIV_OP                  = 57402
+	DOUBLE_LITERAL          = 57356
+	DPROGRAM                = 57485
+	DSTACK                  = 57484
+	DSTATE                  = 57486
+	ELLIPSIS                = 57474
+	ELSE                    = 57373
+	ENUM                    = 57462
+	EQUAL                   = 57388
+	EQUALWORD               = 57391
+	EQ_OP                   = 57435
+	EXP                     = 57412
+	EXPEQ                   = 57422
+	EXPR                    = 57479
+	F32                     = 57450
+	F64                     = 57451
+	FIELD                   = 57480
+	FLOAT_LITERAL           = 57355
+	FOR                     = 57374
+	FUNC                    = 57357
+	GE_OP                   = 57433
+	GOTO                    = 57383
+	GTEQ_OP                 = 57386
+	GTHANEQ                 = 57394
+	GTHANWORD               = 57392
+	GT_OP                   = 57384
+	I16                     = 57453
+	I32                     = 57454
+	I64                     = 57455
+	I8                      = 57452
+	IDENTIFIER              = 57365
+	IF                      = 57372
+	IMPORT                  = 57381
+	INC_OP                  = 57429
+	INFER                   = 57490
+	INTERFACE               = 57470
+	INT_LITERAL             = 57349
+	LBRACE                  = 57361
+	LBRACK                  = 57363
+	LEFTSHIFT               = 57410
+	LEFTSHIFTEQ             = 57423
+	LEFT_ASSIGN             = 57441
+	LEFT_OP                 = 57431
+	LE_OP                   = 57434
+	LONG_LITERAL            = 57350
+	LPAREN                  = 57359
+	LTEQ_OP                 = 57387
+	LTHANEQ                 = 57395
+	LTHANWORD               = 57393
+	LT_OP                   = 57385
+	MAP                     = 57469
+	MINUSEQ                 = 57418
+	MINUSMINUS              = 57408
+	MOD_ASSIGN              = 57442
+	MOD_OP                  = 57403
+	MULTEQ                  = 57419
+	MUL_ASSIGN              = 57443
+	MUL_OP                  = 57401
+	NEG_OP                  = 57405
+	NEW                     = 57390
+	NEWLINE                 = 57378
+	NE_OP                   = 57436
+	NIL                     = 57472
+	NOT                     = 57413
+	OBJECT                  = 57482
+	OBJECTS                 = 57483
+	OP                      = 57358
+	OR                      = 57398
+	OR_ASSIGN               = 57445
+	OR_OP                   = 57438
+	PACKAGE                 = 57371
+	PERIOD                  = 57368
+	PLUSEQ                  = 57417
+	PLUSPLUS                = 57407
+	PTR_OP                  = 57430
+	RANGE                   = 57473
+	RBRACE                  = 57362
+	RBRACK                  = 57364
+	REF_OP                  = 57404
+	REM                     = 57477
+	REMAINDER               = 57409
+	REMAINDEREQ             = 57421
+	RETURN                  = 57382
+	RIGHTSHIFT              = 57411
+	RIGHTSHIFTEQ            = 57424
+	RIGHT_ASSIGN            = 57446
+	RIGHT_OP                = 57432
+	RPAREN                  = 57360
+	SEMICOLON               = 57377
+	SHORT_LITERAL           = 57348
+	STR                     = 57456
+	STRING_LITERAL          = 57370
+	STRUCT                  = 57376
+	SUB_ASSIGN              = 57447
+	SUB_OP                  = 57400
+	SWITCH                  = 57466
+	TAG                     = 57489
+	TYPE                    = 57475
+	TYPSTRUCT               = 57375
+	UI16                    = 57458
+	UI32                    = 57459
+	UI64                    = 57460
+	UI8                     = 57457
+	UNEQUAL                 = 57396
+	UNION                   = 57461
+	UNSIGNED_BYTE_LITERAL   = 57351
+	UNSIGNED_INT_LITERAL    = 57353
+	UNSIGNED_LONG_LITERAL   = 57354
+	UNSIGNED_SHORT_LITERAL  = 57352
+	VALUE                   = 57491
+	VAR                     = 57366
+	XOR_ASSIGN              = 57448
+	yyErrCode               = 57345
 
 	yyMaxDepth = 200
-	yyTabOfs   = -230
+	yyTabOfs   = -364
 )
 
 var (
+
 	yyPrec = map[int]int{
 		IDENTIFIER: 0,
-		LBRACE:     0,
-	}
+		LBRACE: 0,
+		}
 
 	yyXLAT = map[int]int{
-		57377: 0,   // SEMICOLON (206x)
-		57404: 1,   // REF_OP (200x)
-		57359: 2,   // LPAREN (199x)
-		57401: 3,   // MUL_OP (193x)
-		57400: 4,   // SUB_OP (190x)
-		57399: 5,   // ADD_OP (189x)
-		57363: 6,   // LBRACK (187x)
-		57428: 7,   // DEC_OP (174x)
-		57429: 8,   // INC_OP (174x)
-		57362: 9,   // RBRACE (172x)
-		57365: 10,  // IDENTIFIER (166x)
-		57361: 11,  // LBRACE (165x)
-		57367: 12,  // COMMA (159x)
-		57357: 13,  // FUNC (145x)
-		57360: 14,  // RPAREN (145x)
-		57481: 15,  // AFF (135x)
-		57449: 16,  // BOOL (135x)
-		57450: 17,  // F32 (135x)
-		57451: 18,  // F64 (135x)
-		57453: 19,  // I16 (135x)
-		57454: 20,  // I32 (135x)
-		57455: 21,  // I64 (135x)
-		57452: 22,  // I8 (135x)
-		57456: 23,  // STR (135x)
-		57458: 24,  // UI16 (135x)
-		57459: 25,  // UI32 (135x)
-		57460: 26,  // UI64 (135x)
-		57457: 27,  // UI8 (135x)
-		57349: 28,  // INT_LITERAL (123x)
-		57370: 29,  // STRING_LITERAL (120x)
-		57346: 30,  // BOOLEAN_LITERAL (118x)
-		57347: 31,  // BYTE_LITERAL (118x)
-		57356: 32,  // DOUBLE_LITERAL (118x)
-		57355: 33,  // FLOAT_LITERAL (118x)
-		57484: 34,  // INFER (118x)
-		57350: 35,  // LONG_LITERAL (118x)
-		57405: 36,  // NEG_OP (118x)
-		57348: 37,  // SHORT_LITERAL (118x)
-		57351: 38,  // UNSIGNED_BYTE_LITERAL (118x)
-		57353: 39,  // UNSIGNED_INT_LITERAL (118x)
-		57354: 40,  // UNSIGNED_LONG_LITERAL (118x)
-		57352: 41,  // UNSIGNED_SHORT_LITERAL (118x)
-		57389: 42,  // COLON (104x)
-		57364: 43,  // RBRACK (103x)
-		63:    44,  // '?' (89x)
-		57438: 45,  // OR_OP (89x)
-		57437: 46,  // AND_OP (88x)
-		57415: 47,  // BITOR_OP (86x)
-		57414: 48,  // BITXOR_OP (84x)
-		57435: 49,  // EQ_OP (80x)
-		57384: 50,  // GT_OP (80x)
-		57386: 51,  // GTEQ_OP (80x)
-		57385: 52,  // LT_OP (80x)
-		57387: 53,  // LTEQ_OP (80x)
-		57436: 54,  // NE_OP (80x)
-		57416: 55,  // BITCLEAR_OP (78x)
-		57431: 56,  // LEFT_OP (78x)
-		57432: 57,  // RIGHT_OP (78x)
-		57554: 58,  // type_specifier (74x)
-		57379: 59,  // ASSIGN (73x)
-		57479: 60,  // DPROGRAM (70x)
-		57381: 61,  // IMPORT (70x)
-		57402: 62,  // DIV_OP (67x)
-		57521: 63,  // indexing_literal (67x)
-		57403: 64,  // MOD_OP (67x)
-		57366: 65,  // VAR (67x)
-		57546: 66,  // slice_literal_expression (63x)
-		57492: 67,  // array_literal_expression (62x)
-		57539: 68,  // postfix_expression (62x)
-		57540: 69,  // primary_expression (62x)
-		57556: 70,  // unary_expression (62x)
-		57557: 71,  // unary_operator (62x)
-		57439: 72,  // ADD_ASSIGN (60x)
-		57440: 73,  // AND_ASSIGN (60x)
-		57380: 74,  // CASSIGN (60x)
-		57444: 75,  // DIV_ASSIGN (60x)
-		57441: 76,  // LEFT_ASSIGN (60x)
-		57442: 77,  // MOD_ASSIGN (60x)
-		57443: 78,  // MUL_ASSIGN (60x)
-		57445: 79,  // OR_ASSIGN (60x)
-		57368: 80,  // PERIOD (60x)
-		57446: 81,  // RIGHT_ASSIGN (60x)
-		57447: 82,  // SUB_ASSIGN (60x)
-		57448: 83,  // XOR_ASSIGN (60x)
-		57534: 84,  // multiplicative_expression (55x)
-		57488: 85,  // additive_expression (53x)
-		57372: 86,  // IF (52x)
-		57467: 87,  // BREAK (51x)
-		57464: 88,  // CASE (51x)
-		57468: 89,  // CONTINUE (51x)
-		57465: 90,  // DEFAULT (51x)
-		57374: 91,  // FOR (51x)
-		57383: 92,  // GOTO (51x)
-		57382: 93,  // RETURN (51x)
-		57466: 94,  // SWITCH (51x)
-		57545: 95,  // shift_expression (50x)
-		57541: 96,  // relational_expression (44x)
-		57490: 97,  // and_expression (43x)
-		57509: 98,  // exclusive_or_expression (42x)
-		57520: 99,  // inclusive_or_expression (41x)
-		57532: 100, // logical_and_expression (40x)
-		57499: 101, // conditional_expression (39x)
-		57533: 102, // logical_or_expression (39x)
-		57551: 103, // struct_literal_expression (33x)
-		57494: 104, // assignment_expression (31x)
-		57371: 105, // PACKAGE (22x)
-		57469: 106, // TYPE (22x)
-		57344: 107, // $end (21x)
-		57510: 108, // expression (19x)
-		57498: 109, // compound_statement (18x)
-		57501: 110, // debugging (14x)
-		57511: 111, // expression_statement (14x)
-		57529: 112, // iteration_statement (12x)
-		57530: 113, // jump_statement (12x)
-		57531: 114, // labeled_statement (12x)
-		57543: 115, // selection_statement (12x)
-		57544: 116, // selector (12x)
-		57548: 117, // statement (12x)
-		57496: 118, // block_item (9x)
-		57502: 119, // declaration (9x)
-		57504: 120, // declarator (8x)
-		57505: 121, // direct_declarator (8x)
-		57373: 122, // ELSE (8x)
-		57503: 123, // declaration_specifiers (5x)
-		57536: 124, // parameter_declaration (5x)
-		57497: 125, // block_item_list (4x)
-		57506: 126, // else_statement (4x)
-		57507: 127, // elseif (4x)
-		57523: 128, // infer_action (4x)
-		57500: 129, // constant_expression (3x)
-		57552: 130, // struct_literal_fields (3x)
-		57493: 131, // array_literal_expression_list (2x)
-		57508: 132, // elseif_list (2x)
-		57512: 133, // external_declaration (2x)
-		57514: 134, // function_declaration (2x)
-		57515: 135, // function_header (2x)
-		57516: 136, // function_parameters (2x)
-		57517: 137, // global_declaration (2x)
-		57519: 138, // import_declaration (2x)
-		57527: 139, // initializer (2x)
-		57535: 140, // package_declaration (2x)
-		57537: 141, // parameter_list (2x)
-		57538: 142, // parameter_type_list (2x)
-		57547: 143, // slice_literal_expression_list (2x)
-		57549: 144, // struct_declaration (2x)
-		57555: 145, // types_list (2x)
-		57489: 146, // after_period (1x)
-		57491: 147, // argument_expression_list (1x)
-		57495: 148, // assignment_operator (1x)
-		57513: 149, // fields (1x)
-		57518: 150, // id_list (1x)
-		57524: 151, // infer_action_arg (1x)
-		57525: 152, // infer_actions (1x)
-		57526: 153, // infer_clauses (1x)
-		57528: 154, // int_value (1x)
-		57542: 155, // return_expression (1x)
-		57376: 156, // STRUCT (1x)
-		57550: 157, // struct_fields (1x)
-		57553: 158, // translation_unit (1x)
-		57487: 159, // $default (0x)
-		57486: 160, // ADDR (0x)
-		57406: 161, // AFFVAR (0x)
-		57397: 162, // AND (0x)
-		57470: 163, // BASICTYPE (0x)
-		57425: 164, // BITANDEQ (0x)
-		57427: 165, // BITOREQ (0x)
-		57426: 166, // BITXOREQ (0x)
-		57482: 167, // CAFF (0x)
-		57475: 168, // CLAUSES (0x)
-		57369: 169, // COMMENT (0x)
-		57463: 170, // CONST (0x)
-		57472: 171, // DEF (0x)
-		57420: 172, // DIVEQ (0x)
-		57478: 173, // DSTACK (0x)
-		57480: 174, // DSTATE (0x)
-		57462: 175, // ENUM (0x)
-		57388: 176, // EQUAL (0x)
-		57391: 177, // EQUALWORD (0x)
-		57345: 178, // error (0x)
-		57412: 179, // EXP (0x)
-		57422: 180, // EXPEQ (0x)
-		57473: 181, // EXPR (0x)
-		57474: 182, // FIELD (0x)
-		57433: 183, // GE_OP (0x)
-		57394: 184, // GTHANEQ (0x)
-		57392: 185, // GTHANWORD (0x)
-		57522: 186, // indexing_slice_literal (0x)
-		57434: 187, // LE_OP (0x)
-		57410: 188, // LEFTSHIFT (0x)
-		57423: 189, // LEFTSHIFTEQ (0x)
-		57395: 190, // LTHANEQ (0x)
-		57393: 191, // LTHANWORD (0x)
-		57418: 192, // MINUSEQ (0x)
-		57408: 193, // MINUSMINUS (0x)
-		57419: 194, // MULTEQ (0x)
-		57390: 195, // NEW (0x)
-		57378: 196, // NEWLINE (0x)
-		57413: 197, // NOT (0x)
-		57476: 198, // OBJECT (0x)
-		57477: 199, // OBJECTS (0x)
-		57358: 200, // OP (0x)
-		57398: 201, // OR (0x)
-		57417: 202, // PLUSEQ (0x)
-		57407: 203, // PLUSPLUS (0x)
-		57430: 204, // PTR_OP (0x)
-		57471: 205, // REM (0x)
-		57409: 206, // REMAINDER (0x)
-		57421: 207, // REMAINDEREQ (0x)
-		57411: 208, // RIGHTSHIFT (0x)
-		57424: 209, // RIGHTSHIFTEQ (0x)
-		57483: 210, // TAG (0x)
-		57375: 211, // TYPSTRUCT (0x)
-		57396: 212, // UNEQUAL (0x)
-		57461: 213, // UNION (0x)
-		57485: 214, // VALUE (0x)
+		 57377:   0, // SEMICOLON (332x)
+		 57400:   1, // SUB_OP (312x)
+		 57399:   2, // ADD_OP (311x)
+		 57359:   3, // LPAREN (305x)
+		 57404:   4, // REF_OP (304x)
+		 57401:   5, // MUL_OP (302x)
+		 57365:   6, // IDENTIFIER (283x)
+		 57363:   7, // LBRACK (273x)
+		 57362:   8, // RBRACE (248x)
+		 57428:   9, // DEC_OP (236x)
+		 57429:  10, // INC_OP (236x)
+		 57487:  11, // AFF (229x)
+		 57449:  12, // BOOL (229x)
+		 57450:  13, // F32 (229x)
+		 57451:  14, // F64 (229x)
+		 57453:  15, // I16 (229x)
+		 57454:  16, // I32 (229x)
+		 57455:  17, // I64 (229x)
+		 57452:  18, // I8 (229x)
+		 57456:  19, // STR (229x)
+		 57458:  20, // UI16 (229x)
+		 57459:  21, // UI32 (229x)
+		 57460:  22, // UI64 (229x)
+		 57457:  23, // UI8 (229x)
+		 57361:  24, // LBRACE (226x)
+		 57360:  25, // RPAREN (225x)
+		 57357:  26, // FUNC (219x)
+		 57367:  27, // COMMA (200x)
+		 57364:  28, // RBRACK (191x)
+		 57349:  29, // INT_LITERAL (190x)
+		 57370:  30, // STRING_LITERAL (190x)
+		 57346:  31, // BOOLEAN_LITERAL (188x)
+		 57347:  32, // BYTE_LITERAL (188x)
+		 57356:  33, // DOUBLE_LITERAL (188x)
+		 57355:  34, // FLOAT_LITERAL (188x)
+		 57350:  35, // LONG_LITERAL (188x)
+		 57348:  36, // SHORT_LITERAL (188x)
+		 57351:  37, // UNSIGNED_BYTE_LITERAL (188x)
+		 57353:  38, // UNSIGNED_INT_LITERAL (188x)
+		 57354:  39, // UNSIGNED_LONG_LITERAL (188x)
+		 57352:  40, // UNSIGNED_SHORT_LITERAL (188x)
+		 57469:  41, // MAP (187x)
+		 57405:  42, // NEG_OP (186x)
+		 57438:  43, // OR_OP (166x)
+		 57437:  44, // AND_OP (159x)
+		 57490:  45, // INFER (159x)
+		 57472:  46, // NIL (159x)
+		 57415:  47, // BITOR_OP (155x)
+		 57414:  48, // BITXOR_OP (151x)
+		 57592:  49, // type_specifier (150x)
+		 57389:  50, // COLON (147x)
+		 57435:  51, // EQ_OP (143x)
+		 57384:  52, // GT_OP (143x)
+		 57386:  53, // GTEQ_OP (143x)
+		 57385:  54, // LT_OP (143x)
+		 57387:  55, // LTEQ_OP (143x)
+		 57436:  56, // NE_OP (143x)
+		 57416:  57, // BITCLEAR_OP (139x)
+		 57431:  58, // LEFT_OP (139x)
+		 57432:  59, // RIGHT_OP (139x)
+		 57474:  60, // ELLIPSIS (125x)
+		 57402:  61, // DIV_OP (117x)
+		 57403:  62, // MOD_OP (117x)
+		    63:  63, // '?' (112x)
+		 57549:  64, // indexing_literal (110x)
+		 57463:  65, // CONST (104x)
+		 57485:  66, // DPROGRAM (104x)
+		 57381:  67, // IMPORT (104x)
+		 57366:  68, // VAR (104x)
+		 57379:  69, // ASSIGN (99x)
+		 57579:  70, // slice_literal_expression (90x)
+		 57500:  71, // array_literal_expression (89x)
+		 57544:  72, // function_literal_header (89x)
+		 57566:  73, // map_literal_expression (89x)
+		 57572:  74, // postfix_expression (89x)
+		 57573:  75, // primary_expression (89x)
+		 57597:  76, // unary_expression (87x)
+		 57598:  77, // unary_operator (87x)
+		 57368:  78, // PERIOD (86x)
+		 57380:  79, // CASSIGN (82x)
+		 57439:  80, // ADD_ASSIGN (81x)
+		 57440:  81, // AND_ASSIGN (81x)
+		 57444:  82, // DIV_ASSIGN (81x)
+		 57441:  83, // LEFT_ASSIGN (81x)
+		 57442:  84, // MOD_ASSIGN (81x)
+		 57443:  85, // MUL_ASSIGN (81x)
+		 57445:  86, // OR_ASSIGN (81x)
+		 57446:  87, // RIGHT_ASSIGN (81x)
+		 57447:  88, // SUB_ASSIGN (81x)
+		 57448:  89, // XOR_ASSIGN (81x)
+		 57567:  90, // multiplicative_expression (80x)
+		 57496:  91, // additive_expression (78x)
+		 57578:  92, // shift_expression (75x)
+		 57372:  93, // IF (73x)
+		 57467:  94, // BREAK (72x)
+		 57468:  95, // CONTINUE (72x)
+		 57471:  96, // DEFER (72x)
+		 57374:  97, // FOR (72x)
+		 57383:  98, // GOTO (72x)
+		 57382:  99, // RETURN (72x)
+		 57466: 100, // SWITCH (72x)
+		 57464: 101, // CASE (70x)
+		 57465: 102, // DEFAULT (70x)
+		 57574: 103, // relational_expression (69x)
+		 57498: 104, // and_expression (68x)
+		 57536: 105, // exclusive_or_expression (67x)
+		 57548: 106, // inclusive_or_expression (66x)
+		 57563: 107, // logical_and_expression (65x)
+		 57507: 108, // conditional_expression (64x)
+		 57564: 109, // logical_or_expression (64x)
+		 57585: 110, // struct_literal_expression (53x)
+		 57502: 111, // assignment_expression (51x)
+		 57475: 112, // TYPE (41x)
+		 57462: 113, // ENUM (39x)
+		 57371: 114, // PACKAGE (39x)
+		 57461: 115, // UNION (39x)
+		 57344: 116, // $end (38x)
+		 57516: 117, // const_primary_expression (29x)
+		 57521: 118, // const_unary_expression (29x)
+		 57537: 119, // expression (29x)
+		 57506: 120, // compound_statement (28x)
+		 57515: 121, // const_multiplicative_expression (23x)
+		 57508: 122, // const_additive_expression (21x)
+		 57510: 123, // const_declaration (21x)
+		 57523: 124, // debugging (21x)
+		 57525: 125, // declaration_specifiers (21x)
+		 57538: 126, // expression_statement (21x)
+		 57504: 127, // block_item (19x)
+		 57524: 128, // declaration (19x)
+		 57528: 129, // defer_statement (19x)
+		 57560: 130, // iteration_statement (19x)
+		 57561: 131, // jump_statement (19x)
+		 57562: 132, // labeled_statement (19x)
+		 57576: 133, // selection_statement (19x)
+		 57577: 134, // selector (19x)
+		 57581: 135, // statement (19x)
+		 57582: 136, // statement_label (19x)
+		 57518: 137, // const_shift_expression (18x)
+		 57473: 138, // RANGE (15x)
+		 57517: 139, // const_relational_expression (12x)
+		 57509: 140, // const_and_expression (11x)
+		 57511: 141, // const_exclusive_or_expression (10x)
+		 57505: 142, // block_item_list (9x)
+		 57513: 143, // const_inclusive_or_expression (9x)
+		 57514: 144, // const_logical_and_expression (8x)
+		 57527: 145, // declarator (8x)
+		 57529: 146, // direct_declarator (8x)
+		 57373: 147, // ELSE (8x)
+		 57512: 148, // const_expression (7x)
+		 57545: 149, // function_parameters (6x)
+		 57497: 150, // after_period (5x)
+		 57569: 151, // parameter_declaration (5x)
+		 57530: 152, // else_statement (4x)
+		 57531: 153, // elseif (4x)
+		 57551: 154, // infer_action (4x)
+		 57503: 155, // assignment_operator (3x)
+		 57519: 156, // const_spec (3x)
+		 57586: 157, // struct_literal_fields (3x)
+		 57501: 158, // array_literal_expression_list (2x)
+		 57522: 159, // constant_expression (2x)
+		 57532: 160, // elseif_list (2x)
+		 57533: 161, // enum_declaration (2x)
+		 57534: 162, // enum_members (2x)
+		 57535: 163, // enum_separator (2x)
+		 57539: 164, // external_declaration (2x)
+		 57541: 165, // function_declaration (2x)
+		 57542: 166, // function_header (2x)
+		 57546: 167, // global_declaration (2x)
+		 57547: 168, // import_declaration (2x)
+		 57555: 169, // initializer (2x)
+		 57557: 170, // interface_declaration (2x)
+		 57558: 171, // interface_method (2x)
+		 57565: 172, // map_literal_entries (2x)
+		 57568: 173, // package_declaration (2x)
+		 57570: 174, // parameter_list (2x)
+		 57571: 175, // parameter_type_list (2x)
+		 57580: 176, // slice_literal_expression_list (2x)
+		 57583: 177, // struct_declaration (2x)
+		 57587: 178, // switch_case (2x)
+		 57589: 179, // switch_cases (2x)
+		 57591: 180, // type_declaration (2x)
+		 57593: 181, // type_switch_case (2x)
+		 57594: 182, // type_switch_cases (2x)
+		 57596: 183, // types_list (2x)
+		 57599: 184, // union_declaration (2x)
+		 57493: 185, // $@1 (1x)
+		 57494: 186, // $@2 (1x)
+		 57499: 187, // argument_expression_list (1x)
+		 57520: 188, // const_spec_list (1x)
+		 57526: 189, // declaration_specifiers_list (1x)
+		 57540: 190, // fields (1x)
+		 57543: 191, // function_literal_body (1x)
+		 57552: 192, // infer_action_arg (1x)
+		 57553: 193, // infer_actions (1x)
+		 57554: 194, // infer_clauses (1x)
+		 57556: 195, // int_value (1x)
+		 57470: 196, // INTERFACE (1x)
+		 57559: 197, // interface_methods (1x)
+		 57575: 198, // return_expression (1x)
+		 57376: 199, // STRUCT (1x)
+		 57584: 200, // struct_fields (1x)
+		 57588: 201, // switch_case_values (1x)
+		 57590: 202, // translation_unit (1x)
+		 57595: 203, // type_switch_types (1x)
+		 57495: 204, // $default (0x)
+		 57492: 205, // ADDR (0x)
+		 57406: 206, // AFFVAR (0x)
+		 57397: 207, // AND (0x)
+		 57476: 208, // BASICTYPE (0x)
+		 57425: 209, // BITANDEQ (0x)
+		 57427: 210, // BITOREQ (0x)
+		 57426: 211, // BITXOREQ (0x)
+		 57488: 212, // CAFF (0x)
+		 57481: 213, // CLAUSES (0x)
+		 57369: 214, // COMMENT (0x)
+		 57478: 215, // DEF (0x)
+		 57420: 216, // DIVEQ (0x)
+		 57484: 217, // DSTACK (0x)
+		 57486: 218, // DSTATE (0x)
+		 57388: 219, // EQUAL (0x)
+		 57391: 220, // EQUALWORD (0x)
+		 57345: 221, // error (0x)
+		 57412: 222, // EXP (0x)
+		 57422: 223, // EXPEQ (0x)
+		 57479: 224, // EXPR (0x)
+		 57480: 225, // FIELD (0x)
+		 57433: 226, // GE_OP (0x)
+		 57394: 227, // GTHANEQ (0x)
+		 57392: 228, // GTHANWORD (0x)
+		 57550: 229, // indexing_slice_literal (0x)
+		 57434: 230, // LE_OP (0x)
+		 57410: 231, // LEFTSHIFT (0x)
+		 57423: 232, // LEFTSHIFTEQ (0x)
+		 57395: 233, // LTHANEQ (0x)
+		 57393: 234, // LTHANWORD (0x)
+		 57418: 235, // MINUSEQ (0x)
+		 57408: 236, // MINUSMINUS (0x)
+		 57419: 237, // MULTEQ (0x)
+		 57390: 238, // NEW (0x)
+		 57378: 239, // NEWLINE (0x)
+		 57413: 240, // NOT (0x)
+		 57482: 241, // OBJECT (0x)
+		 57483: 242, // OBJECTS (0x)
+		 57358: 243, // OP (0x)
+		 57398: 244, // OR (0x)
+		 57417: 245, // PLUSEQ (0x)
+		 57407: 246, // PLUSPLUS (0x)
+		 57430: 247, // PTR_OP (0x)
+		 57477: 248, // REM (0x)
+		 57409: 249, // REMAINDER (0x)
+		 57421: 250, // REMAINDEREQ (0x)
+		 57411: 251, // RIGHTSHIFT (0x)
+		 57424: 252, // RIGHTSHIFTEQ (0x)
+		 57489: 253, // TAG (0x)
+		 57375: 254, // TYPSTRUCT (0x)
+		 57396: 255, // UNEQUAL (0x)
+		 57491: 256, // VALUE (0x)
 	}
 
 	yySymNames = []string{
 		"SEMICOLON",
-		"REF_OP",
-		"LPAREN",
-		"MUL_OP",
 		"SUB_OP",
 		"ADD_OP",
+		"LPAREN",
+		"REF_OP",
+		"MUL_OP",
+		"IDENTIFIER",
 		"LBRACK",
+		"RBRACE",
 		"DEC_OP",
 		"INC_OP",
-		"RBRACE",
-		"IDENTIFIER",
-		"LBRACE",
-		"COMMA",
-		"FUNC",
-		"RPAREN",
 		"AFF",
 		"BOOL",
 		"F32",
@@ -474,27 +528,33 @@ var (
 		"UI32",
 		"UI64",
 		"UI8",
+		"LBRACE",
+		"RPAREN",
+		"FUNC",
+		"COMMA",
+		"RBRACK",
 		"INT_LITERAL",
 		"STRING_LITERAL",
 		"BOOLEAN_LITERAL",
 		"BYTE_LITERAL",
 		"DOUBLE_LITERAL",
 		"FLOAT_LITERAL",
-		"INFER",
 		"LONG_LITERAL",
-		"NEG_OP",
 		"SHORT_LITERAL",
 		"UNSIGNED_BYTE_LITERAL",
 		"UNSIGNED_INT_LITERAL",
 		"UNSIGNED_LONG_LITERAL",
 		"UNSIGNED_SHORT_LITERAL",
-		"COLON",
-		"RBRACK",
-		"'?'",
+		"MAP",
+		"NEG_OP",
 		"OR_OP",
 		"AND_OP",
+		"INFER",
+		"NIL",
 		"BITOR_OP",
 		"BITXOR_OP",
+		"type_specifier",
+		"COLON",
 		"EQ_OP",
 		"GT_OP",
 		"GTEQ_OP",
@@ -504,44 +564,49 @@ var (
 		"BITCLEAR_OP",
 		"LEFT_OP",
 		"RIGHT_OP",
-		"type_specifier",
-		"ASSIGN",
-		"DPROGRAM",
-		"IMPORT",
+		"ELLIPSIS",
 		"DIV_OP",
-		"indexing_literal",
 		"MOD_OP",
+		"'?'",
+		"indexing_literal",
+		"CONST",
+		"DPROGRAM",
+		"IMPORT",
 		"VAR",
+		"ASSIGN",
 		"slice_literal_expression",
 		"array_literal_expression",
+		"function_literal_header",
+		"map_literal_expression",
 		"postfix_expression",
 		"primary_expression",
 		"unary_expression",
 		"unary_operator",
+		"PERIOD",
+		"CASSIGN",
 		"ADD_ASSIGN",
 		"AND_ASSIGN",
-		"CASSIGN",
 		"DIV_ASSIGN",
 		"LEFT_ASSIGN",
 		"MOD_ASSIGN",
 		"MUL_ASSIGN",
 		"OR_ASSIGN",
-		"PERIOD",
 		"RIGHT_ASSIGN",
 		"SUB_ASSIGN",
 		"XOR_ASSIGN",
 		"multiplicative_expression",
 		"additive_expression",
+		"shift_expression",
 		"IF",
 		"BREAK",
-		"CASE",
 		"CONTINUE",
-		"DEFAULT",
+		"DEFER",
 		"FOR",
 		"GOTO",
 		"RETURN",
 		"SWITCH",
-		"shift_expression",
+		"CASE",
+		"DEFAULT",
 		"relational_expression",
 		"and_expression",
 		"exclusive_or_expression",
@@ -551,60 +616,98 @@ var (
 		"logical_or_expression",
 		"struct_literal_expression",
 		"assignment_expression",
-		"PACKAGE",
 		"TYPE",
+		"ENUM",
+		"PACKAGE",
+		"UNION",
 		"$end",
+		"const_primary_expression",
+		"const_unary_expression",
 		"expression",
 		"compound_statement",
+		"const_multiplicative_expression",
+		"const_additive_expression",
+		"const_declaration",
 		"debugging",
+		"declaration_specifiers",
 		"expression_statement",
+		"block_item",
+		"declaration",
+		"defer_statement",
 		"iteration_statement",
 		"jump_statement",
 		"labeled_statement",
 		"selection_statement",
 		"selector",
 		"statement",
-		"block_item",
-		"declaration",
+		"statement_label",
+		"const_shift_expression",
+		"RANGE",
+		"const_relational_expression",
+		"const_and_expression",
+		"const_exclusive_or_expression",
+		"block_item_list",
+		"const_inclusive_or_expression",
+		"const_logical_and_expression",
 		"declarator",
 		"direct_declarator",
 		"ELSE",
-		"declaration_specifiers",
+		"const_expression",
+		"function_parameters",
+		"after_period",
 		"parameter_declaration",
-		"block_item_list",
 		"else_statement",
 		"elseif",
 		"infer_action",
-		"constant_expression",
+		"assignment_operator",
+		"const_spec",
 		"struct_literal_fields",
 		"array_literal_expression_list",
+		"constant_expression",
 		"elseif_list",
+		"enum_declaration",
+		"enum_members",
+		"enum_separator",
 		"external_declaration",
 		"function_declaration",
 		"function_header",
-		"function_parameters",
 		"global_declaration",
 		"import_declaration",
 		"initializer",
+		"interface_declaration",
+		"interface_method",
+		"map_literal_entries",
 		"package_declaration",
 		"parameter_list",
 		"parameter_type_list",
 		"slice_literal_expression_list",
 		"struct_declaration",
+		"switch_case",
+		"switch_cases",
+		"type_declaration",
+		"type_switch_case",
+		"type_switch_cases",
 		"types_list",
-		"after_period",
+		"union_declaration",
+		"$@1",
+		"$@2",
 		"argument_expression_list",
-		"assignment_operator",
+		"const_spec_list",
+		"declaration_specifiers_list",
 		"fields",
-		"id_list",
+		"function_literal_body",
 		"infer_action_arg",
 		"infer_actions",
 		"infer_clauses",
 		"int_value",
+		"INTERFACE",
+		"interface_methods",
 		"return_expression",
 		"STRUCT",
 		"struct_fields",
+		"switch_case_values",
 		"translation_unit",
+		"type_switch_types",
 		"$default",
 		"ADDR",
 		"AFFVAR",
@@ -616,12 +719,10 @@ var (
 		"CAFF",
 		"CLAUSES",
 		"COMMENT",
-		"CONST",
 		"DEF",
 		"DIVEQ",
 		"DSTACK",
 		"DSTATE",
-		"ENUM",
 		"EQUAL",
 		"EQUALWORD",
 		"error",
@@ -659,750 +760,1219 @@ var (
 		"TAG",
 		"TYPSTRUCT",
 		"UNEQUAL",
-		"UNION",
 		"VALUE",
 	}
 
-	yyTokenLiteralStrings = map[int]string{}
+	yyTokenLiteralStrings = map[int]string{
+	}
 
-	yyReductions = map[int]struct{ xsym, components int }{
-		0:   {0, 1},
-		1:   {158, 1},
-		2:   {158, 2},
-		3:   {133, 1},
-		4:   {133, 1},
-		5:   {133, 1},
-		6:   {133, 1},
-		7:   {133, 1},
-		8:   {133, 1},
-		9:   {110, 1},
-		10:  {116, 4},
-		11:  {137, 4},
-		12:  {137, 6},
-		13:  {144, 4},
-		14:  {157, 3},
-		15:  {157, 4},
-		16:  {149, 2},
-		17:  {149, 3},
-		18:  {140, 3},
-		19:  {138, 3},
-		20:  {135, 2},
-		21:  {135, 5},
-		22:  {136, 2},
-		23:  {136, 3},
-		24:  {134, 3},
-		25:  {134, 4},
-		26:  {142, 1},
-		27:  {141, 1},
-		28:  {141, 3},
-		29:  {124, 2},
-		30:  {120, 1},
-		31:  {121, 1},
-		32:  {121, 3},
-		33:  {150, 1},
-		34:  {150, 1},
-		35:  {150, 3},
-		36:  {150, 3},
-		37:  {145, 3},
-		38:  {145, 2},
-		39:  {123, 3},
-		40:  {123, 2},
-		41:  {123, 3},
-		42:  {123, 1},
-		43:  {123, 1},
-		44:  {123, 2},
-		45:  {123, 2},
-		46:  {123, 3},
-		47:  {123, 3},
-		48:  {58, 1},
-		49:  {58, 1},
-		50:  {58, 1},
-		51:  {58, 1},
-		52:  {58, 1},
-		53:  {58, 1},
-		54:  {58, 1},
-		55:  {58, 1},
-		56:  {58, 1},
-		57:  {58, 1},
-		58:  {58, 1},
-		59:  {58, 1},
-		60:  {58, 1},
-		61:  {130, 0},
-		62:  {130, 3},
-		63:  {130, 5},
-		64:  {131, 1},
-		65:  {131, 3},
-		66:  {63, 3},
-		67:  {63, 4},
-		68:  {186, 2},
-		69:  {186, 3},
-		70:  {67, 5},
-		71:  {67, 4},
-		72:  {67, 5},
-		73:  {67, 4},
-		74:  {143, 1},
-		75:  {143, 3},
-		76:  {66, 6},
-		77:  {66, 5},
-		78:  {66, 6},
-		79:  {66, 5},
-		80:  {66, 3},
-		81:  {151, 1},
-		82:  {151, 1},
-		83:  {151, 3},
-		84:  {128, 6},
-		85:  {128, 4},
-		86:  {128, 4},
-		87:  {128, 6},
-		88:  {152, 2},
-		89:  {152, 3},
-		90:  {153, 0},
-		91:  {153, 1},
-		92:  {154, 1},
-		93:  {154, 2},
-		94:  {69, 1},
-		95:  {69, 3},
-		96:  {69, 4},
-		97:  {69, 1},
-		98:  {69, 1},
-		99:  {69, 1},
-		100: {69, 1},
-		101: {69, 1},
-		102: {69, 1},
-		103: {69, 1},
-		104: {69, 1},
-		105: {69, 1},
-		106: {69, 1},
-		107: {69, 1},
-		108: {69, 1},
-		109: {69, 3},
-		110: {69, 1},
-		111: {69, 1},
-		112: {146, 1},
-		113: {146, 1},
-		114: {68, 1},
-		115: {68, 4},
-		116: {68, 3},
-		117: {68, 3},
-		118: {68, 4},
-		119: {68, 2},
-		120: {68, 2},
-		121: {68, 3},
-		122: {147, 1},
-		123: {147, 3},
-		124: {70, 1},
-		125: {70, 2},
-		126: {70, 2},
-		127: {70, 2},
-		128: {71, 1},
-		129: {71, 1},
-		130: {71, 1},
-		131: {71, 1},
-		132: {71, 1},
-		133: {84, 1},
-		134: {84, 3},
-		135: {84, 3},
-		136: {84, 3},
-		137: {85, 1},
-		138: {85, 3},
-		139: {85, 3},
-		140: {95, 1},
-		141: {95, 3},
-		142: {95, 3},
-		143: {95, 3},
-		144: {96, 1},
-		145: {96, 3},
-		146: {96, 3},
-		147: {96, 3},
-		148: {96, 3},
-		149: {96, 3},
-		150: {96, 3},
-		151: {97, 1},
-		152: {97, 3},
-		153: {98, 1},
-		154: {98, 3},
-		155: {99, 1},
-		156: {99, 3},
-		157: {100, 1},
-		158: {100, 3},
-		159: {102, 1},
-		160: {102, 3},
-		161: {101, 1},
-		162: {101, 5},
-		163: {103, 1},
-		164: {103, 4},
-		165: {103, 5},
-		166: {103, 6},
-		167: {104, 1},
-		168: {104, 3},
-		169: {148, 1},
-		170: {148, 1},
-		171: {148, 1},
-		172: {148, 1},
-		173: {148, 1},
-		174: {148, 1},
-		175: {148, 1},
-		176: {148, 1},
-		177: {148, 1},
-		178: {148, 1},
-		179: {148, 1},
-		180: {148, 1},
-		181: {108, 1},
-		182: {108, 3},
-		183: {129, 1},
-		184: {119, 4},
-		185: {119, 6},
-		186: {139, 1},
-		187: {117, 1},
-		188: {117, 1},
-		189: {117, 1},
-		190: {117, 1},
-		191: {117, 1},
-		192: {117, 1},
-		193: {117, 1},
-		194: {117, 1},
-		195: {114, 3},
-		196: {114, 4},
-		197: {114, 3},
-		198: {109, 3},
-		199: {109, 4},
-		200: {125, 1},
-		201: {125, 2},
-		202: {118, 1},
-		203: {118, 1},
-		204: {111, 1},
-		205: {111, 2},
-		206: {115, 8},
-		207: {115, 7},
-		208: {115, 6},
-		209: {115, 7},
-		210: {115, 6},
-		211: {115, 7},
-		212: {115, 3},
-		213: {115, 5},
-		214: {127, 6},
-		215: {127, 5},
-		216: {132, 1},
-		217: {132, 2},
-		218: {126, 4},
-		219: {126, 3},
-		220: {112, 3},
-		221: {112, 4},
-		222: {112, 5},
-		223: {155, 1},
-		224: {155, 3},
-		225: {113, 3},
-		226: {113, 2},
-		227: {113, 2},
-		228: {113, 2},
-		229: {113, 3},
+	yyReductions = map[int]struct{xsym, components int}{
+		0: {0, 1},
+		1: {202, 1},
+		2: {202, 2},
+		3: {164, 1},
+		4: {164, 1},
+		5: {164, 1},
+		6: {164, 1},
+		7: {164, 1},
+		8: {164, 1},
+		9: {164, 1},
+		10: {164, 1},
+		11: {164, 1},
+		12: {164, 1},
+		13: {164, 1},
+		14: {124, 1},
+		15: {134, 4},
+		16: {167, 4},
+		17: {167, 6},
+		18: {123, 2},
+		19: {123, 5},
+		20: {188, 1},
+		21: {188, 2},
+		22: {156, 4},
+		23: {156, 5},
+		24: {117, 1},
+		25: {117, 3},
+		26: {117, 1},
+		27: {117, 1},
+		28: {117, 1},
+		29: {117, 1},
+		30: {117, 1},
+		31: {117, 1},
+		32: {117, 1},
+		33: {117, 1},
+		34: {117, 1},
+		35: {117, 1},
+		36: {117, 1},
+		37: {117, 1},
+		38: {117, 3},
+		39: {117, 4},
+		40: {118, 1},
+		41: {118, 2},
+		42: {118, 2},
+		43: {118, 2},
+		44: {121, 1},
+		45: {121, 3},
+		46: {121, 3},
+		47: {121, 3},
+		48: {122, 1},
+		49: {122, 3},
+		50: {122, 3},
+		51: {137, 1},
+		52: {137, 3},
+		53: {137, 3},
+		54: {137, 3},
+		55: {139, 1},
+		56: {139, 3},
+		57: {139, 3},
+		58: {139, 3},
+		59: {139, 3},
+		60: {139, 3},
+		61: {139, 3},
+		62: {140, 1},
+		63: {140, 3},
+		64: {141, 1},
+		65: {141, 3},
+		66: {143, 1},
+		67: {143, 3},
+		68: {144, 1},
+		69: {144, 3},
+		70: {148, 1},
+		71: {148, 3},
+		72: {161, 6},
+		73: {161, 7},
+		74: {162, 1},
+		75: {162, 3},
+		76: {163, 1},
+		77: {163, 1},
+		78: {184, 6},
+		79: {184, 7},
+		80: {177, 4},
+		81: {180, 4},
+		82: {180, 5},
+		83: {170, 6},
+		84: {170, 7},
+		85: {197, 2},
+		86: {197, 3},
+		87: {171, 2},
+		88: {171, 3},
+		89: {171, 3},
+		90: {200, 3},
+		91: {200, 4},
+		92: {190, 2},
+		93: {190, 3},
+		94: {190, 2},
+		95: {190, 4},
+		96: {190, 3},
+		97: {190, 5},
+		98: {173, 3},
+		99: {168, 3},
+		100: {166, 2},
+		101: {166, 5},
+		102: {149, 2},
+		103: {149, 3},
+		104: {72, 2},
+		105: {72, 3},
+		106: {191, 2},
+		107: {191, 3},
+		108: {185, 0},
+		109: {165, 4},
+		110: {186, 0},
+		111: {165, 5},
+		112: {175, 1},
+		113: {174, 1},
+		114: {174, 3},
+		115: {151, 2},
+		116: {151, 3},
+		117: {145, 1},
+		118: {146, 1},
+		119: {146, 3},
+		120: {189, 1},
+		121: {189, 3},
+		122: {189, 2},
+		123: {189, 4},
+		124: {183, 3},
+		125: {183, 2},
+		126: {125, 3},
+		127: {125, 2},
+		128: {125, 2},
+		129: {125, 3},
+		130: {125, 5},
+		131: {125, 1},
+		132: {125, 1},
+		133: {125, 2},
+		134: {125, 2},
+		135: {125, 3},
+		136: {125, 3},
+		137: {49, 1},
+		138: {49, 1},
+		139: {49, 1},
+		140: {49, 1},
+		141: {49, 1},
+		142: {49, 1},
+		143: {49, 1},
+		144: {49, 1},
+		145: {49, 1},
+		146: {49, 1},
+		147: {49, 1},
+		148: {49, 1},
+		149: {49, 1},
+		150: {157, 0},
+		151: {157, 3},
+		152: {157, 5},
+		153: {158, 1},
+		154: {158, 3},
+		155: {64, 3},
+		156: {64, 4},
+		157: {229, 2},
+		158: {229, 3},
+		159: {71, 5},
+		160: {71, 4},
+		161: {71, 5},
+		162: {71, 4},
+		163: {176, 1},
+		164: {176, 3},
+		165: {70, 6},
+		166: {70, 5},
+		167: {70, 6},
+		168: {70, 5},
+		169: {70, 3},
+		170: {172, 3},
+		171: {172, 5},
+		172: {73, 8},
+		173: {73, 9},
+		174: {73, 7},
+		175: {73, 8},
+		176: {73, 9},
+		177: {73, 7},
+		178: {192, 1},
+		179: {192, 1},
+		180: {192, 3},
+		181: {154, 6},
+		182: {154, 4},
+		183: {154, 4},
+		184: {154, 6},
+		185: {193, 2},
+		186: {193, 3},
+		187: {194, 0},
+		188: {194, 1},
+		189: {195, 1},
+		190: {195, 2},
+		191: {75, 1},
+		192: {75, 2},
+		193: {75, 4},
+		194: {75, 1},
+		195: {75, 1},
+		196: {75, 1},
+		197: {75, 1},
+		198: {75, 1},
+		199: {75, 1},
+		200: {75, 1},
+		201: {75, 1},
+		202: {75, 1},
+		203: {75, 1},
+		204: {75, 1},
+		205: {75, 1},
+		206: {75, 1},
+		207: {75, 3},
+		208: {75, 1},
+		209: {75, 1},
+		210: {75, 1},
+		211: {150, 1},
+		212: {150, 1},
+		213: {74, 1},
+		214: {74, 4},
+		215: {74, 4},
+		216: {74, 5},
+		217: {74, 5},
+		218: {74, 6},
+		219: {74, 7},
+		220: {74, 8},
+		221: {74, 3},
+		222: {74, 4},
+		223: {74, 3},
+		224: {74, 4},
+		225: {74, 5},
+		226: {74, 5},
+		227: {74, 2},
+		228: {74, 2},
+		229: {74, 3},
+		230: {187, 1},
+		231: {187, 3},
+		232: {76, 1},
+		233: {76, 2},
+		234: {76, 2},
+		235: {76, 2},
+		236: {77, 1},
+		237: {77, 1},
+		238: {77, 1},
+		239: {77, 1},
+		240: {77, 1},
+		241: {90, 1},
+		242: {90, 3},
+		243: {90, 3},
+		244: {90, 3},
+		245: {91, 1},
+		246: {91, 3},
+		247: {91, 3},
+		248: {92, 1},
+		249: {92, 3},
+		250: {92, 3},
+		251: {92, 3},
+		252: {103, 1},
+		253: {103, 3},
+		254: {103, 3},
+		255: {103, 3},
+		256: {103, 3},
+		257: {103, 3},
+		258: {103, 3},
+		259: {104, 1},
+		260: {104, 3},
+		261: {105, 1},
+		262: {105, 3},
+		263: {106, 1},
+		264: {106, 3},
+		265: {107, 1},
+		266: {107, 3},
+		267: {109, 1},
+		268: {109, 3},
+		269: {108, 1},
+		270: {108, 5},
+		271: {110, 1},
+		272: {110, 4},
+		273: {110, 5},
+		274: {110, 6},
+		275: {111, 1},
+		276: {111, 3},
+		277: {155, 1},
+		278: {155, 1},
+		279: {155, 1},
+		280: {155, 1},
+		281: {155, 1},
+		282: {155, 1},
+		283: {155, 1},
+		284: {155, 1},
+		285: {155, 1},
+		286: {155, 1},
+		287: {155, 1},
+		288: {155, 1},
+		289: {119, 1},
+		290: {119, 3},
+		291: {159, 1},
+		292: {128, 4},
+		293: {128, 6},
+		294: {128, 1},
+		295: {169, 1},
+		296: {135, 1},
+		297: {135, 1},
+		298: {135, 1},
+		299: {135, 1},
+		300: {135, 1},
+		301: {135, 1},
+		302: {135, 1},
+		303: {135, 1},
+		304: {135, 1},
+		305: {132, 2},
+		306: {136, 2},
+		307: {120, 3},
+		308: {120, 4},
+		309: {142, 1},
+		310: {142, 2},
+		311: {127, 1},
+		312: {127, 1},
+		313: {126, 1},
+		314: {126, 2},
+		315: {133, 8},
+		316: {133, 7},
+		317: {133, 6},
+		318: {133, 7},
+		319: {133, 6},
+		320: {133, 7},
+		321: {133, 3},
+		322: {133, 6},
+		323: {133, 5},
+		324: {133, 12},
+		325: {133, 10},
+		326: {179, 0},
+		327: {179, 2},
+		328: {178, 4},
+		329: {178, 3},
+		330: {178, 3},
+		331: {178, 2},
+		332: {201, 1},
+		333: {201, 3},
+		334: {182, 0},
+		335: {182, 2},
+		336: {181, 4},
+		337: {181, 3},
+		338: {181, 3},
+		339: {181, 2},
+		340: {203, 1},
+		341: {203, 3},
+		342: {153, 6},
+		343: {153, 5},
+		344: {160, 1},
+		345: {160, 2},
+		346: {152, 4},
+		347: {152, 3},
+		348: {130, 3},
+		349: {130, 4},
+		350: {130, 5},
+		351: {130, 4},
+		352: {130, 6},
+		353: {130, 8},
+		354: {198, 1},
+		355: {198, 3},
+		356: {131, 3},
+		357: {131, 2},
+		358: {131, 3},
+		359: {131, 2},
+		360: {131, 3},
+		361: {131, 2},
+		362: {131, 3},
+		363: {129, 3},
 	}
 
-	yyXErrors = map[yyXError]string{}
+	yyXErrors = map[yyXError]string{
+	}
 
-	yyParseTab = [418][]uint16{
+	yyParseTab = [696][]uint16{
 		// 0
-		{13: 244, 60: 239, 243, 65: 240, 105: 242, 241, 110: 238, 133: 232, 235, 245, 137: 234, 236, 140: 233, 144: 237, 158: 231},
-		{13: 244, 60: 239, 243, 65: 240, 105: 242, 241, 230, 110: 238, 133: 647, 235, 245, 137: 234, 236, 140: 233, 144: 237},
-		{13: 229, 60: 229, 229, 65: 229, 105: 229, 229, 229},
-		{13: 227, 60: 227, 227, 65: 227, 105: 227, 227, 227},
-		{13: 226, 60: 226, 226, 65: 226, 105: 226, 226, 226},
+		{26: 386, 65: 380, 378, 385, 379, 112: 383, 381, 384, 382, 123: 376, 377, 161: 373, 164: 366, 369, 387, 368, 370, 170: 374, 173: 367, 177: 371, 180: 372, 184: 375, 202: 365},
+		{26: 386, 65: 380, 378, 385, 379, 112: 383, 381, 384, 382, 364, 123: 376, 377, 161: 373, 164: 1059, 369, 387, 368, 370, 170: 374, 173: 367, 177: 371, 180: 372, 184: 375},
+		{26: 363, 65: 363, 363, 363, 363, 112: 363, 363, 363, 363, 363},
+		{26: 361, 65: 361, 361, 361, 361, 112: 361, 361, 361, 361, 361},
+		{26: 360, 65: 360, 360, 360, 360, 112: 360, 360, 360, 360, 360},
 		// 5
-		{13: 225, 60: 225, 225, 65: 225, 105: 225, 225, 225},
-		{13: 224, 60: 224, 224, 65: 224, 105: 224, 224, 224},
-		{13: 223, 60: 223, 223, 65: 223, 105: 223, 223, 223},
-		{13: 222, 60: 222, 222, 65: 222, 105: 222, 222, 222},
-		{221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 13: 221, 15: 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 60: 221, 221, 65: 221, 86: 221, 221, 221, 221, 221, 221, 221, 221, 221, 105: 221, 221, 221},
+		{26: 359, 65: 359, 359, 359, 359, 112: 359, 359, 359, 359, 359},
+		{26: 358, 65: 358, 358, 358, 358, 112: 358, 358, 358, 358, 358},
+		{26: 357, 65: 357, 357, 357, 357, 112: 357, 357, 357, 357, 357},
+		{26: 356, 65: 356, 356, 356, 356, 112: 356, 356, 356, 356, 356},
+		{26: 355, 65: 355, 355, 355, 355, 112: 355, 355, 355, 355, 355},
 		// 10
-		{2: 431, 10: 430, 120: 641, 429},
-		{10: 628},
-		{10: 626},
-		{29: 624},
-		{2: 620, 10: 619},
+		{26: 354, 65: 354, 354, 354, 354, 112: 354, 354, 354, 354, 354},
+		{26: 353, 65: 353, 353, 353, 353, 112: 353, 353, 353, 353, 353},
+		{26: 352, 65: 352, 352, 352, 352, 112: 352, 352, 352, 352, 352},
+		{26: 351, 65: 351, 351, 351, 351, 112: 351, 351, 351, 351, 351},
+		{350, 350, 350, 350, 350, 350, 350, 350, 350, 350, 350, 350, 350, 350, 350, 350, 350, 350, 350, 350, 350, 350, 350, 350, 350, 26: 350, 29: 350, 350, 350, 350, 350, 350, 350, 350, 350, 350, 350, 350, 350, 350, 45: 350, 350, 65: 350, 350, 350, 350, 93: 350, 350, 350, 350, 350, 350, 350, 350, 350, 350, 112: 350, 350, 350, 350, 350},
 		// 15
-		{2: 246, 136: 247},
-		{2: 431, 10: 430, 14: 610, 120: 614, 429, 124: 613, 141: 612, 611},
-		{2: 246, 11: 250, 109: 248, 136: 249},
-		{13: 206, 60: 206, 206, 65: 206, 105: 206, 206, 206},
-		{11: 250, 109: 609},
+		{3: 792, 6: 791, 145: 1053, 790},
+		{3: 1039, 6: 1040, 156: 1038},
+		{6: 1030},
+		{6: 1018},
+		{6: 975},
 		// 20
-		{326, 291, 282, 292, 294, 293, 265, 289, 288, 321, 267, 250, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 60: 239, 251, 63: 266, 65: 310, 284, 283, 286, 285, 296, 290, 84: 297, 298, 327, 332, 319, 331, 320, 329, 330, 333, 328, 299, 300, 301, 302, 303, 304, 306, 305, 307, 308, 108: 309, 312, 317, 313, 315, 318, 311, 314, 316, 325, 323, 324, 125: 322},
-		{29: 606},
-		{182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 11: 182, 182, 14: 182, 42: 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 59: 182, 62: 182, 64: 182, 72: 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182, 182},
-		{181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 11: 181, 181, 14: 181, 42: 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 59: 181, 62: 181, 64: 181, 72: 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181, 181},
-		{180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 11: 180, 180, 14: 180, 42: 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 59: 180, 62: 180, 64: 180, 72: 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180, 180},
+		{6: 973},
+		{30: 971},
+		{3: 967, 6: 966},
+		{3: 388, 149: 389},
+		{3: 792, 6: 791, 25: 955, 145: 959, 790, 151: 958, 174: 957, 956},
 		// 25
-		{179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 11: 179, 179, 14: 179, 42: 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 59: 179, 62: 179, 64: 179, 72: 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179, 179},
-		{178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 11: 178, 178, 14: 178, 42: 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 59: 178, 62: 178, 64: 178, 72: 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178},
-		{177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 11: 177, 177, 14: 177, 42: 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 59: 177, 62: 177, 64: 177, 72: 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177},
-		{176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 11: 176, 176, 14: 176, 42: 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 59: 176, 62: 176, 64: 176, 72: 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176},
-		{175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 11: 175, 175, 14: 175, 42: 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 59: 175, 62: 175, 64: 175, 72: 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175},
+		{3: 388, 24: 256, 149: 391, 185: 390},
+		{24: 394, 120: 954},
+		{24: 254, 186: 392},
+		{24: 394, 120: 393},
+		{26: 253, 65: 253, 253, 253, 253, 112: 253, 253, 253, 253, 253},
 		// 30
-		{174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 11: 174, 174, 14: 174, 42: 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 59: 174, 62: 174, 64: 174, 72: 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174},
-		{173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 11: 173, 173, 14: 173, 42: 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 59: 173, 62: 173, 64: 173, 72: 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173},
-		{172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 11: 172, 172, 14: 172, 42: 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 59: 172, 62: 172, 64: 172, 72: 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172},
-		{171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 11: 171, 171, 14: 171, 42: 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 59: 171, 62: 171, 64: 171, 72: 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171},
-		{170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 11: 170, 170, 14: 170, 42: 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 59: 170, 62: 170, 64: 170, 72: 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170},
+		{475, 442, 441, 429, 439, 440, 413, 410, 470, 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 394, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 380, 378, 395, 458, 70: 431, 430, 414, 432, 434, 433, 444, 438, 90: 445, 446, 447, 476, 481, 480, 483, 478, 479, 482, 477, 103: 448, 449, 450, 451, 452, 454, 453, 455, 456, 119: 457, 461, 123: 459, 466, 126: 462, 472, 473, 468, 464, 467, 460, 463, 465, 474, 469, 142: 471},
+		{30: 951},
+		{3: 388, 149: 949},
+		{227, 227, 227, 227, 227, 227, 7: 227, 227, 227, 227, 24: 227, 227, 27: 227, 227, 43: 227, 227, 47: 227, 227, 50: 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 69: 227, 78: 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227, 227},
+		{226, 226, 226, 226, 226, 226, 7: 226, 226, 226, 226, 24: 226, 226, 27: 226, 226, 43: 226, 226, 47: 226, 226, 50: 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 69: 226, 78: 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226, 226},
 		// 35
-		{28: 455, 43: 590},
-		{6: 447, 10: 577, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 58: 578},
-		{136, 136, 136, 136, 136, 136, 136, 136, 136, 11: 342, 136, 42: 575, 44: 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 59: 136, 62: 136, 64: 136, 72: 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136},
-		{2: 573},
-		{11: 545},
+		{225, 225, 225, 225, 225, 225, 7: 225, 225, 225, 225, 24: 225, 225, 27: 225, 225, 43: 225, 225, 47: 225, 225, 50: 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 69: 225, 78: 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225, 225},
+		{224, 224, 224, 224, 224, 224, 7: 224, 224, 224, 224, 24: 224, 224, 27: 224, 224, 43: 224, 224, 47: 224, 224, 50: 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 69: 224, 78: 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224, 224},
+		{223, 223, 223, 223, 223, 223, 7: 223, 223, 223, 223, 24: 223, 223, 27: 223, 223, 43: 223, 223, 47: 223, 223, 50: 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 69: 223, 78: 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223},
+		{222, 222, 222, 222, 222, 222, 7: 222, 222, 222, 222, 24: 222, 222, 27: 222, 222, 43: 222, 222, 47: 222, 222, 50: 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 69: 222, 78: 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222},
+		{221, 221, 221, 221, 221, 221, 7: 221, 221, 221, 221, 24: 221, 221, 27: 221, 221, 43: 221, 221, 47: 221, 221, 50: 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 69: 221, 78: 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221},
 		// 40
-		{133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 11: 133, 133, 14: 133, 42: 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 59: 133, 62: 133, 64: 133, 72: 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133},
-		{132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 11: 132, 132, 14: 132, 42: 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 59: 132, 62: 132, 64: 132, 72: 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132},
-		{131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 11: 131, 131, 14: 131, 42: 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 59: 131, 62: 131, 64: 131, 72: 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131},
-		{130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 11: 130, 130, 14: 130, 42: 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 59: 130, 62: 130, 64: 130, 72: 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130},
-		{129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 11: 129, 129, 14: 129, 42: 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 59: 129, 62: 129, 64: 129, 72: 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129},
+		{220, 220, 220, 220, 220, 220, 7: 220, 220, 220, 220, 24: 220, 220, 27: 220, 220, 43: 220, 220, 47: 220, 220, 50: 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 69: 220, 78: 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220},
+		{219, 219, 219, 219, 219, 219, 7: 219, 219, 219, 219, 24: 219, 219, 27: 219, 219, 43: 219, 219, 47: 219, 219, 50: 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 69: 219, 78: 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219},
+		{218, 218, 218, 218, 218, 218, 7: 218, 218, 218, 218, 24: 218, 218, 27: 218, 218, 43: 218, 218, 47: 218, 218, 50: 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 69: 218, 78: 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218},
+		{217, 217, 217, 217, 217, 217, 7: 217, 217, 217, 217, 24: 217, 217, 27: 217, 217, 43: 217, 217, 47: 217, 217, 50: 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 69: 217, 78: 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217},
+		{216, 216, 216, 216, 216, 216, 7: 216, 216, 216, 216, 24: 216, 216, 27: 216, 216, 43: 216, 216, 47: 216, 216, 50: 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 69: 216, 78: 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216},
 		// 45
-		{128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 11: 128, 128, 14: 128, 42: 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 59: 128, 62: 128, 64: 128, 72: 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128},
-		{127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 11: 127, 127, 14: 127, 42: 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 59: 127, 62: 127, 64: 127, 72: 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127},
-		{126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 11: 126, 126, 14: 126, 42: 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 59: 126, 62: 126, 64: 126, 72: 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126},
-		{125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 11: 125, 125, 14: 125, 42: 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 59: 125, 62: 125, 64: 125, 72: 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125},
-		{124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 11: 124, 124, 14: 124, 42: 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 59: 124, 62: 124, 64: 124, 72: 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124},
+		{215, 215, 215, 215, 215, 215, 7: 215, 215, 215, 215, 24: 215, 215, 27: 215, 215, 43: 215, 215, 47: 215, 215, 50: 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 69: 215, 78: 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215},
+		{1: 525, 524, 521, 6: 508, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 28: 933, 513, 509, 510, 511, 520, 519, 514, 512, 515, 517, 518, 516, 42: 526, 49: 522, 117: 523, 527, 121: 528, 529, 137: 530, 139: 531, 532, 533, 143: 534, 535, 148: 594},
+		{6: 920, 507, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 49: 921},
+		{7: 897},
+		{173, 173, 173, 173, 173, 173, 7: 173, 9: 173, 173, 24: 621, 27: 173, 43: 173, 173, 47: 173, 173, 50: 896, 173, 173, 173, 173, 173, 173, 173, 173, 173, 61: 173, 173, 173, 69: 173, 78: 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173},
 		// 50
-		{123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 11: 123, 123, 14: 123, 42: 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 59: 123, 62: 123, 64: 123, 72: 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123},
-		{122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 11: 122, 122, 14: 122, 42: 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 59: 122, 62: 122, 64: 122, 72: 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122},
-		{1: 291, 282, 292, 294, 293, 265, 289, 288, 10: 334, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 63: 266, 66: 284, 283, 286, 285, 296, 290, 84: 297, 298, 95: 299, 300, 301, 302, 303, 304, 306, 305, 307, 308, 108: 543},
-		{120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 11: 120, 120, 14: 120, 42: 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 59: 120, 62: 120, 64: 120, 72: 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120},
-		{119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 11: 119, 119, 14: 119, 42: 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 59: 119, 62: 119, 64: 119, 72: 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119},
+		{24: 891, 191: 892},
+		{24: 863},
+		{170, 170, 170, 170, 170, 170, 7: 170, 170, 170, 170, 24: 170, 170, 27: 170, 170, 43: 170, 170, 47: 170, 170, 50: 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 69: 170, 78: 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170},
+		{169, 169, 169, 169, 169, 169, 7: 169, 169, 169, 169, 24: 169, 169, 27: 169, 169, 43: 169, 169, 47: 169, 169, 50: 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 69: 169, 78: 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169},
+		{168, 168, 168, 168, 168, 168, 7: 168, 168, 168, 168, 24: 168, 168, 27: 168, 168, 43: 168, 168, 47: 168, 168, 50: 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 69: 168, 78: 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168},
 		// 55
-		{116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 11: 116, 116, 14: 116, 42: 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 59: 116, 62: 116, 64: 116, 72: 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116},
-		{106, 106, 356, 106, 106, 106, 355, 358, 357, 106, 11: 106, 106, 14: 106, 42: 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 59: 106, 62: 106, 64: 106, 72: 106, 106, 106, 106, 106, 106, 106, 106, 538, 106, 106, 106},
-		{80: 534},
-		{1: 291, 282, 292, 294, 293, 265, 289, 288, 10: 350, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 63: 266, 66: 284, 283, 351, 285, 533, 352},
-		{1: 291, 282, 292, 294, 293, 265, 289, 288, 10: 350, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 63: 266, 66: 284, 283, 351, 285, 532, 352},
+		{167, 167, 167, 167, 167, 167, 7: 167, 167, 167, 167, 24: 167, 167, 27: 167, 167, 43: 167, 167, 47: 167, 167, 50: 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 69: 167, 78: 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167},
+		{166, 166, 166, 166, 166, 166, 7: 166, 166, 166, 166, 24: 166, 166, 27: 166, 166, 43: 166, 166, 47: 166, 166, 50: 166, 166, 166, 166, 166, 166, 166, 166, 166, 166, 166, 166, 166, 166, 69: 166, 78: 166, 166, 166, 166, 166, 166, 166, 166, 166, 166, 166, 166},
+		{165, 165, 165, 165, 165, 165, 7: 165, 165, 165, 165, 24: 165, 165, 27: 165, 165, 43: 165, 165, 47: 165, 165, 50: 165, 165, 165, 165, 165, 165, 165, 165, 165, 165, 165, 165, 165, 165, 69: 165, 78: 165, 165, 165, 165, 165, 165, 165, 165, 165, 165, 165, 165},
+		{164, 164, 164, 164, 164, 164, 7: 164, 164, 164, 164, 24: 164, 164, 27: 164, 164, 43: 164, 164, 47: 164, 164, 50: 164, 164, 164, 164, 164, 164, 164, 164, 164, 164, 164, 164, 164, 164, 69: 164, 78: 164, 164, 164, 164, 164, 164, 164, 164, 164, 164, 164, 164},
+		{163, 163, 163, 163, 163, 163, 7: 163, 163, 163, 163, 24: 163, 163, 27: 163, 163, 43: 163, 163, 47: 163, 163, 50: 163, 163, 163, 163, 163, 163, 163, 163, 163, 163, 163, 163, 163, 163, 69: 163, 78: 163, 163, 163, 163, 163, 163, 163, 163, 163, 163, 163, 163},
 		// 60
-		{1: 291, 282, 292, 294, 293, 265, 289, 288, 10: 528, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 63: 266, 66: 284, 283, 351, 285, 354, 352},
-		{1: 102, 102, 102, 102, 102, 102, 102, 102, 10: 102, 13: 102, 15: 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102},
-		{1: 101, 101, 101, 101, 101, 101, 101, 101, 10: 101, 13: 101, 15: 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101},
-		{1: 100, 100, 100, 100, 100, 100, 100, 100, 10: 100, 13: 100, 15: 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100},
-		{1: 99, 99, 99, 99, 99, 99, 99, 99, 10: 99, 13: 99, 15: 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99},
+		{162, 162, 162, 162, 162, 162, 7: 162, 162, 162, 162, 24: 162, 162, 27: 162, 162, 43: 162, 162, 47: 162, 162, 50: 162, 162, 162, 162, 162, 162, 162, 162, 162, 162, 162, 162, 162, 162, 69: 162, 78: 162, 162, 162, 162, 162, 162, 162, 162, 162, 162, 162, 162},
+		{161, 161, 161, 161, 161, 161, 7: 161, 161, 161, 161, 24: 161, 161, 27: 161, 161, 43: 161, 161, 47: 161, 161, 50: 161, 161, 161, 161, 161, 161, 161, 161, 161, 161, 161, 161, 161, 161, 69: 161, 78: 161, 161, 161, 161, 161, 161, 161, 161, 161, 161, 161, 161},
+		{160, 160, 160, 160, 160, 160, 7: 160, 160, 160, 160, 24: 160, 160, 27: 160, 160, 43: 160, 160, 47: 160, 160, 50: 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 69: 160, 78: 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160},
+		{159, 159, 159, 159, 159, 159, 7: 159, 159, 159, 159, 24: 159, 159, 27: 159, 159, 43: 159, 159, 47: 159, 159, 50: 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 69: 159, 78: 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159},
+		{158, 158, 158, 158, 158, 158, 7: 158, 158, 158, 158, 24: 158, 158, 27: 158, 158, 43: 158, 158, 47: 158, 158, 50: 158, 158, 158, 158, 158, 158, 158, 158, 158, 158, 158, 158, 158, 158, 69: 158, 78: 158, 158, 158, 158, 158, 158, 158, 158, 158, 158, 158, 158},
 		// 65
-		{1: 98, 98, 98, 98, 98, 98, 98, 98, 10: 98, 13: 98, 15: 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98},
-		{97, 97, 3: 97, 97, 97, 9: 97, 11: 97, 97, 14: 97, 42: 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 59: 515, 62: 97, 64: 97, 72: 520, 524, 516, 518, 522, 519, 517, 526, 81: 523, 521, 525, 148: 514},
-		{93, 93, 3: 500, 93, 93, 9: 93, 11: 93, 93, 14: 93, 42: 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 62: 501, 64: 502},
-		{90, 90, 4: 498, 497, 9: 90, 11: 90, 90, 14: 90, 42: 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90},
-		{86, 86, 9: 86, 11: 86, 86, 14: 86, 42: 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 495, 493, 494},
+		{1: 442, 441, 429, 439, 440, 612, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 434, 433, 444, 438, 90: 445, 446, 447, 103: 448, 449, 450, 451, 452, 454, 453, 455, 456, 119: 861},
+		{156, 156, 156, 156, 156, 156, 7: 156, 156, 156, 156, 24: 156, 156, 27: 156, 156, 43: 156, 156, 47: 156, 156, 50: 156, 156, 156, 156, 156, 156, 156, 156, 156, 156, 156, 156, 156, 156, 69: 156, 78: 156, 156, 156, 156, 156, 156, 156, 156, 156, 156, 156, 156},
+		{155, 155, 155, 155, 155, 155, 7: 155, 155, 155, 155, 24: 155, 155, 27: 155, 155, 43: 155, 155, 47: 155, 155, 50: 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 69: 155, 78: 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155},
+		{154, 154, 154, 154, 154, 154, 7: 154, 154, 154, 154, 24: 154, 154, 27: 154, 154, 43: 154, 154, 47: 154, 154, 50: 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 69: 154, 78: 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 154},
+		{151, 151, 151, 151, 151, 151, 7: 151, 151, 151, 151, 24: 151, 151, 27: 151, 151, 43: 151, 151, 47: 151, 151, 50: 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 69: 151, 78: 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151},
 		// 70
-		{79, 79, 9: 79, 11: 79, 79, 14: 79, 42: 79, 79, 79, 79, 79, 79, 79, 486, 489, 491, 488, 490, 487},
-		{77, 484, 9: 77, 11: 77, 77, 14: 77, 42: 77, 77, 77, 77, 77, 77, 77},
-		{75, 9: 75, 11: 75, 75, 14: 75, 42: 75, 75, 75, 75, 75, 75, 482},
-		{73, 9: 73, 11: 73, 73, 14: 73, 42: 73, 73, 73, 73, 73, 480},
-		{71, 9: 71, 11: 71, 71, 14: 71, 42: 71, 71, 71, 71, 478},
+		{132, 132, 132, 487, 132, 132, 7: 486, 132, 490, 489, 24: 132, 132, 27: 132, 132, 43: 132, 132, 47: 132, 132, 50: 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 69: 132, 78: 856, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132},
+		{3: 852, 78: 851},
+		{1: 442, 441, 429, 439, 440, 484, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 629, 433, 850, 630},
+		{1: 442, 441, 429, 439, 440, 484, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 629, 433, 849, 630},
+		{1: 442, 441, 429, 439, 440, 845, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 629, 433, 633, 630},
 		// 75
-		{69, 9: 69, 11: 69, 69, 14: 69, 42: 69, 69, 473, 472},
-		{67, 9: 67, 11: 67, 67, 14: 67, 42: 67, 67},
-		{63, 9: 63, 11: 63, 63, 14: 63, 42: 63, 63},
-		{49, 11: 49, 49, 14: 49, 42: 49, 49},
-		{383, 12: 369},
+		{1: 128, 128, 128, 128, 128, 128, 128, 9: 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 26: 128, 29: 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 45: 128, 128},
+		{1: 127, 127, 127, 127, 127, 127, 127, 9: 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 26: 127, 29: 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 45: 127, 127},
+		{1: 126, 126, 126, 126, 126, 126, 126, 9: 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 26: 126, 29: 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 45: 126, 126},
+		{1: 125, 125, 125, 125, 125, 125, 125, 9: 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 26: 125, 29: 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 45: 125, 125},
+		{1: 124, 124, 124, 124, 124, 124, 124, 9: 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 26: 124, 29: 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 45: 124, 124},
 		// 80
-		{2: 431, 10: 430, 120: 432, 429},
-		{43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 13: 43, 15: 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 60: 43, 43, 65: 43, 86: 43, 43, 43, 43, 43, 43, 43, 43, 43},
-		{42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 13: 42, 15: 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 60: 42, 42, 65: 42, 86: 42, 42, 42, 42, 42, 42, 42, 42, 42},
-		{41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 13: 41, 15: 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 60: 41, 41, 65: 41, 86: 41, 41, 41, 41, 41, 41, 41, 41, 41},
-		{40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 13: 40, 15: 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 60: 40, 40, 65: 40, 86: 40, 40, 40, 40, 40, 40, 40, 40, 40},
+		{123, 123, 123, 4: 123, 123, 8: 123, 24: 123, 123, 27: 123, 123, 43: 123, 123, 47: 123, 123, 50: 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 69: 683, 79: 684, 688, 692, 686, 690, 687, 685, 694, 691, 689, 693, 155: 844},
+		{119, 119, 119, 4: 119, 830, 8: 119, 24: 119, 119, 27: 119, 119, 43: 119, 119, 47: 119, 119, 50: 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 831, 832, 119},
+		{116, 828, 827, 4: 116, 8: 116, 24: 116, 116, 27: 116, 116, 43: 116, 116, 47: 116, 116, 50: 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 63: 116},
+		{112, 4: 112, 8: 112, 24: 112, 112, 27: 112, 112, 43: 112, 112, 47: 112, 112, 50: 112, 112, 112, 112, 112, 112, 112, 825, 823, 824, 112, 63: 112},
+		{105, 4: 105, 8: 105, 24: 105, 105, 27: 105, 105, 43: 105, 105, 47: 105, 105, 50: 105, 816, 819, 821, 818, 820, 817, 60: 105, 63: 105},
 		// 85
-		{39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 13: 39, 15: 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 60: 39, 39, 65: 39, 86: 39, 39, 39, 39, 39, 39, 39, 39, 39},
-		{38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 13: 38, 15: 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 60: 38, 38, 65: 38, 86: 38, 38, 38, 38, 38, 38, 38, 38, 38},
-		{37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 13: 37, 15: 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 60: 37, 37, 65: 37, 86: 37, 37, 37, 37, 37, 37, 37, 37, 37},
-		{36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 13: 36, 15: 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 60: 36, 36, 65: 36, 86: 36, 36, 36, 36, 36, 36, 36, 36, 36},
-		{1: 291, 282, 292, 294, 293, 265, 289, 288, 10: 350, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 63: 266, 66: 284, 283, 351, 285, 335, 352, 84: 297, 298, 95: 299, 300, 301, 302, 303, 304, 353, 305, 129: 426},
+		{103, 4: 814, 8: 103, 24: 103, 103, 27: 103, 103, 43: 103, 103, 47: 103, 103, 50: 103, 60: 103, 63: 103},
+		{101, 8: 101, 24: 101, 101, 27: 101, 101, 43: 101, 101, 47: 101, 812, 50: 101, 60: 101, 63: 101},
+		{99, 8: 99, 24: 99, 99, 27: 99, 99, 43: 99, 99, 47: 810, 50: 99, 60: 99, 63: 99},
+		{97, 8: 97, 24: 97, 97, 27: 97, 97, 43: 97, 808, 50: 97, 60: 97, 63: 97},
+		{95, 8: 95, 24: 95, 95, 27: 95, 95, 43: 802, 50: 95, 60: 95, 63: 803},
 		// 90
-		{42: 424},
-		{416},
-		{326, 291, 282, 292, 294, 293, 265, 289, 288, 423, 267, 250, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 60: 239, 251, 63: 266, 65: 310, 284, 283, 286, 285, 296, 290, 84: 297, 298, 327, 332, 319, 331, 320, 329, 330, 333, 328, 299, 300, 301, 302, 303, 304, 306, 305, 307, 308, 108: 309, 312, 317, 313, 315, 318, 311, 314, 316, 325, 395, 324},
-		{30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 13: 30, 15: 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 60: 30, 30, 65: 30, 86: 30, 30, 30, 30, 30, 30, 30, 30, 30},
-		{28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 13: 28, 15: 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 60: 28, 28, 65: 28, 86: 28, 28, 28, 28, 28, 28, 28, 28, 28},
+		{93, 8: 93, 24: 93, 93, 27: 93, 93, 50: 93, 60: 93},
+		{89, 8: 89, 24: 89, 89, 27: 89, 89, 50: 89, 60: 89},
+		{75, 24: 75, 75, 27: 75, 75, 50: 75},
+		{679, 27: 642},
+		{3: 792, 6: 791, 145: 793, 790},
 		// 95
-		{27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 13: 27, 15: 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 60: 27, 27, 65: 27, 86: 27, 27, 27, 27, 27, 27, 27, 27, 27},
-		{26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 13: 26, 15: 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 60: 26, 26, 65: 26, 86: 26, 26, 26, 26, 26, 26, 26, 26, 26},
-		{1: 291, 282, 292, 294, 293, 265, 289, 288, 10: 350, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 63: 266, 66: 284, 283, 351, 285, 335, 352, 84: 297, 298, 95: 299, 300, 301, 302, 303, 304, 389, 305},
-		{2: 385},
-		{326, 291, 282, 292, 294, 293, 265, 289, 288, 10: 334, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 63: 266, 66: 284, 283, 286, 285, 296, 290, 84: 297, 298, 95: 299, 300, 301, 302, 303, 304, 306, 305, 307, 308, 108: 377, 111: 378},
+		{70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 26: 70, 29: 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 70, 45: 70, 70, 65: 70, 70, 70, 70, 93: 70, 70, 70, 70, 70, 70, 70, 70, 70, 70},
+		{68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 26: 68, 29: 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 45: 68, 68, 65: 68, 68, 68, 68, 93: 68, 68, 68, 68, 68, 68, 68, 68, 68, 68},
+		{67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 26: 67, 29: 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 45: 67, 67, 65: 67, 67, 67, 67, 93: 67, 67, 67, 67, 67, 67, 67, 67, 67, 67},
+		{66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 26: 66, 29: 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 66, 45: 66, 66, 65: 66, 66, 66, 66, 93: 66, 66, 66, 66, 66, 66, 66, 66, 66, 66},
+		{65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 26: 65, 29: 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 45: 65, 65, 65: 65, 65, 65, 65, 93: 65, 65, 65, 65, 65, 65, 65, 65, 65, 65},
 		// 100
-		{10: 375},
-		{374},
-		{373},
-		{338, 291, 282, 292, 294, 293, 265, 289, 288, 10: 334, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 63: 266, 66: 284, 283, 286, 285, 335, 290, 84: 297, 298, 95: 299, 300, 301, 302, 303, 304, 306, 305, 336, 155: 337},
-		{136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 11: 342, 136, 14: 136, 42: 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 59: 136, 62: 136, 64: 136, 72: 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136},
+		{64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 26: 64, 29: 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 45: 64, 64, 65: 64, 64, 64, 64, 93: 64, 64, 64, 64, 64, 64, 64, 64, 64, 64},
+		{63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 26: 63, 29: 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 45: 63, 63, 65: 63, 63, 63, 63, 93: 63, 63, 63, 63, 63, 63, 63, 63, 63, 63},
+		{62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 26: 62, 29: 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 45: 62, 62, 65: 62, 62, 62, 62, 93: 62, 62, 62, 62, 62, 62, 62, 62, 62, 62},
+		{61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 26: 61, 29: 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 45: 61, 61, 65: 61, 61, 61, 61, 93: 61, 61, 61, 61, 61, 61, 61, 61, 61, 61},
+		{60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 26: 60, 29: 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 45: 60, 60, 65: 60, 60, 60, 60, 93: 60, 60, 60, 60, 60, 60, 60, 60, 60, 60},
 		// 105
-		{97, 97, 3: 97, 97, 97, 9: 97, 11: 97, 97, 14: 97, 42: 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 62: 97, 64: 97},
-		{7, 12: 7},
-		{340, 12: 339},
-		{2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 13: 2, 15: 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 60: 2, 2, 65: 2, 86: 2, 2, 2, 2, 2, 2, 2, 2, 2},
-		{1: 291, 282, 292, 294, 293, 265, 289, 288, 10: 334, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 63: 266, 66: 284, 283, 286, 285, 335, 290, 84: 297, 298, 95: 299, 300, 301, 302, 303, 304, 306, 305, 341},
+		{475, 442, 441, 429, 439, 440, 413, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 394, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 380, 378, 395, 458, 70: 431, 430, 414, 432, 434, 433, 444, 438, 90: 445, 446, 447, 476, 481, 480, 483, 478, 479, 482, 477, 103: 448, 449, 450, 451, 452, 454, 453, 455, 456, 119: 457, 461, 123: 459, 466, 126: 462, 789, 473, 468, 464, 467, 460, 463, 465, 474, 469},
+		{781},
+		{475, 442, 441, 429, 439, 440, 413, 410, 788, 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 394, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 380, 378, 395, 458, 70: 431, 430, 414, 432, 434, 433, 444, 438, 90: 445, 446, 447, 476, 481, 480, 483, 478, 479, 482, 477, 103: 448, 449, 450, 451, 452, 454, 453, 455, 456, 119: 457, 461, 123: 459, 466, 126: 462, 714, 473, 468, 464, 467, 460, 463, 465, 474, 469},
+		{55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 26: 55, 29: 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 45: 55, 55, 65: 55, 55, 55, 55, 93: 55, 55, 55, 55, 55, 55, 55, 55, 55, 55},
+		{53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 26: 53, 29: 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 45: 53, 53, 65: 53, 53, 53, 53, 93: 53, 53, 53, 53, 53, 53, 53, 53, 53, 53},
 		// 110
-		{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 13: 1, 15: 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 60: 1, 1, 65: 1, 86: 1, 1, 1, 1, 1, 1, 1, 1, 1},
-		{6, 12: 6},
-		{9: 169, 343, 12: 169, 130: 344},
-		{42: 371},
-		{9: 346, 12: 345},
+		{52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 26: 52, 29: 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 45: 52, 52, 65: 52, 52, 52, 52, 93: 52, 52, 52, 52, 52, 52, 52, 52, 52, 52},
+		{51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 26: 51, 29: 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 45: 51, 51, 65: 51, 51, 51, 51, 93: 51, 51, 51, 51, 51, 51, 51, 51, 51, 51},
+		{1: 442, 441, 429, 439, 440, 484, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 629, 433, 631, 630, 90: 445, 446, 447, 103: 448, 449, 450, 451, 452, 755, 453},
+		{1: 442, 441, 429, 439, 440, 703, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 706, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 704, 433, 631, 630, 90: 445, 446, 447, 103: 448, 449, 450, 451, 452, 705, 453},
+		{475, 442, 441, 429, 439, 440, 612, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 434, 433, 668, 438, 90: 445, 446, 447, 103: 448, 449, 450, 451, 452, 454, 453, 455, 456, 119: 669, 126: 670, 138: 671},
 		// 115
-		{10: 347},
-		{66, 9: 66, 11: 66, 66, 14: 66, 42: 66, 66},
-		{42: 348},
-		{1: 291, 282, 292, 294, 293, 265, 289, 288, 10: 350, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 63: 266, 66: 284, 283, 351, 285, 335, 352, 84: 297, 298, 95: 299, 300, 301, 302, 303, 304, 353, 305, 129: 349},
-		{9: 167, 12: 167},
+		{6: 666},
+		{663, 6: 664},
+		{660, 6: 661},
+		{656, 442, 441, 429, 439, 440, 612, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 434, 433, 631, 438, 90: 445, 446, 447, 103: 448, 449, 450, 451, 452, 454, 453, 654, 198: 655},
+		{3: 429, 6: 484, 410, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 485, 433},
 		// 120
-		{136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 11: 136, 136, 14: 136, 42: 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 59: 136, 62: 136, 64: 136, 72: 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136},
-		{106, 106, 356, 106, 106, 106, 355, 358, 357, 106, 11: 106, 106, 14: 106, 42: 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 106, 59: 106, 62: 106, 64: 106, 72: 106, 106, 106, 106, 106, 106, 106, 106, 359, 106, 106, 106},
-		{1: 291, 282, 292, 294, 293, 265, 289, 288, 10: 350, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 63: 266, 66: 284, 283, 351, 285, 354, 352},
-		{9: 47, 12: 47, 42: 47},
-		{103, 103, 3: 103, 103, 103, 9: 103, 11: 103, 103, 14: 103, 42: 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 59: 103, 62: 103, 64: 103, 72: 103, 103, 103, 103, 103, 103, 103, 103, 81: 103, 103, 103},
+		{173, 173, 173, 173, 173, 173, 7: 173, 173, 173, 173, 24: 173, 173, 27: 173, 173, 43: 173, 173, 47: 173, 173, 50: 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 69: 173, 78: 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173},
+		{491, 3: 487, 7: 486, 9: 490, 489, 78: 488},
+		{1: 442, 441, 429, 439, 440, 612, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 637, 64: 411, 70: 431, 430, 414, 432, 434, 433, 444, 438, 90: 445, 446, 447, 103: 448, 449, 450, 451, 452, 454, 453, 455, 456, 119: 636},
+		{1: 442, 441, 429, 439, 440, 612, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 25: 613, 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 434, 433, 444, 438, 90: 445, 446, 447, 103: 448, 449, 450, 451, 452, 454, 453, 455, 615, 187: 614},
+		{3: 494, 6: 493, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 49: 492, 150: 495},
 		// 125
-		{1: 291, 282, 292, 294, 293, 265, 289, 288, 10: 334, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 63: 266, 66: 284, 283, 286, 285, 296, 290, 84: 297, 298, 95: 299, 300, 301, 302, 303, 304, 306, 305, 307, 308, 108: 367},
-		{1: 291, 282, 292, 294, 293, 265, 289, 288, 10: 334, 13: 268, 361, 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 63: 266, 66: 284, 283, 286, 285, 296, 290, 84: 297, 298, 95: 299, 300, 301, 302, 303, 304, 306, 305, 307, 363, 147: 362},
-		{111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 11: 111, 111, 14: 111, 42: 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 59: 111, 62: 111, 64: 111, 72: 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111},
-		{110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 11: 110, 110, 14: 110, 42: 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 59: 110, 62: 110, 64: 110, 72: 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110},
-		{10: 360},
+		{137, 137, 137, 137, 137, 137, 7: 137, 137, 137, 137, 24: 137, 137, 27: 137, 137, 43: 137, 137, 47: 137, 137, 50: 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 69: 137, 78: 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137},
+		{136, 136, 136, 136, 136, 136, 7: 136, 136, 136, 136, 24: 136, 136, 27: 136, 136, 43: 136, 136, 47: 136, 136, 50: 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 69: 136, 78: 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136},
+		{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 26: 1, 29: 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 45: 1, 1, 65: 1, 1, 1, 1, 93: 1, 1, 1, 1, 1, 1, 1, 1, 1, 1},
+		{153, 153, 153, 153, 153, 153, 7: 153, 153, 153, 153, 24: 153, 153, 27: 153, 153, 43: 153, 153, 47: 153, 153, 50: 153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 69: 153, 78: 153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 153},
+		{152, 152, 152, 152, 152, 152, 7: 152, 152, 152, 152, 24: 152, 152, 27: 152, 152, 43: 152, 152, 47: 152, 152, 50: 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 69: 152, 78: 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152},
 		// 130
-		{109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 11: 109, 109, 14: 109, 42: 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 59: 109, 62: 109, 64: 109, 72: 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109},
-		{113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 11: 113, 113, 14: 113, 42: 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 59: 113, 62: 113, 64: 113, 72: 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113},
-		{12: 365, 14: 364},
-		{12: 108, 14: 108},
-		{112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 11: 112, 112, 14: 112, 42: 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 59: 112, 62: 112, 64: 112, 72: 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112},
+		{5: 497, 501, 498, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 496, 41: 499, 49: 500, 64: 502, 125: 503},
+		{135, 135, 135, 135, 135, 135, 7: 135, 135, 135, 135, 24: 135, 135, 27: 135, 135, 43: 135, 135, 47: 135, 135, 50: 135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 69: 135, 78: 135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 135},
+		{3: 599, 183: 600},
+		{5: 497, 501, 498, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 496, 41: 499, 49: 500, 64: 502, 125: 598},
+		{1: 525, 524, 521, 6: 508, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 28: 595, 513, 509, 510, 511, 520, 519, 514, 512, 515, 517, 518, 516, 42: 526, 49: 522, 117: 523, 527, 121: 528, 529, 137: 530, 139: 531, 532, 533, 143: 534, 535, 148: 594},
 		// 135
-		{1: 291, 282, 292, 294, 293, 265, 289, 288, 10: 334, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 63: 266, 66: 284, 283, 286, 285, 296, 290, 84: 297, 298, 95: 299, 300, 301, 302, 303, 304, 306, 305, 307, 366},
-		{12: 107, 14: 107},
-		{12: 369, 43: 368},
-		{115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 11: 115, 115, 14: 115, 42: 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 59: 115, 62: 115, 64: 115, 72: 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115},
-		{1: 291, 282, 292, 294, 293, 265, 289, 288, 10: 334, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 63: 266, 66: 284, 283, 286, 285, 296, 290, 84: 297, 298, 95: 299, 300, 301, 302, 303, 304, 306, 305, 307, 370},
+		{7: 590},
+		{233, 25: 233, 27: 233, 233, 50: 233, 69: 233, 78: 588},
+		{232, 25: 232, 27: 232, 232, 50: 232, 69: 232, 78: 586},
+		{6: 506, 507, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 49: 505},
+		{25: 504},
 		// 140
-		{48, 11: 48, 48, 14: 48, 42: 48, 48},
-		{1: 291, 282, 292, 294, 293, 265, 289, 288, 10: 350, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 63: 266, 66: 284, 283, 351, 285, 335, 352, 84: 297, 298, 95: 299, 300, 301, 302, 303, 304, 353, 305, 129: 372},
-		{9: 168, 12: 168},
-		{3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 13: 3, 15: 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 60: 3, 3, 65: 3, 86: 3, 3, 3, 3, 3, 3, 3, 3, 3},
-		{4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 13: 4, 15: 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 60: 4, 4, 65: 4, 86: 4, 4, 4, 4, 4, 4, 4, 4, 4},
+		{138, 138, 138, 138, 138, 138, 7: 138, 138, 138, 138, 24: 138, 138, 27: 138, 138, 43: 138, 138, 47: 138, 138, 50: 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 69: 138, 78: 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138},
+		{231, 25: 231, 27: 231, 231, 50: 231, 69: 231},
+		{230, 25: 230, 27: 230, 230, 50: 230, 69: 230},
+		{1: 525, 524, 521, 6: 508, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 29: 513, 509, 510, 511, 520, 519, 514, 512, 515, 517, 518, 516, 42: 526, 49: 522, 117: 523, 527, 121: 528, 529, 137: 530, 139: 531, 532, 533, 143: 534, 535, 148: 536},
+		{340, 340, 340, 4: 340, 340, 25: 340, 28: 340, 43: 340, 340, 47: 340, 340, 51: 340, 340, 340, 340, 340, 340, 340, 340, 340, 61: 340, 340, 78: 584},
 		// 145
-		{376},
-		{5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 13: 5, 15: 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 60: 5, 5, 65: 5, 86: 5, 5, 5, 5, 5, 5, 5, 5, 5},
-		{383, 11: 250, 369, 109: 384},
-		{326, 291, 282, 292, 294, 293, 265, 289, 288, 10: 334, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 63: 266, 66: 284, 283, 286, 285, 296, 290, 84: 297, 298, 95: 299, 300, 301, 302, 303, 304, 306, 305, 307, 308, 108: 309, 111: 379},
-		{1: 291, 282, 292, 294, 293, 265, 289, 288, 10: 334, 250, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 63: 266, 66: 284, 283, 286, 285, 296, 290, 84: 297, 298, 95: 299, 300, 301, 302, 303, 304, 306, 305, 307, 308, 108: 380, 381},
+		{338, 338, 338, 4: 338, 338, 25: 338, 28: 338, 43: 338, 338, 47: 338, 338, 51: 338, 338, 338, 338, 338, 338, 338, 338, 338, 61: 338, 338},
+		{337, 337, 337, 4: 337, 337, 25: 337, 28: 337, 43: 337, 337, 47: 337, 337, 51: 337, 337, 337, 337, 337, 337, 337, 337, 337, 61: 337, 337},
+		{336, 336, 336, 4: 336, 336, 25: 336, 28: 336, 43: 336, 336, 47: 336, 336, 51: 336, 336, 336, 336, 336, 336, 336, 336, 336, 61: 336, 336},
+		{335, 335, 335, 4: 335, 335, 25: 335, 28: 335, 43: 335, 335, 47: 335, 335, 51: 335, 335, 335, 335, 335, 335, 335, 335, 335, 61: 335, 335},
+		{334, 334, 334, 4: 334, 334, 25: 334, 28: 334, 43: 334, 334, 47: 334, 334, 51: 334, 334, 334, 334, 334, 334, 334, 334, 334, 61: 334, 334},
 		// 150
-		{11: 250, 369, 109: 382},
-		{9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 13: 9, 15: 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 60: 9, 9, 65: 9, 86: 9, 9, 9, 9, 9, 9, 9, 9, 9},
-		{8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 13: 8, 15: 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 60: 8, 8, 65: 8, 86: 8, 8, 8, 8, 8, 8, 8, 8, 8},
-		{25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 13: 25, 15: 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 60: 25, 25, 65: 25, 86: 25, 25, 25, 25, 25, 25, 25, 25, 25},
-		{10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 13: 10, 15: 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 60: 10, 10, 65: 10, 86: 10, 10, 10, 10, 10, 10, 10, 10, 10},
+		{333, 333, 333, 4: 333, 333, 25: 333, 28: 333, 43: 333, 333, 47: 333, 333, 51: 333, 333, 333, 333, 333, 333, 333, 333, 333, 61: 333, 333},
+		{332, 332, 332, 4: 332, 332, 25: 332, 28: 332, 43: 332, 332, 47: 332, 332, 51: 332, 332, 332, 332, 332, 332, 332, 332, 332, 61: 332, 332},
+		{331, 331, 331, 4: 331, 331, 25: 331, 28: 331, 43: 331, 331, 47: 331, 331, 51: 331, 331, 331, 331, 331, 331, 331, 331, 331, 61: 331, 331},
+		{330, 330, 330, 4: 330, 330, 25: 330, 28: 330, 43: 330, 330, 47: 330, 330, 51: 330, 330, 330, 330, 330, 330, 330, 330, 330, 61: 330, 330},
+		{329, 329, 329, 4: 329, 329, 25: 329, 28: 329, 43: 329, 329, 47: 329, 329, 51: 329, 329, 329, 329, 329, 329, 329, 329, 329, 61: 329, 329},
 		// 155
-		{1: 291, 282, 292, 294, 293, 265, 289, 288, 10: 334, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 63: 266, 66: 284, 283, 286, 285, 296, 290, 84: 297, 298, 95: 299, 300, 301, 302, 303, 304, 306, 305, 307, 308, 108: 386},
-		{12: 369, 14: 387},
-		{326, 291, 282, 292, 294, 293, 265, 289, 288, 10: 267, 250, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 60: 239, 251, 63: 266, 66: 284, 283, 286, 285, 296, 290, 84: 297, 298, 327, 332, 319, 331, 320, 329, 330, 333, 328, 299, 300, 301, 302, 303, 304, 306, 305, 307, 308, 108: 309, 312, 317, 313, 315, 318, 311, 314, 316, 388},
-		{17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 13: 17, 15: 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 60: 17, 17, 65: 17, 86: 17, 17, 17, 17, 17, 17, 17, 17, 17},
-		{11: 390, 109: 391},
+		{328, 328, 328, 4: 328, 328, 25: 328, 28: 328, 43: 328, 328, 47: 328, 328, 51: 328, 328, 328, 328, 328, 328, 328, 328, 328, 61: 328, 328},
+		{327, 327, 327, 4: 327, 327, 25: 327, 28: 327, 43: 327, 327, 47: 327, 327, 51: 327, 327, 327, 327, 327, 327, 327, 327, 327, 61: 327, 327},
+		{1: 525, 524, 521, 6: 508, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 29: 513, 509, 510, 511, 520, 519, 514, 512, 515, 517, 518, 516, 42: 526, 49: 522, 117: 523, 527, 121: 528, 529, 137: 530, 139: 531, 532, 533, 143: 534, 535, 148: 582},
+		{3: 579},
+		{324, 324, 324, 4: 324, 324, 25: 324, 28: 324, 43: 324, 324, 47: 324, 324, 51: 324, 324, 324, 324, 324, 324, 324, 324, 324, 61: 324, 324},
 		// 160
-		{326, 291, 282, 292, 294, 293, 265, 289, 288, 392, 267, 250, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 60: 239, 251, 63: 266, 65: 310, 284, 283, 286, 285, 296, 290, 84: 297, 298, 327, 332, 319, 331, 320, 329, 330, 333, 328, 299, 300, 301, 302, 303, 304, 306, 305, 307, 308, 108: 309, 312, 317, 313, 315, 318, 311, 314, 316, 325, 323, 324, 125: 393},
-		{18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 13: 18, 15: 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 60: 18, 18, 65: 18, 86: 18, 18, 18, 18, 18, 18, 18, 18, 18},
-		{416, 122: 399, 126: 417, 400, 132: 418},
-		{326, 291, 282, 292, 294, 293, 265, 289, 288, 394, 267, 250, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 60: 239, 251, 63: 266, 65: 310, 284, 283, 286, 285, 296, 290, 84: 297, 298, 327, 332, 319, 331, 320, 329, 330, 333, 328, 299, 300, 301, 302, 303, 304, 306, 305, 307, 308, 108: 309, 312, 317, 313, 315, 318, 311, 314, 316, 325, 395, 324},
-		{396, 122: 399, 126: 398, 400, 132: 397},
+		{1: 525, 524, 521, 6: 508, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 29: 513, 509, 510, 511, 520, 519, 514, 512, 515, 517, 518, 516, 42: 526, 49: 522, 117: 523, 578},
+		{1: 525, 524, 521, 6: 508, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 29: 513, 509, 510, 511, 520, 519, 514, 512, 515, 517, 518, 516, 42: 526, 49: 522, 117: 523, 577},
+		{1: 525, 524, 521, 6: 508, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 29: 513, 509, 510, 511, 520, 519, 514, 512, 515, 517, 518, 516, 42: 526, 49: 522, 117: 523, 576},
+		{320, 320, 320, 4: 320, 320, 25: 320, 28: 320, 43: 320, 320, 47: 320, 320, 51: 320, 320, 320, 320, 320, 320, 320, 320, 320, 61: 320, 320},
+		{316, 316, 316, 4: 316, 562, 25: 316, 28: 316, 43: 316, 316, 47: 316, 316, 51: 316, 316, 316, 316, 316, 316, 316, 316, 316, 61: 563, 564},
 		// 165
-		{29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 13: 29, 15: 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 60: 29, 29, 65: 29, 86: 29, 29, 29, 29, 29, 29, 29, 29, 29},
-		{31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 13: 31, 15: 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 60: 31, 31, 65: 31, 86: 31, 31, 31, 31, 31, 31, 31, 31, 31, 105: 31, 31, 31},
-		{413, 122: 399, 126: 412, 414},
-		{411},
-		{11: 402, 86: 401},
+		{313, 560, 559, 4: 313, 25: 313, 28: 313, 43: 313, 313, 47: 313, 313, 51: 313, 313, 313, 313, 313, 313, 313, 313, 313},
+		{309, 4: 309, 25: 309, 28: 309, 43: 309, 309, 47: 309, 309, 51: 309, 309, 309, 309, 309, 309, 557, 555, 556},
+		{302, 4: 302, 25: 302, 28: 302, 43: 302, 302, 47: 302, 302, 51: 548, 551, 553, 550, 552, 549},
+		{300, 4: 546, 25: 300, 28: 300, 43: 300, 300, 47: 300, 300},
+		{298, 25: 298, 28: 298, 43: 298, 298, 47: 298, 544},
 		// 170
-		{14, 122: 14},
-		{1: 291, 282, 292, 294, 293, 265, 289, 288, 10: 350, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 63: 266, 66: 284, 283, 351, 285, 335, 352, 84: 297, 298, 95: 299, 300, 301, 302, 303, 304, 406, 305},
-		{326, 291, 282, 292, 294, 293, 265, 289, 288, 404, 267, 250, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 60: 239, 251, 63: 266, 65: 310, 284, 283, 286, 285, 296, 290, 84: 297, 298, 327, 332, 319, 331, 320, 329, 330, 333, 328, 299, 300, 301, 302, 303, 304, 306, 305, 307, 308, 108: 309, 312, 317, 313, 315, 318, 311, 314, 316, 325, 323, 324, 125: 403},
-		{326, 291, 282, 292, 294, 293, 265, 289, 288, 405, 267, 250, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 60: 239, 251, 63: 266, 65: 310, 284, 283, 286, 285, 296, 290, 84: 297, 298, 327, 332, 319, 331, 320, 329, 330, 333, 328, 299, 300, 301, 302, 303, 304, 306, 305, 307, 308, 108: 309, 312, 317, 313, 315, 318, 311, 314, 316, 325, 395, 324},
-		{11},
+		{296, 25: 296, 28: 296, 43: 296, 296, 47: 542},
+		{294, 25: 294, 28: 294, 43: 294, 540},
+		{28: 538, 43: 537},
+		{1: 525, 524, 521, 6: 508, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 29: 513, 509, 510, 511, 520, 519, 514, 512, 515, 517, 518, 516, 42: 526, 49: 522, 117: 523, 527, 121: 528, 529, 137: 530, 139: 531, 532, 533, 143: 534, 539},
+		{6: 208, 208, 11: 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208, 208},
 		// 175
-		{12},
-		{11: 407},
-		{326, 291, 282, 292, 294, 293, 265, 289, 288, 409, 267, 250, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 60: 239, 251, 63: 266, 65: 310, 284, 283, 286, 285, 296, 290, 84: 297, 298, 327, 332, 319, 331, 320, 329, 330, 333, 328, 299, 300, 301, 302, 303, 304, 306, 305, 307, 308, 108: 309, 312, 317, 313, 315, 318, 311, 314, 316, 325, 323, 324, 125: 408},
-		{326, 291, 282, 292, 294, 293, 265, 289, 288, 410, 267, 250, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 60: 239, 251, 63: 266, 65: 310, 284, 283, 286, 285, 296, 290, 84: 297, 298, 327, 332, 319, 331, 320, 329, 330, 333, 328, 299, 300, 301, 302, 303, 304, 306, 305, 307, 308, 108: 309, 312, 317, 313, 315, 318, 311, 314, 316, 325, 395, 324},
-		{15, 122: 15},
+		{293, 25: 293, 28: 293, 43: 293, 540},
+		{1: 525, 524, 521, 6: 508, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 29: 513, 509, 510, 511, 520, 519, 514, 512, 515, 517, 518, 516, 42: 526, 49: 522, 117: 523, 527, 121: 528, 529, 137: 530, 139: 531, 532, 533, 143: 541},
+		{295, 25: 295, 28: 295, 43: 295, 295, 47: 542},
+		{1: 525, 524, 521, 6: 508, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 29: 513, 509, 510, 511, 520, 519, 514, 512, 515, 517, 518, 516, 42: 526, 49: 522, 117: 523, 527, 121: 528, 529, 137: 530, 139: 531, 532, 543},
+		{297, 25: 297, 28: 297, 43: 297, 297, 47: 297, 544},
 		// 180
-		{16, 122: 16},
-		{23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 13: 23, 15: 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 60: 23, 23, 65: 23, 86: 23, 23, 23, 23, 23, 23, 23, 23, 23},
-		{415},
-		{21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 13: 21, 15: 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 60: 21, 21, 65: 21, 86: 21, 21, 21, 21, 21, 21, 21, 21, 21},
-		{13, 122: 13},
+		{1: 525, 524, 521, 6: 508, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 29: 513, 509, 510, 511, 520, 519, 514, 512, 515, 517, 518, 516, 42: 526, 49: 522, 117: 523, 527, 121: 528, 529, 137: 530, 139: 531, 545},
+		{299, 4: 546, 25: 299, 28: 299, 43: 299, 299, 47: 299, 299},
+		{1: 525, 524, 521, 6: 508, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 29: 513, 509, 510, 511, 520, 519, 514, 512, 515, 517, 518, 516, 42: 526, 49: 522, 117: 523, 527, 121: 528, 529, 137: 530, 139: 547},
+		{301, 4: 301, 25: 301, 28: 301, 43: 301, 301, 47: 301, 301, 51: 548, 551, 553, 550, 552, 549},
+		{1: 525, 524, 521, 6: 508, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 29: 513, 509, 510, 511, 520, 519, 514, 512, 515, 517, 518, 516, 42: 526, 49: 522, 117: 523, 527, 121: 528, 529, 137: 575},
 		// 185
-		{24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 13: 24, 15: 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 60: 24, 24, 65: 24, 86: 24, 24, 24, 24, 24, 24, 24, 24, 24},
-		{32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 13: 32, 15: 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 60: 32, 32, 65: 32, 86: 32, 32, 32, 32, 32, 32, 32, 32, 32, 105: 32, 32, 32},
-		{422},
-		{419, 122: 399, 126: 420, 414},
-		{20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 13: 20, 15: 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 60: 20, 20, 65: 20, 86: 20, 20, 20, 20, 20, 20, 20, 20, 20},
+		{1: 525, 524, 521, 6: 508, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 29: 513, 509, 510, 511, 520, 519, 514, 512, 515, 517, 518, 516, 42: 526, 49: 522, 117: 523, 527, 121: 528, 529, 137: 574},
+		{1: 525, 524, 521, 6: 508, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 29: 513, 509, 510, 511, 520, 519, 514, 512, 515, 517, 518, 516, 42: 526, 49: 522, 117: 523, 527, 121: 528, 529, 137: 573},
+		{1: 525, 524, 521, 6: 508, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 29: 513, 509, 510, 511, 520, 519, 514, 512, 515, 517, 518, 516, 42: 526, 49: 522, 117: 523, 527, 121: 528, 529, 137: 572},
+		{1: 525, 524, 521, 6: 508, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 29: 513, 509, 510, 511, 520, 519, 514, 512, 515, 517, 518, 516, 42: 526, 49: 522, 117: 523, 527, 121: 528, 529, 137: 571},
+		{1: 525, 524, 521, 6: 508, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 29: 513, 509, 510, 511, 520, 519, 514, 512, 515, 517, 518, 516, 42: 526, 49: 522, 117: 523, 527, 121: 528, 529, 137: 554},
 		// 190
-		{421},
-		{19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 13: 19, 15: 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 60: 19, 19, 65: 19, 86: 19, 19, 19, 19, 19, 19, 19, 19, 19},
-		{22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 13: 22, 15: 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 60: 22, 22, 65: 22, 86: 22, 22, 22, 22, 22, 22, 22, 22, 22},
-		{396},
-		{326, 291, 282, 292, 294, 293, 265, 289, 288, 10: 267, 250, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 60: 239, 251, 63: 266, 66: 284, 283, 286, 285, 296, 290, 84: 297, 298, 327, 332, 319, 331, 320, 329, 330, 333, 328, 299, 300, 301, 302, 303, 304, 306, 305, 307, 308, 108: 309, 312, 317, 313, 315, 318, 311, 314, 316, 425},
+		{303, 4: 303, 25: 303, 28: 303, 43: 303, 303, 47: 303, 303, 51: 303, 303, 303, 303, 303, 303, 557, 555, 556},
+		{1: 525, 524, 521, 6: 508, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 29: 513, 509, 510, 511, 520, 519, 514, 512, 515, 517, 518, 516, 42: 526, 49: 522, 117: 523, 527, 121: 528, 570},
+		{1: 525, 524, 521, 6: 508, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 29: 513, 509, 510, 511, 520, 519, 514, 512, 515, 517, 518, 516, 42: 526, 49: 522, 117: 523, 527, 121: 528, 569},
+		{1: 525, 524, 521, 6: 508, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 29: 513, 509, 510, 511, 520, 519, 514, 512, 515, 517, 518, 516, 42: 526, 49: 522, 117: 523, 527, 121: 528, 558},
+		{310, 560, 559, 4: 310, 25: 310, 28: 310, 43: 310, 310, 47: 310, 310, 51: 310, 310, 310, 310, 310, 310, 310, 310, 310},
 		// 195
-		{33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 13: 33, 15: 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 60: 33, 33, 65: 33, 86: 33, 33, 33, 33, 33, 33, 33, 33, 33},
-		{42: 427},
-		{326, 291, 282, 292, 294, 293, 265, 289, 288, 10: 267, 250, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 60: 239, 251, 63: 266, 66: 284, 283, 286, 285, 296, 290, 84: 297, 298, 327, 332, 319, 331, 320, 329, 330, 333, 328, 299, 300, 301, 302, 303, 304, 306, 305, 307, 308, 108: 309, 312, 317, 313, 315, 318, 311, 314, 316, 428},
-		{34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 13: 34, 15: 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 60: 34, 34, 65: 34, 86: 34, 34, 34, 34, 34, 34, 34, 34, 34},
-		{3: 200, 6: 200, 10: 200, 13: 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200},
+		{1: 525, 524, 521, 6: 508, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 29: 513, 509, 510, 511, 520, 519, 514, 512, 515, 517, 518, 516, 42: 526, 49: 522, 117: 523, 527, 121: 568},
+		{1: 525, 524, 521, 6: 508, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 29: 513, 509, 510, 511, 520, 519, 514, 512, 515, 517, 518, 516, 42: 526, 49: 522, 117: 523, 527, 121: 561},
+		{314, 314, 314, 4: 314, 562, 25: 314, 28: 314, 43: 314, 314, 47: 314, 314, 51: 314, 314, 314, 314, 314, 314, 314, 314, 314, 61: 563, 564},
+		{1: 525, 524, 521, 6: 508, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 29: 513, 509, 510, 511, 520, 519, 514, 512, 515, 517, 518, 516, 42: 526, 49: 522, 117: 523, 567},
+		{1: 525, 524, 521, 6: 508, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 29: 513, 509, 510, 511, 520, 519, 514, 512, 515, 517, 518, 516, 42: 526, 49: 522, 117: 523, 566},
 		// 200
-		{3: 199, 6: 199, 10: 199, 13: 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199},
-		{2: 431, 10: 430, 120: 470, 429},
-		{3: 434, 6: 435, 10: 437, 13: 433, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 58: 436, 63: 438, 123: 439},
-		{2: 459, 145: 460},
-		{3: 434, 6: 435, 10: 437, 13: 433, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 58: 436, 63: 438, 123: 458},
+		{1: 525, 524, 521, 6: 508, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 29: 513, 509, 510, 511, 520, 519, 514, 512, 515, 517, 518, 516, 42: 526, 49: 522, 117: 523, 565},
+		{317, 317, 317, 4: 317, 317, 25: 317, 28: 317, 43: 317, 317, 47: 317, 317, 51: 317, 317, 317, 317, 317, 317, 317, 317, 317, 61: 317, 317},
+		{318, 318, 318, 4: 318, 318, 25: 318, 28: 318, 43: 318, 318, 47: 318, 318, 51: 318, 318, 318, 318, 318, 318, 318, 318, 318, 61: 318, 318},
+		{319, 319, 319, 4: 319, 319, 25: 319, 28: 319, 43: 319, 319, 47: 319, 319, 51: 319, 319, 319, 319, 319, 319, 319, 319, 319, 61: 319, 319},
+		{315, 315, 315, 4: 315, 562, 25: 315, 28: 315, 43: 315, 315, 47: 315, 315, 51: 315, 315, 315, 315, 315, 315, 315, 315, 315, 61: 563, 564},
 		// 205
-		{28: 455, 43: 454},
-		{188, 12: 188, 14: 188, 59: 188, 80: 452},
-		{187, 12: 187, 14: 187, 59: 187, 80: 450},
-		{6: 447, 10: 446, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 58: 445},
-		{440, 59: 441},
+		{311, 560, 559, 4: 311, 25: 311, 28: 311, 43: 311, 311, 47: 311, 311, 51: 311, 311, 311, 311, 311, 311, 311, 311, 311},
+		{312, 560, 559, 4: 312, 25: 312, 28: 312, 43: 312, 312, 47: 312, 312, 51: 312, 312, 312, 312, 312, 312, 312, 312, 312},
+		{304, 4: 304, 25: 304, 28: 304, 43: 304, 304, 47: 304, 304, 51: 304, 304, 304, 304, 304, 304, 557, 555, 556},
+		{305, 4: 305, 25: 305, 28: 305, 43: 305, 305, 47: 305, 305, 51: 305, 305, 305, 305, 305, 305, 557, 555, 556},
+		{306, 4: 306, 25: 306, 28: 306, 43: 306, 306, 47: 306, 306, 51: 306, 306, 306, 306, 306, 306, 557, 555, 556},
 		// 210
-		{46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 13: 46, 15: 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 60: 46, 46, 65: 46, 86: 46, 46, 46, 46, 46, 46, 46, 46, 46},
-		{1: 291, 282, 292, 294, 293, 265, 289, 288, 10: 334, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 63: 266, 66: 284, 283, 286, 285, 296, 290, 84: 297, 298, 95: 299, 300, 301, 302, 303, 304, 306, 305, 307, 443, 139: 442},
-		{444},
-		{44},
-		{45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 13: 45, 15: 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 60: 45, 45, 65: 45, 86: 45, 45, 45, 45, 45, 45, 45, 45, 45},
+		{307, 4: 307, 25: 307, 28: 307, 43: 307, 307, 47: 307, 307, 51: 307, 307, 307, 307, 307, 307, 557, 555, 556},
+		{308, 4: 308, 25: 308, 28: 308, 43: 308, 308, 47: 308, 308, 51: 308, 308, 308, 308, 308, 308, 557, 555, 556},
+		{321, 321, 321, 4: 321, 321, 25: 321, 28: 321, 43: 321, 321, 47: 321, 321, 51: 321, 321, 321, 321, 321, 321, 321, 321, 321, 61: 321, 321},
+		{322, 322, 322, 4: 322, 322, 25: 322, 28: 322, 43: 322, 322, 47: 322, 322, 51: 322, 322, 322, 322, 322, 322, 322, 322, 322, 61: 322, 322},
+		{323, 323, 323, 4: 323, 323, 25: 323, 28: 323, 43: 323, 323, 47: 323, 323, 51: 323, 323, 323, 323, 323, 323, 323, 323, 323, 61: 323, 323},
 		// 215
-		{186, 12: 186, 14: 186, 59: 186},
-		{185, 12: 185, 14: 185, 59: 185},
-		{28: 448},
-		{43: 449},
-		{6: 163, 10: 163, 15: 163, 163, 163, 163, 163, 163, 163, 163, 163, 163, 163, 163, 163},
+		{1: 525, 524, 521, 6: 508, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 29: 513, 509, 510, 511, 520, 519, 514, 512, 515, 517, 518, 516, 42: 526, 49: 522, 117: 523, 527, 121: 528, 529, 137: 530, 139: 531, 532, 533, 143: 534, 535, 148: 580},
+		{25: 581, 43: 537},
+		{325, 325, 325, 4: 325, 325, 25: 325, 28: 325, 43: 325, 325, 47: 325, 325, 51: 325, 325, 325, 325, 325, 325, 325, 325, 325, 61: 325, 325},
+		{25: 583, 43: 537},
+		{326, 326, 326, 4: 326, 326, 25: 326, 28: 326, 43: 326, 326, 47: 326, 326, 51: 326, 326, 326, 326, 326, 326, 326, 326, 326, 61: 326, 326},
 		// 220
-		{10: 451},
-		{184, 12: 184, 14: 184, 59: 184},
-		{10: 453},
-		{183, 12: 183, 14: 183, 59: 183},
-		{3: 434, 6: 435, 10: 437, 13: 433, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 58: 436, 63: 438, 123: 457},
+		{6: 585},
+		{339, 339, 339, 4: 339, 339, 25: 339, 28: 339, 43: 339, 339, 47: 339, 339, 51: 339, 339, 339, 339, 339, 339, 339, 339, 339, 61: 339, 339},
+		{6: 587},
+		{229, 25: 229, 27: 229, 229, 50: 229, 69: 229},
+		{6: 589},
 		// 225
-		{43: 456},
-		{6: 164, 10: 164, 15: 164, 164, 164, 164, 164, 164, 164, 164, 164, 164, 164, 164, 164},
-		{189, 12: 189, 14: 189, 59: 189},
-		{190, 12: 190, 14: 190, 59: 190},
-		{10: 462, 14: 465, 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 58: 463, 150: 464},
+		{228, 25: 228, 27: 228, 228, 50: 228, 69: 228},
+		{5: 497, 501, 498, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 496, 41: 499, 49: 500, 64: 502, 125: 591},
+		{28: 592},
+		{5: 497, 501, 498, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 496, 41: 499, 49: 500, 64: 502, 125: 593},
+		{234, 25: 234, 27: 234, 234, 50: 234, 69: 234},
 		// 230
-		{2: 459, 145: 461},
-		{191, 12: 191, 14: 191, 59: 191},
-		{12: 197, 14: 197},
-		{12: 196, 14: 196},
-		{12: 466, 14: 467},
+		{28: 597, 43: 537},
+		{5: 497, 501, 498, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 496, 41: 499, 49: 500, 64: 502, 125: 596},
+		{235, 25: 235, 27: 235, 235, 50: 235, 69: 235},
+		{6: 209, 209, 11: 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209, 209},
+		{236, 25: 236, 27: 236, 236, 50: 236, 69: 236},
 		// 235
-		{192, 2: 192, 12: 192, 14: 192, 59: 192},
-		{10: 468, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 58: 469},
-		{193, 2: 193, 12: 193, 14: 193, 59: 193},
-		{12: 195, 14: 195},
-		{12: 194, 14: 194},
+		{5: 497, 501, 498, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 25: 605, 496, 41: 499, 49: 500, 60: 604, 64: 502, 125: 602, 189: 603},
+		{237, 3: 599, 25: 237, 27: 237, 237, 50: 237, 69: 237, 183: 601},
+		{238, 25: 238, 27: 238, 238, 50: 238, 69: 238},
+		{25: 244, 27: 244},
+		{25: 608, 27: 607},
 		// 240
-		{14: 471},
-		{3: 198, 6: 198, 10: 198, 13: 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198},
-		{1: 291, 282, 292, 294, 293, 265, 289, 288, 10: 350, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 63: 266, 66: 284, 283, 351, 285, 335, 352, 84: 297, 298, 95: 299, 300, 301, 302, 303, 477},
-		{1: 291, 282, 292, 294, 293, 265, 289, 288, 10: 334, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 63: 266, 66: 284, 283, 286, 285, 296, 290, 84: 297, 298, 95: 299, 300, 301, 302, 303, 304, 306, 305, 307, 308, 108: 474},
-		{12: 369, 42: 475},
+		{5: 497, 501, 498, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 496, 41: 499, 49: 500, 64: 502, 125: 606},
+		{239, 3: 239, 25: 239, 27: 239, 239, 50: 239, 69: 239},
+		{25: 242, 27: 242},
+		{5: 497, 501, 498, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 496, 41: 499, 49: 500, 60: 610, 64: 502, 125: 609},
+		{240, 3: 240, 25: 240, 27: 240, 240, 50: 240, 69: 240},
 		// 245
-		{1: 291, 282, 292, 294, 293, 265, 289, 288, 10: 350, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 63: 266, 66: 284, 283, 351, 285, 335, 352, 84: 297, 298, 95: 299, 300, 301, 302, 303, 304, 476, 305},
-		{68, 9: 68, 11: 68, 68, 14: 68, 42: 68, 68},
-		{70, 9: 70, 11: 70, 70, 14: 70, 42: 70, 70, 70, 70, 478},
-		{1: 291, 282, 292, 294, 293, 265, 289, 288, 10: 350, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 63: 266, 66: 284, 283, 351, 285, 335, 352, 84: 297, 298, 95: 299, 300, 301, 302, 479},
-		{72, 9: 72, 11: 72, 72, 14: 72, 42: 72, 72, 72, 72, 72, 480},
+		{25: 243, 27: 243},
+		{5: 497, 501, 498, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 496, 41: 499, 49: 500, 64: 502, 125: 611},
+		{25: 241, 27: 241},
+		{173, 173, 173, 173, 173, 173, 7: 173, 173, 173, 173, 24: 621, 173, 27: 173, 173, 43: 173, 173, 47: 173, 173, 50: 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 69: 173, 78: 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173},
+		{141, 141, 141, 141, 141, 141, 7: 141, 141, 141, 141, 24: 141, 141, 27: 141, 141, 43: 141, 141, 47: 141, 141, 50: 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 69: 141, 78: 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141},
 		// 250
-		{1: 291, 282, 292, 294, 293, 265, 289, 288, 10: 350, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 63: 266, 66: 284, 283, 351, 285, 335, 352, 84: 297, 298, 95: 299, 300, 301, 481},
-		{74, 9: 74, 11: 74, 74, 14: 74, 42: 74, 74, 74, 74, 74, 74, 482},
-		{1: 291, 282, 292, 294, 293, 265, 289, 288, 10: 350, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 63: 266, 66: 284, 283, 351, 285, 335, 352, 84: 297, 298, 95: 299, 300, 483},
-		{76, 484, 9: 76, 11: 76, 76, 14: 76, 42: 76, 76, 76, 76, 76, 76, 76},
-		{1: 291, 282, 292, 294, 293, 265, 289, 288, 10: 350, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 63: 266, 66: 284, 283, 351, 285, 335, 352, 84: 297, 298, 95: 299, 485},
+		{25: 616, 27: 618, 60: 617},
+		{25: 134, 27: 134, 60: 134},
+		{140, 140, 140, 140, 140, 140, 7: 140, 140, 140, 140, 24: 140, 140, 27: 140, 140, 43: 140, 140, 47: 140, 140, 50: 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 69: 140, 78: 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140},
+		{25: 620},
+		{1: 442, 441, 429, 439, 440, 612, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 434, 433, 444, 438, 90: 445, 446, 447, 103: 448, 449, 450, 451, 452, 454, 453, 455, 619},
 		// 255
-		{78, 78, 9: 78, 11: 78, 78, 14: 78, 42: 78, 78, 78, 78, 78, 78, 78, 486, 489, 491, 488, 490, 487},
-		{1: 291, 282, 292, 294, 293, 265, 289, 288, 10: 350, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 63: 266, 66: 284, 283, 351, 285, 335, 352, 84: 297, 298, 95: 513},
-		{1: 291, 282, 292, 294, 293, 265, 289, 288, 10: 350, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 63: 266, 66: 284, 283, 351, 285, 335, 352, 84: 297, 298, 95: 512},
-		{1: 291, 282, 292, 294, 293, 265, 289, 288, 10: 350, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 63: 266, 66: 284, 283, 351, 285, 335, 352, 84: 297, 298, 95: 511},
-		{1: 291, 282, 292, 294, 293, 265, 289, 288, 10: 350, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 63: 266, 66: 284, 283, 351, 285, 335, 352, 84: 297, 298, 95: 510},
+		{25: 133, 27: 133, 60: 133},
+		{139, 139, 139, 139, 139, 139, 7: 139, 139, 139, 139, 24: 139, 139, 27: 139, 139, 43: 139, 139, 47: 139, 139, 50: 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 69: 139, 78: 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139},
+		{6: 622, 8: 214, 27: 214, 157: 623},
+		{50: 634},
+		{8: 625, 27: 624},
 		// 260
-		{1: 291, 282, 292, 294, 293, 265, 289, 288, 10: 350, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 63: 266, 66: 284, 283, 351, 285, 335, 352, 84: 297, 298, 95: 509},
-		{1: 291, 282, 292, 294, 293, 265, 289, 288, 10: 350, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 63: 266, 66: 284, 283, 351, 285, 335, 352, 84: 297, 298, 95: 492},
-		{80, 80, 9: 80, 11: 80, 80, 14: 80, 42: 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 495, 493, 494},
-		{1: 291, 282, 292, 294, 293, 265, 289, 288, 10: 350, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 63: 266, 66: 284, 283, 351, 285, 335, 352, 84: 297, 508},
-		{1: 291, 282, 292, 294, 293, 265, 289, 288, 10: 350, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 63: 266, 66: 284, 283, 351, 285, 335, 352, 84: 297, 507},
+		{6: 626},
+		{92, 8: 92, 24: 92, 92, 27: 92, 92, 50: 92, 60: 92},
+		{50: 627},
+		{1: 442, 441, 429, 439, 440, 484, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 629, 433, 631, 630, 90: 445, 446, 447, 103: 448, 449, 450, 451, 452, 632, 453, 159: 628},
+		{8: 212, 27: 212},
 		// 265
-		{1: 291, 282, 292, 294, 293, 265, 289, 288, 10: 350, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 63: 266, 66: 284, 283, 351, 285, 335, 352, 84: 297, 496},
-		{87, 87, 4: 498, 497, 9: 87, 11: 87, 87, 14: 87, 42: 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87},
-		{1: 291, 282, 292, 294, 293, 265, 289, 288, 10: 350, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 63: 266, 66: 284, 283, 351, 285, 335, 352, 84: 506},
-		{1: 291, 282, 292, 294, 293, 265, 289, 288, 10: 350, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 63: 266, 66: 284, 283, 351, 285, 335, 352, 84: 499},
-		{91, 91, 3: 500, 91, 91, 9: 91, 11: 91, 91, 14: 91, 42: 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 62: 501, 64: 502},
+		{132, 132, 132, 487, 132, 132, 7: 486, 132, 490, 489, 24: 132, 132, 27: 132, 132, 43: 132, 132, 47: 132, 132, 50: 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 69: 132, 78: 488, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132},
+		{1: 442, 441, 429, 439, 440, 484, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 629, 433, 633, 630},
+		{123, 123, 123, 4: 123, 123, 8: 123, 24: 123, 123, 27: 123, 123, 43: 123, 123, 47: 123, 123, 50: 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123},
+		{8: 73, 27: 73},
+		{129, 129, 129, 4: 129, 129, 8: 129, 24: 129, 129, 27: 129, 129, 43: 129, 129, 47: 129, 129, 50: 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 69: 129, 79: 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129},
 		// 270
-		{1: 291, 282, 292, 294, 293, 265, 289, 288, 10: 350, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 63: 266, 66: 284, 283, 351, 285, 505, 352},
-		{1: 291, 282, 292, 294, 293, 265, 289, 288, 10: 350, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 63: 266, 66: 284, 283, 351, 285, 504, 352},
-		{1: 291, 282, 292, 294, 293, 265, 289, 288, 10: 350, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 63: 266, 66: 284, 283, 351, 285, 503, 352},
-		{94, 94, 3: 94, 94, 94, 9: 94, 11: 94, 94, 14: 94, 42: 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 62: 94, 64: 94},
-		{95, 95, 3: 95, 95, 95, 9: 95, 11: 95, 95, 14: 95, 42: 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 62: 95, 64: 95},
+		{1: 442, 441, 429, 439, 440, 484, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 629, 433, 631, 630, 90: 445, 446, 447, 103: 448, 449, 450, 451, 452, 632, 453, 159: 635},
+		{8: 213, 27: 213},
+		{27: 642, 646, 50: 647},
+		{1: 442, 441, 429, 439, 440, 612, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 28: 638, 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 434, 433, 444, 438, 90: 445, 446, 447, 103: 448, 449, 450, 451, 452, 454, 453, 455, 456, 119: 639},
+		{149, 149, 149, 149, 149, 149, 7: 149, 149, 149, 149, 24: 149, 149, 27: 149, 149, 43: 149, 149, 47: 149, 149, 50: 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 69: 149, 78: 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149},
 		// 275
-		{96, 96, 3: 96, 96, 96, 9: 96, 11: 96, 96, 14: 96, 42: 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 62: 96, 64: 96},
-		{92, 92, 3: 500, 92, 92, 9: 92, 11: 92, 92, 14: 92, 42: 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 62: 501, 64: 502},
-		{88, 88, 4: 498, 497, 9: 88, 11: 88, 88, 14: 88, 42: 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88},
-		{89, 89, 4: 498, 497, 9: 89, 11: 89, 89, 14: 89, 42: 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89},
-		{81, 81, 9: 81, 11: 81, 81, 14: 81, 42: 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 495, 493, 494},
+		{27: 642, 640, 50: 641},
+		{147, 147, 147, 147, 147, 147, 7: 147, 147, 147, 147, 24: 147, 147, 27: 147, 147, 43: 147, 147, 47: 147, 147, 50: 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 69: 147, 78: 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147},
+		{1: 442, 441, 429, 439, 440, 612, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 434, 433, 444, 438, 90: 445, 446, 447, 103: 448, 449, 450, 451, 452, 454, 453, 455, 456, 119: 644},
+		{1: 442, 441, 429, 439, 440, 612, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 434, 433, 444, 438, 90: 445, 446, 447, 103: 448, 449, 450, 451, 452, 454, 453, 455, 643},
+		{74, 24: 74, 74, 27: 74, 74, 50: 74},
 		// 280
-		{82, 82, 9: 82, 11: 82, 82, 14: 82, 42: 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 495, 493, 494},
-		{83, 83, 9: 83, 11: 83, 83, 14: 83, 42: 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 495, 493, 494},
-		{84, 84, 9: 84, 11: 84, 84, 14: 84, 42: 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 495, 493, 494},
-		{85, 85, 9: 85, 11: 85, 85, 14: 85, 42: 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 495, 493, 494},
-		{1: 291, 282, 292, 294, 293, 265, 289, 288, 10: 334, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 63: 266, 66: 284, 283, 286, 285, 296, 290, 84: 297, 298, 95: 299, 300, 301, 302, 303, 304, 306, 305, 307, 527},
+		{27: 642, 645},
+		{145, 145, 145, 145, 145, 145, 7: 145, 145, 145, 145, 24: 145, 145, 27: 145, 145, 43: 145, 145, 47: 145, 145, 50: 145, 145, 145, 145, 145, 145, 145, 145, 145, 145, 145, 145, 145, 145, 69: 145, 78: 145, 145, 145, 145, 145, 145, 145, 145, 145, 145, 145, 145},
+		{150, 150, 150, 150, 150, 150, 7: 150, 150, 150, 150, 24: 150, 150, 27: 150, 150, 43: 150, 150, 47: 150, 150, 50: 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 69: 150, 78: 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150},
+		{1: 442, 441, 429, 439, 440, 612, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 28: 648, 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 434, 433, 444, 438, 90: 445, 446, 447, 103: 448, 449, 450, 451, 452, 454, 453, 455, 456, 119: 649},
+		{148, 148, 148, 148, 148, 148, 7: 148, 148, 148, 148, 24: 148, 148, 27: 148, 148, 43: 148, 148, 47: 148, 148, 50: 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 69: 148, 78: 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 148},
 		// 285
-		{1: 61, 61, 61, 61, 61, 61, 61, 61, 10: 61, 13: 61, 15: 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61},
-		{1: 60, 60, 60, 60, 60, 60, 60, 60, 10: 60, 13: 60, 15: 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60},
-		{1: 59, 59, 59, 59, 59, 59, 59, 59, 10: 59, 13: 59, 15: 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59},
-		{1: 58, 58, 58, 58, 58, 58, 58, 58, 10: 58, 13: 58, 15: 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58},
-		{1: 57, 57, 57, 57, 57, 57, 57, 57, 10: 57, 13: 57, 15: 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57},
+		{27: 642, 650, 50: 651},
+		{146, 146, 146, 146, 146, 146, 7: 146, 146, 146, 146, 24: 146, 146, 27: 146, 146, 43: 146, 146, 47: 146, 146, 50: 146, 146, 146, 146, 146, 146, 146, 146, 146, 146, 146, 146, 146, 146, 69: 146, 78: 146, 146, 146, 146, 146, 146, 146, 146, 146, 146, 146, 146},
+		{1: 442, 441, 429, 439, 440, 612, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 434, 433, 444, 438, 90: 445, 446, 447, 103: 448, 449, 450, 451, 452, 454, 453, 455, 456, 119: 652},
+		{27: 642, 653},
+		{144, 144, 144, 144, 144, 144, 7: 144, 144, 144, 144, 24: 144, 144, 27: 144, 144, 43: 144, 144, 47: 144, 144, 50: 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 69: 144, 78: 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144},
 		// 290
-		{1: 56, 56, 56, 56, 56, 56, 56, 56, 10: 56, 13: 56, 15: 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56},
-		{1: 55, 55, 55, 55, 55, 55, 55, 55, 10: 55, 13: 55, 15: 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55},
-		{1: 54, 54, 54, 54, 54, 54, 54, 54, 10: 54, 13: 54, 15: 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54},
-		{1: 53, 53, 53, 53, 53, 53, 53, 53, 10: 53, 13: 53, 15: 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53},
-		{1: 52, 52, 52, 52, 52, 52, 52, 52, 10: 52, 13: 52, 15: 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52},
+		{10, 27: 10},
+		{658, 27: 657},
+		{3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 26: 3, 29: 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 45: 3, 3, 65: 3, 3, 3, 3, 93: 3, 3, 3, 3, 3, 3, 3, 3, 3, 3},
+		{1: 442, 441, 429, 439, 440, 612, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 434, 433, 631, 438, 90: 445, 446, 447, 103: 448, 449, 450, 451, 452, 454, 453, 659},
+		{2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 26: 2, 29: 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 45: 2, 2, 65: 2, 2, 2, 2, 93: 2, 2, 2, 2, 2, 2, 2, 2, 2, 2},
 		// 295
-		{1: 51, 51, 51, 51, 51, 51, 51, 51, 10: 51, 13: 51, 15: 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51},
-		{1: 50, 50, 50, 50, 50, 50, 50, 50, 10: 50, 13: 50, 15: 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50},
-		{62, 9: 62, 11: 62, 62, 14: 62, 42: 62, 62},
-		{136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 11: 529, 136, 14: 136, 42: 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 59: 136, 62: 136, 64: 136, 72: 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136},
-		{9: 169, 343, 12: 169, 130: 530},
+		{9, 27: 9},
+		{5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 26: 5, 29: 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 45: 5, 5, 65: 5, 5, 5, 5, 93: 5, 5, 5, 5, 5, 5, 5, 5, 5, 5},
+		{662},
+		{4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 26: 4, 29: 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 45: 4, 4, 65: 4, 4, 4, 4, 93: 4, 4, 4, 4, 4, 4, 4, 4, 4, 4},
+		{7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 26: 7, 29: 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 45: 7, 7, 65: 7, 7, 7, 7, 93: 7, 7, 7, 7, 7, 7, 7, 7, 7, 7},
 		// 300
-		{9: 531, 12: 345},
-		{65, 9: 65, 11: 65, 65, 14: 65, 42: 65, 65},
-		{104, 104, 3: 104, 104, 104, 9: 104, 11: 104, 104, 14: 104, 42: 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 59: 104, 62: 104, 64: 104, 72: 104, 104, 104, 104, 104, 104, 104, 104, 81: 104, 104, 104},
-		{105, 105, 3: 105, 105, 105, 9: 105, 11: 105, 105, 14: 105, 42: 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 105, 59: 105, 62: 105, 64: 105, 72: 105, 105, 105, 105, 105, 105, 105, 105, 81: 105, 105, 105},
-		{10: 536, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 58: 535, 146: 537},
+		{665},
+		{6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 26: 6, 29: 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 45: 6, 6, 65: 6, 6, 6, 6, 93: 6, 6, 6, 6, 6, 6, 6, 6, 6, 6},
+		{667},
+		{8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 26: 8, 29: 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 45: 8, 8, 65: 8, 8, 8, 8, 93: 8, 8, 8, 8, 8, 8, 8, 8, 8, 8},
+		{123, 123, 123, 4: 123, 123, 24: 123, 27: 123, 43: 123, 123, 47: 123, 123, 51: 123, 123, 123, 123, 123, 123, 123, 123, 123, 61: 123, 123, 123, 69: 683, 79: 684, 688, 692, 686, 690, 687, 685, 694, 691, 689, 693, 155: 699},
 		// 305
-		{118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 11: 118, 118, 14: 118, 42: 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 59: 118, 62: 118, 64: 118, 72: 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118},
-		{117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 11: 117, 117, 14: 117, 42: 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 59: 117, 62: 117, 64: 117, 72: 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117},
-		{114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 11: 114, 114, 14: 114, 42: 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 59: 114, 62: 114, 64: 114, 72: 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114},
-		{10: 539},
-		{109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 11: 540, 109, 14: 109, 42: 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 59: 109, 62: 109, 64: 109, 72: 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109},
+		{679, 24: 394, 27: 678, 120: 680},
+		{475, 442, 441, 429, 439, 440, 612, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 434, 433, 444, 438, 90: 445, 446, 447, 103: 448, 449, 450, 451, 452, 454, 453, 455, 456, 119: 457, 126: 674},
+		{1: 442, 441, 429, 439, 440, 484, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 629, 433, 631, 630, 90: 445, 446, 447, 103: 448, 449, 450, 451, 452, 672, 453},
+		{24: 394, 120: 673},
+		{13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 26: 13, 29: 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 45: 13, 13, 65: 13, 13, 13, 13, 93: 13, 13, 13, 13, 13, 13, 13, 13, 13, 13},
 		// 310
-		{9: 169, 343, 12: 169, 130: 541},
-		{9: 542, 12: 345},
-		{64, 9: 64, 11: 64, 64, 14: 64, 42: 64, 64},
-		{12: 369, 14: 544},
-		{121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 11: 121, 121, 14: 121, 42: 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 59: 121, 62: 121, 64: 121, 72: 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121},
+		{1: 442, 441, 429, 439, 440, 612, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 394, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 434, 433, 444, 438, 90: 445, 446, 447, 103: 448, 449, 450, 451, 452, 454, 453, 455, 456, 119: 675, 676},
+		{24: 394, 27: 642, 120: 677},
+		{15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 26: 15, 29: 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 45: 15, 15, 65: 15, 15, 15, 15, 93: 15, 15, 15, 15, 15, 15, 15, 15, 15, 15},
+		{14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 26: 14, 29: 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 45: 14, 14, 65: 14, 14, 14, 14, 93: 14, 14, 14, 14, 14, 14, 14, 14, 14, 14},
+		{1: 442, 441, 429, 439, 440, 612, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 434, 433, 681, 438, 90: 445, 446, 447, 103: 448, 449, 450, 451, 452, 454, 453, 455, 643},
 		// 315
-		{9: 140, 546, 128: 547, 152: 548, 549},
-		{2: 554},
-		{553},
-		{9: 139, 546, 128: 551},
-		{9: 550},
+		{50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 26: 50, 29: 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 45: 50, 50, 65: 50, 50, 50, 50, 93: 50, 50, 50, 50, 50, 50, 50, 50, 50, 50},
+		{16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 26: 16, 29: 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 45: 16, 16, 65: 16, 16, 16, 16, 93: 16, 16, 16, 16, 16, 16, 16, 16, 16, 16},
+		{123, 123, 123, 4: 123, 123, 24: 123, 27: 123, 43: 123, 123, 47: 123, 123, 51: 123, 123, 123, 123, 123, 123, 123, 123, 123, 61: 123, 123, 123, 69: 683, 79: 684, 688, 692, 686, 690, 687, 685, 694, 691, 689, 693, 155: 682},
+		{1: 442, 441, 429, 439, 440, 612, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 434, 433, 444, 438, 90: 445, 446, 447, 103: 448, 449, 450, 451, 452, 454, 453, 455, 695, 138: 696},
+		{1: 87, 87, 87, 87, 87, 87, 87, 9: 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 26: 87, 29: 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 45: 87, 87, 138: 87},
 		// 320
-		{134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 11: 134, 134, 14: 134, 42: 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 59: 134, 62: 134, 64: 134, 72: 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134},
-		{552},
-		{9: 141, 141},
-		{9: 142, 142},
-		{4: 561, 10: 555, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 560, 58: 557, 128: 559, 151: 558, 154: 556},
+		{1: 86, 86, 86, 86, 86, 86, 86, 9: 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 26: 86, 29: 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 45: 86, 86, 138: 86},
+		{1: 85, 85, 85, 85, 85, 85, 85, 9: 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 26: 85, 29: 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 45: 85, 85, 138: 85},
+		{1: 84, 84, 84, 84, 84, 84, 84, 9: 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 26: 84, 29: 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 45: 84, 84, 138: 84},
+		{1: 83, 83, 83, 83, 83, 83, 83, 9: 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 26: 83, 29: 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 45: 83, 83, 138: 83},
+		{1: 82, 82, 82, 82, 82, 82, 82, 9: 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 26: 82, 29: 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 45: 82, 82, 138: 82},
 		// 325
-		{2: 554, 12: 149, 14: 149},
-		{12: 148, 14: 148},
-		{80: 571},
-		{12: 567, 14: 568},
-		{12: 564, 14: 563},
+		{1: 81, 81, 81, 81, 81, 81, 81, 9: 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 26: 81, 29: 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 45: 81, 81, 138: 81},
+		{1: 80, 80, 80, 80, 80, 80, 80, 9: 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 26: 80, 29: 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 45: 80, 80, 138: 80},
+		{1: 79, 79, 79, 79, 79, 79, 79, 9: 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 26: 79, 29: 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 45: 79, 79, 138: 79},
+		{1: 78, 78, 78, 78, 78, 78, 78, 9: 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 26: 78, 29: 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 45: 78, 78, 138: 78},
+		{1: 77, 77, 77, 77, 77, 77, 77, 9: 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 26: 77, 29: 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 45: 77, 77, 138: 77},
 		// 330
-		{12: 138, 14: 138},
-		{28: 562},
-		{12: 137, 14: 137},
-		{144, 12: 144, 14: 144},
-		{10: 546, 128: 565},
+		{1: 76, 76, 76, 76, 76, 76, 76, 9: 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 26: 76, 29: 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 45: 76, 76, 138: 76},
+		{88, 8: 88, 24: 88, 88, 27: 88, 88, 50: 88, 60: 88},
+		{1: 442, 441, 429, 439, 440, 484, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 629, 433, 631, 630, 90: 445, 446, 447, 103: 448, 449, 450, 451, 452, 697, 453},
+		{24: 394, 120: 698},
+		{11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 26: 11, 29: 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 45: 11, 11, 65: 11, 11, 11, 11, 93: 11, 11, 11, 11, 11, 11, 11, 11, 11, 11},
 		// 335
-		{14: 566},
-		{143, 12: 143, 14: 143},
-		{10: 569},
-		{145, 12: 145, 14: 145},
-		{14: 570},
+		{1: 442, 441, 429, 439, 440, 612, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 434, 433, 444, 438, 90: 445, 446, 447, 103: 448, 449, 450, 451, 452, 454, 453, 455, 695, 138: 700},
+		{1: 442, 441, 429, 439, 440, 484, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 629, 433, 631, 630, 90: 445, 446, 447, 103: 448, 449, 450, 451, 452, 701, 453},
+		{24: 394, 120: 702},
+		{12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 26: 12, 29: 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 45: 12, 12, 65: 12, 12, 12, 12, 93: 12, 12, 12, 12, 12, 12, 12, 12, 12, 12},
+		{1: 173, 173, 173, 173, 173, 7: 173, 9: 173, 173, 24: 173, 43: 173, 173, 47: 173, 173, 51: 173, 173, 173, 173, 173, 173, 173, 173, 173, 61: 173, 173, 173, 78: 173, 745},
 		// 340
-		{146, 12: 146, 14: 146},
-		{10: 572},
-		{12: 147, 14: 147},
-		{14: 574},
-		{135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 11: 135, 135, 14: 135, 42: 135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 59: 135, 62: 135, 64: 135, 72: 135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 135},
+		{1: 132, 132, 487, 132, 132, 7: 486, 9: 490, 489, 24: 132, 43: 132, 132, 47: 132, 132, 51: 132, 132, 132, 132, 132, 132, 132, 132, 132, 61: 132, 132, 132, 78: 726},
+		{24: 722},
+		{8: 38, 101: 38, 38, 179: 707},
+		{8: 708, 101: 710, 711, 178: 709},
+		{721},
 		// 345
-		{326, 291, 282, 292, 294, 293, 265, 289, 288, 10: 267, 250, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 60: 239, 251, 63: 266, 65: 310, 284, 283, 286, 285, 296, 290, 84: 297, 298, 327, 332, 319, 331, 320, 329, 330, 333, 328, 299, 300, 301, 302, 303, 304, 306, 305, 307, 308, 108: 309, 312, 317, 313, 315, 318, 311, 314, 316, 325, 576, 324},
-		{35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 13: 35, 15: 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 60: 35, 35, 65: 35, 86: 35, 35, 35, 35, 35, 35, 35, 35, 35},
-		{11: 586},
-		{11: 579},
-		{1: 291, 282, 292, 294, 293, 265, 289, 288, 582, 334, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 63: 266, 66: 284, 283, 286, 285, 296, 290, 84: 297, 298, 95: 299, 300, 301, 302, 303, 304, 306, 305, 307, 580, 131: 581},
+		{8: 37, 101: 37, 37},
+		{1: 442, 441, 429, 439, 440, 484, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 629, 433, 631, 630, 90: 445, 446, 447, 103: 448, 449, 450, 451, 452, 716, 453, 201: 715},
+		{50: 712},
+		{475, 442, 441, 429, 439, 440, 413, 410, 33, 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 394, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 380, 378, 395, 458, 70: 431, 430, 414, 432, 434, 433, 444, 438, 90: 445, 446, 447, 476, 481, 480, 483, 478, 479, 482, 477, 33, 33, 448, 449, 450, 451, 452, 454, 453, 455, 456, 119: 457, 461, 123: 459, 466, 126: 462, 472, 473, 468, 464, 467, 460, 463, 465, 474, 469, 142: 713},
+		{475, 442, 441, 429, 439, 440, 413, 410, 34, 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 394, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 380, 378, 395, 458, 70: 431, 430, 414, 432, 434, 433, 444, 438, 90: 445, 446, 447, 476, 481, 480, 483, 478, 479, 482, 477, 34, 34, 448, 449, 450, 451, 452, 454, 453, 455, 456, 119: 457, 461, 123: 459, 466, 126: 462, 714, 473, 468, 464, 467, 460, 463, 465, 474, 469},
 		// 350
-		{9: 166, 12: 166},
-		{9: 584, 12: 583},
-		{157, 157, 157, 157, 157, 157, 157, 157, 157, 157, 11: 157, 157, 14: 157, 42: 157, 157, 157, 157, 157, 157, 157, 157, 157, 157, 157, 157, 157, 157, 157, 157, 59: 157, 62: 157, 64: 157, 72: 157, 157, 157, 157, 157, 157, 157, 157, 157, 157, 157, 157},
-		{1: 291, 282, 292, 294, 293, 265, 289, 288, 10: 334, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 63: 266, 66: 284, 283, 286, 285, 296, 290, 84: 297, 298, 95: 299, 300, 301, 302, 303, 304, 306, 305, 307, 585},
-		{158, 158, 158, 158, 158, 158, 158, 158, 158, 158, 11: 158, 158, 14: 158, 42: 158, 158, 158, 158, 158, 158, 158, 158, 158, 158, 158, 158, 158, 158, 158, 158, 59: 158, 62: 158, 64: 158, 72: 158, 158, 158, 158, 158, 158, 158, 158, 158, 158, 158, 158},
+		{54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 26: 54, 29: 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 45: 54, 54, 65: 54, 54, 54, 54, 93: 54, 54, 54, 54, 54, 54, 54, 54, 54, 54},
+		{27: 718, 50: 717},
+		{27: 32, 50: 32},
+		{475, 442, 441, 429, 439, 440, 413, 410, 35, 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 394, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 380, 378, 395, 458, 70: 431, 430, 414, 432, 434, 433, 444, 438, 90: 445, 446, 447, 476, 481, 480, 483, 478, 479, 482, 477, 35, 35, 448, 449, 450, 451, 452, 454, 453, 455, 456, 119: 457, 461, 123: 459, 466, 126: 462, 472, 473, 468, 464, 467, 460, 463, 465, 474, 469, 142: 720},
+		{1: 442, 441, 429, 439, 440, 484, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 629, 433, 631, 630, 90: 445, 446, 447, 103: 448, 449, 450, 451, 452, 719, 453},
 		// 355
-		{9: 165, 12: 165},
-		{1: 291, 282, 292, 294, 293, 265, 289, 288, 588, 334, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 63: 266, 66: 284, 283, 286, 285, 296, 290, 84: 297, 298, 95: 299, 300, 301, 302, 303, 304, 306, 305, 307, 580, 131: 587},
-		{9: 589, 12: 583},
-		{159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 11: 159, 159, 14: 159, 42: 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 59: 159, 62: 159, 64: 159, 72: 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159},
-		{160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 11: 160, 160, 14: 160, 42: 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 59: 160, 62: 160, 64: 160, 72: 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160},
+		{27: 31, 50: 31},
+		{475, 442, 441, 429, 439, 440, 413, 410, 36, 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 394, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 380, 378, 395, 458, 70: 431, 430, 414, 432, 434, 433, 444, 438, 90: 445, 446, 447, 476, 481, 480, 483, 478, 479, 482, 477, 36, 36, 448, 449, 450, 451, 452, 454, 453, 455, 456, 119: 457, 461, 123: 459, 466, 126: 462, 714, 473, 468, 464, 467, 460, 463, 465, 474, 469},
+		{41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 26: 41, 29: 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 45: 41, 41, 65: 41, 41, 41, 41, 93: 41, 41, 41, 41, 41, 41, 41, 41, 41, 41},
+		{8: 38, 101: 38, 38, 179: 723},
+		{8: 724, 101: 710, 711, 178: 709},
 		// 360
-		{6: 591, 10: 592, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 58: 593, 66: 594},
-		{43: 590},
-		{11: 602},
-		{11: 595},
-		{150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 11: 150, 150, 14: 150, 42: 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 59: 150, 62: 150, 64: 150, 72: 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150},
+		{725},
+		{42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 26: 42, 29: 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 45: 42, 42, 65: 42, 42, 42, 42, 93: 42, 42, 42, 42, 42, 42, 42, 42, 42, 42},
+		{3: 727, 6: 493, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 49: 492, 150: 495},
+		{5: 497, 501, 498, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 496, 41: 499, 49: 500, 64: 502, 112: 728, 125: 503},
+		{25: 729},
 		// 365
-		{1: 291, 282, 292, 294, 293, 265, 289, 288, 598, 334, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 63: 266, 66: 284, 283, 286, 285, 296, 290, 84: 297, 298, 95: 299, 300, 301, 302, 303, 304, 306, 305, 307, 596, 143: 597},
-		{9: 156, 12: 156},
-		{9: 600, 12: 599},
-		{151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 11: 151, 151, 14: 151, 42: 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 59: 151, 62: 151, 64: 151, 72: 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151},
-		{1: 291, 282, 292, 294, 293, 265, 289, 288, 10: 334, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 63: 266, 66: 284, 283, 286, 285, 296, 290, 84: 297, 298, 95: 299, 300, 301, 302, 303, 304, 306, 305, 307, 601},
+		{24: 730},
+		{8: 30, 101: 30, 30, 182: 731},
+		{8: 732, 101: 734, 735, 181: 733},
+		{744},
+		{8: 29, 101: 29, 29},
 		// 370
-		{152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 11: 152, 152, 14: 152, 42: 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 59: 152, 62: 152, 64: 152, 72: 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152},
-		{9: 155, 12: 155},
-		{1: 291, 282, 292, 294, 293, 265, 289, 288, 604, 334, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 63: 266, 66: 284, 283, 286, 285, 296, 290, 84: 297, 298, 95: 299, 300, 301, 302, 303, 304, 306, 305, 307, 596, 143: 603},
-		{9: 605, 12: 599},
-		{153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 11: 153, 153, 14: 153, 42: 153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 59: 153, 62: 153, 64: 153, 72: 153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 153},
+		{5: 497, 501, 498, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 496, 41: 499, 49: 500, 64: 502, 125: 739, 203: 738},
+		{50: 736},
+		{475, 442, 441, 429, 439, 440, 413, 410, 25, 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 394, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 380, 378, 395, 458, 70: 431, 430, 414, 432, 434, 433, 444, 438, 90: 445, 446, 447, 476, 481, 480, 483, 478, 479, 482, 477, 25, 25, 448, 449, 450, 451, 452, 454, 453, 455, 456, 119: 457, 461, 123: 459, 466, 126: 462, 472, 473, 468, 464, 467, 460, 463, 465, 474, 469, 142: 737},
+		{475, 442, 441, 429, 439, 440, 413, 410, 26, 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 394, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 380, 378, 395, 458, 70: 431, 430, 414, 432, 434, 433, 444, 438, 90: 445, 446, 447, 476, 481, 480, 483, 478, 479, 482, 477, 26, 26, 448, 449, 450, 451, 452, 454, 453, 455, 456, 119: 457, 461, 123: 459, 466, 126: 462, 714, 473, 468, 464, 467, 460, 463, 465, 474, 469},
+		{27: 741, 50: 740},
 		// 375
-		{154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 11: 154, 154, 14: 154, 42: 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 59: 154, 62: 154, 64: 154, 72: 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 154},
-		{10: 607},
-		{608},
-		{220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 13: 220, 15: 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 60: 220, 220, 65: 220, 86: 220, 220, 220, 220, 220, 220, 220, 220, 220},
-		{13: 205, 60: 205, 205, 65: 205, 105: 205, 205, 205},
+		{27: 24, 50: 24},
+		{475, 442, 441, 429, 439, 440, 413, 410, 27, 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 394, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 380, 378, 395, 458, 70: 431, 430, 414, 432, 434, 433, 444, 438, 90: 445, 446, 447, 476, 481, 480, 483, 478, 479, 482, 477, 27, 27, 448, 449, 450, 451, 452, 454, 453, 455, 456, 119: 457, 461, 123: 459, 466, 126: 462, 472, 473, 468, 464, 467, 460, 463, 465, 474, 469, 142: 743},
+		{5: 497, 501, 498, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 496, 41: 499, 49: 500, 64: 502, 125: 742},
+		{27: 23, 50: 23},
+		{475, 442, 441, 429, 439, 440, 413, 410, 28, 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 394, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 380, 378, 395, 458, 70: 431, 430, 414, 432, 434, 433, 444, 438, 90: 445, 446, 447, 476, 481, 480, 483, 478, 479, 482, 477, 28, 28, 448, 449, 450, 451, 452, 454, 453, 455, 456, 119: 457, 461, 123: 459, 466, 126: 462, 714, 473, 468, 464, 467, 460, 463, 465, 474, 469},
 		// 380
-		{2: 208, 11: 208},
-		{14: 618},
-		{12: 616, 14: 204},
-		{12: 203, 14: 203},
-		{3: 434, 6: 435, 10: 437, 13: 433, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 58: 436, 63: 438, 123: 615},
+		{39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 26: 39, 29: 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 45: 39, 39, 65: 39, 39, 39, 39, 93: 39, 39, 39, 39, 39, 39, 39, 39, 39, 39},
+		{3: 429, 6: 484, 410, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 746, 433},
+		{3: 487, 7: 486, 9: 490, 489, 78: 747},
+		{3: 748, 6: 493, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 49: 492, 150: 495},
+		{5: 497, 501, 498, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 496, 41: 499, 49: 500, 64: 502, 112: 749, 125: 503},
 		// 385
-		{201, 12: 201, 14: 201},
-		{2: 431, 10: 430, 120: 614, 429, 124: 617},
-		{12: 202, 14: 202},
-		{2: 207, 11: 207},
-		{2: 210},
+		{25: 750},
+		{24: 751},
+		{8: 30, 101: 30, 30, 182: 752},
+		{8: 753, 101: 734, 735, 181: 733},
+		{754},
 		// 390
-		{2: 431, 10: 430, 120: 614, 429, 124: 613, 141: 612, 621},
-		{14: 622},
-		{10: 623},
-		{2: 209},
-		{625},
+		{40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 26: 40, 29: 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 45: 40, 40, 65: 40, 40, 40, 40, 93: 40, 40, 40, 40, 40, 40, 40, 40, 40, 40},
+		{24: 756, 120: 757},
+		{475, 442, 441, 429, 439, 440, 413, 410, 758, 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 394, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 380, 378, 395, 458, 70: 431, 430, 414, 432, 434, 433, 444, 438, 90: 445, 446, 447, 476, 481, 480, 483, 478, 479, 482, 477, 103: 448, 449, 450, 451, 452, 454, 453, 455, 456, 119: 457, 461, 123: 459, 466, 126: 462, 472, 473, 468, 464, 467, 460, 463, 465, 474, 469, 142: 759},
+		{43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 26: 43, 29: 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 45: 43, 43, 65: 43, 43, 43, 43, 93: 43, 43, 43, 43, 43, 43, 43, 43, 43, 43},
+		{781, 147: 764, 152: 782, 765, 160: 783},
 		// 395
-		{13: 211, 60: 211, 211, 65: 211, 105: 211, 211, 211},
-		{627},
-		{13: 212, 60: 212, 212, 65: 212, 105: 212, 212, 212},
-		{156: 629},
-		{11: 631, 157: 630},
+		{475, 442, 441, 429, 439, 440, 413, 410, 760, 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 394, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 380, 378, 395, 458, 70: 431, 430, 414, 432, 434, 433, 444, 438, 90: 445, 446, 447, 476, 481, 480, 483, 478, 479, 482, 477, 103: 448, 449, 450, 451, 452, 454, 453, 455, 456, 119: 457, 461, 123: 459, 466, 126: 462, 714, 473, 468, 464, 467, 460, 463, 465, 474, 469},
+		{761, 147: 764, 152: 763, 765, 160: 762},
+		{56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 26: 56, 29: 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 45: 56, 56, 65: 56, 56, 56, 56, 93: 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 112: 56, 56, 56, 56, 56},
+		{778, 147: 764, 152: 777, 779},
+		{776},
 		// 400
-		{13: 217, 60: 217, 217, 65: 217, 105: 217, 217, 217},
-		{2: 431, 9: 632, 430, 120: 614, 429, 124: 634, 149: 633},
-		{640},
-		{2: 431, 9: 636, 430, 120: 614, 429, 124: 637},
-		{635},
+		{24: 767, 93: 766},
+		{20, 147: 20},
+		{1: 442, 441, 429, 439, 440, 484, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 629, 433, 631, 630, 90: 445, 446, 447, 103: 448, 449, 450, 451, 452, 771, 453},
+		{475, 442, 441, 429, 439, 440, 413, 410, 769, 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 394, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 380, 378, 395, 458, 70: 431, 430, 414, 432, 434, 433, 444, 438, 90: 445, 446, 447, 476, 481, 480, 483, 478, 479, 482, 477, 103: 448, 449, 450, 451, 452, 454, 453, 455, 456, 119: 457, 461, 123: 459, 466, 126: 462, 472, 473, 468, 464, 467, 460, 463, 465, 474, 469, 142: 768},
+		{475, 442, 441, 429, 439, 440, 413, 410, 770, 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 394, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 380, 378, 395, 458, 70: 431, 430, 414, 432, 434, 433, 444, 438, 90: 445, 446, 447, 476, 481, 480, 483, 478, 479, 482, 477, 103: 448, 449, 450, 451, 452, 454, 453, 455, 456, 119: 457, 461, 123: 459, 466, 126: 462, 714, 473, 468, 464, 467, 460, 463, 465, 474, 469},
 		// 405
-		{2: 214, 9: 214, 214},
-		{639},
-		{638},
-		{2: 213, 9: 213, 213},
-		{13: 215, 60: 215, 215, 65: 215, 105: 215, 215, 215},
+		{17},
+		{18},
+		{24: 772},
+		{475, 442, 441, 429, 439, 440, 413, 410, 774, 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 394, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 380, 378, 395, 458, 70: 431, 430, 414, 432, 434, 433, 444, 438, 90: 445, 446, 447, 476, 481, 480, 483, 478, 479, 482, 477, 103: 448, 449, 450, 451, 452, 454, 453, 455, 456, 119: 457, 461, 123: 459, 466, 126: 462, 472, 473, 468, 464, 467, 460, 463, 465, 474, 469, 142: 773},
+		{475, 442, 441, 429, 439, 440, 413, 410, 775, 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 394, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 380, 378, 395, 458, 70: 431, 430, 414, 432, 434, 433, 444, 438, 90: 445, 446, 447, 476, 481, 480, 483, 478, 479, 482, 477, 103: 448, 449, 450, 451, 452, 454, 453, 455, 456, 119: 457, 461, 123: 459, 466, 126: 462, 714, 473, 468, 464, 467, 460, 463, 465, 474, 469},
 		// 410
-		{13: 216, 60: 216, 216, 65: 216, 105: 216, 216, 216},
-		{3: 434, 6: 435, 10: 437, 13: 433, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 58: 436, 63: 438, 123: 642},
-		{643, 59: 644},
-		{13: 219, 60: 219, 219, 65: 219, 105: 219, 219, 219},
-		{1: 291, 282, 292, 294, 293, 265, 289, 288, 10: 334, 13: 268, 15: 252, 253, 255, 256, 258, 259, 260, 257, 254, 262, 263, 264, 261, 274, 270, 271, 272, 281, 280, 269, 275, 295, 273, 276, 278, 279, 277, 58: 287, 63: 266, 66: 284, 283, 286, 285, 296, 290, 84: 297, 298, 95: 299, 300, 301, 302, 303, 304, 306, 305, 307, 443, 139: 645},
+		{21, 147: 21},
+		{22, 147: 22},
+		{48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 26: 48, 29: 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 45: 48, 48, 65: 48, 48, 48, 48, 93: 48, 48, 48, 48, 48, 48, 48, 48, 48, 48},
+		{780},
+		{46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 26: 46, 29: 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 45: 46, 46, 65: 46, 46, 46, 46, 93: 46, 46, 46, 46, 46, 46, 46, 46, 46, 46},
 		// 415
-		{646},
-		{13: 218, 60: 218, 218, 65: 218, 105: 218, 218, 218},
-		{13: 228, 60: 228, 228, 65: 228, 105: 228, 228, 228},
+		{19, 147: 19},
+		{49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 26: 49, 29: 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 45: 49, 49, 65: 49, 49, 49, 49, 93: 49, 49, 49, 49, 49, 49, 49, 49, 49, 49},
+		{57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 26: 57, 29: 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 45: 57, 57, 65: 57, 57, 57, 57, 93: 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 112: 57, 57, 57, 57, 57},
+		{787},
+		{784, 147: 764, 152: 785, 779},
+		// 420
+		{45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 26: 45, 29: 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45: 45, 45, 65: 45, 45, 45, 45, 93: 45, 45, 45, 45, 45, 45, 45, 45, 45, 45},
+		{786},
+		{44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 26: 44, 29: 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 45: 44, 44, 65: 44, 44, 44, 44, 93: 44, 44, 44, 44, 44, 44, 44, 44, 44, 44},
+		{47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 26: 47, 29: 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 45: 47, 47, 65: 47, 47, 47, 47, 93: 47, 47, 47, 47, 47, 47, 47, 47, 47, 47},
+		{761},
+		// 425
+		{59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 26: 59, 29: 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 45: 59, 59, 65: 59, 59, 59, 59, 93: 59, 59, 59, 59, 59, 59, 59, 59, 59, 59},
+		{5: 247, 247, 247, 11: 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 247, 25: 247, 247, 41: 247, 60: 247},
+		{5: 246, 246, 246, 11: 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 25: 246, 246, 41: 246, 60: 246},
+		{3: 792, 6: 791, 145: 800, 790},
+		{5: 497, 501, 498, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 496, 41: 499, 49: 500, 64: 502, 125: 794},
+		// 430
+		{795, 69: 796},
+		{72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 26: 72, 29: 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 72, 45: 72, 72, 65: 72, 72, 72, 72, 93: 72, 72, 72, 72, 72, 72, 72, 72, 72, 72},
+		{1: 442, 441, 429, 439, 440, 612, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 434, 433, 444, 438, 90: 445, 446, 447, 103: 448, 449, 450, 451, 452, 454, 453, 455, 798, 169: 797},
+		{799},
+		{69},
+		// 435
+		{71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 26: 71, 29: 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 71, 45: 71, 71, 65: 71, 71, 71, 71, 93: 71, 71, 71, 71, 71, 71, 71, 71, 71, 71},
+		{25: 801},
+		{5: 245, 245, 245, 11: 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 245, 25: 245, 245, 41: 245, 60: 245},
+		{1: 442, 441, 429, 439, 440, 484, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 629, 433, 631, 630, 90: 445, 446, 447, 103: 448, 449, 450, 451, 807},
+		{1: 442, 441, 429, 439, 440, 612, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 434, 433, 444, 438, 90: 445, 446, 447, 103: 448, 449, 450, 451, 452, 454, 453, 455, 456, 119: 804},
+		// 440
+		{27: 642, 50: 805},
+		{1: 442, 441, 429, 439, 440, 484, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 629, 433, 631, 630, 90: 445, 446, 447, 103: 448, 449, 450, 451, 452, 806, 453},
+		{94, 8: 94, 24: 94, 94, 27: 94, 94, 50: 94, 60: 94},
+		{96, 8: 96, 24: 96, 96, 27: 96, 96, 43: 96, 808, 50: 96, 60: 96, 63: 96},
+		{1: 442, 441, 429, 439, 440, 484, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 629, 433, 631, 630, 90: 445, 446, 447, 103: 448, 449, 450, 809},
+		// 445
+		{98, 8: 98, 24: 98, 98, 27: 98, 98, 43: 98, 98, 47: 810, 50: 98, 60: 98, 63: 98},
+		{1: 442, 441, 429, 439, 440, 484, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 629, 433, 631, 630, 90: 445, 446, 447, 103: 448, 449, 811},
+		{100, 8: 100, 24: 100, 100, 27: 100, 100, 43: 100, 100, 47: 100, 812, 50: 100, 60: 100, 63: 100},
+		{1: 442, 441, 429, 439, 440, 484, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 629, 433, 631, 630, 90: 445, 446, 447, 103: 448, 813},
+		{102, 4: 814, 8: 102, 24: 102, 102, 27: 102, 102, 43: 102, 102, 47: 102, 102, 50: 102, 60: 102, 63: 102},
+		// 450
+		{1: 442, 441, 429, 439, 440, 484, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 629, 433, 631, 630, 90: 445, 446, 447, 103: 815},
+		{104, 4: 104, 8: 104, 24: 104, 104, 27: 104, 104, 43: 104, 104, 47: 104, 104, 50: 104, 816, 819, 821, 818, 820, 817, 60: 104, 63: 104},
+		{1: 442, 441, 429, 439, 440, 484, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 629, 433, 631, 630, 90: 445, 446, 843},
+		{1: 442, 441, 429, 439, 440, 484, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 629, 433, 631, 630, 90: 445, 446, 842},
+		{1: 442, 441, 429, 439, 440, 484, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 629, 433, 631, 630, 90: 445, 446, 841},
+		// 455
+		{1: 442, 441, 429, 439, 440, 484, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 629, 433, 631, 630, 90: 445, 446, 840},
+		{1: 442, 441, 429, 439, 440, 484, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 629, 433, 631, 630, 90: 445, 446, 839},
+		{1: 442, 441, 429, 439, 440, 484, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 629, 433, 631, 630, 90: 445, 446, 822},
+		{106, 4: 106, 8: 106, 24: 106, 106, 27: 106, 106, 43: 106, 106, 47: 106, 106, 50: 106, 106, 106, 106, 106, 106, 106, 825, 823, 824, 106, 63: 106},
+		{1: 442, 441, 429, 439, 440, 484, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 629, 433, 631, 630, 90: 445, 838},
+		// 460
+		{1: 442, 441, 429, 439, 440, 484, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 629, 433, 631, 630, 90: 445, 837},
+		{1: 442, 441, 429, 439, 440, 484, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 629, 433, 631, 630, 90: 445, 826},
+		{113, 828, 827, 4: 113, 8: 113, 24: 113, 113, 27: 113, 113, 43: 113, 113, 47: 113, 113, 50: 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 63: 113},
+		{1: 442, 441, 429, 439, 440, 484, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 629, 433, 631, 630, 90: 836},
+		{1: 442, 441, 429, 439, 440, 484, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 629, 433, 631, 630, 90: 829},
+		// 465
+		{117, 117, 117, 4: 117, 830, 8: 117, 24: 117, 117, 27: 117, 117, 43: 117, 117, 47: 117, 117, 50: 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 831, 832, 117},
+		{1: 442, 441, 429, 439, 440, 484, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 629, 433, 835, 630},
+		{1: 442, 441, 429, 439, 440, 484, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 629, 433, 834, 630},
+		{1: 442, 441, 429, 439, 440, 484, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 629, 433, 833, 630},
+		{120, 120, 120, 4: 120, 120, 8: 120, 24: 120, 120, 27: 120, 120, 43: 120, 120, 47: 120, 120, 50: 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120},
+		// 470
+		{121, 121, 121, 4: 121, 121, 8: 121, 24: 121, 121, 27: 121, 121, 43: 121, 121, 47: 121, 121, 50: 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121},
+		{122, 122, 122, 4: 122, 122, 8: 122, 24: 122, 122, 27: 122, 122, 43: 122, 122, 47: 122, 122, 50: 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122},
+		{118, 118, 118, 4: 118, 830, 8: 118, 24: 118, 118, 27: 118, 118, 43: 118, 118, 47: 118, 118, 50: 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 831, 832, 118},
+		{114, 828, 827, 4: 114, 8: 114, 24: 114, 114, 27: 114, 114, 43: 114, 114, 47: 114, 114, 50: 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 63: 114},
+		{115, 828, 827, 4: 115, 8: 115, 24: 115, 115, 27: 115, 115, 43: 115, 115, 47: 115, 115, 50: 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 63: 115},
+		// 475
+		{107, 4: 107, 8: 107, 24: 107, 107, 27: 107, 107, 43: 107, 107, 47: 107, 107, 50: 107, 107, 107, 107, 107, 107, 107, 825, 823, 824, 107, 63: 107},
+		{108, 4: 108, 8: 108, 24: 108, 108, 27: 108, 108, 43: 108, 108, 47: 108, 108, 50: 108, 108, 108, 108, 108, 108, 108, 825, 823, 824, 108, 63: 108},
+		{109, 4: 109, 8: 109, 24: 109, 109, 27: 109, 109, 43: 109, 109, 47: 109, 109, 50: 109, 109, 109, 109, 109, 109, 109, 825, 823, 824, 109, 63: 109},
+		{110, 4: 110, 8: 110, 24: 110, 110, 27: 110, 110, 43: 110, 110, 47: 110, 110, 50: 110, 110, 110, 110, 110, 110, 110, 825, 823, 824, 110, 63: 110},
+		{111, 4: 111, 8: 111, 24: 111, 111, 27: 111, 111, 43: 111, 111, 47: 111, 111, 50: 111, 111, 111, 111, 111, 111, 111, 825, 823, 824, 111, 63: 111},
+		// 480
+		{1: 442, 441, 429, 439, 440, 612, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 434, 433, 444, 438, 90: 445, 446, 447, 103: 448, 449, 450, 451, 452, 454, 453, 455, 695},
+		{173, 173, 173, 173, 173, 173, 7: 173, 173, 173, 173, 24: 846, 173, 27: 173, 173, 43: 173, 173, 47: 173, 173, 50: 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 69: 173, 78: 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173},
+		{6: 622, 8: 214, 27: 214, 157: 847},
+		{8: 848, 27: 624},
+		{91, 8: 91, 24: 91, 91, 27: 91, 91, 50: 91, 60: 91},
+		// 485
+		{130, 130, 130, 4: 130, 130, 8: 130, 24: 130, 130, 27: 130, 130, 43: 130, 130, 47: 130, 130, 50: 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 69: 130, 79: 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130},
+		{131, 131, 131, 4: 131, 131, 8: 131, 24: 131, 131, 27: 131, 131, 43: 131, 131, 47: 131, 131, 50: 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 69: 131, 79: 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131},
+		{6: 493, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 49: 492, 150: 855},
+		{1: 442, 441, 429, 439, 440, 612, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 434, 433, 444, 438, 90: 445, 446, 447, 103: 448, 449, 450, 451, 452, 454, 453, 455, 853},
+		{25: 854},
+		// 490
+		{142, 142, 142, 142, 142, 142, 7: 142, 142, 142, 142, 24: 142, 142, 27: 142, 142, 43: 142, 142, 47: 142, 142, 50: 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 69: 142, 78: 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142},
+		{143, 143, 143, 143, 143, 143, 7: 143, 143, 143, 143, 24: 143, 143, 27: 143, 143, 43: 143, 143, 47: 143, 143, 50: 143, 143, 143, 143, 143, 143, 143, 143, 143, 143, 143, 143, 143, 143, 69: 143, 78: 143, 143, 143, 143, 143, 143, 143, 143, 143, 143, 143, 143},
+		{3: 494, 6: 857, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 49: 492, 150: 495},
+		{152, 152, 152, 152, 152, 152, 7: 152, 152, 152, 152, 24: 858, 152, 27: 152, 152, 43: 152, 152, 47: 152, 152, 50: 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 69: 152, 78: 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152},
+		{6: 622, 8: 214, 27: 214, 157: 859},
+		// 495
+		{8: 860, 27: 624},
+		{90, 8: 90, 24: 90, 90, 27: 90, 90, 50: 90, 60: 90},
+		{25: 862, 27: 642},
+		{157, 157, 157, 157, 157, 157, 7: 157, 157, 157, 157, 24: 157, 157, 27: 157, 157, 43: 157, 157, 47: 157, 157, 50: 157, 157, 157, 157, 157, 157, 157, 157, 157, 157, 157, 157, 157, 157, 69: 157, 78: 157, 157, 157, 157, 157, 157, 157, 157, 157, 157, 157, 157},
+		{6: 864, 8: 177, 154: 865, 193: 866, 867},
+		// 500
+		{3: 872},
+		{871},
+		{6: 864, 8: 176, 154: 869},
+		{8: 868},
+		{171, 171, 171, 171, 171, 171, 7: 171, 171, 171, 171, 24: 171, 171, 27: 171, 171, 43: 171, 171, 47: 171, 171, 50: 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 69: 171, 78: 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171},
+		// 505
+		{870},
+		{6: 178, 8: 178},
+		{6: 179, 8: 179},
+		{1: 879, 6: 873, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 29: 878, 49: 875, 154: 877, 192: 876, 195: 874},
+		{3: 872, 25: 186, 27: 186},
+		// 510
+		{25: 185, 27: 185},
+		{78: 889},
+		{25: 886, 27: 885},
+		{25: 881, 27: 882},
+		{25: 175, 27: 175},
+		// 515
+		{29: 880},
+		{25: 174, 27: 174},
+		{181, 25: 181, 27: 181},
+		{6: 864, 154: 883},
+		{25: 884},
+		// 520
+		{180, 25: 180, 27: 180},
+		{6: 887},
+		{182, 25: 182, 27: 182},
+		{25: 888},
+		{183, 25: 183, 27: 183},
+		// 525
+		{6: 890},
+		{25: 184, 27: 184},
+		{475, 442, 441, 429, 439, 440, 413, 410, 893, 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 394, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 380, 378, 395, 458, 70: 431, 430, 414, 432, 434, 433, 444, 438, 90: 445, 446, 447, 476, 481, 480, 483, 478, 479, 482, 477, 103: 448, 449, 450, 451, 452, 454, 453, 455, 456, 119: 457, 461, 123: 459, 466, 126: 462, 472, 473, 468, 464, 467, 460, 463, 465, 474, 469, 142: 894},
+		{172, 172, 172, 172, 172, 172, 7: 172, 172, 172, 172, 24: 172, 172, 27: 172, 172, 43: 172, 172, 47: 172, 172, 50: 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 69: 172, 78: 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172},
+		{258, 258, 258, 258, 258, 258, 7: 258, 258, 258, 258, 24: 258, 258, 27: 258, 258, 43: 258, 258, 47: 258, 258, 50: 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 69: 258, 78: 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258, 258},
+		// 530
+		{475, 442, 441, 429, 439, 440, 413, 410, 895, 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 394, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 380, 378, 395, 458, 70: 431, 430, 414, 432, 434, 433, 444, 438, 90: 445, 446, 447, 476, 481, 480, 483, 478, 479, 482, 477, 103: 448, 449, 450, 451, 452, 454, 453, 455, 456, 119: 457, 461, 123: 459, 466, 126: 462, 714, 473, 468, 464, 467, 460, 463, 465, 474, 469},
+		{257, 257, 257, 257, 257, 257, 7: 257, 257, 257, 257, 24: 257, 257, 27: 257, 257, 43: 257, 257, 47: 257, 257, 50: 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 69: 257, 78: 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257, 257},
+		{58, 58, 58, 58, 58, 58, 58, 58, 9: 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 26: 58, 29: 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 45: 58, 58, 65: 58, 58, 58, 58, 93: 58, 58, 58, 58, 58, 58, 58, 58},
+		{5: 497, 501, 498, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 496, 41: 499, 49: 500, 64: 502, 125: 898},
+		{28: 899},
+		// 535
+		{6: 901, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 49: 900},
+		{24: 914},
+		{24: 902},
+		{1: 442, 441, 429, 439, 440, 612, 410, 905, 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 434, 433, 444, 438, 90: 445, 446, 447, 103: 448, 449, 450, 451, 452, 454, 453, 455, 903, 172: 904},
+		{50: 912},
+		// 540
+		{8: 907, 27: 906},
+		{187, 187, 187, 187, 187, 187, 7: 187, 187, 187, 187, 24: 187, 187, 27: 187, 187, 43: 187, 187, 47: 187, 187, 50: 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 69: 187, 78: 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187},
+		{1: 442, 441, 429, 439, 440, 612, 410, 909, 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 434, 433, 444, 438, 90: 445, 446, 447, 103: 448, 449, 450, 451, 452, 454, 453, 455, 908},
+		{189, 189, 189, 189, 189, 189, 7: 189, 189, 189, 189, 24: 189, 189, 27: 189, 189, 43: 189, 189, 47: 189, 189, 50: 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 69: 189, 78: 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189},
+		{50: 910},
+		// 545
+		{188, 188, 188, 188, 188, 188, 7: 188, 188, 188, 188, 24: 188, 188, 27: 188, 188, 43: 188, 188, 47: 188, 188, 50: 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 69: 188, 78: 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188},
+		{1: 442, 441, 429, 439, 440, 612, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 434, 433, 444, 438, 90: 445, 446, 447, 103: 448, 449, 450, 451, 452, 454, 453, 455, 911},
+		{8: 193, 27: 193},
+		{1: 442, 441, 429, 439, 440, 612, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 434, 433, 444, 438, 90: 445, 446, 447, 103: 448, 449, 450, 451, 452, 454, 453, 455, 913},
+		{8: 194, 27: 194},
+		// 550
+		{1: 442, 441, 429, 439, 440, 612, 410, 916, 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 434, 433, 444, 438, 90: 445, 446, 447, 103: 448, 449, 450, 451, 452, 454, 453, 455, 903, 172: 915},
+		{8: 918, 27: 917},
+		{190, 190, 190, 190, 190, 190, 7: 190, 190, 190, 190, 24: 190, 190, 27: 190, 190, 43: 190, 190, 47: 190, 190, 50: 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 69: 190, 78: 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190, 190},
+		{1: 442, 441, 429, 439, 440, 612, 410, 919, 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 434, 433, 444, 438, 90: 445, 446, 447, 103: 448, 449, 450, 451, 452, 454, 453, 455, 908},
+		{192, 192, 192, 192, 192, 192, 7: 192, 192, 192, 192, 24: 192, 192, 27: 192, 192, 43: 192, 192, 47: 192, 192, 50: 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 69: 192, 78: 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192},
+		// 555
+		{191, 191, 191, 191, 191, 191, 7: 191, 191, 191, 191, 24: 191, 191, 27: 191, 191, 43: 191, 191, 47: 191, 191, 50: 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 69: 191, 78: 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191, 191},
+		{24: 929},
+		{24: 922},
+		{1: 442, 441, 429, 439, 440, 612, 410, 925, 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 434, 433, 444, 438, 90: 445, 446, 447, 103: 448, 449, 450, 451, 452, 454, 453, 455, 923, 158: 924},
+		{8: 211, 27: 211},
+		// 560
+		{8: 927, 27: 926},
+		{202, 202, 202, 202, 202, 202, 7: 202, 202, 202, 202, 24: 202, 202, 27: 202, 202, 43: 202, 202, 47: 202, 202, 50: 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 69: 202, 78: 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202, 202},
+		{1: 442, 441, 429, 439, 440, 612, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 434, 433, 444, 438, 90: 445, 446, 447, 103: 448, 449, 450, 451, 452, 454, 453, 455, 928},
+		{203, 203, 203, 203, 203, 203, 7: 203, 203, 203, 203, 24: 203, 203, 27: 203, 203, 43: 203, 203, 47: 203, 203, 50: 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 69: 203, 78: 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203},
+		{8: 210, 27: 210},
+		// 565
+		{1: 442, 441, 429, 439, 440, 612, 410, 931, 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 434, 433, 444, 438, 90: 445, 446, 447, 103: 448, 449, 450, 451, 452, 454, 453, 455, 923, 158: 930},
+		{8: 932, 27: 926},
+		{204, 204, 204, 204, 204, 204, 7: 204, 204, 204, 204, 24: 204, 204, 27: 204, 204, 43: 204, 204, 47: 204, 204, 50: 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 69: 204, 78: 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204},
+		{205, 205, 205, 205, 205, 205, 7: 205, 205, 205, 205, 24: 205, 205, 27: 205, 205, 43: 205, 205, 47: 205, 205, 50: 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 69: 205, 78: 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205, 205},
+		{6: 935, 934, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 49: 936, 70: 937},
+		// 570
+		{28: 933},
+		{24: 945},
+		{24: 938},
+		{195, 195, 195, 195, 195, 195, 7: 195, 195, 195, 195, 24: 195, 195, 27: 195, 195, 43: 195, 195, 47: 195, 195, 50: 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 69: 195, 78: 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195},
+		{1: 442, 441, 429, 439, 440, 612, 410, 941, 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 434, 433, 444, 438, 90: 445, 446, 447, 103: 448, 449, 450, 451, 452, 454, 453, 455, 939, 176: 940},
+		// 575
+		{8: 201, 27: 201},
+		{8: 943, 27: 942},
+		{196, 196, 196, 196, 196, 196, 7: 196, 196, 196, 196, 24: 196, 196, 27: 196, 196, 43: 196, 196, 47: 196, 196, 50: 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 69: 196, 78: 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196},
+		{1: 442, 441, 429, 439, 440, 612, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 434, 433, 444, 438, 90: 445, 446, 447, 103: 448, 449, 450, 451, 452, 454, 453, 455, 944},
+		{197, 197, 197, 197, 197, 197, 7: 197, 197, 197, 197, 24: 197, 197, 27: 197, 197, 43: 197, 197, 47: 197, 197, 50: 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 69: 197, 78: 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197},
+		// 580
+		{8: 200, 27: 200},
+		{1: 442, 441, 429, 439, 440, 612, 410, 947, 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 434, 433, 444, 438, 90: 445, 446, 447, 103: 448, 449, 450, 451, 452, 454, 453, 455, 939, 176: 946},
+		{8: 948, 27: 942},
+		{198, 198, 198, 198, 198, 198, 7: 198, 198, 198, 198, 24: 198, 198, 27: 198, 198, 43: 198, 198, 47: 198, 198, 50: 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 69: 198, 78: 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198},
+		{199, 199, 199, 199, 199, 199, 7: 199, 199, 199, 199, 24: 199, 199, 27: 199, 199, 43: 199, 199, 47: 199, 199, 50: 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 69: 199, 78: 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199},
+		// 585
+		{3: 388, 24: 260, 149: 950},
+		{24: 259},
+		{6: 952},
+		{953},
+		{349, 349, 349, 349, 349, 349, 349, 349, 349, 349, 349, 349, 349, 349, 349, 349, 349, 349, 349, 349, 349, 349, 349, 349, 349, 26: 349, 29: 349, 349, 349, 349, 349, 349, 349, 349, 349, 349, 349, 349, 349, 349, 45: 349, 349, 65: 349, 349, 349, 349, 93: 349, 349, 349, 349, 349, 349, 349, 349, 349, 349},
+		// 590
+		{26: 255, 65: 255, 255, 255, 255, 112: 255, 255, 255, 255, 255},
+		{262, 3: 262, 5: 262, 262, 262, 11: 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 262, 26: 262, 41: 262},
+		{25: 965},
+		{25: 252, 27: 963},
+		{25: 251, 27: 251},
+		// 595
+		{5: 497, 501, 498, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 496, 41: 499, 49: 500, 60: 961, 64: 502, 125: 960},
+		{249, 25: 249, 27: 249},
+		{5: 497, 501, 498, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 496, 41: 499, 49: 500, 64: 502, 125: 962},
+		{248, 25: 248, 27: 248},
+		{3: 792, 6: 791, 145: 959, 790, 151: 964},
+		// 600
+		{25: 250, 27: 250},
+		{261, 3: 261, 5: 261, 261, 261, 11: 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 261, 26: 261, 41: 261},
+		{3: 264},
+		{3: 792, 6: 791, 145: 959, 790, 151: 958, 174: 957, 968},
+		{25: 969},
+		// 605
+		{6: 970},
+		{3: 263},
+		{972},
+		{26: 265, 65: 265, 265, 265, 265, 112: 265, 265, 265, 265, 265},
+		{974},
+		// 610
+		{26: 266, 65: 266, 266, 266, 266, 112: 266, 266, 266, 266, 266},
+		{5: 497, 501, 498, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 496, 41: 499, 49: 500, 64: 502, 69: 978, 125: 977, 196: 979, 199: 976},
+		{24: 998, 200: 997},
+		{996},
+		{5: 497, 501, 498, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 496, 41: 499, 49: 500, 64: 502, 125: 994},
+		// 615
+		{24: 980},
+		{6: 984, 8: 981, 171: 983, 197: 982},
+		{993},
+		{6: 984, 8: 989, 171: 990},
+		{988},
+		// 620
+		{3: 388, 149: 985},
+		{277, 3: 388, 5: 497, 501, 498, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 496, 41: 499, 49: 500, 64: 502, 125: 987, 149: 986},
+		{276},
+		{275},
+		{6: 279, 8: 279},
+		// 625
+		{992},
+		{991},
+		{6: 278, 8: 278},
+		{26: 280, 65: 280, 280, 280, 280, 112: 280, 280, 280, 280, 280},
+		{26: 281, 65: 281, 281, 281, 281, 112: 281, 281, 281, 281, 281},
+		// 630
+		{995},
+		{26: 282, 65: 282, 282, 282, 282, 112: 282, 282, 282, 282, 282},
+		{26: 283, 65: 283, 283, 283, 283, 112: 283, 283, 283, 283, 283},
+		{26: 284, 65: 284, 284, 284, 284, 112: 284, 284, 284, 284, 284},
+		{3: 792, 6: 1002, 8: 999, 145: 959, 790, 151: 1001, 190: 1000},
+		// 635
+		{1017},
+		{3: 792, 6: 1010, 8: 1008, 145: 959, 790, 151: 1009},
+		{1007},
+		{1003, 5: 246, 246, 246, 11: 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 26: 246, 41: 246, 60: 246, 78: 1004},
+		{3: 270, 6: 270, 8: 270},
+		// 640
+		{6: 1005},
+		{1006},
+		{3: 269, 6: 269, 8: 269},
+		{3: 272, 6: 272, 8: 272},
+		{1016},
+		// 645
+		{1015},
+		{1011, 5: 246, 246, 246, 11: 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 246, 26: 246, 41: 246, 60: 246, 78: 1012},
+		{3: 268, 6: 268, 8: 268},
+		{6: 1013},
+		{1014},
+		// 650
+		{3: 267, 6: 267, 8: 267},
+		{3: 271, 6: 271, 8: 271},
+		{26: 273, 65: 273, 273, 273, 273, 112: 273, 273, 273, 273, 273},
+		{26: 274, 65: 274, 274, 274, 274, 112: 274, 274, 274, 274, 274},
+		{24: 1019},
+		// 655
+		{6: 1020, 162: 1021},
+		{290, 8: 290, 27: 290},
+		{1024, 8: 1025, 27: 1023, 163: 1022},
+		{6: 1027, 8: 1028},
+		{6: 288, 8: 288},
+		// 660
+		{6: 287, 8: 287},
+		{1026},
+		{26: 286, 65: 286, 286, 286, 286, 112: 286, 286, 286, 286, 286},
+		{289, 8: 289, 27: 289},
+		{1029},
+		// 665
+		{26: 285, 65: 285, 285, 285, 285, 112: 285, 285, 285, 285, 285},
+		{24: 1031},
+		{6: 1020, 162: 1032},
+		{1024, 8: 1033, 27: 1023, 163: 1034},
+		{1037},
+		// 670
+		{6: 1027, 8: 1035},
+		{1036},
+		{26: 291, 65: 291, 291, 291, 291, 112: 291, 291, 291, 291, 291},
+		{26: 292, 65: 292, 292, 292, 292, 112: 292, 292, 292, 292, 292},
+		{346, 346, 346, 346, 346, 346, 346, 346, 346, 346, 346, 346, 346, 346, 346, 346, 346, 346, 346, 346, 346, 346, 346, 346, 346, 26: 346, 29: 346, 346, 346, 346, 346, 346, 346, 346, 346, 346, 346, 346, 346, 346, 45: 346, 346, 65: 346, 346, 346, 346, 93: 346, 346, 346, 346, 346, 346, 346, 346, 346, 346, 112: 346, 346, 346, 346, 346},
+		// 675
+		{6: 1040, 156: 1049, 188: 1048},
+		{11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 49: 1042, 69: 1041},
+		{1: 525, 524, 521, 6: 508, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 29: 513, 509, 510, 511, 520, 519, 514, 512, 515, 517, 518, 516, 42: 526, 49: 522, 117: 523, 527, 121: 528, 529, 137: 530, 139: 531, 532, 533, 143: 534, 535, 148: 1046},
+		{69: 1043},
+		{1: 525, 524, 521, 6: 508, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 29: 513, 509, 510, 511, 520, 519, 514, 512, 515, 517, 518, 516, 42: 526, 49: 522, 117: 523, 527, 121: 528, 529, 137: 530, 139: 531, 532, 533, 143: 534, 535, 148: 1044},
+		// 680
+		{1045, 43: 537},
+		{341, 341, 341, 341, 341, 341, 341, 341, 341, 341, 341, 341, 341, 341, 341, 341, 341, 341, 341, 341, 341, 341, 341, 341, 341, 341, 341, 29: 341, 341, 341, 341, 341, 341, 341, 341, 341, 341, 341, 341, 341, 341, 45: 341, 341, 65: 341, 341, 341, 341, 93: 341, 341, 341, 341, 341, 341, 341, 341, 341, 341, 112: 341, 341, 341, 341, 341},
+		{1047, 43: 537},
+		{342, 342, 342, 342, 342, 342, 342, 342, 342, 342, 342, 342, 342, 342, 342, 342, 342, 342, 342, 342, 342, 342, 342, 342, 342, 342, 342, 29: 342, 342, 342, 342, 342, 342, 342, 342, 342, 342, 342, 342, 342, 342, 45: 342, 342, 65: 342, 342, 342, 342, 93: 342, 342, 342, 342, 342, 342, 342, 342, 342, 342, 112: 342, 342, 342, 342, 342},
+		{6: 1040, 25: 1050, 156: 1051},
+		// 685
+		{6: 344, 25: 344},
+		{1052},
+		{6: 343, 25: 343},
+		{345, 345, 345, 345, 345, 345, 345, 345, 345, 345, 345, 345, 345, 345, 345, 345, 345, 345, 345, 345, 345, 345, 345, 345, 345, 26: 345, 29: 345, 345, 345, 345, 345, 345, 345, 345, 345, 345, 345, 345, 345, 345, 45: 345, 345, 65: 345, 345, 345, 345, 93: 345, 345, 345, 345, 345, 345, 345, 345, 345, 345, 112: 345, 345, 345, 345, 345},
+		{5: 497, 501, 498, 11: 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 496, 41: 499, 49: 500, 64: 502, 125: 1054},
+		// 690
+		{1055, 69: 1056},
+		{26: 348, 65: 348, 348, 348, 348, 112: 348, 348, 348, 348, 348},
+		{1: 442, 441, 429, 439, 440, 612, 410, 9: 437, 436, 397, 398, 400, 401, 403, 404, 405, 402, 399, 407, 408, 409, 406, 26: 396, 29: 421, 416, 417, 419, 428, 427, 422, 420, 423, 425, 426, 424, 412, 443, 45: 415, 418, 49: 435, 64: 411, 70: 431, 430, 414, 432, 434, 433, 444, 438, 90: 445, 446, 447, 103: 448, 449, 450, 451, 452, 454, 453, 455, 798, 169: 1057},
+		{1058},
+		{26: 347, 65: 347, 347, 347, 347, 112: 347, 347, 347, 347, 347},
+		// 695
+		{26: 362, 65: 362, 362, 362, 362, 112: 362, 362, 362, 362, 362},
 	}
 )
 
@@ -1441,9 +2011,9 @@ func yylex1(yylex yyLexer, lval *yySymType) (n int) {
 	}
 	return n
 }
-
+	
 func yyParse(yylex yyLexer) int {
-	const yyError = 178
+	const yyError = 221
 
 	yyEx, _ := yylex.(yyLexerEx)
 	var yyn int
@@ -1451,9 +2021,10 @@ func yyParse(yylex yyLexer) int {
 	var yyVAL yySymType
 	yyS := make([]yySymType, 200)
 
+
 	Nerrs := 0   /* number of errors */
 	Errflag := 0 /* error recovery flag */
-	yyerrok := func() {
+	yyerrok := func() { 
 		if yyDebug >= 2 {
 			__yyfmt__.Printf("yyerrok()\n")
 		}
@@ -1573,7 +2144,7 @@ yynewstate:
 			for yyp >= 0 {
 				row := yyParseTab[yyS[yyp].yys]
 				if yyError < len(row) {
-					yyn = int(row[yyError]) + yyTabOfs
+					yyn = int(row[yyError])+yyTabOfs
 					if yyn > 0 { // hit
 						if yyDebug >= 2 {
 							__yyfmt__.Printf("error recovery found error shift in state %d\n", yyS[yyp].yys)
@@ -1624,613 +2195,702 @@ yynewstate:
 
 	/* consult goto table to find next state */
 	exState := yystate
-	yystate = int(yyParseTab[yyS[yyp].yys][x]) + yyTabOfs
+	yystate = int(yyParseTab[yyS[yyp].yys][x])+yyTabOfs
 	/* reduction by production r */
 	if yyDebug >= 2 {
 		__yyfmt__.Printf("reduce using rule %v (%s), and goto state %d\n", r, yySymNames[x], yystate)
 	}
 
 	switch r {
-	case 9:
-		{
+	case 14: {
 			actions.AST.PrintProgram()
-		}
-	case 10:
-		{
-			//
-		}
-	case 11:
-		{
+                }
+	case 15: {
+                //
+                }
+	case 16: {
 			actions.DeclareGlobal(yyS[yypt-2].argument, yyS[yypt-1].argument, nil, false)
-		}
-	case 12:
-		{
+                }
+	case 17: {
 			actions.DeclareGlobal(yyS[yypt-4].argument, yyS[yypt-3].argument, yyS[yypt-1].expressions, true)
-		}
-	case 13:
-		{
-			actions.DeclareStruct(yyS[yypt-2].tok, yyS[yypt-0].arguments)
-		}
-	case 14:
-		{
-			yyVAL.arguments = nil
-		}
-	case 15:
-		{
-			yyVAL.arguments = yyS[yypt-2].arguments
-		}
-	case 16:
-		{
+                }
+	case 22: {
+			actions.DeclareConstant(yyS[yypt-3].tok, yyS[yypt-3].line, constants.TYPE_UNDEFINED, yyS[yypt-1].constant)
+                }
+	case 23: {
+			actions.DeclareConstant(yyS[yypt-4].tok, yyS[yypt-4].line, yyS[yypt-3].i, yyS[yypt-1].constant)
+                }
+	case 24: {
+			yyVAL.constant = actions.ConstantIdentifier("", yyS[yypt-0].tok)
+                }
+	case 25: {
+			yyVAL.constant = actions.ConstantIdentifier(yyS[yypt-2].tok, yyS[yypt-0].tok)
+                }
+	case 26: {
+			yyVAL.constant = actions.ConstantStr(yyS[yypt-0].tok)
+                }
+	case 27: {
+			yyVAL.constant = actions.ConstantBool(yyS[yypt-0].bool)
+                }
+	case 28: {
+			yyVAL.constant = actions.ConstantInt(constants.TYPE_I8, int64(yyS[yypt-0].i8))
+                }
+	case 29: {
+			yyVAL.constant = actions.ConstantInt(constants.TYPE_I16, int64(yyS[yypt-0].i16))
+                }
+	case 30: {
+			yyVAL.constant = actions.ConstantInt(constants.TYPE_I32, int64(yyS[yypt-0].i32))
+                }
+	case 31: {
+			yyVAL.constant = actions.ConstantInt(constants.TYPE_I64, int64(yyS[yypt-0].i64))
+                }
+	case 32: {
+			yyVAL.constant = actions.ConstantInt(constants.TYPE_UI8, int64(yyS[yypt-0].ui8))
+                }
+	case 33: {
+			yyVAL.constant = actions.ConstantInt(constants.TYPE_UI16, int64(yyS[yypt-0].ui16))
+                }
+	case 34: {
+			yyVAL.constant = actions.ConstantInt(constants.TYPE_UI32, int64(yyS[yypt-0].ui32))
+                }
+	case 35: {
+			yyVAL.constant = actions.ConstantInt(constants.TYPE_UI64, int64(yyS[yypt-0].ui64))
+                }
+	case 36: {
+			yyVAL.constant = actions.ConstantFloat(constants.TYPE_F32, float64(yyS[yypt-0].f32))
+                }
+	case 37: {
+			yyVAL.constant = actions.ConstantFloat(constants.TYPE_F64, yyS[yypt-0].f64)
+                }
+	case 38: {
+			yyVAL.constant = yyS[yypt-1].constant
+                }
+	case 39: {
+			yyVAL.constant = actions.ConstantConversion(yyS[yypt-3].i, yyS[yypt-1].constant)
+                }
+	case 41: {
+			yyVAL.constant = yyS[yypt-0].constant
+                }
+	case 42: {
+			yyVAL.constant = actions.ConstantUnary(constants.OP_NEG, yyS[yypt-0].constant)
+                }
+	case 43: {
+			yyVAL.constant = actions.ConstantUnary(constants.OP_BOOL_NOT, yyS[yypt-0].constant)
+                }
+	case 45: {
+			yyVAL.constant = actions.ConstantBinary(yyS[yypt-2].constant, yyS[yypt-0].constant, constants.OP_MUL)
+                }
+	case 46: {
+			yyVAL.constant = actions.ConstantBinary(yyS[yypt-2].constant, yyS[yypt-0].constant, constants.OP_DIV)
+                }
+	case 47: {
+			yyVAL.constant = actions.ConstantBinary(yyS[yypt-2].constant, yyS[yypt-0].constant, constants.OP_MOD)
+                }
+	case 49: {
+			yyVAL.constant = actions.ConstantBinary(yyS[yypt-2].constant, yyS[yypt-0].constant, constants.OP_ADD)
+                }
+	case 50: {
+			yyVAL.constant = actions.ConstantBinary(yyS[yypt-2].constant, yyS[yypt-0].constant, constants.OP_SUB)
+                }
+	case 52: {
+			yyVAL.constant = actions.ConstantBinary(yyS[yypt-2].constant, yyS[yypt-0].constant, constants.OP_BITSHL)
+                }
+	case 53: {
+			yyVAL.constant = actions.ConstantBinary(yyS[yypt-2].constant, yyS[yypt-0].constant, constants.OP_BITSHR)
+                }
+	case 54: {
+			yyVAL.constant = actions.ConstantBinary(yyS[yypt-2].constant, yyS[yypt-0].constant, constants.OP_BITCLEAR)
+                }
+	case 56: {
+			yyVAL.constant = actions.ConstantBinary(yyS[yypt-2].constant, yyS[yypt-0].constant, constants.OP_EQUAL)
+                }
+	case 57: {
+			yyVAL.constant = actions.ConstantBinary(yyS[yypt-2].constant, yyS[yypt-0].constant, constants.OP_UNEQUAL)
+                }
+	case 58: {
+			yyVAL.constant = actions.ConstantBinary(yyS[yypt-2].constant, yyS[yypt-0].constant, constants.OP_LT)
+                }
+	case 59: {
+			yyVAL.constant = actions.ConstantBinary(yyS[yypt-2].constant, yyS[yypt-0].constant, constants.OP_GT)
+                }
+	case 60: {
+			yyVAL.constant = actions.ConstantBinary(yyS[yypt-2].constant, yyS[yypt-0].constant, constants.OP_LTEQ)
+                }
+	case 61: {
+			yyVAL.constant = actions.ConstantBinary(yyS[yypt-2].constant, yyS[yypt-0].constant, constants.OP_GTEQ)
+                }
+	case 63: {
+			yyVAL.constant = actions.ConstantBinary(yyS[yypt-2].constant, yyS[yypt-0].constant, constants.OP_BITAND)
+                }
+	case 65: {
+			yyVAL.constant = actions.ConstantBinary(yyS[yypt-2].constant, yyS[yypt-0].constant, constants.OP_BITXOR)
+                }
+	case 67: {
+			yyVAL.constant = actions.ConstantBinary(yyS[yypt-2].constant, yyS[yypt-0].constant, constants.OP_BITOR)
+                }
+	case 69: {
+			yyVAL.constant = actions.ConstantBinary(yyS[yypt-2].constant, yyS[yypt-0].constant, constants.OP_BOOL_AND)
+                }
+	case 71: {
+			yyVAL.constant = actions.ConstantBinary(yyS[yypt-2].constant, yyS[yypt-0].constant, constants.OP_BOOL_OR)
+                }
+	case 72: {
+			actions.DeclareEnum(yyS[yypt-4].tok, yyS[yypt-4].line, yyS[yypt-2].stringA)
+                }
+	case 73: {
+			actions.DeclareEnum(yyS[yypt-5].tok, yyS[yypt-5].line, yyS[yypt-3].stringA)
+                }
+	case 74: {
+			yyVAL.stringA = []string{yyS[yypt-0].tok}
+                }
+	case 75: {
+			yyVAL.stringA = append(yyS[yypt-2].stringA, yyS[yypt-0].tok)
+                }
+	case 78: {
+			actions.DeclareUnion(yyS[yypt-4].tok, yyS[yypt-4].line, yyS[yypt-2].stringA, true)
+                }
+	case 79: {
+			actions.DeclareUnion(yyS[yypt-5].tok, yyS[yypt-5].line, yyS[yypt-3].stringA, true)
+                }
+	case 80: {
+		        actions.DeclareStruct(yyS[yypt-2].tok, yyS[yypt-0].arguments)
+                }
+	case 81: {
+			actions.DeclareNamedType(yyS[yypt-2].tok, yyS[yypt-2].line, yyS[yypt-1].argument, false)
+                }
+	case 82: {
+			actions.DeclareNamedType(yyS[yypt-3].tok, yyS[yypt-3].line, yyS[yypt-1].argument, true)
+                }
+	case 83: {
+			actions.DeclareInterface(yyS[yypt-4].tok, nil, true)
+                }
+	case 84: {
+			actions.DeclareInterface(yyS[yypt-5].tok, yyS[yypt-2].InterfaceMethods, true)
+                }
+	case 85: {
+			yyVAL.InterfaceMethods = []actions.InterfaceMethod{yyS[yypt-1].InterfaceMethod}
+                }
+	case 86: {
+			yyVAL.InterfaceMethods = append(yyS[yypt-2].InterfaceMethods, yyS[yypt-1].InterfaceMethod)
+                }
+	case 87: {
+			yyVAL.InterfaceMethod = actions.InterfaceMethod{Name: yyS[yypt-1].tok, Line: actions.LineNo, Inputs: yyS[yypt-0].arguments}
+                }
+	case 88: {
+			yyVAL.InterfaceMethod = actions.InterfaceMethod{Name: yyS[yypt-2].tok, Line: actions.LineNo, Inputs: yyS[yypt-1].arguments, Outputs: yyS[yypt-0].arguments}
+                }
+	case 89: {
+			yyVAL.InterfaceMethod = actions.InterfaceMethod{Name: yyS[yypt-2].tok, Line: actions.LineNo, Inputs: yyS[yypt-1].arguments, Outputs: []*ast.CXArgument{yyS[yypt-0].argument}}
+                }
+	case 90: { yyVAL.arguments = nil }
+	case 91: { yyVAL.arguments = yyS[yypt-2].arguments }
+	case 92: {
 			yyVAL.arguments = []*ast.CXArgument{yyS[yypt-1].argument}
-		}
-	case 17:
-		{
+                }
+	case 93: {
 			yyVAL.arguments = append(yyS[yypt-2].arguments, yyS[yypt-1].argument)
-		}
-	case 18:
-		{
+                }
+	case 94: {
+			yyVAL.arguments = []*ast.CXArgument{actions.EmbeddedField(yyS[yypt-1].tok, "", false)}
+                }
+	case 95: {
+			yyVAL.arguments = []*ast.CXArgument{actions.EmbeddedField(yyS[yypt-1].tok, yyS[yypt-3].tok, true)}
+                }
+	case 96: {
+			yyVAL.arguments = append(yyS[yypt-2].arguments, actions.EmbeddedField(yyS[yypt-1].tok, "", false))
+                }
+	case 97: {
+			yyVAL.arguments = append(yyS[yypt-4].arguments, actions.EmbeddedField(yyS[yypt-1].tok, yyS[yypt-3].tok, true))
+                }
+	case 98: {
 			actions.DeclarePackage(yyS[yypt-1].tok)
-		}
-	case 19:
-		{
+                }
+	case 99: {
 			// DeclareImport($2)
-		}
-	case 20:
-		{
+                }
+	case 100: {
 			yylval.line = 0
+			actions.OpenConstantScope()
 			yyVAL.function = actions.FunctionHeader(yyS[yypt-0].tok, nil, false)
-		}
-	case 21:
-		{
+                }
+	case 101: {
+			actions.OpenConstantScope()
+			actions.DeclareLocalNames(yyS[yypt-2].arguments)
 			yyVAL.function = actions.FunctionHeader(yyS[yypt-0].tok, yyS[yypt-2].arguments, true)
-		}
-	case 22:
-		{
-			yyVAL.arguments = nil
-		}
-	case 23:
-		{
-			yyVAL.arguments = yyS[yypt-1].arguments
-		}
-	case 24:
-		{
-			actions.FunctionDeclaration(yyS[yypt-2].function, yyS[yypt-1].arguments, nil, yyS[yypt-0].expressions)
-		}
-	case 25:
-		{
-			actions.FunctionDeclaration(yyS[yypt-3].function, yyS[yypt-2].arguments, yyS[yypt-1].arguments, yyS[yypt-0].expressions)
-		}
-	case 27:
-		{
+                }
+	case 102: { yyVAL.arguments = nil }
+	case 103: { yyVAL.arguments = yyS[yypt-1].arguments }
+	case 104: {
+			actions.DeclareLocalNames(yyS[yypt-0].arguments)
+			yyVAL.function = actions.FunctionLiteralHeader(yyS[yypt-0].arguments, nil)
+                }
+	case 105: {
+			actions.DeclareLocalNames(yyS[yypt-1].arguments)
+			actions.DeclareLocalNames(yyS[yypt-0].arguments)
+			yyVAL.function = actions.FunctionLiteralHeader(yyS[yypt-1].arguments, yyS[yypt-0].arguments)
+                }
+	case 106: { yyVAL.expressions = nil }
+	case 107: { yyVAL.expressions = yyS[yypt-1].expressions }
+	case 108: {
+			actions.DeclareLocalNames(yyS[yypt-0].arguments)
+                }
+	case 109: {
+			actions.CloseConstantScope()
+			actions.FunctionDeclaration(yyS[yypt-3].function, yyS[yypt-2].arguments, nil, yyS[yypt-0].expressions)
+                }
+	case 110: {
+			actions.DeclareLocalNames(yyS[yypt-1].arguments)
+			actions.DeclareLocalNames(yyS[yypt-0].arguments)
+                }
+	case 111: {
+			actions.CloseConstantScope()
+			actions.FunctionDeclaration(yyS[yypt-4].function, yyS[yypt-3].arguments, yyS[yypt-2].arguments, yyS[yypt-0].expressions)
+                }
+	case 113: {
 			yyVAL.arguments = []*ast.CXArgument{yyS[yypt-0].argument}
-		}
-	case 28:
-		{
+                }
+	case 114: {
 			yyVAL.arguments = append(yyS[yypt-2].arguments, yyS[yypt-0].argument)
-		}
-	case 29:
-		{
+                }
+	case 115: {
 			yyS[yypt-0].argument.ArgDetails.Name = yyS[yypt-1].argument.ArgDetails.Name
 			yyS[yypt-0].argument.ArgDetails.Package = yyS[yypt-1].argument.ArgDetails.Package
 			yyS[yypt-0].argument.IsLocalDeclaration = true
 			yyVAL.argument = yyS[yypt-0].argument
-		}
-	case 31:
-		{
+                }
+	case 116: {
+			yyVAL.argument = actions.VariadicParameterDeclaration(yyS[yypt-2].argument, yyS[yypt-0].argument)
+                }
+	case 118: {
 			if pkg, err := actions.AST.GetCurrentPackage(); err == nil {
 				arg := ast.MakeArgument("", actions.CurrentFile, actions.LineNo)
-				arg.AddType(constants.TypeNames[constants.TYPE_UNDEFINED])
+                                arg.AddType(constants.TypeNames[constants.TYPE_UNDEFINED])
 				arg.ArgDetails.Name = yyS[yypt-0].tok
 				arg.ArgDetails.Package = pkg
 				yyVAL.argument = arg
 			} else {
 				panic(err)
 			}
+                }
+	case 119: { yyVAL.argument = yyS[yypt-1].argument }
+	case 120: {
+			yyVAL.arguments = []*ast.CXArgument{yyS[yypt-0].argument}
 		}
-	case 32:
-		{
-			yyVAL.argument = yyS[yypt-1].argument
-		}
-	case 33:
-		{
-			arg := actions.DeclarationSpecifiersStruct(yyS[yypt-0].tok, "", false, actions.CurrentFile, actions.LineNo)
-			yyVAL.arguments = []*ast.CXArgument{arg}
-		}
-	case 34:
-		{
-			arg := actions.DeclarationSpecifiersBasic(yyS[yypt-0].i)
-			yyVAL.arguments = []*ast.CXArgument{arg}
+	case 121: {
+			yyVAL.arguments = append(yyS[yypt-2].arguments, yyS[yypt-0].argument)
 		}
-	case 35:
-		{
-			arg := actions.DeclarationSpecifiersStruct(yyS[yypt-0].tok, "", false, actions.CurrentFile, actions.LineNo)
-			yyVAL.arguments = append(yyS[yypt-2].arguments, arg)
+	case 122: {
+			yyVAL.arguments = []*ast.CXArgument{actions.VariadicTypeDeclaration(yyS[yypt-0].argument)}
 		}
-	case 36:
-		{
-			arg := actions.DeclarationSpecifiersBasic(yyS[yypt-0].i)
-			yyVAL.arguments = append(yyS[yypt-2].arguments, arg)
+	case 123: {
+			yyVAL.arguments = append(yyS[yypt-3].arguments, actions.VariadicTypeDeclaration(yyS[yypt-0].argument))
 		}
-	case 37:
-		{
-
+	case 124: {
 			yyVAL.arguments = yyS[yypt-1].arguments
-
 		}
-	case 38:
-		{
+	case 125: {
 			yyVAL.arguments = nil
 		}
-	case 39:
-		{
+	case 126: {
 			arg := ast.MakeArgument("", actions.CurrentFile, actions.LineNo).AddType("func")
 			arg.Inputs = yyS[yypt-1].arguments
 			arg.Outputs = yyS[yypt-0].arguments
 			yyVAL.argument = actions.DeclarationSpecifiers(arg, []int{0}, constants.DECL_FUNC)
 		}
-	case 40:
-		{
-			yyVAL.argument = actions.DeclarationSpecifiers(yyS[yypt-0].argument, []int{0}, constants.DECL_POINTER)
+	case 127: {
+			arg := ast.MakeArgument("", actions.CurrentFile, actions.LineNo).AddType("func")
+			arg.Inputs = yyS[yypt-0].arguments
+			yyVAL.argument = actions.DeclarationSpecifiers(arg, []int{0}, constants.DECL_FUNC)
 		}
-	case 41:
-		{
+	case 128: {
+			yyVAL.argument = actions.DeclarationSpecifiers(yyS[yypt-0].argument, []int{0}, constants.DECL_POINTER)
+                }
+	case 129: {
 			yyVAL.argument = actions.DeclarationSpecifiers(yyS[yypt-0].argument, []int{0}, constants.DECL_SLICE)
-		}
-	case 42:
-		{
+                }
+	case 130: {
+			yyVAL.argument = actions.DeclarationSpecifiersMap(yyS[yypt-2].argument, yyS[yypt-0].argument)
+                }
+	case 131: {
 			yyVAL.argument = actions.DeclarationSpecifiersBasic(yyS[yypt-0].i)
-		}
-	case 43:
-		{
+                }
+	case 132: {
 			yyVAL.argument = actions.DeclarationSpecifiersStruct(yyS[yypt-0].tok, "", false, actions.CurrentFile, actions.LineNo)
-		}
-	case 44:
-		{
+                }
+	case 133: {
 			basic := actions.DeclarationSpecifiersBasic(yyS[yypt-0].i)
 			yyVAL.argument = actions.DeclarationSpecifiers(basic, yyS[yypt-1].ints, constants.DECL_ARRAY)
-		}
-	case 45:
-		{
+                }
+	case 134: {
 			strct := actions.DeclarationSpecifiersStruct(yyS[yypt-0].tok, "", false, actions.CurrentFile, actions.LineNo)
 			yyVAL.argument = actions.DeclarationSpecifiers(strct, yyS[yypt-1].ints, constants.DECL_ARRAY)
-		}
-	case 46:
-		{
+                }
+	case 135: {
 			yyVAL.argument = actions.DeclarationSpecifiersStruct(yyS[yypt-0].tok, yyS[yypt-2].tok, true, actions.CurrentFile, actions.LineNo)
-		}
-	case 47:
-		{
+                }
+	case 136: {
 			yyVAL.argument = actions.DeclarationSpecifiersStruct(yyS[yypt-0].tok, constants.TypeNames[yyS[yypt-2].i], true, actions.CurrentFile, actions.LineNo)
-		}
-	case 48:
-		{
-			yyVAL.i = constants.TYPE_AFF
-		}
-	case 49:
-		{
-			yyVAL.i = constants.TYPE_BOOL
-		}
-	case 50:
-		{
-			yyVAL.i = constants.TYPE_STR
-		}
-	case 51:
-		{
-			yyVAL.i = constants.TYPE_F32
-		}
-	case 52:
-		{
-			yyVAL.i = constants.TYPE_F64
-		}
-	case 53:
-		{
-			yyVAL.i = constants.TYPE_I8
-		}
-	case 54:
-		{
-			yyVAL.i = constants.TYPE_I16
-		}
-	case 55:
-		{
-			yyVAL.i = constants.TYPE_I32
-		}
-	case 56:
-		{
-			yyVAL.i = constants.TYPE_I64
-		}
-	case 57:
-		{
-			yyVAL.i = constants.TYPE_UI8
-		}
-	case 58:
-		{
-			yyVAL.i = constants.TYPE_UI16
-		}
-	case 59:
-		{
-			yyVAL.i = constants.TYPE_UI32
-		}
-	case 60:
-		{
-			yyVAL.i = constants.TYPE_UI64
-		}
-	case 61:
-		{
-			yyVAL.expressions = nil
-		}
-	case 62:
-		{
+                }
+	case 137: { yyVAL.i = constants.TYPE_AFF }
+	case 138: { yyVAL.i = constants.TYPE_BOOL }
+	case 139: { yyVAL.i = constants.TYPE_STR }
+	case 140: { yyVAL.i = constants.TYPE_F32 }
+	case 141: { yyVAL.i = constants.TYPE_F64 }
+	case 142: { yyVAL.i = constants.TYPE_I8 }
+	case 143: { yyVAL.i = constants.TYPE_I16 }
+	case 144: { yyVAL.i = constants.TYPE_I32 }
+	case 145: { yyVAL.i = constants.TYPE_I64 }
+	case 146: { yyVAL.i = constants.TYPE_UI8 }
+	case 147: { yyVAL.i = constants.TYPE_UI16 }
+	case 148: { yyVAL.i = constants.TYPE_UI32 }
+	case 149: { yyVAL.i = constants.TYPE_UI64 }
+	case 150: { yyVAL.expressions = nil }
+	case 151: {
 			if yyS[yypt-0].expressions[0].IsStructLiteral() {
 				yyVAL.expressions = actions.StructLiteralAssignment([]*ast.CXExpression{actions.StructLiteralFields(yyS[yypt-2].tok)}, yyS[yypt-0].expressions)
 			} else {
 				yyVAL.expressions = actions.Assignment([]*ast.CXExpression{actions.StructLiteralFields(yyS[yypt-2].tok)}, "=", yyS[yypt-0].expressions)
 			}
-		}
-	case 63:
-		{
+                }
+	case 152: {
 			if yyS[yypt-0].expressions[0].IsStructLiteral() {
 				yyVAL.expressions = append(yyS[yypt-4].expressions, actions.StructLiteralAssignment([]*ast.CXExpression{actions.StructLiteralFields(yyS[yypt-2].tok)}, yyS[yypt-0].expressions)...)
 			} else {
 				yyVAL.expressions = append(yyS[yypt-4].expressions, actions.Assignment([]*ast.CXExpression{actions.StructLiteralFields(yyS[yypt-2].tok)}, "=", yyS[yypt-0].expressions)...)
 			}
-		}
-	case 64:
-		{
-			yyS[yypt-0].expressions[len(yyS[yypt-0].expressions)-1].ExpressionType = ast.CXEXPR_ARRAY_LITERAL
+                }
+	case 153: {
+			yyS[yypt-0].expressions[len(yyS[yypt-0].expressions) - 1].ExpressionType = ast.CXEXPR_ARRAY_LITERAL
 			yyVAL.expressions = yyS[yypt-0].expressions
-		}
-	case 65:
-		{
-			yyS[yypt-0].expressions[len(yyS[yypt-0].expressions)-1].ExpressionType = ast.CXEXPR_ARRAY_LITERAL
+                }
+	case 154: {
+			yyS[yypt-0].expressions[len(yyS[yypt-0].expressions) - 1].ExpressionType = ast.CXEXPR_ARRAY_LITERAL
 			yyVAL.expressions = append(yyS[yypt-2].expressions, yyS[yypt-0].expressions...)
+                }
+	case 155: {
+			yyVAL.ints = []int{actions.ConstantLength(yyS[yypt-1].constant)}
 		}
-	case 66:
-		{
-			yyVAL.ints = []int{int(yyS[yypt-1].i32)}
-		}
-	case 67:
-		{
-			yyVAL.ints = append(yyS[yypt-3].ints, int(yyS[yypt-1].i32))
+	case 156: {
+			yyVAL.ints = append(yyS[yypt-3].ints, actions.ConstantLength(yyS[yypt-1].constant))
 		}
-	case 68:
-		{
+	case 157: {
 			yyVAL.ints = []int{0}
 		}
-	case 69:
-		{
+	case 158: {
 			yyVAL.ints = append(yyS[yypt-2].ints, 0)
 		}
-	case 70:
-		{
+	case 159: {
 			yyVAL.expressions = yyS[yypt-1].expressions
-		}
-	case 71:
-		{
+                }
+	case 160: {
 			yyVAL.expressions = nil
-		}
-	case 72:
-		{
+                }
+	case 161: {
 			yyVAL.expressions = actions.ArrayLiteralExpression(yyS[yypt-4].ints, yyS[yypt-3].i, yyS[yypt-1].expressions)
-		}
-	case 73:
-		{
+                }
+	case 162: {
 			yyVAL.expressions = nil
-		}
-	case 74:
-		{
-			yyS[yypt-0].expressions[len(yyS[yypt-0].expressions)-1].ExpressionType = ast.CXEXPR_ARRAY_LITERAL
+                }
+	case 163: {
+			yyS[yypt-0].expressions[len(yyS[yypt-0].expressions) - 1].ExpressionType = ast.CXEXPR_ARRAY_LITERAL
 			yyVAL.expressions = yyS[yypt-0].expressions
-		}
-	case 75:
-		{
+                }
+	case 164: {
 
-			yyS[yypt-0].expressions[len(yyS[yypt-0].expressions)-1].ExpressionType = ast.CXEXPR_ARRAY_LITERAL
+			yyS[yypt-0].expressions[len(yyS[yypt-0].expressions) - 1].ExpressionType = ast.CXEXPR_ARRAY_LITERAL
 			yyVAL.expressions = append(yyS[yypt-2].expressions, yyS[yypt-0].expressions...)
-		}
-	case 76:
-		{
+                }
+	case 165: {
 			yyVAL.expressions = yyS[yypt-1].expressions
-		}
-	case 77:
-		{
+                }
+	case 166: {
 			yyVAL.expressions = nil
-		}
-	case 78:
-		{
+                }
+	case 167: {
 			yyVAL.expressions = actions.SliceLiteralExpression(yyS[yypt-3].i, yyS[yypt-1].expressions)
-		}
-	case 79:
-		{
+                }
+	case 168: {
 			yyVAL.expressions = nil
-		}
-	case 80:
-		{
+                }
+	case 169: {
 			for _, expr := range yyS[yypt-0].expressions {
-				if expr.Outputs[0].ArgDetails.Name == yyS[yypt-0].expressions[len(yyS[yypt-0].expressions)-1].Inputs[0].ArgDetails.Name {
+				if expr.Outputs[0].ArgDetails.Name == yyS[yypt-0].expressions[len(yyS[yypt-0].expressions) - 1].Inputs[0].ArgDetails.Name {
 					expr.Outputs[0].Lengths = append(expr.Outputs[0].Lengths, 0)
 					expr.Outputs[0].DeclarationSpecifiers = append(expr.Outputs[0].DeclarationSpecifiers, constants.DECL_SLICE)
-				}
+                                    }
 			}
-
+	
 			yyS[yypt-0].expressions[len(yyS[yypt-0].expressions)-1].ExpressionType = ast.CXEXPR_ARRAY_LITERAL
 			yyVAL.expressions = yyS[yypt-0].expressions
-		}
-	case 81:
-		{
+                }
+	case 170: {
+			yyVAL.arrayArguments = [][]*ast.CXExpression{yyS[yypt-2].expressions, yyS[yypt-0].expressions}
+                }
+	case 171: {
+			yyVAL.arrayArguments = append(yyS[yypt-4].arrayArguments, yyS[yypt-2].expressions, yyS[yypt-0].expressions)
+                }
+	case 172: {
+			yyVAL.expressions = actions.MapLiteralExpression(yyS[yypt-5].argument, actions.DeclarationSpecifiersBasic(yyS[yypt-3].i), yyS[yypt-1].arrayArguments)
+                }
+	case 173: {
+			yyVAL.expressions = actions.MapLiteralExpression(yyS[yypt-6].argument, actions.DeclarationSpecifiersBasic(yyS[yypt-4].i), yyS[yypt-2].arrayArguments)
+                }
+	case 174: {
+			yyVAL.expressions = actions.MapLiteralExpression(yyS[yypt-4].argument, actions.DeclarationSpecifiersBasic(yyS[yypt-2].i), nil)
+                }
+	case 175: {
+			yyVAL.expressions = actions.MapLiteralExpression(yyS[yypt-5].argument, actions.DeclarationSpecifiersStruct(yyS[yypt-3].tok, "", false, actions.CurrentFile, actions.LineNo), yyS[yypt-1].arrayArguments)
+                }
+	case 176: {
+			yyVAL.expressions = actions.MapLiteralExpression(yyS[yypt-6].argument, actions.DeclarationSpecifiersStruct(yyS[yypt-4].tok, "", false, actions.CurrentFile, actions.LineNo), yyS[yypt-2].arrayArguments)
+                }
+	case 177: {
+			yyVAL.expressions = actions.MapLiteralExpression(yyS[yypt-4].argument, actions.DeclarationSpecifiersStruct(yyS[yypt-2].tok, "", false, actions.CurrentFile, actions.LineNo), nil)
+                }
+	case 178: {
 			yyVAL.string = yyS[yypt-0].tok
-		}
-	case 82:
-		{
+                }
+	case 179: {
 			yyVAL.string = strconv.Itoa(int(yyS[yypt-0].i32))
-		}
-	case 83:
-		{
+                }
+	case 180: {
 			yyVAL.string = constants.TypeNames[yyS[yypt-2].i] + "." + yyS[yypt-0].tok
 		}
-	case 84:
-		{
+	case 181: {
 			res := append([]string{yyS[yypt-3].string}, yyS[yypt-1].tok)
 			res = append(res, yyS[yypt-5].tok)
 			yyVAL.stringA = res
 		}
-	case 85:
-		{
+	case 182: {
 			yyVAL.stringA = append([]string{yyS[yypt-3].tok}, yyS[yypt-1].string)
 		}
-	case 86:
-		{
+	case 183: {
 			yyVAL.stringA = append(yyS[yypt-1].stringA, yyS[yypt-3].tok)
 		}
-	case 87:
-		{
+	case 184: {
 			res := append(yyS[yypt-3].stringA, yyS[yypt-1].stringA...)
 			yyVAL.stringA = append(res, yyS[yypt-5].tok)
 		}
-	case 88:
-		{
+	case 185: {
 			yyVAL.stringA = yyS[yypt-1].stringA
-		}
-	case 89:
-		{
+                }
+	case 186: {
 			yyS[yypt-2].stringA = append(yyS[yypt-2].stringA, yyS[yypt-1].stringA...)
 			yyVAL.stringA = yyS[yypt-2].stringA
-		}
-	case 90:
-		{
+                }
+	case 187: {
 			yyVAL.expressions = actions.SliceLiteralExpression(constants.TYPE_AFF, nil)
-		}
-	case 91:
-		{
+                }
+	case 188: {
 			var exprs []*ast.CXExpression
 			for _, str := range yyS[yypt-0].stringA {
 				expr := actions.WritePrimary(constants.TYPE_AFF, encoder.Serialize(str), false)
-				expr[len(expr)-1].ExpressionType = ast.CXEXPR_ARRAY_LITERAL
+				expr[len(expr) - 1].ExpressionType = ast.CXEXPR_ARRAY_LITERAL
 				exprs = append(exprs, expr...)
 			}
-
+			
 			yyVAL.expressions = actions.SliceLiteralExpression(constants.TYPE_AFF, exprs)
-		}
-	case 92:
-		{
-			yyVAL.i32 = yyS[yypt-0].i32
-		}
-	case 93:
-		{
-			yyVAL.i32 = -yyS[yypt-0].i32
-		}
-	case 94:
-		{
+                }
+	case 189: {
+		    yyVAL.i32 = yyS[yypt-0].i32
+            }
+	case 190: {
+		    yyVAL.i32 = -yyS[yypt-0].i32
+            }
+	case 191: {
 			yyVAL.expressions = actions.PrimaryIdentifier(yyS[yypt-0].tok)
-		}
-	case 95:
-		{
-			yyVAL.expressions = nil
-		}
-	case 96:
-		{
+                }
+	case 192: {
+			yyVAL.expressions = actions.FunctionLiteral(yyS[yypt-1].function, yyS[yypt-0].expressions)
+                }
+	case 193: {
 			yyVAL.expressions = yyS[yypt-1].expressions
-		}
-	case 97:
-		{
+                }
+	case 194: {
 			yyVAL.expressions = actions.WritePrimary(constants.TYPE_STR, encoder.Serialize(yyS[yypt-0].tok), false)
-		}
-	case 98:
-		{
+                }
+	case 195: {
 			exprs := actions.WritePrimary(constants.TYPE_BOOL, encoder.Serialize(yyS[yypt-0].bool), false)
 			yyVAL.expressions = exprs
-		}
-	case 99:
-		{
+                }
+	case 196: {
+			yyVAL.expressions = actions.WritePrimary(constants.TYPE_ERROR, encoder.Serialize(int32(0)), false)
+                }
+	case 197: {
 			yyVAL.expressions = actions.WritePrimary(constants.TYPE_I8, encoder.Serialize(yyS[yypt-0].i8), false)
-		}
-	case 100:
-		{
+                }
+	case 198: {
 			yyVAL.expressions = actions.WritePrimary(constants.TYPE_I16, encoder.Serialize(yyS[yypt-0].i16), false)
-		}
-	case 101:
-		{
+                }
+	case 199: {
 			yyVAL.expressions = actions.WritePrimary(constants.TYPE_I32, encoder.Serialize(yyS[yypt-0].i32), false)
-		}
-	case 102:
-		{
+                }
+	case 200: {
 			yyVAL.expressions = actions.WritePrimary(constants.TYPE_I64, encoder.Serialize(yyS[yypt-0].i64), false)
-		}
-	case 103:
-		{
+                }
+	case 201: {
 			yyVAL.expressions = actions.WritePrimary(constants.TYPE_UI8, encoder.Serialize(yyS[yypt-0].ui8), false)
-		}
-	case 104:
-		{
+                }
+	case 202: {
 			yyVAL.expressions = actions.WritePrimary(constants.TYPE_UI16, encoder.Serialize(yyS[yypt-0].ui16), false)
-		}
-	case 105:
-		{
+                }
+	case 203: {
 			yyVAL.expressions = actions.WritePrimary(constants.TYPE_UI32, encoder.Serialize(yyS[yypt-0].ui32), false)
-		}
-	case 106:
-		{
+                }
+	case 204: {
 			yyVAL.expressions = actions.WritePrimary(constants.TYPE_UI64, encoder.Serialize(yyS[yypt-0].ui64), false)
-		}
-	case 107:
-		{
+                }
+	case 205: {
 			yyVAL.expressions = actions.WritePrimary(constants.TYPE_F32, encoder.Serialize(yyS[yypt-0].f32), false)
-		}
-	case 108:
-		{
+                }
+	case 206: {
 			yyVAL.expressions = actions.WritePrimary(constants.TYPE_F64, encoder.Serialize(yyS[yypt-0].f64), false)
-		}
-	case 109:
-		{
-			yyVAL.expressions = yyS[yypt-1].expressions
-		}
-	case 110:
-		{
+                }
+	case 207: { yyVAL.expressions = yyS[yypt-1].expressions }
+	case 208: {
 			yyVAL.expressions = yyS[yypt-0].expressions
-		}
-	case 111:
-		{
+                }
+	case 209: {
 			yyVAL.expressions = yyS[yypt-0].expressions
-		}
-	case 112:
-		{
+                }
+	case 210: {
+			yyVAL.expressions = yyS[yypt-0].expressions
+                }
+	case 211: {
 			yyVAL.tok = constants.TypeNames[yyS[yypt-0].i]
-		}
-	case 115:
-		{
+                }
+	case 214: {
 			yyVAL.expressions = actions.PostfixExpressionArray(yyS[yypt-3].expressions, yyS[yypt-1].expressions)
-		}
-	case 116:
-		{
+                }
+	case 215: {
+			yyVAL.expressions = actions.PostfixExpressionSlice(yyS[yypt-3].expressions, nil, nil, nil)
+                }
+	case 216: {
+			yyVAL.expressions = actions.PostfixExpressionSlice(yyS[yypt-4].expressions, yyS[yypt-2].expressions, nil, nil)
+                }
+	case 217: {
+			yyVAL.expressions = actions.PostfixExpressionSlice(yyS[yypt-4].expressions, nil, yyS[yypt-1].expressions, nil)
+                }
+	case 218: {
+			yyVAL.expressions = actions.PostfixExpressionSlice(yyS[yypt-5].expressions, yyS[yypt-3].expressions, yyS[yypt-1].expressions, nil)
+                }
+	case 219: {
+			yyVAL.expressions = actions.PostfixExpressionSlice(yyS[yypt-6].expressions, nil, yyS[yypt-3].expressions, yyS[yypt-1].expressions)
+                }
+	case 220: {
+			yyVAL.expressions = actions.PostfixExpressionSlice(yyS[yypt-7].expressions, yyS[yypt-5].expressions, yyS[yypt-3].expressions, yyS[yypt-1].expressions)
+                }
+	case 221: {
 			yyVAL.expressions = actions.PostfixExpressionNative(int(yyS[yypt-2].i), yyS[yypt-0].tok)
-		}
-	case 117:
-		{
+                }
+	case 222: {
+			yyVAL.expressions = actions.PostfixExpressionConversion(int(yyS[yypt-3].i), yyS[yypt-1].expressions)
+                }
+	case 223: {
 			yyVAL.expressions = actions.PostfixExpressionEmptyFunCall(yyS[yypt-2].expressions)
-		}
-	case 118:
-		{
-			yyVAL.expressions = actions.PostfixExpressionFunCall(yyS[yypt-3].expressions, yyS[yypt-1].expressions)
-		}
-	case 119:
-		{
+                }
+	case 224: {
+			yyVAL.expressions = actions.PostfixExpressionFunCall(yyS[yypt-3].expressions, yyS[yypt-1].expressions, false)
+                }
+	case 225: {
+			yyVAL.expressions = actions.PostfixExpressionFunCall(yyS[yypt-4].expressions, yyS[yypt-2].expressions, true)
+                }
+	case 226: {
+			yyVAL.expressions = actions.PostfixExpressionTypeAssertion(yyS[yypt-4].expressions, yyS[yypt-1].argument)
+                }
+	case 227: {
 			yyVAL.expressions = actions.PostfixExpressionIncDec(yyS[yypt-1].expressions, true)
-		}
-	case 120:
-		{
+                }
+	case 228: {
 			yyVAL.expressions = actions.PostfixExpressionIncDec(yyS[yypt-1].expressions, false)
-		}
-	case 121:
-		{
+                }
+	case 229: {
 			yyVAL.expressions = actions.PostfixExpressionField(yyS[yypt-2].expressions, yyS[yypt-0].tok)
-		}
-	case 123:
-		{
+                }
+	case 231: {
 			yyVAL.expressions = append(yyS[yypt-2].expressions, yyS[yypt-0].expressions...)
-		}
-	case 125:
-		{
+                }
+	case 233: {
 			// TODO
 			yyVAL.expressions = yyS[yypt-0].expressions
-		}
-	case 126:
-		{
+                }
+	case 234: {
 			// TODO
 			yyVAL.expressions = yyS[yypt-0].expressions
-		}
-	case 127:
-		{
+                }
+	case 235: {
 			yyVAL.expressions = actions.UnaryExpression(yyS[yypt-1].tok, yyS[yypt-0].expressions)
-		}
-	case 134:
-		{
+                }
+	case 242: {
 			yyVAL.expressions = actions.OperatorExpression(yyS[yypt-2].expressions, yyS[yypt-0].expressions, constants.OP_MUL)
-		}
-	case 135:
-		{
+                }
+	case 243: {
 			yyVAL.expressions = actions.OperatorExpression(yyS[yypt-2].expressions, yyS[yypt-0].expressions, constants.OP_DIV)
-		}
-	case 136:
-		{
+                }
+	case 244: {
 			yyVAL.expressions = actions.OperatorExpression(yyS[yypt-2].expressions, yyS[yypt-0].expressions, constants.OP_MOD)
-		}
-	case 138:
-		{
+                }
+	case 246: {
 			yyVAL.expressions = actions.OperatorExpression(yyS[yypt-2].expressions, yyS[yypt-0].expressions, constants.OP_ADD)
-		}
-	case 139:
-		{
+                }
+	case 247: {
 			yyVAL.expressions = actions.OperatorExpression(yyS[yypt-2].expressions, yyS[yypt-0].expressions, constants.OP_SUB)
-		}
-	case 141:
-		{
+                }
+	case 249: {
 			yyVAL.expressions = actions.OperatorExpression(yyS[yypt-2].expressions, yyS[yypt-0].expressions, constants.OP_BITSHL)
-		}
-	case 142:
-		{
+                }
+	case 250: {
 			yyVAL.expressions = actions.OperatorExpression(yyS[yypt-2].expressions, yyS[yypt-0].expressions, constants.OP_BITSHR)
-		}
-	case 143:
-		{
+                }
+	case 251: {
 			yyVAL.expressions = actions.OperatorExpression(yyS[yypt-2].expressions, yyS[yypt-0].expressions, constants.OP_BITCLEAR)
-		}
-	case 145:
-		{
+                }
+	case 253: {
 			yyVAL.expressions = actions.OperatorExpression(yyS[yypt-2].expressions, yyS[yypt-0].expressions, constants.OP_EQUAL)
-		}
-	case 146:
-		{
+                }
+	case 254: {
 			yyVAL.expressions = actions.OperatorExpression(yyS[yypt-2].expressions, yyS[yypt-0].expressions, constants.OP_UNEQUAL)
-		}
-	case 147:
-		{
+                }
+	case 255: {
 			yyVAL.expressions = actions.OperatorExpression(yyS[yypt-2].expressions, yyS[yypt-0].expressions, constants.OP_LT)
-		}
-	case 148:
-		{
+                }
+	case 256: {
 			yyVAL.expressions = actions.OperatorExpression(yyS[yypt-2].expressions, yyS[yypt-0].expressions, constants.OP_GT)
-		}
-	case 149:
-		{
+                }
+	case 257: {
 			yyVAL.expressions = actions.OperatorExpression(yyS[yypt-2].expressions, yyS[yypt-0].expressions, constants.OP_LTEQ)
-		}
-	case 150:
-		{
+                }
+	case 258: {
 			yyVAL.expressions = actions.OperatorExpression(yyS[yypt-2].expressions, yyS[yypt-0].expressions, constants.OP_GTEQ)
-		}
-	case 152:
-		{
+                }
+	case 260: {
 			yyVAL.expressions = actions.OperatorExpression(yyS[yypt-2].expressions, yyS[yypt-0].expressions, constants.OP_BITAND)
-		}
-	case 154:
-		{
+                }
+	case 262: {
 			yyVAL.expressions = actions.OperatorExpression(yyS[yypt-2].expressions, yyS[yypt-0].expressions, constants.OP_BITXOR)
-		}
-	case 156:
-		{
+                }
+	case 264: {
 			yyVAL.expressions = actions.OperatorExpression(yyS[yypt-2].expressions, yyS[yypt-0].expressions, constants.OP_BITOR)
-		}
-	case 158:
-		{
+                }
+	case 266: {
 			yyVAL.expressions = actions.OperatorExpression(yyS[yypt-2].expressions, yyS[yypt-0].expressions, constants.OP_BOOL_AND)
-		}
-	case 160:
-		{
+                }
+	case 268: {
 			yyVAL.expressions = actions.OperatorExpression(yyS[yypt-2].expressions, yyS[yypt-0].expressions, constants.OP_BOOL_OR)
-		}
-	case 164:
-		{
+                }
+	case 272: {
 			yyVAL.expressions = actions.PrimaryStructLiteral(yyS[yypt-3].tok, yyS[yypt-1].expressions)
-		}
-	case 165:
-		{
+                }
+	case 273: {
 			yyVAL.expressions = actions.UnaryExpression(yyS[yypt-4].tok, actions.PrimaryStructLiteral(yyS[yypt-3].tok, yyS[yypt-1].expressions))
-		}
-	case 166:
-		{
+                }
+	case 274: {
 			yyVAL.expressions = actions.PrimaryStructLiteralExternal(yyS[yypt-5].expressions[0].Outputs[0].ArgDetails.Name, yyS[yypt-3].tok, yyS[yypt-1].expressions)
-		}
-	case 168:
-		{
+                }
+	case 276: {
+			if yyS[yypt-1].tok == ":=" {
+				yyS[yypt-2].expressions = actions.DeclareShortVariable(yyS[yypt-2].expressions)
+			}
 			if yyS[yypt-0].expressions == nil {
 				yyVAL.expressions = nil
 			}
@@ -2246,7 +2906,7 @@ yynewstate:
 						}
 					}
 					yyVAL.expressions = actions.ArrayLiteralAssignment(yyS[yypt-2].expressions, yyS[yypt-0].expressions)
-				} else if yyS[yypt-0].expressions[len(yyS[yypt-0].expressions)-1].IsStructLiteral() {
+				} else if yyS[yypt-0].expressions[len(yyS[yypt-0].expressions) - 1].IsStructLiteral() {
 					if yyS[yypt-1].tok != "=" && yyS[yypt-1].tok != ":=" {
 						panic("")
 					}
@@ -2261,64 +2921,46 @@ yynewstate:
 					yyVAL.expressions = actions.Assignment(yyS[yypt-2].expressions, yyS[yypt-1].tok, yyS[yypt-0].expressions)
 				}
 			}
-		}
-	case 182:
-		{
-			yyS[yypt-0].expressions[len(yyS[yypt-0].expressions)-1].Outputs = append(yyS[yypt-2].expressions[len(yyS[yypt-2].expressions)-1].Outputs, yyS[yypt-0].expressions[len(yyS[yypt-0].expressions)-1].Outputs...)
-			yyVAL.expressions = yyS[yypt-0].expressions
-		}
-	case 184:
-		{
+                }
+	case 290: {
+			yyVAL.expressions = actions.MultipleAssignment(yyS[yypt-2].expressions, yyS[yypt-0].expressions)
+                }
+	case 292: {
 			yyVAL.expressions = actions.DeclareLocal(yyS[yypt-2].argument, yyS[yypt-1].argument, nil, false)
-		}
-	case 185:
-		{
+                }
+	case 293: {
 			yyVAL.expressions = actions.DeclareLocal(yyS[yypt-4].argument, yyS[yypt-3].argument, yyS[yypt-1].expressions, true)
-		}
-	case 193:
-		{
+                }
+	case 294: {
 			yyVAL.expressions = nil
-		}
-	case 195:
-		{
+                }
+	case 302: { yyVAL.expressions = nil }
+	case 305: {
 			// it has to be the first expression so all the nested expressions are executed
 			// instead of only executing the last one
 			// UPDATE: I need to label all expressions. `goto` will jump to first occurrance anyway, so no problem
 			// I need this behavior for affordances
 			for _, expr := range yyS[yypt-0].expressions {
-				expr.Label = yyS[yypt-2].tok
+				expr.Label = yyS[yypt-1].tok
 			}
 
 			yyVAL.expressions = yyS[yypt-0].expressions
-		}
-	case 196:
-		{
-			yyVAL.expressions = nil
-		}
-	case 197:
-		{
-			yyVAL.expressions = nil
-		}
-	case 198:
-		{
-			yyVAL.expressions = nil
-		}
-	case 199:
-		{
-			yyVAL.expressions = yyS[yypt-2].expressions
-		}
-	case 201:
-		{
+                }
+	case 306: {
+			actions.StatementLabel(yyS[yypt-1].tok)
+			yyVAL.tok = yyS[yypt-1].tok
+                }
+	case 307: { yyVAL.expressions = nil }
+	case 308: {
+                    yyVAL.expressions = yyS[yypt-2].expressions
+                }
+	case 310: {
 			yyVAL.expressions = append(yyS[yypt-1].expressions, yyS[yypt-0].expressions...)
-		}
-	case 204:
-		{
-			yyVAL.expressions = nil
-		}
-	case 205:
-		{
-			if len(yyS[yypt-1].expressions) > 0 && yyS[yypt-1].expressions[len(yyS[yypt-1].expressions)-1].Operator == nil && !yyS[yypt-1].expressions[len(yyS[yypt-1].expressions)-1].IsMethodCall() {
-				outs := yyS[yypt-1].expressions[len(yyS[yypt-1].expressions)-1].Outputs
+                }
+	case 313: { yyVAL.expressions = nil }
+	case 314: {
+			if len(yyS[yypt-1].expressions) > 0 && yyS[yypt-1].expressions[len(yyS[yypt-1].expressions) - 1].Operator == nil && !yyS[yypt-1].expressions[len(yyS[yypt-1].expressions) - 1].IsMethodCall() {
+				outs := yyS[yypt-1].expressions[len(yyS[yypt-1].expressions) - 1].Outputs
 				if len(outs) > 0 {
 					println(ast.CompilationError(outs[0].ArgDetails.FileName, outs[0].ArgDetails.FileLine), "invalid expression")
 				} else {
@@ -2329,97 +2971,163 @@ yynewstate:
 				yyVAL.expressions = yyS[yypt-1].expressions
 			}
 			// $$ = $1
-		}
-	case 206:
-		{
+                }
+	case 315: {
 			yyVAL.expressions = actions.SelectionStatement(yyS[yypt-6].expressions, yyS[yypt-4].expressions, yyS[yypt-2].SelectStatements, yyS[yypt-1].expressions, actions.SEL_ELSEIFELSE)
-		}
-	case 207:
-		{
+                }
+	case 316: {
 			yyVAL.expressions = actions.SelectionExpressions(yyS[yypt-5].expressions, yyS[yypt-3].expressions, yyS[yypt-1].expressions)
-		}
-	case 208:
-		{
+                }
+	case 317: {
 			yyVAL.expressions = actions.SelectionExpressions(yyS[yypt-4].expressions, nil, yyS[yypt-1].expressions)
-		}
-	case 209:
-		{
+                }
+	case 318: {
 			yyVAL.expressions = actions.SelectionStatement(yyS[yypt-5].expressions, yyS[yypt-3].expressions, yyS[yypt-1].SelectStatements, nil, actions.SEL_ELSEIF)
-		}
-	case 210:
-		{
+                }
+	case 319: {
 			//
 			yyVAL.expressions = actions.SelectionStatement(yyS[yypt-4].expressions, nil, yyS[yypt-1].SelectStatements, nil, actions.SEL_ELSEIF)
-		}
-	case 211:
-		{
+                }
+	case 320: {
 			//
 			yyVAL.expressions = actions.SelectionStatement(yyS[yypt-5].expressions, nil, yyS[yypt-2].SelectStatements, yyS[yypt-1].expressions, actions.SEL_ELSEIFELSE)
-		}
-	case 212:
-		{
+                }
+	case 321: {
 			yyVAL.expressions = actions.SelectionExpressions(yyS[yypt-1].expressions, yyS[yypt-0].expressions, nil)
-		}
-	case 213:
-		{
-			yyVAL.expressions = nil
-		}
-	case 214:
-		{
+                }
+	case 322: {
+			yyVAL.expressions = actions.SwitchStatement(yyS[yypt-4].expressions, yyS[yypt-2].SwitchCases)
+                }
+	case 323: {
+			yyVAL.expressions = actions.SwitchStatement(nil, yyS[yypt-2].SwitchCases)
+                }
+	case 324: {
+			yyVAL.expressions = actions.TypeSwitchStatement(yyS[yypt-10].tok, yyS[yypt-8].expressions, yyS[yypt-2].SwitchCases)
+                }
+	case 325: {
+			yyVAL.expressions = actions.TypeSwitchStatement("", yyS[yypt-8].expressions, yyS[yypt-2].SwitchCases)
+                }
+	case 326: {
+			yyVAL.SwitchCases = nil
+                }
+	case 327: {
+			yyVAL.SwitchCases = append(yyS[yypt-1].SwitchCases, yyS[yypt-0].SwitchCase)
+                }
+	case 328: {
+			yyVAL.SwitchCase = actions.SwitchCase{
+				Values: yyS[yypt-2].arrayArguments,
+				Body: yyS[yypt-0].expressions,
+			}
+                }
+	case 329: {
+			yyVAL.SwitchCase = actions.SwitchCase{
+				Values: yyS[yypt-1].arrayArguments,
+			}
+                }
+	case 330: {
+			yyVAL.SwitchCase = actions.SwitchCase{
+				IsDefault: true,
+				Body: yyS[yypt-0].expressions,
+			}
+                }
+	case 331: {
+			yyVAL.SwitchCase = actions.SwitchCase{
+				IsDefault: true,
+			}
+                }
+	case 332: {
+			yyVAL.arrayArguments = [][]*ast.CXExpression{yyS[yypt-0].expressions}
+                }
+	case 333: {
+			yyVAL.arrayArguments = append(yyS[yypt-2].arrayArguments, yyS[yypt-0].expressions)
+                }
+	case 334: {
+			yyVAL.SwitchCases = nil
+                }
+	case 335: {
+			yyVAL.SwitchCases = append(yyS[yypt-1].SwitchCases, yyS[yypt-0].SwitchCase)
+                }
+	case 336: {
+			yyVAL.SwitchCase = actions.SwitchCase{
+				Types: yyS[yypt-2].arguments,
+				Body: yyS[yypt-0].expressions,
+			}
+                }
+	case 337: {
+			yyVAL.SwitchCase = actions.SwitchCase{
+				Types: yyS[yypt-1].arguments,
+			}
+                }
+	case 338: {
+			yyVAL.SwitchCase = actions.SwitchCase{
+				IsDefault: true,
+				Body: yyS[yypt-0].expressions,
+			}
+                }
+	case 339: {
+			yyVAL.SwitchCase = actions.SwitchCase{
+				IsDefault: true,
+			}
+                }
+	case 340: {
+			yyVAL.arguments = []*ast.CXArgument{yyS[yypt-0].argument}
+                }
+	case 341: {
+			yyVAL.arguments = append(yyS[yypt-2].arguments, yyS[yypt-0].argument)
+                }
+	case 342: {
 			yyVAL.SelectStatement = actions.SelectStatement{
 				Condition: yyS[yypt-3].expressions,
-				Then:      yyS[yypt-1].expressions,
+				Then: yyS[yypt-1].expressions,
 			}
-		}
-	case 215:
-		{
+                }
+	case 343: {
 			yyVAL.SelectStatement = actions.SelectStatement{
 				Condition: yyS[yypt-2].expressions,
-				Then:      nil,
+				Then: nil,
 			}
 		}
-	case 216:
-		{
+	case 344: {
 			yyVAL.SelectStatements = []actions.SelectStatement{yyS[yypt-0].SelectStatement}
-		}
-	case 217:
-		{
+                }
+	case 345: {
 			yyVAL.SelectStatements = append(yyS[yypt-1].SelectStatements, yyS[yypt-0].SelectStatement)
-		}
-	case 218:
-		{
+                }
+	case 346: {
 			yyVAL.expressions = yyS[yypt-1].expressions
-		}
-	case 219:
-		{
+                }
+	case 347: {
 			yyVAL.expressions = nil
 		}
-	case 220:
-		{
+	case 348: {
 			yyVAL.expressions = actions.IterationExpressions(nil, yyS[yypt-1].expressions, nil, yyS[yypt-0].expressions)
-		}
-	case 221:
-		{
+                }
+	case 349: {			
 			yyVAL.expressions = actions.IterationExpressions(yyS[yypt-2].expressions, yyS[yypt-1].expressions, nil, yyS[yypt-0].expressions)
-		}
-	case 222:
-		{
+                }
+	case 350: {
 			yyVAL.expressions = actions.IterationExpressions(yyS[yypt-3].expressions, yyS[yypt-2].expressions, yyS[yypt-1].expressions, yyS[yypt-0].expressions)
-		}
-	case 223:
-		{
+                }
+	case 351: {
+			yyVAL.expressions = actions.RangeExpressions(nil, nil, "", yyS[yypt-1].expressions, yyS[yypt-0].expressions)
+                }
+	case 352: {
+			yyVAL.expressions = actions.RangeExpressions(yyS[yypt-4].expressions, nil, yyS[yypt-3].tok, yyS[yypt-1].expressions, yyS[yypt-0].expressions)
+                }
+	case 353: {
+			yyVAL.expressions = actions.RangeExpressions(yyS[yypt-6].expressions, yyS[yypt-4].expressions, yyS[yypt-3].tok, yyS[yypt-1].expressions, yyS[yypt-0].expressions)
+                }
+	case 354: {
 			retExprs := actions.ReturnExpressions{Expressions: actions.AssociateReturnExpressions(0, yyS[yypt-0].expressions)}
 			retExprs.Size++
 			yyVAL.ReturnExpressions = retExprs
 		}
-	case 224:
-		{
+	case 355: {
 			yyS[yypt-2].ReturnExpressions.Expressions = append(yyS[yypt-2].ReturnExpressions.Expressions, actions.AssociateReturnExpressions(yyS[yypt-2].ReturnExpressions.Size, yyS[yypt-0].expressions)...)
 			yyS[yypt-2].ReturnExpressions.Size++
 			yyVAL.ReturnExpressions = yyS[yypt-2].ReturnExpressions
 		}
-	case 225:
-		{
+	case 356: {
 			if pkg, err := actions.AST.GetCurrentPackage(); err == nil {
 				expr := ast.MakeExpression(ast.Natives[constants.OP_GOTO], actions.CurrentFile, actions.LineNo)
 				expr.Package = pkg
@@ -2428,23 +3136,28 @@ yynewstate:
 			} else {
 				panic(err)
 			}
+                }
+	case 357: {
+			yyVAL.expressions = actions.ContinueExpressions("", actions.LineNo)
 		}
-	case 226:
-		{
-			yyVAL.expressions = actions.ContinueExpressions()
+	case 358: {
+			yyVAL.expressions = actions.ContinueExpressions(yyS[yypt-1].tok, yyS[yypt-1].line)
 		}
-	case 227:
-		{
-			yyVAL.expressions = actions.BreakExpressions()
+	case 359: {
+			yyVAL.expressions = actions.BreakExpressions("", actions.LineNo)
 		}
-	case 228:
-		{
-			yyVAL.expressions = actions.AddJmpToReturnExpressions(actions.ReturnExpressions{})
+	case 360: {
+			yyVAL.expressions = actions.BreakExpressions(yyS[yypt-1].tok, yyS[yypt-1].line)
 		}
-	case 229:
-		{
+	case 361: {
+			yyVAL.expressions = actions.AddJmpToReturnExpressions(actions.ReturnExpressions{})
+                }
+	case 362: {
 			yyVAL.expressions = actions.AddJmpToReturnExpressions(yyS[yypt-1].ReturnExpressions)
-		}
+                }
+	case 363: {
+			yyVAL.expressions = actions.DeferStatement(yyS[yypt-1].expressions)
+                }
 
 	}
 
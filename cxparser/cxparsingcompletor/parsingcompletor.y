@@ -45,6 +45,8 @@ build-parser: ## Generate lexer and parser for CX grammar
 	stringA []string
 	ints    []int
 
+	constant actions.ConstantValue
+
 	line int
 
 	argument *ast.CXArgument
@@ -56,10 +58,16 @@ build-parser: ## Generate lexer and parser for CX grammar
 	SelectStatement actions.SelectStatement
 	SelectStatements []actions.SelectStatement
 
+	SwitchCase actions.SwitchCase
+	SwitchCases []actions.SwitchCase
+
 	ReturnExpressions actions.ReturnExpressions
 
 	arrayArguments [][]*ast.CXExpression
 
+	InterfaceMethod actions.InterfaceMethod
+	InterfaceMethods []actions.InterfaceMethod
+
     function *ast.CXFunction
 }
 
@@ -96,7 +104,8 @@ build-parser: ## Generate lexer and parser for CX grammar
                         I8 I16 I32 I64
                         STR
                         UI8 UI16 UI32 UI64
-                        UNION ENUM CONST CASE DEFAULT SWITCH BREAK CONTINUE
+                        UNION ENUM CONST CASE DEFAULT SWITCH BREAK CONTINUE MAP
+                        INTERFACE DEFER NIL RANGE ELLIPSIS
                         TYPE
                         
                         /* Types */
@@ -117,7 +126,19 @@ build-parser: ## Generate lexer and parser for CX grammar
 %type   <tok>           after_period
 %type   <tok>           unary_operator
 %type   <tok>           assignment_operator
+%type   <tok>           statement_label
 %type   <i>             type_specifier
+%type   <constant>      const_primary_expression
+%type   <constant>      const_unary_expression
+%type   <constant>      const_multiplicative_expression
+%type   <constant>      const_additive_expression
+%type   <constant>      const_shift_expression
+%type   <constant>      const_relational_expression
+%type   <constant>      const_and_expression
+%type   <constant>      const_exclusive_or_expression
+%type   <constant>      const_inclusive_or_expression
+%type   <constant>      const_logical_and_expression
+%type   <constant>      const_expression
 %type   <argument>      declaration_specifiers
 %type   <argument>      declarator
 %type   <argument>      direct_declarator
@@ -127,7 +148,11 @@ build-parser: ## Generate lexer and parser for CX grammar
 %type   <arguments>     parameter_list
 %type   <arguments>     fields
 %type   <arguments>     struct_fields
-%type   <arguments>     id_list
+%type   <stringA>       enum_members
+%type   <InterfaceMethod>       interface_method
+%type   <InterfaceMethods>      interface_methods
+%type   <arguments>     type_switch_types
+%type   <arguments>     declaration_specifiers_list
 %type   <arguments>     types_list
 
 /* %type   <stringA>       package_identifier */
@@ -164,6 +189,13 @@ build-parser: ## Generate lexer and parser for CX grammar
 %type   <expressions>   struct_literal_fields
 %type   <SelectStatement>   elseif
 %type   <SelectStatements>   elseif_list
+%type   <SwitchCase>    switch_case
+%type   <SwitchCases>   switch_cases
+%type   <arrayArguments>        switch_case_values
+%type   <SwitchCase>    type_switch_case
+%type   <SwitchCases>   type_switch_cases
+%type   <arrayArguments>        map_literal_entries
+%type   <expressions>   map_literal_expression
 
 %type   <expressions>   declaration
 //                      %type   <expressions>   init_declarator_list
@@ -181,9 +213,12 @@ build-parser: ## Generate lexer and parser for CX grammar
 %type   <expressions>   selection_statement
 %type   <expressions>   iteration_statement
 %type   <expressions>   jump_statement
+%type   <expressions>   defer_statement
 %type   <expressions>   statement
 
 %type   <function>      function_header
+%type   <function>      function_literal_header
+%type   <expressions>   function_literal_body
 
 %type   <string>        infer_action_arg
 %type   <stringA>       infer_action, infer_actions
@@ -211,6 +246,11 @@ external_declaration:
         |       function_declaration
         |       import_declaration
         |       struct_declaration
+        |       type_declaration
+        |       enum_declaration
+        |       interface_declaration
+        |       union_declaration
+        |       const_declaration
 
         |       debugging
         ;
@@ -241,6 +281,259 @@ global_declaration:
                 }
                 ;
 
+const_declaration:
+                CONST const_spec
+        |       CONST LPAREN const_spec_list RPAREN SEMICOLON
+                ;
+
+const_spec_list:
+                const_spec
+        |       const_spec_list const_spec
+                ;
+
+const_spec:     IDENTIFIER ASSIGN const_expression SEMICOLON
+                {
+			actions.DeclareConstant($1, $<line>1, constants.TYPE_UNDEFINED, $3)
+                }
+        |       IDENTIFIER type_specifier ASSIGN const_expression SEMICOLON
+                {
+			actions.DeclareConstant($1, $<line>1, $2, $4)
+                }
+                ;
+
+// constant expressions are folded while parsing
+const_primary_expression:
+                IDENTIFIER
+                {
+			$$ = actions.ConstantIdentifier("", $1)
+                }
+        |       IDENTIFIER PERIOD IDENTIFIER
+                {
+			$$ = actions.ConstantIdentifier($1, $3)
+                }
+        |       STRING_LITERAL
+                {
+			$$ = actions.ConstantStr($1)
+                }
+        |       BOOLEAN_LITERAL
+                {
+			$$ = actions.ConstantBool($1)
+                }
+        |       BYTE_LITERAL
+                {
+			$$ = actions.ConstantInt(constants.TYPE_I8, int64($1))
+                }
+        |       SHORT_LITERAL
+                {
+			$$ = actions.ConstantInt(constants.TYPE_I16, int64($1))
+                }
+        |       INT_LITERAL
+                {
+			$$ = actions.ConstantInt(constants.TYPE_I32, int64($1))
+                }
+        |       LONG_LITERAL
+                {
+			$$ = actions.ConstantInt(constants.TYPE_I64, int64($1))
+                }
+        |       UNSIGNED_BYTE_LITERAL
+                {
+			$$ = actions.ConstantInt(constants.TYPE_UI8, int64($1))
+                }
+        |       UNSIGNED_SHORT_LITERAL
+                {
+			$$ = actions.ConstantInt(constants.TYPE_UI16, int64($1))
+                }
+        |       UNSIGNED_INT_LITERAL
+                {
+			$$ = actions.ConstantInt(constants.TYPE_UI32, int64($1))
+                }
+        |       UNSIGNED_LONG_LITERAL
+                {
+			$$ = actions.ConstantInt(constants.TYPE_UI64, int64($1))
+                }
+        |       FLOAT_LITERAL
+                {
+			$$ = actions.ConstantFloat(constants.TYPE_F32, float64($1))
+                }
+        |       DOUBLE_LITERAL
+                {
+			$$ = actions.ConstantFloat(constants.TYPE_F64, $1)
+                }
+        |       LPAREN const_expression RPAREN
+                {
+			$$ = $2
+                }
+        |       type_specifier LPAREN const_expression RPAREN
+                {
+			$$ = actions.ConstantConversion($1, $3)
+                }
+                ;
+
+const_unary_expression:
+                const_primary_expression
+        |       ADD_OP const_unary_expression
+                {
+			$$ = $2
+                }
+        |       SUB_OP const_unary_expression
+                {
+			$$ = actions.ConstantUnary(constants.OP_NEG, $2)
+                }
+        |       NEG_OP const_unary_expression
+                {
+			$$ = actions.ConstantUnary(constants.OP_BOOL_NOT, $2)
+                }
+                ;
+
+const_multiplicative_expression:
+                const_unary_expression
+        |       const_multiplicative_expression MUL_OP const_unary_expression
+                {
+			$$ = actions.ConstantBinary($1, $3, constants.OP_MUL)
+                }
+        |       const_multiplicative_expression DIV_OP const_unary_expression
+                {
+			$$ = actions.ConstantBinary($1, $3, constants.OP_DIV)
+                }
+        |       const_multiplicative_expression MOD_OP const_unary_expression
+                {
+			$$ = actions.ConstantBinary($1, $3, constants.OP_MOD)
+                }
+                ;
+
+const_additive_expression:
+                const_multiplicative_expression
+        |       const_additive_expression ADD_OP const_multiplicative_expression
+                {
+			$$ = actions.ConstantBinary($1, $3, constants.OP_ADD)
+                }
+        |       const_additive_expression SUB_OP const_multiplicative_expression
+                {
+			$$ = actions.ConstantBinary($1, $3, constants.OP_SUB)
+                }
+                ;
+
+const_shift_expression:
+                const_additive_expression
+        |       const_shift_expression LEFT_OP const_additive_expression
+                {
+			$$ = actions.ConstantBinary($1, $3, constants.OP_BITSHL)
+                }
+        |       const_shift_expression RIGHT_OP const_additive_expression
+                {
+			$$ = actions.ConstantBinary($1, $3, constants.OP_BITSHR)
+                }
+        |       const_shift_expression BITCLEAR_OP const_additive_expression
+                {
+			$$ = actions.ConstantBinary($1, $3, constants.OP_BITCLEAR)
+                }
+                ;
+
+const_relational_expression:
+                const_shift_expression
+        |       const_relational_expression EQ_OP const_shift_expression
+                {
+			$$ = actions.ConstantBinary($1, $3, constants.OP_EQUAL)
+                }
+        |       const_relational_expression NE_OP const_shift_expression
+                {
+			$$ = actions.ConstantBinary($1, $3, constants.OP_UNEQUAL)
+                }
+        |       const_relational_expression LT_OP const_shift_expression
+                {
+			$$ = actions.ConstantBinary($1, $3, constants.OP_LT)
+                }
+        |       const_relational_expression GT_OP const_shift_expression
+                {
+			$$ = actions.ConstantBinary($1, $3, constants.OP_GT)
+                }
+        |       const_relational_expression LTEQ_OP const_shift_expression
+                {
+			$$ = actions.ConstantBinary($1, $3, constants.OP_LTEQ)
+                }
+        |       const_relational_expression GTEQ_OP const_shift_expression
+                {
+			$$ = actions.ConstantBinary($1, $3, constants.OP_GTEQ)
+                }
+                ;
+
+const_and_expression:
+                const_relational_expression
+        |       const_and_expression REF_OP const_relational_expression
+                {
+			$$ = actions.ConstantBinary($1, $3, constants.OP_BITAND)
+                }
+                ;
+
+const_exclusive_or_expression:
+                const_and_expression
+        |       const_exclusive_or_expression BITXOR_OP const_and_expression
+                {
+			$$ = actions.ConstantBinary($1, $3, constants.OP_BITXOR)
+                }
+                ;
+
+const_inclusive_or_expression:
+                const_exclusive_or_expression
+        |       const_inclusive_or_expression BITOR_OP const_exclusive_or_expression
+                {
+			$$ = actions.ConstantBinary($1, $3, constants.OP_BITOR)
+                }
+                ;
+
+const_logical_and_expression:
+                const_inclusive_or_expression
+        |       const_logical_and_expression AND_OP const_inclusive_or_expression
+                {
+			$$ = actions.ConstantBinary($1, $3, constants.OP_BOOL_AND)
+                }
+                ;
+
+const_expression:
+                const_logical_and_expression
+        |       const_expression OR_OP const_logical_and_expression
+                {
+			$$ = actions.ConstantBinary($1, $3, constants.OP_BOOL_OR)
+                }
+                ;
+
+enum_declaration:
+                ENUM IDENTIFIER LBRACE enum_members RBRACE SEMICOLON
+                {
+			actions.DeclareEnum($2, $<line>2, $4)
+                }
+        |       ENUM IDENTIFIER LBRACE enum_members enum_separator RBRACE SEMICOLON
+                {
+			actions.DeclareEnum($2, $<line>2, $4)
+                }
+                ;
+
+enum_members:   IDENTIFIER
+                {
+			$$ = []string{$1}
+                }
+        |       enum_members enum_separator IDENTIFIER
+                {
+			$$ = append($1, $3)
+                }
+                ;
+
+// members are separated by commas or, one per line, by newlines
+enum_separator: COMMA
+        |       SEMICOLON
+                ;
+
+union_declaration:
+                UNION IDENTIFIER LBRACE enum_members RBRACE SEMICOLON
+                {
+			actions.DeclareUnion($2, $<line>2, $4, true)
+                }
+        |       UNION IDENTIFIER LBRACE enum_members enum_separator RBRACE SEMICOLON
+                {
+			actions.DeclareUnion($2, $<line>2, $4, true)
+                }
+                ;
+
 struct_declaration:
                 TYPE IDENTIFIER STRUCT struct_fields
                 {
@@ -248,6 +541,54 @@ struct_declaration:
                 }
                 ;
 
+type_declaration:
+                TYPE IDENTIFIER declaration_specifiers SEMICOLON
+                {
+			actions.DeclareNamedType($2, $<line>2, $3, false)
+                }
+        |       TYPE IDENTIFIER ASSIGN declaration_specifiers SEMICOLON
+                {
+			actions.DeclareNamedType($2, $<line>2, $4, true)
+                }
+                ;
+
+interface_declaration:
+                TYPE IDENTIFIER INTERFACE LBRACE RBRACE SEMICOLON
+                {
+			actions.DeclareInterface($2, nil, true)
+                }
+        |       TYPE IDENTIFIER INTERFACE LBRACE interface_methods RBRACE SEMICOLON
+                {
+			actions.DeclareInterface($2, $5, true)
+                }
+                ;
+
+interface_methods:
+                interface_method SEMICOLON
+                {
+			$$ = []actions.InterfaceMethod{$1}
+                }
+        |       interface_methods interface_method SEMICOLON
+                {
+			$$ = append($1, $2)
+                }
+                ;
+
+interface_method:
+                IDENTIFIER function_parameters
+                {
+			$$ = actions.InterfaceMethod{Name: $1, Line: actions.LineNo, Inputs: $2}
+                }
+        |       IDENTIFIER function_parameters function_parameters
+                {
+			$$ = actions.InterfaceMethod{Name: $1, Line: actions.LineNo, Inputs: $2, Outputs: $3}
+                }
+        |       IDENTIFIER function_parameters declaration_specifiers
+                {
+			$$ = actions.InterfaceMethod{Name: $1, Line: actions.LineNo, Inputs: $2, Outputs: []*ast.CXArgument{$3}}
+                }
+                ;
+
 struct_fields:
                 LBRACE RBRACE SEMICOLON
                 { $$ = nil }
@@ -263,6 +604,22 @@ fields:         parameter_declaration SEMICOLON
                 {
 			$$ = append($1, $2)
                 }
+        |       IDENTIFIER SEMICOLON
+                {
+			$$ = []*ast.CXArgument{actions.EmbeddedField($1, "", false)}
+                }
+        |       IDENTIFIER PERIOD IDENTIFIER SEMICOLON
+                {
+			$$ = []*ast.CXArgument{actions.EmbeddedField($3, $1, true)}
+                }
+        |       fields IDENTIFIER SEMICOLON
+                {
+			$$ = append($1, actions.EmbeddedField($2, "", false))
+                }
+        |       fields IDENTIFIER PERIOD IDENTIFIER SEMICOLON
+                {
+			$$ = append($1, actions.EmbeddedField($4, $2, true))
+                }
         ;
 
 package_declaration:
@@ -283,10 +640,13 @@ function_header:
                 FUNC IDENTIFIER
                 {
 			yylval.line = 0
+			actions.OpenConstantScope()
 			$$ = actions.FunctionHeader($2, nil, false)
                 }
         |       FUNC LPAREN parameter_type_list RPAREN IDENTIFIER
                 {
+			actions.OpenConstantScope()
+			actions.DeclareLocalNames($3)
 			$$ = actions.FunctionHeader($5, $3, true)
                 }
         ;
@@ -298,14 +658,46 @@ function_parameters:
                 { $$ = $2 }
                 ;
 
+function_literal_header:
+                FUNC function_parameters
+                {
+			actions.DeclareLocalNames($2)
+			$$ = actions.FunctionLiteralHeader($2, nil)
+                }
+        |       FUNC function_parameters function_parameters
+                {
+			actions.DeclareLocalNames($2)
+			actions.DeclareLocalNames($3)
+			$$ = actions.FunctionLiteralHeader($2, $3)
+                }
+        ;
+
+function_literal_body:
+                LBRACE RBRACE
+                { $$ = nil }
+        |       LBRACE block_item_list RBRACE
+                { $$ = $2 }
+        ;
+
 function_declaration:
-                function_header function_parameters compound_statement
+                function_header function_parameters
+                {
+			actions.DeclareLocalNames($2)
+                }
+                compound_statement
                 {
-			actions.FunctionDeclaration($1, $2, nil, $3)
+			actions.CloseConstantScope()
+			actions.FunctionDeclaration($1, $2, nil, $4)
                 }
-        |       function_header function_parameters function_parameters compound_statement
+        |       function_header function_parameters function_parameters
                 {
-			actions.FunctionDeclaration($1, $2, $3, $4)
+			actions.DeclareLocalNames($2)
+			actions.DeclareLocalNames($3)
+                }
+                compound_statement
+                {
+			actions.CloseConstantScope()
+			actions.FunctionDeclaration($1, $2, $3, $5)
                 }
         ;
 
@@ -332,6 +724,10 @@ parameter_declaration:
 			$2.IsLocalDeclaration = true
 			$$ = $2
                 }
+	|       declarator ELLIPSIS declaration_specifiers
+                {
+			$$ = actions.VariadicParameterDeclaration($1, $3)
+                }
                 ;
 
 declarator:     direct_declarator
@@ -354,38 +750,29 @@ direct_declarator:
                 { $$ = $2 }
                 ;
 
-id_list:	IDENTIFIER
+declaration_specifiers_list:
+		declaration_specifiers
 		{
-			arg := actions.DeclarationSpecifiersStruct($1, "", false, actions.CurrentFile, actions.LineNo)
-			$$ = []*ast.CXArgument{arg}
+			$$ = []*ast.CXArgument{$1}
 		}
-	|	type_specifier
+	|	declaration_specifiers_list COMMA declaration_specifiers
 		{
-			arg := actions.DeclarationSpecifiersBasic($1)
-			$$ = []*ast.CXArgument{arg}
+			$$ = append($1, $3)
 		}
-	|	id_list COMMA IDENTIFIER
+	|	ELLIPSIS declaration_specifiers
 		{
-			arg := actions.DeclarationSpecifiersStruct($3, "", false, actions.CurrentFile, actions.LineNo)
-			$$ = append($1, arg)
+			$$ = []*ast.CXArgument{actions.VariadicTypeDeclaration($2)}
 		}
-	|	id_list COMMA type_specifier
+	|	declaration_specifiers_list COMMA ELLIPSIS declaration_specifiers
 		{
-			arg := actions.DeclarationSpecifiersBasic($3)
-			$$ = append($1, arg)
+			$$ = append($1, actions.VariadicTypeDeclaration($4))
 		}
 	;
 
-
-
 types_list:
-
-		LPAREN id_list RPAREN
-
+		LPAREN declaration_specifiers_list RPAREN
 		{
-
 			$$ = $2
-
 		}
 	|	LPAREN RPAREN
 		{
@@ -401,6 +788,12 @@ declaration_specifiers:
 			arg.Outputs = $3
 			$$ = actions.DeclarationSpecifiers(arg, []int{0}, constants.DECL_FUNC)
 		}
+	|	FUNC types_list
+		{
+			arg := ast.MakeArgument("", actions.CurrentFile, actions.LineNo).AddType("func")
+			arg.Inputs = $2
+			$$ = actions.DeclarationSpecifiers(arg, []int{0}, constants.DECL_FUNC)
+		}
         |       MUL_OP declaration_specifiers
                 {
 			$$ = actions.DeclarationSpecifiers($2, []int{0}, constants.DECL_POINTER)
@@ -413,6 +806,10 @@ declaration_specifiers:
                 {
 			$$ = actions.DeclarationSpecifiers($3, []int{0}, constants.DECL_SLICE)
                 }
+        |       MAP LBRACK declaration_specifiers RBRACK declaration_specifiers
+                {
+			$$ = actions.DeclarationSpecifiersMap($3, $5)
+                }
         |       type_specifier
                 {
 			$$ = actions.DeclarationSpecifiersBasic($1)
@@ -514,13 +911,13 @@ array_literal_expression_list:
                 ;
 
 indexing_literal:
-		LBRACK INT_LITERAL RBRACK
+		LBRACK const_expression RBRACK
 		{
-			$$ = []int{int($2)}
+			$$ = []int{actions.ConstantLength($2)}
 		}
-        |       indexing_literal LBRACK INT_LITERAL RBRACK
+        |       indexing_literal LBRACK const_expression RBRACK
 		{
-			$$ = append($1, int($3))
+			$$ = append($1, actions.ConstantLength($3))
 		}
 		;
 
@@ -606,6 +1003,44 @@ slice_literal_expression:
                 }
                 ;
 
+map_literal_entries:
+                assignment_expression COLON assignment_expression
+                {
+			$$ = [][]*ast.CXExpression{$1, $3}
+                }
+	|       map_literal_entries COMMA assignment_expression COLON assignment_expression
+                {
+			$$ = append($1, $3, $5)
+                }
+                ;
+
+map_literal_expression:
+                MAP LBRACK declaration_specifiers RBRACK type_specifier LBRACE map_literal_entries RBRACE
+                {
+			$$ = actions.MapLiteralExpression($3, actions.DeclarationSpecifiersBasic($5), $7)
+                }
+        |       MAP LBRACK declaration_specifiers RBRACK type_specifier LBRACE map_literal_entries COMMA RBRACE
+                {
+			$$ = actions.MapLiteralExpression($3, actions.DeclarationSpecifiersBasic($5), $7)
+                }
+        |       MAP LBRACK declaration_specifiers RBRACK type_specifier LBRACE RBRACE
+                {
+			$$ = actions.MapLiteralExpression($3, actions.DeclarationSpecifiersBasic($5), nil)
+                }
+        |       MAP LBRACK declaration_specifiers RBRACK IDENTIFIER LBRACE map_literal_entries RBRACE
+                {
+			$$ = actions.MapLiteralExpression($3, actions.DeclarationSpecifiersStruct($5, "", false, actions.CurrentFile, actions.LineNo), $7)
+                }
+        |       MAP LBRACK declaration_specifiers RBRACK IDENTIFIER LBRACE map_literal_entries COMMA RBRACE
+                {
+			$$ = actions.MapLiteralExpression($3, actions.DeclarationSpecifiersStruct($5, "", false, actions.CurrentFile, actions.LineNo), $7)
+                }
+        |       MAP LBRACK declaration_specifiers RBRACK IDENTIFIER LBRACE RBRACE
+                {
+			$$ = actions.MapLiteralExpression($3, actions.DeclarationSpecifiersStruct($5, "", false, actions.CurrentFile, actions.LineNo), nil)
+                }
+                ;
+
 infer_action_arg:
                 IDENTIFIER
                 {
@@ -689,10 +1124,10 @@ primary_expression:
                 {
 			$$ = actions.PrimaryIdentifier($1)
                 }
-	|	FUNC LPAREN RPAREN
-		{
-			$$ = nil
-		}
+        |       function_literal_header function_literal_body
+                {
+			$$ = actions.FunctionLiteral($1, $2)
+                }
         |       INFER LBRACE infer_clauses RBRACE
                 {
 			$$ = $3
@@ -706,6 +1141,10 @@ primary_expression:
 			exprs := actions.WritePrimary(constants.TYPE_BOOL, encoder.Serialize($1), false)
 			$$ = exprs
                 }
+        |       NIL
+                {
+			$$ = actions.WritePrimary(constants.TYPE_ERROR, encoder.Serialize(int32(0)), false)
+                }
         |       BYTE_LITERAL
                 {
 			$$ = actions.WritePrimary(constants.TYPE_I8, encoder.Serialize($1), false)
@@ -756,6 +1195,10 @@ primary_expression:
                 {
 			$$ = $1
                 }
+        |       map_literal_expression
+                {
+			$$ = $1
+                }
                 ;
 
 after_period:   type_specifier
@@ -771,17 +1214,53 @@ postfix_expression:
                 {
 			$$ = actions.PostfixExpressionArray($1, $3)
                 }
+	|       postfix_expression LBRACK COLON RBRACK
+                {
+			$$ = actions.PostfixExpressionSlice($1, nil, nil, nil)
+                }
+	|       postfix_expression LBRACK expression COLON RBRACK
+                {
+			$$ = actions.PostfixExpressionSlice($1, $3, nil, nil)
+                }
+	|       postfix_expression LBRACK COLON expression RBRACK
+                {
+			$$ = actions.PostfixExpressionSlice($1, nil, $4, nil)
+                }
+	|       postfix_expression LBRACK expression COLON expression RBRACK
+                {
+			$$ = actions.PostfixExpressionSlice($1, $3, $5, nil)
+                }
+	|       postfix_expression LBRACK COLON expression COLON expression RBRACK
+                {
+			$$ = actions.PostfixExpressionSlice($1, nil, $4, $6)
+                }
+	|       postfix_expression LBRACK expression COLON expression COLON expression RBRACK
+                {
+			$$ = actions.PostfixExpressionSlice($1, $3, $5, $7)
+                }
         |       type_specifier PERIOD after_period
                 {
 			$$ = actions.PostfixExpressionNative(int($1), $3)
                 }
+        |       type_specifier LPAREN assignment_expression RPAREN
+                {
+			$$ = actions.PostfixExpressionConversion(int($1), $3)
+                }
 	|       postfix_expression LPAREN RPAREN
                 {
 			$$ = actions.PostfixExpressionEmptyFunCall($1)
                 }
 	|       postfix_expression LPAREN argument_expression_list RPAREN
                 {
-			$$ = actions.PostfixExpressionFunCall($1, $3)
+			$$ = actions.PostfixExpressionFunCall($1, $3, false)
+                }
+	|       postfix_expression LPAREN argument_expression_list ELLIPSIS RPAREN
+                {
+			$$ = actions.PostfixExpressionFunCall($1, $3, true)
+                }
+	|       postfix_expression PERIOD LPAREN declaration_specifiers RPAREN
+                {
+			$$ = actions.PostfixExpressionTypeAssertion($1, $4)
                 }
 	|       postfix_expression INC_OP
                 {
@@ -791,7 +1270,7 @@ postfix_expression:
                 {
 			$$ = actions.PostfixExpressionIncDec($1, false)
                 }
-        |       postfix_expression PERIOD IDENTIFIER
+        |       postfix_expression PERIOD after_period
                 {
 			$$ = actions.PostfixExpressionField($1, $3)
                 }
@@ -967,6 +1446,9 @@ assignment_expression:
                 struct_literal_expression
 	|       unary_expression assignment_operator assignment_expression
                 {
+			if $2 == ":=" {
+				$1 = actions.DeclareShortVariable($1)
+			}
 			if $3 == nil {
 				$$ = nil
 			}
@@ -1018,8 +1500,7 @@ assignment_operator:
 expression:     assignment_expression
 	|       expression COMMA assignment_expression
                 {
-			$3[len($3) - 1].Outputs = append($1[len($1) - 1].Outputs, $3[len($3) - 1].Outputs...)
-			$$ = $3
+			$$ = actions.MultipleAssignment($1, $3)
                 }
                 ;
 
@@ -1036,6 +1517,10 @@ declaration:
                 {
 			$$ = actions.DeclareLocal($2, $3, $5, true)
                 }
+        |       const_declaration
+                {
+			$$ = nil
+                }
                 ;
 
 initializer:    assignment_expression
@@ -1051,25 +1536,30 @@ statement:      labeled_statement
         |       debugging
                 { $$ = nil }
 	|       jump_statement
+	|       defer_statement
                 ;
 
 labeled_statement:
-                IDENTIFIER COLON block_item
+                statement_label block_item
                 {
 			// it has to be the first expression so all the nested expressions are executed
 			// instead of only executing the last one
 			// UPDATE: I need to label all expressions. `goto` will jump to first occurrance anyway, so no problem
 			// I need this behavior for affordances
-			for _, expr := range $3 {
+			for _, expr := range $2 {
 				expr.Label = $1
 			}
 
-			$$ = $3
+			$$ = $2
+                }
+                ;
+
+statement_label:
+                IDENTIFIER COLON
+                {
+			actions.StatementLabel($1)
+			$$ = $1
                 }
-	|       CASE constant_expression COLON statement
-                { $$ = nil }
-	|       DEFAULT COLON statement
-                { $$ = nil }
                 ;
 
 compound_statement:
@@ -1144,8 +1634,123 @@ selection_statement:
                 {
 			$$ = actions.SelectionExpressions($2, $3, nil)
                 }
-	|       SWITCH LPAREN expression RPAREN statement
-                { $$ = nil }
+	|       SWITCH conditional_expression LBRACE switch_cases RBRACE SEMICOLON
+                {
+			$$ = actions.SwitchStatement($2, $4)
+                }
+	|       SWITCH LBRACE switch_cases RBRACE SEMICOLON
+                {
+			$$ = actions.SwitchStatement(nil, $3)
+                }
+	|       SWITCH IDENTIFIER CASSIGN postfix_expression PERIOD LPAREN TYPE RPAREN LBRACE type_switch_cases RBRACE SEMICOLON
+                {
+			$$ = actions.TypeSwitchStatement($2, $4, $10)
+                }
+	|       SWITCH postfix_expression PERIOD LPAREN TYPE RPAREN LBRACE type_switch_cases RBRACE SEMICOLON
+                {
+			$$ = actions.TypeSwitchStatement("", $2, $8)
+                }
+                ;
+
+switch_cases:
+                /* empty */
+                {
+			$$ = nil
+                }
+	|       switch_cases switch_case
+                {
+			$$ = append($1, $2)
+                }
+                ;
+
+switch_case:    CASE switch_case_values COLON block_item_list
+                {
+			$$ = actions.SwitchCase{
+				Values: $2,
+				Body: $4,
+			}
+                }
+	|       CASE switch_case_values COLON
+                {
+			$$ = actions.SwitchCase{
+				Values: $2,
+			}
+                }
+	|       DEFAULT COLON block_item_list
+                {
+			$$ = actions.SwitchCase{
+				IsDefault: true,
+				Body: $3,
+			}
+                }
+	|       DEFAULT COLON
+                {
+			$$ = actions.SwitchCase{
+				IsDefault: true,
+			}
+                }
+                ;
+
+switch_case_values:
+                conditional_expression
+                {
+			$$ = [][]*ast.CXExpression{$1}
+                }
+	|       switch_case_values COMMA conditional_expression
+                {
+			$$ = append($1, $3)
+                }
+                ;
+
+type_switch_cases:
+                /* empty */
+                {
+			$$ = nil
+                }
+	|       type_switch_cases type_switch_case
+                {
+			$$ = append($1, $2)
+                }
+                ;
+
+type_switch_case:
+                CASE type_switch_types COLON block_item_list
+                {
+			$$ = actions.SwitchCase{
+				Types: $2,
+				Body: $4,
+			}
+                }
+	|       CASE type_switch_types COLON
+                {
+			$$ = actions.SwitchCase{
+				Types: $2,
+			}
+                }
+	|       DEFAULT COLON block_item_list
+                {
+			$$ = actions.SwitchCase{
+				IsDefault: true,
+				Body: $3,
+			}
+                }
+	|       DEFAULT COLON
+                {
+			$$ = actions.SwitchCase{
+				IsDefault: true,
+			}
+                }
+                ;
+
+type_switch_types:
+                declaration_specifiers
+                {
+			$$ = []*ast.CXArgument{$1}
+                }
+	|       type_switch_types COMMA declaration_specifiers
+                {
+			$$ = append($1, $3)
+                }
                 ;
 
 elseif:         ELSE IF conditional_expression LBRACE block_item_list RBRACE
@@ -1198,6 +1803,18 @@ iteration_statement:
                 {
 			$$ = actions.IterationExpressions($2, $3, $4, $5)
                 }
+        |       FOR RANGE conditional_expression compound_statement
+                {
+			$$ = actions.RangeExpressions(nil, nil, "", $3, $4)
+                }
+        |       FOR unary_expression assignment_operator RANGE conditional_expression compound_statement
+                {
+			$$ = actions.RangeExpressions($2, nil, $3, $5, $6)
+                }
+        |       FOR expression COMMA unary_expression assignment_operator RANGE conditional_expression compound_statement
+                {
+			$$ = actions.RangeExpressions($2, $4, $5, $7, $8)
+                }
                 ;
 
 return_expression:
@@ -1228,11 +1845,19 @@ jump_statement: GOTO IDENTIFIER SEMICOLON
                 }
 	|       CONTINUE SEMICOLON
 		{
-			$$ = actions.ContinueExpressions()
+			$$ = actions.ContinueExpressions("", actions.LineNo)
+		}
+	|       CONTINUE IDENTIFIER SEMICOLON
+		{
+			$$ = actions.ContinueExpressions($2, $<line>2)
 		}
 	|       BREAK SEMICOLON
 		{
-			$$ = actions.BreakExpressions()
+			$$ = actions.BreakExpressions("", actions.LineNo)
+		}
+	|       BREAK IDENTIFIER SEMICOLON
+		{
+			$$ = actions.BreakExpressions($2, $<line>2)
 		}
 	|       RETURN SEMICOLON
                 {
@@ -1243,4 +1868,11 @@ jump_statement: GOTO IDENTIFIER SEMICOLON
 			$$ = actions.AddJmpToReturnExpressions($2)
                 }
                 ;
+
+defer_statement:
+                DEFER postfix_expression SEMICOLON
+                {
+			$$ = actions.DeferStatement($2)
+                }
+                ;
 %%
@@ -1,75 +1,82 @@
 // Code generated by goyacc - DO NOT EDIT.
 
-package partialparsing
+
+	package partialparsing
 
 import __yyfmt__ "fmt"
 
-import (
-	"bytes"
-	"github.com/skycoin/cx/cx/ast"
-	"github.com/skycoin/cx/cx/constants"
-	"github.com/skycoin/cx/cxparser/actions"
-)
+	import (
+		"bytes"
+	    "github.com/skycoin/cx/cx/ast"
+	    "github.com/skycoin/cx/cx/constants"
+		"github.com/skycoin/cx/cxparser/actions"
+	)
 
-/*
-   This is a computer generated file
-   - partialparsing.go is generated from partialparsing.y via
-   - ./bin/goyacc -o cxparser/cxpartialparsing/partialparsing.go cxparser/cxpartialparsing/partialparsing.y
-*/
+        /*
+        This is a computer generated file
+        - partialparsing.go is generated from partialparsing.y via 
+        - ./bin/goyacc -o cxparser/cxpartialparsing/partialparsing.go cxparser/cxpartialparsing/partialparsing.y
+        */
 
-var Program *ast.CXProgram
+	var Program *ast.CXProgram
 
-var lineNo int = -1
-var replMode bool = false
-var inREPL bool = false
-var inFn bool = false
-var fileName string
+	var lineNo int = -1
+	var replMode bool = false
+	var inREPL bool = false
+	var inFn bool = false
+	var fileName string
 
-// Parse() is the function that is called from main().
-// It is needed because yyParse is not exported.
-func Parse(code string) int {
-	codeBuf := bytes.NewBufferString(code)
-	return yyParse(NewLexer(codeBuf))
-}
-
-func PreFunctionDeclaration(fn *ast.CXFunction, inputs []*ast.CXArgument, outputs []*ast.CXArgument) {
-	// adding inputs, outputs
-	for _, inp := range inputs {
-		fn.AddInput(inp)
+	// Parse() is the function that is called from main().
+	// It is needed because yyParse is not exported.
+	func Parse(code string) int {
+		codeBuf := bytes.NewBufferString(code)
+		return yyParse(NewLexer(codeBuf))
 	}
-	for _, out := range outputs {
-		fn.AddOutput(out)
+
+	func PreFunctionDeclaration (fn *ast.CXFunction, inputs []*ast.CXArgument, outputs []*ast.CXArgument) {
+		// adding inputs, outputs
+		for _, inp := range inputs {
+			fn.AddInput(inp)
+		}
+		for _, out := range outputs {
+			fn.AddOutput(out)
+		}
 	}
-}
+	
 
 type yySymType struct {
-	yys     int
-	i       int
-	i8      int8
-	i16     int16
-	i32     int32
-	i64     int64
-	ui8     uint8
-	ui16    uint16
-	ui32    uint32
-	ui64    uint64
-	f32     float32
-	f64     float64
-	tok     string
-	bool    bool
-	string  string
+	yys    int
+	i int
+	i8 int8
+	i16 int16
+	i32 int32
+	i64 int64
+	ui8 uint8
+	ui16 uint16
+	ui32 uint32
+	ui64 uint64
+	f32 float32
+	f64 float64
+	tok string
+	bool bool
+	string string
 	stringA []string
 	ints    []int
 
+	constant actions.ConstantValue
+
 	line int
 
-	argument  *ast.CXArgument
+	argument *ast.CXArgument
 	arguments []*ast.CXArgument
 
-	expression  *ast.CXExpression
+	expression *ast.CXExpression
 	expressions []*ast.CXExpression
 
-	function *ast.CXFunction
+	InterfaceMethod actions.InterfaceMethod
+	InterfaceMethods []actions.InterfaceMethod
+
+        function *ast.CXFunction
 }
 
 type yyXError struct {
@@ -77,391 +84,434 @@ type yyXError struct {
 }
 
 const (
-	yyDefault              = 57487
-	yyEofCode              = 57344
-	ADDR                   = 57486
-	ADD_ASSIGN             = 57439
-	ADD_OP                 = 57399
-	AFF                    = 57481
-	AFFVAR                 = 57406
-	AND                    = 57397
-	AND_ASSIGN             = 57440
-	AND_OP                 = 57437
-	ASSIGN                 = 57379
-	BASICTYPE              = 57470
-	BITANDEQ               = 57425
-	BITCLEAR_OP            = 57416
-	BITOREQ                = 57427
-	BITOR_OP               = 57415
-	BITXOREQ               = 57426
-	BITXOR_OP              = 57414
-	BOOL                   = 57449
-	BOOLEAN_LITERAL        = 57346
-	BREAK                  = 57467
-	BYTE_LITERAL           = 57347
-	CAFF                   = 57482
-	CASE                   = 57464
-	CASSIGN                = 57380
-	CLAUSES                = 57475
-	COLON                  = 57389
-	COMMA                  = 57367
-	COMMENT                = 57369
-	CONST                  = 57463
-	CONTINUE               = 57468
-	DEC_OP                 = 57428
-	DEF                    = 57472
-	DEFAULT                = 57465
-	DIVEQ                  = 57420
-	DIV_ASSIGN             = 57444
-	DIV_OP                 = 57402
-	DOUBLE_LITERAL         = 57356
-	DPROGRAM               = 57479
-	DSTACK                 = 57478
-	DSTATE                 = 57480
-	ELSE                   = 57373
-	ENUM                   = 57462
-	EQUAL                  = 57388
-	EQUALWORD              = 57391
-	EQ_OP                  = 57435
-	EXP                    = 57412
-	EXPEQ                  = 57422
-	EXPR                   = 57473
-	F32                    = 57450
-	F64                    = 57451
-	FIELD                  = 57474
-	FLOAT_LITERAL          = 57355
-	FOR                    = 57374
-	FUNC                   = 57357
-	GE_OP                  = 57433
-	GOTO                   = 57383
-	GTEQ_OP                = 57386
-	GTHANEQ                = 57394
-	GTHANWORD              = 57392
-	GT_OP                  = 57384
-	I16                    = 57453
-	I32                    = 57454
-	I64                    = 57455
-	I8                     = 57452
-	IDENTIFIER             = 57365
-	IF                     = 57372
-	IMPORT                 = 57381
-	INC_OP                 = 57429
-	INFER                  = 57484
-	INT_LITERAL            = 57349
-	LBRACE                 = 57361
-	LBRACK                 = 57363
-	LEFTSHIFT              = 57410
-	LEFTSHIFTEQ            = 57423
-	LEFT_ASSIGN            = 57441
-	LEFT_OP                = 57431
-	LE_OP                  = 57434
-	LONG_LITERAL           = 57350
-	LPAREN                 = 57359
-	LTEQ_OP                = 57387
-	LTHANEQ                = 57395
-	LTHANWORD              = 57393
-	LT_OP                  = 57385
-	MINUSEQ                = 57418
-	MINUSMINUS             = 57408
-	MOD_ASSIGN             = 57442
-	MOD_OP                 = 57403
-	MULTEQ                 = 57419
-	MUL_ASSIGN             = 57443
-	MUL_OP                 = 57401
-	NEG_OP                 = 57405
-	NEW                    = 57390
-	NEWLINE                = 57378
-	NE_OP                  = 57436
-	NOT                    = 57413
-	OBJECT                 = 57476
-	OBJECTS                = 57477
-	OP                     = 57358
-	OR                     = 57398
-	OR_ASSIGN              = 57445
-	OR_OP                  = 57438
-	PACKAGE                = 57371
-	PERIOD                 = 57368
-	PLUSEQ                 = 57417
-	PLUSPLUS               = 57407
-	PTR_OP                 = 57430
-	RBRACE                 = 57362
-	RBRACK                 = 57364
-	REF_OP                 = 57404
-	REM                    = 57471
-	REMAINDER              = 57409
-	REMAINDEREQ            = 57421
-	RETURN                 = 57382
-	RIGHTSHIFT             = 57411
-	RIGHTSHIFTEQ           = 57424
-	RIGHT_ASSIGN           = 57446
-	RIGHT_OP               = 57432
-	RPAREN                 = 57360
-	SEMICOLON              = 57377
-	SHORT_LITERAL          = 57348
-	STR                    = 57456
-	STRING_LITERAL         = 57370
-	STRUCT                 = 57376
-	SUB_ASSIGN             = 57447
-	SUB_OP                 = 57400
-	SWITCH                 = 57466
-	TAG                    = 57483
-	TYPE                   = 57469
-	TYPSTRUCT              = 57375
-	UI16                   = 57458
-	UI32                   = 57459
-	UI64                   = 57460
-	UI8                    = 57457
-	UNEQUAL                = 57396
-	UNION                  = 57461
-	UNSIGNED_BYTE_LITERAL  = 57351
-	UNSIGNED_INT_LITERAL   = 57353
-	UNSIGNED_LONG_LITERAL  = 57354
-	UNSIGNED_SHORT_LITERAL = 57352
-	VALUE                  = 57485
-	VAR                    = 57366
-	XOR_ASSIGN             = 57448
-	yyErrCode              = 57345
+	yyDefault               = 57493
+	yyEofCode               = 57344
+	ADDR                    = 57492
+	ADD_ASSIGN              = 57439
+	ADD_OP                  = 57399
+	AFF                     = 57487
+	AFFVAR                  = 57406
+	AND                     = 57397
+	AND_ASSIGN              = 57440
+	AND_OP                  = 57437
+	ASSIGN                  = 57379
+	BASICTYPE               = 57476
+	BITANDEQ                = 57425
+	BITCLEAR_OP             = 57416
+	BITOREQ                 = 57427
+	BITOR_OP                = 57415
+	BITXOREQ                = 57426
+	BITXOR_OP               = 57414
+	BOOL                    = 57449
+	BOOLEAN_LITERAL         = 57346
+	BREAK                   = 57467
+	BYTE_LITERAL            = 57347
+	CAFF                    = 57488
+	CASE                    = 57464
+	CASSIGN                 = 57380
+	CLAUSES                 = 57481
+	COLON                   = 57389
+	COMMA                   = 57367
+	COMMENT                 = 57369
+	CONST                   = 57463
+	CONTINUE                = 57468
+	DEC_OP                  = 57428
+	DEF                     = 57478
+	DEFAULT                 = 57465
+	DEFER                   = 57471
+	DIVEQ                   = 57420
+	DIV_ASSIGN              = 57444
+	DIV_OP                  = 57402
+	DOUBLE_LITERAL          = 57356
+	DPROGRAM                = 57485
+	DSTACK                  = 57484
+	DSTATE                  = 57486
+	ELLIPSIS                = 57474
+	ELSE                    = 57373
+	ENUM                    = 57462
+	EQUAL                   = 57388
+	EQUALWORD               = 57391
+	EQ_OP                   = 57435
+	EXP                     = 57412
+	EXPEQ                   = 57422
+	EXPR                    = 57479
+	F32                     = 57450
+	F64                     = 57451
+	FIELD                   = 57480
+	FLOAT_LITERAL           = 57355
+	FOR                     = 57374
+	FUNC                    = 57357
+	GE_OP                   = 57433
+	GOTO                    = 57383
+	GTEQ_OP                 = 57386
+	GTHANEQ                 = 57394
+	GTHANWORD               = 57392
+	GT_OP                   = 57384
+	I16                     = 57453
+	I32                     = 57454
+	I64                     = 57455
+	I8                      = 57452
+	IDENTIFIER              = 57365
+	IF                      = 57372
+	IMPORT                  = 57381
+	INC_OP                  = 57429
+	INFER                   = 57490
+	INTERFACE               = 57470
+	INT_LITERAL             = 57349
+	LBRACE                  = 57361
+	LBRACK                  = 57363
+	LEFTSHIFT               = 57410
+	LEFTSHIFTEQ             = 57423
+	LEFT_ASSIGN             = 57441
+	LEFT_OP                 = 57431
+	LE_OP                   = 57434
+	LONG_LITERAL            = 57350
+	LPAREN                  = 57359
+	LTEQ_OP                 = 57387
+	LTHANEQ                 = 57395
+	LTHANWORD               = 57393
+	LT_OP                   = 57385
+	MAP                     = 57469
+	MINUSEQ                 = 57418
+	MINUSMINUS              = 57408
+	MOD_ASSIGN              = 57442
+	MOD_OP                  = 57403
+	MULTEQ                  = 57419
+	MUL_ASSIGN              = 57443
+	MUL_OP                  = 57401
+	NEG_OP                  = 57405
+	NEW                     = 57390
+	NEWLINE                 = 57378
+	NE_OP                   = 57436
+	NIL                     = 57472
+	NOT                     = 57413
+	OBJECT                  = 57482
+	OBJECTS                 = 57483
+	OP                      = 57358
+	OR                      = 57398
+	OR_ASSIGN               = 57445
+	OR_OP                   = 57438
+	PACKAGE                 = 57371
+	PERIOD                  = 57368
+	PLUSEQ                  = 57417
+	PLUSPLUS                = 57407
+	PTR_OP                  = 57430
+	RANGE                   = 57473
+	RBRACE                  = 57362
+	RBRACK                  = 57364
+	REF_OP                  = 57404
+	REM                     = 57477
+	REMAINDER               = 57409
+	REMAINDEREQ             = 57421
+	RETURN                  = 57382
+	RIGHTSHIFT              = 57411
+	RIGHTSHIFTEQ            = 57424
+	RIGHT_ASSIGN            = 57446
+	RIGHT_OP                = 57432
+	RPAREN                  = 57360
+	SEMICOLON               = 57377
+	SHORT_LITERAL           = 57348
+	STR                     = 57456
+	STRING_LITERAL          = 57370
+	STRUCT                  = 57376
+	SUB_ASSIGN              = 57447
+	SUB_OP                  = 57400
+	SWITCH                  = 57466
+	TAG                     = 57489
+	TYPE                    = 57475
+	TYPSTRUCT               = 57375
+	UI16                    = 57458
+	UI32                    = 57459
+	UI64                    = 57460
+	UI8                     = 57457
+	UNEQUAL                 = 57396
+	UNION                   = 57461
+	UNSIGNED_BYTE_LITERAL   = 57351
+	UNSIGNED_INT_LITERAL    = 57353
+	UNSIGNED_LONG_LITERAL   = 57354
+	UNSIGNED_SHORT_LITERAL  = 57352
+	VALUE                   = 57491
+	VAR                     = 57366
+	XOR_ASSIGN              = 57448
+	yyErrCode               = 57345
 
 	yyMaxDepth = 200
-	yyTabOfs   = -225
+	yyTabOfs   = -357
 )
 
 var (
+
 	yyPrec = map[int]int{
 		IDENTIFIER: 0,
-		LBRACE:     0,
-	}
+		LBRACE: 0,
+		}
 
 	yyXLAT = map[int]int{
-		57377: 0,   // SEMICOLON (201x)
-		57404: 1,   // REF_OP (198x)
-		57359: 2,   // LPAREN (196x)
-		57401: 3,   // MUL_OP (191x)
-		57399: 4,   // ADD_OP (187x)
-		57400: 5,   // SUB_OP (187x)
-		57363: 6,   // LBRACK (185x)
-		57428: 7,   // DEC_OP (172x)
-		57429: 8,   // INC_OP (172x)
-		57362: 9,   // RBRACE (171x)
-		57361: 10,  // LBRACE (167x)
-		57365: 11,  // IDENTIFIER (164x)
-		57367: 12,  // COMMA (154x)
-		57360: 13,  // RPAREN (141x)
-		57481: 14,  // AFF (134x)
-		57449: 15,  // BOOL (134x)
-		57450: 16,  // F32 (134x)
-		57451: 17,  // F64 (134x)
-		57453: 18,  // I16 (134x)
-		57454: 19,  // I32 (134x)
-		57455: 20,  // I64 (134x)
-		57452: 21,  // I8 (134x)
-		57456: 22,  // STR (134x)
-		57458: 23,  // UI16 (134x)
-		57459: 24,  // UI32 (134x)
-		57460: 25,  // UI64 (134x)
-		57457: 26,  // UI8 (134x)
-		57349: 27,  // INT_LITERAL (121x)
-		57370: 28,  // STRING_LITERAL (118x)
-		57346: 29,  // BOOLEAN_LITERAL (117x)
-		57347: 30,  // BYTE_LITERAL (117x)
-		57356: 31,  // DOUBLE_LITERAL (117x)
-		57355: 32,  // FLOAT_LITERAL (117x)
-		57484: 33,  // INFER (117x)
-		57350: 34,  // LONG_LITERAL (117x)
-		57405: 35,  // NEG_OP (117x)
-		57348: 36,  // SHORT_LITERAL (117x)
-		57351: 37,  // UNSIGNED_BYTE_LITERAL (117x)
-		57353: 38,  // UNSIGNED_INT_LITERAL (117x)
-		57354: 39,  // UNSIGNED_LONG_LITERAL (117x)
-		57352: 40,  // UNSIGNED_SHORT_LITERAL (117x)
-		57389: 41,  // COLON (103x)
-		57364: 42,  // RBRACK (102x)
-		63:    43,  // '?' (88x)
-		57438: 44,  // OR_OP (88x)
-		57437: 45,  // AND_OP (87x)
-		57415: 46,  // BITOR_OP (85x)
-		57414: 47,  // BITXOR_OP (83x)
-		57435: 48,  // EQ_OP (79x)
-		57384: 49,  // GT_OP (79x)
-		57386: 50,  // GTEQ_OP (79x)
-		57385: 51,  // LT_OP (79x)
-		57387: 52,  // LTEQ_OP (79x)
-		57436: 53,  // NE_OP (79x)
-		57416: 54,  // BITCLEAR_OP (77x)
-		57431: 55,  // LEFT_OP (77x)
-		57432: 56,  // RIGHT_OP (77x)
-		57551: 57,  // type_specifier (75x)
-		57379: 58,  // ASSIGN (72x)
-		57521: 59,  // indexing_literal (68x)
-		57402: 60,  // DIV_OP (66x)
-		57403: 61,  // MOD_OP (66x)
-		57366: 62,  // VAR (65x)
-		57544: 63,  // slice_literal_expression (64x)
-		57492: 64,  // array_literal_expression (63x)
-		57539: 65,  // postfix_expression (63x)
-		57540: 66,  // primary_expression (63x)
-		57553: 67,  // unary_expression (63x)
-		57554: 68,  // unary_operator (63x)
-		57439: 69,  // ADD_ASSIGN (59x)
-		57440: 70,  // AND_ASSIGN (59x)
-		57380: 71,  // CASSIGN (59x)
-		57444: 72,  // DIV_ASSIGN (59x)
-		57441: 73,  // LEFT_ASSIGN (59x)
-		57442: 74,  // MOD_ASSIGN (59x)
-		57443: 75,  // MUL_ASSIGN (59x)
-		57445: 76,  // OR_ASSIGN (59x)
-		57368: 77,  // PERIOD (59x)
-		57446: 78,  // RIGHT_ASSIGN (59x)
-		57447: 79,  // SUB_ASSIGN (59x)
-		57448: 80,  // XOR_ASSIGN (59x)
-		57534: 81,  // multiplicative_expression (56x)
-		57488: 82,  // additive_expression (54x)
-		57543: 83,  // shift_expression (51x)
-		57372: 84,  // IF (50x)
-		57467: 85,  // BREAK (49x)
-		57464: 86,  // CASE (49x)
-		57468: 87,  // CONTINUE (49x)
-		57465: 88,  // DEFAULT (49x)
-		57374: 89,  // FOR (49x)
-		57383: 90,  // GOTO (49x)
-		57382: 91,  // RETURN (49x)
-		57466: 92,  // SWITCH (49x)
-		57541: 93,  // relational_expression (45x)
-		57490: 94,  // and_expression (44x)
-		57508: 95,  // exclusive_or_expression (43x)
-		57520: 96,  // inclusive_or_expression (42x)
-		57532: 97,  // logical_and_expression (41x)
-		57499: 98,  // conditional_expression (40x)
-		57533: 99,  // logical_or_expression (40x)
-		57494: 100, // assignment_expression (33x)
-		57548: 101, // struct_literal_expression (33x)
-		57357: 102, // FUNC (28x)
-		57509: 103, // expression (21x)
-		57498: 104, // compound_statement (20x)
-		57381: 105, // IMPORT (20x)
-		57371: 106, // PACKAGE (20x)
-		57469: 107, // TYPE (20x)
-		57344: 108, // $end (19x)
-		57510: 109, // expression_statement (15x)
-		57529: 110, // iteration_statement (12x)
-		57530: 111, // jump_statement (12x)
-		57531: 112, // labeled_statement (12x)
-		57542: 113, // selection_statement (12x)
-		57545: 114, // statement (12x)
-		57501: 115, // declaration (10x)
-		57496: 116, // block_item (9x)
-		57503: 117, // declarator (8x)
-		57504: 118, // direct_declarator (8x)
-		57373: 119, // ELSE (8x)
-		57502: 120, // declaration_specifiers (5x)
-		57536: 121, // parameter_declaration (5x)
-		57497: 122, // block_item_list (4x)
-		57505: 123, // else_statement (4x)
-		57506: 124, // elseif (4x)
-		57523: 125, // infer_action (4x)
-		57491: 126, // argument_expression_list (3x)
-		57493: 127, // array_literal_expression_list (3x)
-		57500: 128, // constant_expression (3x)
-		57549: 129, // struct_literal_fields (3x)
-		57507: 130, // elseif_list (2x)
-		57511: 131, // external_declaration (2x)
-		57513: 132, // function_declaration (2x)
-		57514: 133, // function_header (2x)
-		57515: 134, // function_parameters (2x)
-		57516: 135, // global_declaration (2x)
-		57519: 136, // import_declaration (2x)
-		57527: 137, // initializer (2x)
-		57535: 138, // package_declaration (2x)
-		57537: 139, // parameter_list (2x)
-		57538: 140, // parameter_type_list (2x)
-		57546: 141, // struct_declaration (2x)
-		57552: 142, // types_list (2x)
-		57489: 143, // after_period (1x)
-		57495: 144, // assignment_operator (1x)
-		57512: 145, // fields (1x)
-		57517: 146, // id_list (1x)
-		57524: 147, // infer_action_arg (1x)
-		57525: 148, // infer_actions (1x)
-		57526: 149, // infer_clauses (1x)
-		57376: 150, // STRUCT (1x)
-		57547: 151, // struct_fields (1x)
-		57550: 152, // translation_unit (1x)
-		57487: 153, // $default (0x)
-		57486: 154, // ADDR (0x)
-		57406: 155, // AFFVAR (0x)
-		57397: 156, // AND (0x)
-		57470: 157, // BASICTYPE (0x)
-		57425: 158, // BITANDEQ (0x)
-		57427: 159, // BITOREQ (0x)
-		57426: 160, // BITXOREQ (0x)
-		57482: 161, // CAFF (0x)
-		57475: 162, // CLAUSES (0x)
-		57369: 163, // COMMENT (0x)
-		57463: 164, // CONST (0x)
-		57472: 165, // DEF (0x)
-		57420: 166, // DIVEQ (0x)
-		57479: 167, // DPROGRAM (0x)
-		57478: 168, // DSTACK (0x)
-		57480: 169, // DSTATE (0x)
-		57462: 170, // ENUM (0x)
-		57388: 171, // EQUAL (0x)
-		57391: 172, // EQUALWORD (0x)
-		57345: 173, // error (0x)
-		57412: 174, // EXP (0x)
-		57422: 175, // EXPEQ (0x)
-		57473: 176, // EXPR (0x)
-		57474: 177, // FIELD (0x)
-		57433: 178, // GE_OP (0x)
-		57394: 179, // GTHANEQ (0x)
-		57392: 180, // GTHANWORD (0x)
-		57518: 181, // identifier_list (0x)
-		57522: 182, // indexing_slice_literal (0x)
-		57528: 183, // int_value (0x)
-		57434: 184, // LE_OP (0x)
-		57410: 185, // LEFTSHIFT (0x)
-		57423: 186, // LEFTSHIFTEQ (0x)
-		57395: 187, // LTHANEQ (0x)
-		57393: 188, // LTHANWORD (0x)
-		57418: 189, // MINUSEQ (0x)
-		57408: 190, // MINUSMINUS (0x)
-		57419: 191, // MULTEQ (0x)
-		57390: 192, // NEW (0x)
-		57378: 193, // NEWLINE (0x)
-		57413: 194, // NOT (0x)
-		57476: 195, // OBJECT (0x)
-		57477: 196, // OBJECTS (0x)
-		57358: 197, // OP (0x)
-		57398: 198, // OR (0x)
-		57417: 199, // PLUSEQ (0x)
-		57407: 200, // PLUSPLUS (0x)
-		57430: 201, // PTR_OP (0x)
-		57471: 202, // REM (0x)
-		57409: 203, // REMAINDER (0x)
-		57421: 204, // REMAINDEREQ (0x)
-		57411: 205, // RIGHTSHIFT (0x)
-		57424: 206, // RIGHTSHIFTEQ (0x)
-		57483: 207, // TAG (0x)
-		57375: 208, // TYPSTRUCT (0x)
-		57396: 209, // UNEQUAL (0x)
-		57461: 210, // UNION (0x)
-		57485: 211, // VALUE (0x)
+		 57377:   0, // SEMICOLON (327x)
+		 57399:   1, // ADD_OP (309x)
+		 57400:   2, // SUB_OP (309x)
+		 57359:   3, // LPAREN (303x)
+		 57404:   4, // REF_OP (302x)
+		 57401:   5, // MUL_OP (300x)
+		 57365:   6, // IDENTIFIER (280x)
+		 57363:   7, // LBRACK (271x)
+		 57362:   8, // RBRACE (248x)
+		 57428:   9, // DEC_OP (234x)
+		 57429:  10, // INC_OP (234x)
+		 57487:  11, // AFF (227x)
+		 57449:  12, // BOOL (227x)
+		 57450:  13, // F32 (227x)
+		 57451:  14, // F64 (227x)
+		 57453:  15, // I16 (227x)
+		 57454:  16, // I32 (227x)
+		 57455:  17, // I64 (227x)
+		 57452:  18, // I8 (227x)
+		 57456:  19, // STR (227x)
+		 57458:  20, // UI16 (227x)
+		 57459:  21, // UI32 (227x)
+		 57460:  22, // UI64 (227x)
+		 57457:  23, // UI8 (227x)
+		 57361:  24, // LBRACE (226x)
+		 57360:  25, // RPAREN (223x)
+		 57357:  26, // FUNC (216x)
+		 57367:  27, // COMMA (197x)
+		 57364:  28, // RBRACK (191x)
+		 57349:  29, // INT_LITERAL (187x)
+		 57370:  30, // STRING_LITERAL (187x)
+		 57346:  31, // BOOLEAN_LITERAL (186x)
+		 57347:  32, // BYTE_LITERAL (186x)
+		 57356:  33, // DOUBLE_LITERAL (186x)
+		 57355:  34, // FLOAT_LITERAL (186x)
+		 57350:  35, // LONG_LITERAL (186x)
+		 57348:  36, // SHORT_LITERAL (186x)
+		 57351:  37, // UNSIGNED_BYTE_LITERAL (186x)
+		 57353:  38, // UNSIGNED_INT_LITERAL (186x)
+		 57354:  39, // UNSIGNED_LONG_LITERAL (186x)
+		 57352:  40, // UNSIGNED_SHORT_LITERAL (186x)
+		 57469:  41, // MAP (185x)
+		 57405:  42, // NEG_OP (184x)
+		 57438:  43, // OR_OP (166x)
+		 57437:  44, // AND_OP (159x)
+		 57490:  45, // INFER (157x)
+		 57472:  46, // NIL (157x)
+		 57415:  47, // BITOR_OP (155x)
+		 57414:  48, // BITXOR_OP (151x)
+		 57586:  49, // type_specifier (151x)
+		 57389:  50, // COLON (147x)
+		 57435:  51, // EQ_OP (143x)
+		 57384:  52, // GT_OP (143x)
+		 57386:  53, // GTEQ_OP (143x)
+		 57385:  54, // LT_OP (143x)
+		 57387:  55, // LTEQ_OP (143x)
+		 57436:  56, // NE_OP (143x)
+		 57416:  57, // BITCLEAR_OP (139x)
+		 57431:  58, // LEFT_OP (139x)
+		 57432:  59, // RIGHT_OP (139x)
+		 57474:  60, // ELLIPSIS (125x)
+		 57402:  61, // DIV_OP (117x)
+		 57403:  62, // MOD_OP (117x)
+		    63:  63, // '?' (112x)
+		 57547:  64, // indexing_literal (111x)
+		 57463:  65, // CONST (101x)
+		 57366:  66, // VAR (101x)
+		 57379:  67, // ASSIGN (99x)
+		 57575:  68, // slice_literal_expression (91x)
+		 57498:  69, // array_literal_expression (90x)
+		 57541:  70, // function_literal_header (90x)
+		 57564:  71, // map_literal_expression (90x)
+		 57570:  72, // postfix_expression (90x)
+		 57571:  73, // primary_expression (90x)
+		 57591:  74, // unary_expression (88x)
+		 57592:  75, // unary_operator (88x)
+		 57368:  76, // PERIOD (86x)
+		 57380:  77, // CASSIGN (82x)
+		 57439:  78, // ADD_ASSIGN (81x)
+		 57440:  79, // AND_ASSIGN (81x)
+		 57444:  80, // DIV_ASSIGN (81x)
+		 57441:  81, // LEFT_ASSIGN (81x)
+		 57442:  82, // MOD_ASSIGN (81x)
+		 57443:  83, // MUL_ASSIGN (81x)
+		 57565:  84, // multiplicative_expression (81x)
+		 57445:  85, // OR_ASSIGN (81x)
+		 57446:  86, // RIGHT_ASSIGN (81x)
+		 57447:  87, // SUB_ASSIGN (81x)
+		 57448:  88, // XOR_ASSIGN (81x)
+		 57494:  89, // additive_expression (79x)
+		 57574:  90, // shift_expression (76x)
+		 57372:  91, // IF (70x)
+		 57572:  92, // relational_expression (70x)
+		 57496:  93, // and_expression (69x)
+		 57467:  94, // BREAK (69x)
+		 57468:  95, // CONTINUE (69x)
+		 57471:  96, // DEFER (69x)
+		 57374:  97, // FOR (69x)
+		 57383:  98, // GOTO (69x)
+		 57382:  99, // RETURN (69x)
+		 57466: 100, // SWITCH (69x)
+		 57464: 101, // CASE (68x)
+		 57465: 102, // DEFAULT (68x)
+		 57533: 103, // exclusive_or_expression (68x)
+		 57546: 104, // inclusive_or_expression (67x)
+		 57561: 105, // logical_and_expression (66x)
+		 57505: 106, // conditional_expression (65x)
+		 57562: 107, // logical_or_expression (65x)
+		 57500: 108, // assignment_expression (54x)
+		 57579: 109, // struct_literal_expression (54x)
+		 57475: 110, // TYPE (39x)
+		 57462: 111, // ENUM (37x)
+		 57381: 112, // IMPORT (37x)
+		 57371: 113, // PACKAGE (37x)
+		 57461: 114, // UNION (37x)
+		 57344: 115, // $end (36x)
+		 57534: 116, // expression (32x)
+		 57504: 117, // compound_statement (30x)
+		 57514: 118, // const_primary_expression (29x)
+		 57519: 119, // const_unary_expression (29x)
+		 57513: 120, // const_multiplicative_expression (23x)
+		 57508: 121, // const_declaration (22x)
+		 57535: 122, // expression_statement (22x)
+		 57506: 123, // const_additive_expression (21x)
+		 57522: 124, // declaration_specifiers (21x)
+		 57521: 125, // declaration (20x)
+		 57502: 126, // block_item (19x)
+		 57525: 127, // defer_statement (19x)
+		 57558: 128, // iteration_statement (19x)
+		 57559: 129, // jump_statement (19x)
+		 57560: 130, // labeled_statement (19x)
+		 57573: 131, // selection_statement (19x)
+		 57576: 132, // statement (19x)
+		 57516: 133, // const_shift_expression (18x)
+		 57473: 134, // RANGE (15x)
+		 57515: 135, // const_relational_expression (12x)
+		 57507: 136, // const_and_expression (11x)
+		 57509: 137, // const_exclusive_or_expression (10x)
+		 57503: 138, // block_item_list (9x)
+		 57511: 139, // const_inclusive_or_expression (9x)
+		 57512: 140, // const_logical_and_expression (8x)
+		 57524: 141, // declarator (8x)
+		 57526: 142, // direct_declarator (8x)
+		 57373: 143, // ELSE (8x)
+		 57510: 144, // const_expression (7x)
+		 57542: 145, // function_parameters (6x)
+		 57495: 146, // after_period (5x)
+		 57567: 147, // parameter_declaration (5x)
+		 57527: 148, // else_statement (4x)
+		 57528: 149, // elseif (4x)
+		 57549: 150, // infer_action (4x)
+		 57497: 151, // argument_expression_list (3x)
+		 57499: 152, // array_literal_expression_list (3x)
+		 57501: 153, // assignment_operator (3x)
+		 57517: 154, // const_spec (3x)
+		 57580: 155, // struct_literal_fields (3x)
+		 57520: 156, // constant_expression (2x)
+		 57529: 157, // elseif_list (2x)
+		 57530: 158, // enum_declaration (2x)
+		 57531: 159, // enum_members (2x)
+		 57532: 160, // enum_separator (2x)
+		 57536: 161, // external_declaration (2x)
+		 57538: 162, // function_declaration (2x)
+		 57539: 163, // function_header (2x)
+		 57543: 164, // global_declaration (2x)
+		 57545: 165, // import_declaration (2x)
+		 57553: 166, // initializer (2x)
+		 57555: 167, // interface_declaration (2x)
+		 57556: 168, // interface_method (2x)
+		 57563: 169, // map_literal_entries (2x)
+		 57566: 170, // package_declaration (2x)
+		 57568: 171, // parameter_list (2x)
+		 57569: 172, // parameter_type_list (2x)
+		 57577: 173, // struct_declaration (2x)
+		 57581: 174, // switch_case (2x)
+		 57583: 175, // switch_cases (2x)
+		 57585: 176, // type_declaration (2x)
+		 57587: 177, // type_switch_case (2x)
+		 57588: 178, // type_switch_cases (2x)
+		 57590: 179, // types_list (2x)
+		 57593: 180, // union_declaration (2x)
+		 57518: 181, // const_spec_list (1x)
+		 57523: 182, // declaration_specifiers_list (1x)
+		 57537: 183, // fields (1x)
+		 57540: 184, // function_literal_body (1x)
+		 57550: 185, // infer_action_arg (1x)
+		 57551: 186, // infer_actions (1x)
+		 57552: 187, // infer_clauses (1x)
+		 57470: 188, // INTERFACE (1x)
+		 57557: 189, // interface_methods (1x)
+		 57376: 190, // STRUCT (1x)
+		 57578: 191, // struct_fields (1x)
+		 57582: 192, // switch_case_values (1x)
+		 57584: 193, // translation_unit (1x)
+		 57589: 194, // type_switch_types (1x)
+		 57493: 195, // $default (0x)
+		 57492: 196, // ADDR (0x)
+		 57406: 197, // AFFVAR (0x)
+		 57397: 198, // AND (0x)
+		 57476: 199, // BASICTYPE (0x)
+		 57425: 200, // BITANDEQ (0x)
+		 57427: 201, // BITOREQ (0x)
+		 57426: 202, // BITXOREQ (0x)
+		 57488: 203, // CAFF (0x)
+		 57481: 204, // CLAUSES (0x)
+		 57369: 205, // COMMENT (0x)
+		 57478: 206, // DEF (0x)
+		 57420: 207, // DIVEQ (0x)
+		 57485: 208, // DPROGRAM (0x)
+		 57484: 209, // DSTACK (0x)
+		 57486: 210, // DSTATE (0x)
+		 57388: 211, // EQUAL (0x)
+		 57391: 212, // EQUALWORD (0x)
+		 57345: 213, // error (0x)
+		 57412: 214, // EXP (0x)
+		 57422: 215, // EXPEQ (0x)
+		 57479: 216, // EXPR (0x)
+		 57480: 217, // FIELD (0x)
+		 57433: 218, // GE_OP (0x)
+		 57394: 219, // GTHANEQ (0x)
+		 57392: 220, // GTHANWORD (0x)
+		 57544: 221, // identifier_list (0x)
+		 57548: 222, // indexing_slice_literal (0x)
+		 57554: 223, // int_value (0x)
+		 57434: 224, // LE_OP (0x)
+		 57410: 225, // LEFTSHIFT (0x)
+		 57423: 226, // LEFTSHIFTEQ (0x)
+		 57395: 227, // LTHANEQ (0x)
+		 57393: 228, // LTHANWORD (0x)
+		 57418: 229, // MINUSEQ (0x)
+		 57408: 230, // MINUSMINUS (0x)
+		 57419: 231, // MULTEQ (0x)
+		 57390: 232, // NEW (0x)
+		 57378: 233, // NEWLINE (0x)
+		 57413: 234, // NOT (0x)
+		 57482: 235, // OBJECT (0x)
+		 57483: 236, // OBJECTS (0x)
+		 57358: 237, // OP (0x)
+		 57398: 238, // OR (0x)
+		 57417: 239, // PLUSEQ (0x)
+		 57407: 240, // PLUSPLUS (0x)
+		 57430: 241, // PTR_OP (0x)
+		 57477: 242, // REM (0x)
+		 57409: 243, // REMAINDER (0x)
+		 57421: 244, // REMAINDEREQ (0x)
+		 57411: 245, // RIGHTSHIFT (0x)
+		 57424: 246, // RIGHTSHIFTEQ (0x)
+		 57489: 247, // TAG (0x)
+		 57375: 248, // TYPSTRUCT (0x)
+		 57396: 249, // UNEQUAL (0x)
+		 57491: 250, // VALUE (0x)
 	}
 
 	yySymNames = []string{
 		"SEMICOLON",
-		"REF_OP",
-		"LPAREN",
-		"MUL_OP",
 		"ADD_OP",
 		"SUB_OP",
+		"LPAREN",
+		"REF_OP",
+		"MUL_OP",
+		"IDENTIFIER",
 		"LBRACK",
+		"RBRACE",
 		"DEC_OP",
 		"INC_OP",
-		"RBRACE",
-		"LBRACE",
-		"IDENTIFIER",
-		"COMMA",
-		"RPAREN",
 		"AFF",
 		"BOOL",
 		"F32",
@@ -475,27 +525,33 @@ var (
 		"UI32",
 		"UI64",
 		"UI8",
+		"LBRACE",
+		"RPAREN",
+		"FUNC",
+		"COMMA",
+		"RBRACK",
 		"INT_LITERAL",
 		"STRING_LITERAL",
 		"BOOLEAN_LITERAL",
 		"BYTE_LITERAL",
 		"DOUBLE_LITERAL",
 		"FLOAT_LITERAL",
-		"INFER",
 		"LONG_LITERAL",
-		"NEG_OP",
 		"SHORT_LITERAL",
 		"UNSIGNED_BYTE_LITERAL",
 		"UNSIGNED_INT_LITERAL",
 		"UNSIGNED_LONG_LITERAL",
 		"UNSIGNED_SHORT_LITERAL",
-		"COLON",
-		"RBRACK",
-		"'?'",
+		"MAP",
+		"NEG_OP",
 		"OR_OP",
 		"AND_OP",
+		"INFER",
+		"NIL",
 		"BITOR_OP",
 		"BITXOR_OP",
+		"type_specifier",
+		"COLON",
 		"EQ_OP",
 		"GT_OP",
 		"GTEQ_OP",
@@ -505,44 +561,49 @@ var (
 		"BITCLEAR_OP",
 		"LEFT_OP",
 		"RIGHT_OP",
-		"type_specifier",
-		"ASSIGN",
-		"indexing_literal",
+		"ELLIPSIS",
 		"DIV_OP",
 		"MOD_OP",
+		"'?'",
+		"indexing_literal",
+		"CONST",
 		"VAR",
+		"ASSIGN",
 		"slice_literal_expression",
 		"array_literal_expression",
+		"function_literal_header",
+		"map_literal_expression",
 		"postfix_expression",
 		"primary_expression",
 		"unary_expression",
 		"unary_operator",
+		"PERIOD",
+		"CASSIGN",
 		"ADD_ASSIGN",
 		"AND_ASSIGN",
-		"CASSIGN",
 		"DIV_ASSIGN",
 		"LEFT_ASSIGN",
 		"MOD_ASSIGN",
 		"MUL_ASSIGN",
+		"multiplicative_expression",
 		"OR_ASSIGN",
-		"PERIOD",
 		"RIGHT_ASSIGN",
 		"SUB_ASSIGN",
 		"XOR_ASSIGN",
-		"multiplicative_expression",
 		"additive_expression",
 		"shift_expression",
 		"IF",
+		"relational_expression",
+		"and_expression",
 		"BREAK",
-		"CASE",
 		"CONTINUE",
-		"DEFAULT",
+		"DEFER",
 		"FOR",
 		"GOTO",
 		"RETURN",
 		"SWITCH",
-		"relational_expression",
-		"and_expression",
+		"CASE",
+		"DEFAULT",
 		"exclusive_or_expression",
 		"inclusive_or_expression",
 		"logical_and_expression",
@@ -550,57 +611,91 @@ var (
 		"logical_or_expression",
 		"assignment_expression",
 		"struct_literal_expression",
-		"FUNC",
-		"expression",
-		"compound_statement",
+		"TYPE",
+		"ENUM",
 		"IMPORT",
 		"PACKAGE",
-		"TYPE",
+		"UNION",
 		"$end",
+		"expression",
+		"compound_statement",
+		"const_primary_expression",
+		"const_unary_expression",
+		"const_multiplicative_expression",
+		"const_declaration",
 		"expression_statement",
+		"const_additive_expression",
+		"declaration_specifiers",
+		"declaration",
+		"block_item",
+		"defer_statement",
 		"iteration_statement",
 		"jump_statement",
 		"labeled_statement",
 		"selection_statement",
 		"statement",
-		"declaration",
-		"block_item",
+		"const_shift_expression",
+		"RANGE",
+		"const_relational_expression",
+		"const_and_expression",
+		"const_exclusive_or_expression",
+		"block_item_list",
+		"const_inclusive_or_expression",
+		"const_logical_and_expression",
 		"declarator",
 		"direct_declarator",
 		"ELSE",
-		"declaration_specifiers",
+		"const_expression",
+		"function_parameters",
+		"after_period",
 		"parameter_declaration",
-		"block_item_list",
 		"else_statement",
 		"elseif",
 		"infer_action",
 		"argument_expression_list",
 		"array_literal_expression_list",
-		"constant_expression",
+		"assignment_operator",
+		"const_spec",
 		"struct_literal_fields",
+		"constant_expression",
 		"elseif_list",
+		"enum_declaration",
+		"enum_members",
+		"enum_separator",
 		"external_declaration",
 		"function_declaration",
 		"function_header",
-		"function_parameters",
 		"global_declaration",
 		"import_declaration",
 		"initializer",
+		"interface_declaration",
+		"interface_method",
+		"map_literal_entries",
 		"package_declaration",
 		"parameter_list",
 		"parameter_type_list",
 		"struct_declaration",
+		"switch_case",
+		"switch_cases",
+		"type_declaration",
+		"type_switch_case",
+		"type_switch_cases",
 		"types_list",
-		"after_period",
-		"assignment_operator",
+		"union_declaration",
+		"const_spec_list",
+		"declaration_specifiers_list",
 		"fields",
-		"id_list",
+		"function_literal_body",
 		"infer_action_arg",
 		"infer_actions",
 		"infer_clauses",
+		"INTERFACE",
+		"interface_methods",
 		"STRUCT",
 		"struct_fields",
+		"switch_case_values",
 		"translation_unit",
+		"type_switch_types",
 		"$default",
 		"ADDR",
 		"AFFVAR",
@@ -612,13 +707,11 @@ var (
 		"CAFF",
 		"CLAUSES",
 		"COMMENT",
-		"CONST",
 		"DEF",
 		"DIVEQ",
 		"DPROGRAM",
 		"DSTACK",
 		"DSTATE",
-		"ENUM",
 		"EQUAL",
 		"EQUALWORD",
 		"error",
@@ -658,731 +751,1197 @@ var (
 		"TAG",
 		"TYPSTRUCT",
 		"UNEQUAL",
-		"UNION",
 		"VALUE",
 	}
 
-	yyTokenLiteralStrings = map[int]string{}
+	yyTokenLiteralStrings = map[int]string{
+	}
 
-	yyReductions = map[int]struct{ xsym, components int }{
-		0:   {0, 1},
-		1:   {152, 1},
-		2:   {152, 2},
-		3:   {131, 1},
-		4:   {131, 1},
-		5:   {131, 1},
-		6:   {131, 1},
-		7:   {131, 1},
-		8:   {135, 4},
-		9:   {135, 6},
-		10:  {141, 4},
-		11:  {151, 3},
-		12:  {151, 4},
-		13:  {145, 2},
-		14:  {145, 3},
-		15:  {138, 3},
-		16:  {136, 3},
-		17:  {133, 2},
-		18:  {133, 5},
-		19:  {134, 2},
-		20:  {134, 3},
-		21:  {132, 3},
-		22:  {132, 4},
-		23:  {140, 1},
-		24:  {139, 1},
-		25:  {139, 3},
-		26:  {121, 2},
-		27:  {181, 1},
-		28:  {181, 3},
-		29:  {117, 1},
-		30:  {118, 1},
-		31:  {118, 3},
-		32:  {146, 1},
-		33:  {146, 1},
-		34:  {146, 3},
-		35:  {146, 3},
-		36:  {142, 3},
-		37:  {142, 2},
-		38:  {120, 3},
-		39:  {120, 2},
-		40:  {120, 3},
-		41:  {120, 1},
-		42:  {120, 1},
-		43:  {120, 2},
-		44:  {120, 2},
-		45:  {120, 3},
-		46:  {120, 3},
-		47:  {57, 1},
-		48:  {57, 1},
-		49:  {57, 1},
-		50:  {57, 1},
-		51:  {57, 1},
-		52:  {57, 1},
-		53:  {57, 1},
-		54:  {57, 1},
-		55:  {57, 1},
-		56:  {57, 1},
-		57:  {57, 1},
-		58:  {57, 1},
-		59:  {57, 1},
-		60:  {129, 0},
-		61:  {129, 3},
-		62:  {129, 5},
-		63:  {127, 1},
-		64:  {127, 3},
-		65:  {127, 3},
-		66:  {59, 3},
-		67:  {59, 4},
-		68:  {182, 2},
-		69:  {182, 3},
-		70:  {64, 5},
-		71:  {64, 4},
-		72:  {64, 5},
-		73:  {64, 4},
-		74:  {63, 6},
-		75:  {63, 5},
-		76:  {63, 6},
-		77:  {63, 5},
-		78:  {63, 3},
-		79:  {147, 1},
-		80:  {147, 1},
-		81:  {147, 3},
-		82:  {125, 6},
-		83:  {125, 4},
-		84:  {125, 4},
-		85:  {125, 6},
-		86:  {148, 2},
-		87:  {148, 3},
-		88:  {149, 0},
-		89:  {149, 1},
-		90:  {183, 1},
-		91:  {183, 2},
-		92:  {66, 1},
-		93:  {66, 4},
-		94:  {66, 1},
-		95:  {66, 1},
-		96:  {66, 1},
-		97:  {66, 1},
-		98:  {66, 1},
-		99:  {66, 1},
-		100: {66, 1},
-		101: {66, 1},
-		102: {66, 1},
-		103: {66, 1},
-		104: {66, 1},
-		105: {66, 1},
-		106: {66, 3},
-		107: {66, 1},
-		108: {66, 1},
-		109: {143, 1},
-		110: {143, 1},
-		111: {65, 1},
-		112: {65, 4},
-		113: {65, 3},
-		114: {65, 3},
-		115: {65, 4},
-		116: {65, 2},
-		117: {65, 2},
-		118: {65, 3},
-		119: {126, 1},
-		120: {126, 3},
-		121: {67, 1},
-		122: {67, 2},
-		123: {67, 2},
-		124: {67, 2},
-		125: {68, 1},
-		126: {68, 1},
-		127: {68, 1},
-		128: {68, 1},
-		129: {68, 1},
-		130: {81, 1},
-		131: {81, 3},
-		132: {81, 3},
-		133: {81, 3},
-		134: {82, 1},
-		135: {82, 3},
-		136: {82, 3},
-		137: {83, 1},
-		138: {83, 3},
-		139: {83, 3},
-		140: {83, 3},
-		141: {93, 1},
-		142: {93, 3},
-		143: {93, 3},
-		144: {93, 3},
-		145: {93, 3},
-		146: {93, 3},
-		147: {93, 3},
-		148: {94, 1},
-		149: {94, 3},
-		150: {95, 1},
-		151: {95, 3},
-		152: {96, 1},
-		153: {96, 3},
-		154: {97, 1},
-		155: {97, 3},
-		156: {99, 1},
-		157: {99, 3},
-		158: {98, 1},
-		159: {98, 5},
-		160: {101, 1},
-		161: {101, 4},
-		162: {101, 5},
-		163: {101, 6},
-		164: {100, 1},
-		165: {100, 3},
-		166: {144, 1},
-		167: {144, 1},
-		168: {144, 1},
-		169: {144, 1},
-		170: {144, 1},
-		171: {144, 1},
-		172: {144, 1},
-		173: {144, 1},
-		174: {144, 1},
-		175: {144, 1},
-		176: {144, 1},
-		177: {144, 1},
-		178: {103, 1},
-		179: {103, 3},
-		180: {128, 1},
-		181: {115, 4},
-		182: {115, 6},
-		183: {137, 1},
-		184: {114, 1},
-		185: {114, 1},
-		186: {114, 1},
-		187: {114, 1},
-		188: {114, 1},
-		189: {114, 1},
-		190: {112, 3},
-		191: {112, 4},
-		192: {112, 3},
-		193: {104, 3},
-		194: {104, 4},
-		195: {122, 1},
-		196: {122, 2},
-		197: {116, 1},
-		198: {116, 1},
-		199: {109, 1},
-		200: {109, 2},
-		201: {113, 8},
-		202: {113, 7},
-		203: {113, 6},
-		204: {113, 7},
-		205: {113, 6},
-		206: {113, 7},
-		207: {113, 3},
-		208: {113, 5},
-		209: {124, 6},
-		210: {124, 5},
-		211: {130, 1},
-		212: {130, 2},
-		213: {123, 4},
-		214: {123, 3},
-		215: {110, 3},
-		216: {110, 4},
-		217: {110, 5},
-		218: {110, 4},
-		219: {110, 5},
-		220: {111, 3},
-		221: {111, 2},
-		222: {111, 2},
-		223: {111, 2},
-		224: {111, 3},
+	yyReductions = map[int]struct{xsym, components int}{
+		0: {0, 1},
+		1: {193, 1},
+		2: {193, 2},
+		3: {161, 1},
+		4: {161, 1},
+		5: {161, 1},
+		6: {161, 1},
+		7: {161, 1},
+		8: {161, 1},
+		9: {161, 1},
+		10: {161, 1},
+		11: {161, 1},
+		12: {161, 1},
+		13: {164, 4},
+		14: {164, 6},
+		15: {121, 2},
+		16: {121, 5},
+		17: {181, 1},
+		18: {181, 2},
+		19: {154, 4},
+		20: {154, 5},
+		21: {118, 1},
+		22: {118, 3},
+		23: {118, 1},
+		24: {118, 1},
+		25: {118, 1},
+		26: {118, 1},
+		27: {118, 1},
+		28: {118, 1},
+		29: {118, 1},
+		30: {118, 1},
+		31: {118, 1},
+		32: {118, 1},
+		33: {118, 1},
+		34: {118, 1},
+		35: {118, 3},
+		36: {118, 4},
+		37: {119, 1},
+		38: {119, 2},
+		39: {119, 2},
+		40: {119, 2},
+		41: {120, 1},
+		42: {120, 3},
+		43: {120, 3},
+		44: {120, 3},
+		45: {123, 1},
+		46: {123, 3},
+		47: {123, 3},
+		48: {133, 1},
+		49: {133, 3},
+		50: {133, 3},
+		51: {133, 3},
+		52: {135, 1},
+		53: {135, 3},
+		54: {135, 3},
+		55: {135, 3},
+		56: {135, 3},
+		57: {135, 3},
+		58: {135, 3},
+		59: {136, 1},
+		60: {136, 3},
+		61: {137, 1},
+		62: {137, 3},
+		63: {139, 1},
+		64: {139, 3},
+		65: {140, 1},
+		66: {140, 3},
+		67: {144, 1},
+		68: {144, 3},
+		69: {158, 6},
+		70: {158, 7},
+		71: {159, 1},
+		72: {159, 3},
+		73: {160, 1},
+		74: {160, 1},
+		75: {180, 6},
+		76: {180, 7},
+		77: {173, 4},
+		78: {176, 4},
+		79: {176, 5},
+		80: {167, 6},
+		81: {167, 7},
+		82: {189, 2},
+		83: {189, 3},
+		84: {168, 2},
+		85: {168, 3},
+		86: {168, 3},
+		87: {191, 3},
+		88: {191, 4},
+		89: {183, 2},
+		90: {183, 3},
+		91: {183, 2},
+		92: {183, 4},
+		93: {183, 3},
+		94: {183, 5},
+		95: {170, 3},
+		96: {165, 3},
+		97: {163, 2},
+		98: {163, 5},
+		99: {70, 2},
+		100: {70, 3},
+		101: {184, 2},
+		102: {184, 3},
+		103: {145, 2},
+		104: {145, 3},
+		105: {162, 3},
+		106: {162, 4},
+		107: {172, 1},
+		108: {171, 1},
+		109: {171, 3},
+		110: {147, 2},
+		111: {147, 3},
+		112: {221, 1},
+		113: {221, 3},
+		114: {141, 1},
+		115: {142, 1},
+		116: {142, 3},
+		117: {182, 1},
+		118: {182, 3},
+		119: {182, 2},
+		120: {182, 4},
+		121: {179, 3},
+		122: {179, 2},
+		123: {124, 3},
+		124: {124, 2},
+		125: {124, 2},
+		126: {124, 3},
+		127: {124, 5},
+		128: {124, 1},
+		129: {124, 1},
+		130: {124, 2},
+		131: {124, 2},
+		132: {124, 3},
+		133: {124, 3},
+		134: {49, 1},
+		135: {49, 1},
+		136: {49, 1},
+		137: {49, 1},
+		138: {49, 1},
+		139: {49, 1},
+		140: {49, 1},
+		141: {49, 1},
+		142: {49, 1},
+		143: {49, 1},
+		144: {49, 1},
+		145: {49, 1},
+		146: {49, 1},
+		147: {155, 0},
+		148: {155, 3},
+		149: {155, 5},
+		150: {152, 1},
+		151: {152, 3},
+		152: {152, 3},
+		153: {64, 3},
+		154: {64, 4},
+		155: {222, 2},
+		156: {222, 3},
+		157: {69, 5},
+		158: {69, 4},
+		159: {69, 5},
+		160: {69, 4},
+		161: {68, 6},
+		162: {68, 5},
+		163: {68, 6},
+		164: {68, 5},
+		165: {68, 3},
+		166: {169, 3},
+		167: {169, 5},
+		168: {71, 8},
+		169: {71, 9},
+		170: {71, 7},
+		171: {71, 8},
+		172: {71, 9},
+		173: {71, 7},
+		174: {185, 1},
+		175: {185, 1},
+		176: {185, 3},
+		177: {150, 6},
+		178: {150, 4},
+		179: {150, 4},
+		180: {150, 6},
+		181: {186, 2},
+		182: {186, 3},
+		183: {187, 0},
+		184: {187, 1},
+		185: {223, 1},
+		186: {223, 2},
+		187: {73, 1},
+		188: {73, 2},
+		189: {73, 4},
+		190: {73, 1},
+		191: {73, 1},
+		192: {73, 1},
+		193: {73, 1},
+		194: {73, 1},
+		195: {73, 1},
+		196: {73, 1},
+		197: {73, 1},
+		198: {73, 1},
+		199: {73, 1},
+		200: {73, 1},
+		201: {73, 1},
+		202: {73, 1},
+		203: {73, 3},
+		204: {73, 1},
+		205: {73, 1},
+		206: {73, 1},
+		207: {146, 1},
+		208: {146, 1},
+		209: {72, 1},
+		210: {72, 4},
+		211: {72, 4},
+		212: {72, 5},
+		213: {72, 5},
+		214: {72, 6},
+		215: {72, 7},
+		216: {72, 8},
+		217: {72, 3},
+		218: {72, 4},
+		219: {72, 3},
+		220: {72, 4},
+		221: {72, 5},
+		222: {72, 5},
+		223: {72, 2},
+		224: {72, 2},
+		225: {72, 3},
+		226: {151, 1},
+		227: {151, 3},
+		228: {74, 1},
+		229: {74, 2},
+		230: {74, 2},
+		231: {74, 2},
+		232: {75, 1},
+		233: {75, 1},
+		234: {75, 1},
+		235: {75, 1},
+		236: {75, 1},
+		237: {84, 1},
+		238: {84, 3},
+		239: {84, 3},
+		240: {84, 3},
+		241: {89, 1},
+		242: {89, 3},
+		243: {89, 3},
+		244: {90, 1},
+		245: {90, 3},
+		246: {90, 3},
+		247: {90, 3},
+		248: {92, 1},
+		249: {92, 3},
+		250: {92, 3},
+		251: {92, 3},
+		252: {92, 3},
+		253: {92, 3},
+		254: {92, 3},
+		255: {93, 1},
+		256: {93, 3},
+		257: {103, 1},
+		258: {103, 3},
+		259: {104, 1},
+		260: {104, 3},
+		261: {105, 1},
+		262: {105, 3},
+		263: {107, 1},
+		264: {107, 3},
+		265: {106, 1},
+		266: {106, 5},
+		267: {109, 1},
+		268: {109, 4},
+		269: {109, 5},
+		270: {109, 6},
+		271: {108, 1},
+		272: {108, 3},
+		273: {153, 1},
+		274: {153, 1},
+		275: {153, 1},
+		276: {153, 1},
+		277: {153, 1},
+		278: {153, 1},
+		279: {153, 1},
+		280: {153, 1},
+		281: {153, 1},
+		282: {153, 1},
+		283: {153, 1},
+		284: {153, 1},
+		285: {116, 1},
+		286: {116, 3},
+		287: {156, 1},
+		288: {125, 4},
+		289: {125, 6},
+		290: {125, 1},
+		291: {166, 1},
+		292: {132, 1},
+		293: {132, 1},
+		294: {132, 1},
+		295: {132, 1},
+		296: {132, 1},
+		297: {132, 1},
+		298: {132, 1},
+		299: {130, 3},
+		300: {117, 3},
+		301: {117, 4},
+		302: {138, 1},
+		303: {138, 2},
+		304: {126, 1},
+		305: {126, 1},
+		306: {122, 1},
+		307: {122, 2},
+		308: {131, 8},
+		309: {131, 7},
+		310: {131, 6},
+		311: {131, 7},
+		312: {131, 6},
+		313: {131, 7},
+		314: {131, 3},
+		315: {131, 6},
+		316: {131, 5},
+		317: {131, 12},
+		318: {131, 10},
+		319: {175, 0},
+		320: {175, 2},
+		321: {174, 4},
+		322: {174, 3},
+		323: {174, 3},
+		324: {174, 2},
+		325: {192, 1},
+		326: {192, 3},
+		327: {178, 0},
+		328: {178, 2},
+		329: {177, 4},
+		330: {177, 3},
+		331: {177, 3},
+		332: {177, 2},
+		333: {194, 1},
+		334: {194, 3},
+		335: {149, 6},
+		336: {149, 5},
+		337: {157, 1},
+		338: {157, 2},
+		339: {148, 4},
+		340: {148, 3},
+		341: {128, 3},
+		342: {128, 4},
+		343: {128, 5},
+		344: {128, 4},
+		345: {128, 5},
+		346: {128, 4},
+		347: {128, 6},
+		348: {128, 8},
+		349: {129, 3},
+		350: {129, 2},
+		351: {129, 3},
+		352: {129, 2},
+		353: {129, 3},
+		354: {129, 2},
+		355: {129, 3},
+		356: {127, 3},
 	}
 
-	yyXErrors = map[yyXError]string{}
+	yyXErrors = map[yyXError]string{
+	}
 
-	yyParseTab = [406][]uint16{
+	yyParseTab = [684][]uint16{
 		// 0
-		{62: 233, 102: 237, 105: 236, 235, 234, 131: 227, 230, 238, 135: 229, 231, 138: 228, 141: 232, 152: 226},
-		{62: 233, 102: 237, 105: 236, 235, 234, 225, 131: 630, 230, 238, 135: 229, 231, 138: 228, 141: 232},
-		{62: 224, 102: 224, 105: 224, 224, 224, 224},
-		{62: 222, 102: 222, 105: 222, 222, 222, 222},
-		{62: 221, 102: 221, 105: 221, 221, 221, 221},
+		{26: 377, 65: 371, 370, 110: 374, 372, 376, 375, 373, 121: 369, 158: 366, 161: 359, 362, 378, 361, 363, 167: 367, 170: 360, 173: 364, 176: 365, 180: 368, 193: 358},
+		{26: 377, 65: 371, 370, 110: 374, 372, 376, 375, 373, 357, 121: 369, 158: 366, 161: 1040, 362, 378, 361, 363, 167: 367, 170: 360, 173: 364, 176: 365, 180: 368},
+		{26: 356, 65: 356, 356, 110: 356, 356, 356, 356, 356, 356},
+		{26: 354, 65: 354, 354, 110: 354, 354, 354, 354, 354, 354},
+		{26: 353, 65: 353, 353, 110: 353, 353, 353, 353, 353, 353},
 		// 5
-		{62: 220, 102: 220, 105: 220, 220, 220, 220},
-		{62: 219, 102: 219, 105: 219, 219, 219, 219},
-		{62: 218, 102: 218, 105: 218, 218, 218, 218},
-		{2: 422, 11: 421, 117: 624, 420},
-		{11: 611},
+		{26: 352, 65: 352, 352, 110: 352, 352, 352, 352, 352, 352},
+		{26: 351, 65: 351, 351, 110: 351, 351, 351, 351, 351, 351},
+		{26: 350, 65: 350, 350, 110: 350, 350, 350, 350, 350, 350},
+		{26: 349, 65: 349, 349, 110: 349, 349, 349, 349, 349, 349},
+		{26: 348, 65: 348, 348, 110: 348, 348, 348, 348, 348, 348},
 		// 10
-		{11: 609},
-		{28: 607},
-		{2: 603, 11: 602},
-		{2: 239, 134: 240},
-		{2: 422, 11: 421, 13: 593, 117: 597, 420, 121: 596, 139: 595, 594},
+		{26: 347, 65: 347, 347, 110: 347, 347, 347, 347, 347, 347},
+		{26: 346, 65: 346, 346, 110: 346, 346, 346, 346, 346, 346},
+		{26: 345, 65: 345, 345, 110: 345, 345, 345, 345, 345, 345},
+		{3: 778, 6: 777, 141: 1034, 776},
+		{3: 1020, 6: 1021, 154: 1019},
 		// 15
-		{2: 239, 10: 243, 104: 241, 134: 242},
-		{62: 204, 102: 204, 105: 204, 204, 204, 204},
-		{10: 243, 104: 592},
-		{315, 282, 273, 283, 284, 285, 257, 280, 279, 310, 243, 259, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 62: 301, 275, 274, 277, 276, 287, 281, 81: 288, 289, 290, 316, 321, 308, 320, 309, 318, 319, 322, 317, 291, 292, 293, 294, 295, 297, 296, 299, 298, 103: 300, 303, 109: 304, 306, 307, 302, 305, 314, 313, 312, 122: 311},
-		{178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 12: 178, 178, 41: 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 58: 178, 60: 178, 178, 69: 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178, 178},
+		{6: 1011},
+		{6: 999},
+		{6: 956},
+		{6: 954},
+		{30: 952},
 		// 20
-		{177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 12: 177, 177, 41: 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 58: 177, 60: 177, 177, 69: 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177, 177},
-		{176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 12: 176, 176, 41: 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 58: 176, 60: 176, 176, 69: 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176, 176},
-		{175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 12: 175, 175, 41: 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 58: 175, 60: 175, 175, 69: 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175, 175},
-		{174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 12: 174, 174, 41: 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 58: 174, 60: 174, 174, 69: 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174, 174},
-		{173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 12: 173, 173, 41: 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 58: 173, 60: 173, 173, 69: 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173, 173},
+		{3: 948, 6: 947},
+		{3: 379, 145: 380},
+		{3: 778, 6: 777, 25: 936, 141: 940, 776, 147: 939, 171: 938, 937},
+		{3: 379, 24: 383, 117: 381, 145: 382},
+		{26: 252, 65: 252, 252, 110: 252, 252, 252, 252, 252, 252},
 		// 25
-		{172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 12: 172, 172, 41: 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 58: 172, 60: 172, 172, 69: 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172, 172},
-		{171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 12: 171, 171, 41: 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 58: 171, 60: 171, 171, 69: 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171, 171},
-		{170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 12: 170, 170, 41: 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 58: 170, 60: 170, 170, 69: 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170},
-		{169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 12: 169, 169, 41: 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 58: 169, 60: 169, 169, 69: 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169},
-		{168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 12: 168, 168, 41: 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 58: 168, 60: 168, 168, 69: 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168},
+		{24: 383, 117: 935},
+		{460, 429, 430, 417, 427, 428, 401, 398, 455, 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 383, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 371, 446, 68: 419, 418, 402, 420, 422, 421, 432, 426, 84: 433, 89: 434, 435, 461, 436, 437, 466, 465, 468, 463, 464, 467, 462, 103: 438, 439, 440, 442, 441, 444, 443, 116: 445, 449, 121: 447, 450, 125: 458, 457, 454, 452, 453, 448, 451, 459, 138: 456},
+		{3: 379, 145: 933},
+		{223, 223, 223, 223, 223, 223, 7: 223, 223, 223, 223, 24: 223, 223, 27: 223, 223, 43: 223, 223, 47: 223, 223, 50: 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 223, 67: 223, 76: 223, 223, 223, 223, 223, 223, 223, 223, 85: 223, 223, 223, 223},
+		{222, 222, 222, 222, 222, 222, 7: 222, 222, 222, 222, 24: 222, 222, 27: 222, 222, 43: 222, 222, 47: 222, 222, 50: 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 222, 67: 222, 76: 222, 222, 222, 222, 222, 222, 222, 222, 85: 222, 222, 222, 222},
 		// 30
-		{167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 12: 167, 167, 41: 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 58: 167, 60: 167, 167, 69: 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167},
-		{166, 166, 166, 166, 166, 166, 166, 166, 166, 166, 166, 12: 166, 166, 41: 166, 166, 166, 166, 166, 166, 166, 166, 166, 166, 166, 166, 166, 166, 166, 166, 58: 166, 60: 166, 166, 69: 166, 166, 166, 166, 166, 166, 166, 166, 166, 166, 166, 166},
-		{27: 446, 42: 579},
-		{6: 438, 11: 563, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 57: 564},
-		{133, 133, 133, 133, 133, 133, 133, 133, 133, 10: 329, 12: 133, 41: 561, 43: 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 58: 133, 60: 133, 133, 69: 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133},
+		{221, 221, 221, 221, 221, 221, 7: 221, 221, 221, 221, 24: 221, 221, 27: 221, 221, 43: 221, 221, 47: 221, 221, 50: 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 221, 67: 221, 76: 221, 221, 221, 221, 221, 221, 221, 221, 85: 221, 221, 221, 221},
+		{220, 220, 220, 220, 220, 220, 7: 220, 220, 220, 220, 24: 220, 220, 27: 220, 220, 43: 220, 220, 47: 220, 220, 50: 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 220, 67: 220, 76: 220, 220, 220, 220, 220, 220, 220, 220, 85: 220, 220, 220, 220},
+		{219, 219, 219, 219, 219, 219, 7: 219, 219, 219, 219, 24: 219, 219, 27: 219, 219, 43: 219, 219, 47: 219, 219, 50: 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 219, 67: 219, 76: 219, 219, 219, 219, 219, 219, 219, 219, 85: 219, 219, 219, 219},
+		{218, 218, 218, 218, 218, 218, 7: 218, 218, 218, 218, 24: 218, 218, 27: 218, 218, 43: 218, 218, 47: 218, 218, 50: 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 218, 67: 218, 76: 218, 218, 218, 218, 218, 218, 218, 218, 85: 218, 218, 218, 218},
+		{217, 217, 217, 217, 217, 217, 7: 217, 217, 217, 217, 24: 217, 217, 27: 217, 217, 43: 217, 217, 47: 217, 217, 50: 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 217, 67: 217, 76: 217, 217, 217, 217, 217, 217, 217, 217, 85: 217, 217, 217, 217},
 		// 35
-		{10: 536},
-		{131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 12: 131, 131, 41: 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 58: 131, 60: 131, 131, 69: 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131, 131},
-		{130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 12: 130, 130, 41: 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 58: 130, 60: 130, 130, 69: 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130, 130},
-		{129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 12: 129, 129, 41: 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 58: 129, 60: 129, 129, 69: 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129},
-		{128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 12: 128, 128, 41: 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 58: 128, 60: 128, 128, 69: 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128},
+		{216, 216, 216, 216, 216, 216, 7: 216, 216, 216, 216, 24: 216, 216, 27: 216, 216, 43: 216, 216, 47: 216, 216, 50: 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 216, 67: 216, 76: 216, 216, 216, 216, 216, 216, 216, 216, 85: 216, 216, 216, 216},
+		{215, 215, 215, 215, 215, 215, 7: 215, 215, 215, 215, 24: 215, 215, 27: 215, 215, 43: 215, 215, 47: 215, 215, 50: 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 215, 67: 215, 76: 215, 215, 215, 215, 215, 215, 215, 215, 85: 215, 215, 215, 215},
+		{214, 214, 214, 214, 214, 214, 7: 214, 214, 214, 214, 24: 214, 214, 27: 214, 214, 43: 214, 214, 47: 214, 214, 50: 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 214, 67: 214, 76: 214, 214, 214, 214, 214, 214, 214, 214, 85: 214, 214, 214, 214},
+		{213, 213, 213, 213, 213, 213, 7: 213, 213, 213, 213, 24: 213, 213, 27: 213, 213, 43: 213, 213, 47: 213, 213, 50: 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 213, 67: 213, 76: 213, 213, 213, 213, 213, 213, 213, 213, 85: 213, 213, 213, 213},
+		{212, 212, 212, 212, 212, 212, 7: 212, 212, 212, 212, 24: 212, 212, 27: 212, 212, 43: 212, 212, 47: 212, 212, 50: 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 212, 67: 212, 76: 212, 212, 212, 212, 212, 212, 212, 212, 85: 212, 212, 212, 212},
 		// 40
-		{127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 12: 127, 127, 41: 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 58: 127, 60: 127, 127, 69: 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127},
-		{126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 12: 126, 126, 41: 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 58: 126, 60: 126, 126, 69: 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126},
-		{125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 12: 125, 125, 41: 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 58: 125, 60: 125, 125, 69: 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125},
-		{124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 12: 124, 124, 41: 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 58: 124, 60: 124, 124, 69: 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124},
-		{123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 12: 123, 123, 41: 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 58: 123, 60: 123, 123, 69: 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123},
+		{211, 211, 211, 211, 211, 211, 7: 211, 211, 211, 211, 24: 211, 211, 27: 211, 211, 43: 211, 211, 47: 211, 211, 50: 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 211, 67: 211, 76: 211, 211, 211, 211, 211, 211, 211, 211, 85: 211, 211, 211, 211},
+		{1: 509, 510, 506, 6: 493, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 28: 920, 498, 494, 495, 496, 505, 504, 499, 497, 500, 502, 503, 501, 42: 511, 49: 507, 118: 508, 512, 513, 123: 514, 133: 515, 135: 516, 517, 518, 139: 519, 520, 144: 579},
+		{6: 904, 492, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 49: 905},
+		{7: 881},
+		{170, 170, 170, 170, 170, 170, 7: 170, 9: 170, 170, 24: 606, 27: 170, 43: 170, 170, 47: 170, 170, 50: 879, 170, 170, 170, 170, 170, 170, 170, 170, 170, 61: 170, 170, 170, 67: 170, 76: 170, 170, 170, 170, 170, 170, 170, 170, 85: 170, 170, 170, 170},
 		// 45
-		{122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 12: 122, 122, 41: 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 58: 122, 60: 122, 122, 69: 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122},
-		{121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 12: 121, 121, 41: 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 58: 121, 60: 121, 121, 69: 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121},
-		{120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 12: 120, 120, 41: 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 58: 120, 60: 120, 120, 69: 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120},
-		{1: 282, 273, 283, 284, 285, 257, 280, 279, 11: 323, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 63: 275, 274, 277, 276, 287, 281, 81: 288, 289, 290, 93: 291, 292, 293, 294, 295, 297, 296, 299, 298, 103: 534},
-		{118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 12: 118, 118, 41: 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 58: 118, 60: 118, 118, 69: 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118},
+		{24: 874, 184: 875},
+		{24: 849},
+		{167, 167, 167, 167, 167, 167, 7: 167, 167, 167, 167, 24: 167, 167, 27: 167, 167, 43: 167, 167, 47: 167, 167, 50: 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 167, 67: 167, 76: 167, 167, 167, 167, 167, 167, 167, 167, 85: 167, 167, 167, 167},
+		{166, 166, 166, 166, 166, 166, 7: 166, 166, 166, 166, 24: 166, 166, 27: 166, 166, 43: 166, 166, 47: 166, 166, 50: 166, 166, 166, 166, 166, 166, 166, 166, 166, 166, 166, 166, 166, 166, 67: 166, 76: 166, 166, 166, 166, 166, 166, 166, 166, 85: 166, 166, 166, 166},
+		{165, 165, 165, 165, 165, 165, 7: 165, 165, 165, 165, 24: 165, 165, 27: 165, 165, 43: 165, 165, 47: 165, 165, 50: 165, 165, 165, 165, 165, 165, 165, 165, 165, 165, 165, 165, 165, 165, 67: 165, 76: 165, 165, 165, 165, 165, 165, 165, 165, 85: 165, 165, 165, 165},
 		// 50
-		{117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 12: 117, 117, 41: 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 58: 117, 60: 117, 117, 69: 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117},
-		{114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 12: 114, 114, 41: 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 58: 114, 60: 114, 114, 69: 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114},
-		{104, 104, 344, 104, 104, 104, 343, 346, 345, 104, 104, 12: 104, 104, 41: 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 58: 104, 60: 104, 104, 69: 104, 104, 104, 104, 104, 104, 104, 104, 529, 104, 104, 104},
-		{77: 525},
-		{1: 282, 273, 283, 284, 285, 257, 280, 279, 11: 337, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 63: 275, 274, 338, 276, 524, 339},
+		{164, 164, 164, 164, 164, 164, 7: 164, 164, 164, 164, 24: 164, 164, 27: 164, 164, 43: 164, 164, 47: 164, 164, 50: 164, 164, 164, 164, 164, 164, 164, 164, 164, 164, 164, 164, 164, 164, 67: 164, 76: 164, 164, 164, 164, 164, 164, 164, 164, 85: 164, 164, 164, 164},
+		{163, 163, 163, 163, 163, 163, 7: 163, 163, 163, 163, 24: 163, 163, 27: 163, 163, 43: 163, 163, 47: 163, 163, 50: 163, 163, 163, 163, 163, 163, 163, 163, 163, 163, 163, 163, 163, 163, 67: 163, 76: 163, 163, 163, 163, 163, 163, 163, 163, 85: 163, 163, 163, 163},
+		{162, 162, 162, 162, 162, 162, 7: 162, 162, 162, 162, 24: 162, 162, 27: 162, 162, 43: 162, 162, 47: 162, 162, 50: 162, 162, 162, 162, 162, 162, 162, 162, 162, 162, 162, 162, 162, 162, 67: 162, 76: 162, 162, 162, 162, 162, 162, 162, 162, 85: 162, 162, 162, 162},
+		{161, 161, 161, 161, 161, 161, 7: 161, 161, 161, 161, 24: 161, 161, 27: 161, 161, 43: 161, 161, 47: 161, 161, 50: 161, 161, 161, 161, 161, 161, 161, 161, 161, 161, 161, 161, 161, 161, 67: 161, 76: 161, 161, 161, 161, 161, 161, 161, 161, 85: 161, 161, 161, 161},
+		{160, 160, 160, 160, 160, 160, 7: 160, 160, 160, 160, 24: 160, 160, 27: 160, 160, 43: 160, 160, 47: 160, 160, 50: 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 160, 67: 160, 76: 160, 160, 160, 160, 160, 160, 160, 160, 85: 160, 160, 160, 160},
 		// 55
-		{1: 282, 273, 283, 284, 285, 257, 280, 279, 11: 337, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 63: 275, 274, 338, 276, 523, 339},
-		{1: 282, 273, 283, 284, 285, 257, 280, 279, 11: 519, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 63: 275, 274, 338, 276, 342, 339},
-		{1: 100, 100, 100, 100, 100, 100, 100, 100, 11: 100, 14: 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100, 100},
-		{1: 99, 99, 99, 99, 99, 99, 99, 99, 11: 99, 14: 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99, 99},
-		{1: 98, 98, 98, 98, 98, 98, 98, 98, 11: 98, 14: 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98, 98},
+		{159, 159, 159, 159, 159, 159, 7: 159, 159, 159, 159, 24: 159, 159, 27: 159, 159, 43: 159, 159, 47: 159, 159, 50: 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 67: 159, 76: 159, 159, 159, 159, 159, 159, 159, 159, 85: 159, 159, 159, 159},
+		{158, 158, 158, 158, 158, 158, 7: 158, 158, 158, 158, 24: 158, 158, 27: 158, 158, 43: 158, 158, 47: 158, 158, 50: 158, 158, 158, 158, 158, 158, 158, 158, 158, 158, 158, 158, 158, 158, 67: 158, 76: 158, 158, 158, 158, 158, 158, 158, 158, 85: 158, 158, 158, 158},
+		{157, 157, 157, 157, 157, 157, 7: 157, 157, 157, 157, 24: 157, 157, 27: 157, 157, 43: 157, 157, 47: 157, 157, 50: 157, 157, 157, 157, 157, 157, 157, 157, 157, 157, 157, 157, 157, 157, 67: 157, 76: 157, 157, 157, 157, 157, 157, 157, 157, 85: 157, 157, 157, 157},
+		{156, 156, 156, 156, 156, 156, 7: 156, 156, 156, 156, 24: 156, 156, 27: 156, 156, 43: 156, 156, 47: 156, 156, 50: 156, 156, 156, 156, 156, 156, 156, 156, 156, 156, 156, 156, 156, 156, 67: 156, 76: 156, 156, 156, 156, 156, 156, 156, 156, 85: 156, 156, 156, 156},
+		{155, 155, 155, 155, 155, 155, 7: 155, 155, 155, 155, 24: 155, 155, 27: 155, 155, 43: 155, 155, 47: 155, 155, 50: 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 67: 155, 76: 155, 155, 155, 155, 155, 155, 155, 155, 85: 155, 155, 155, 155},
 		// 60
-		{1: 97, 97, 97, 97, 97, 97, 97, 97, 11: 97, 14: 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97, 97},
-		{1: 96, 96, 96, 96, 96, 96, 96, 96, 11: 96, 14: 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96, 96},
-		{95, 95, 3: 95, 95, 95, 9: 95, 95, 12: 95, 95, 41: 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 58: 506, 60: 95, 95, 69: 511, 515, 507, 509, 513, 510, 508, 517, 78: 514, 512, 516, 144: 505},
-		{91, 91, 3: 491, 91, 91, 9: 91, 91, 12: 91, 91, 41: 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 91, 60: 492, 493},
-		{88, 88, 4: 488, 489, 9: 88, 88, 12: 88, 88, 41: 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88, 88},
+		{1: 429, 430, 417, 427, 428, 597, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 422, 421, 432, 426, 84: 433, 89: 434, 435, 92: 436, 437, 103: 438, 439, 440, 442, 441, 444, 443, 116: 847},
+		{153, 153, 153, 153, 153, 153, 7: 153, 153, 153, 153, 24: 153, 153, 27: 153, 153, 43: 153, 153, 47: 153, 153, 50: 153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 67: 153, 76: 153, 153, 153, 153, 153, 153, 153, 153, 85: 153, 153, 153, 153},
+		{152, 152, 152, 152, 152, 152, 7: 152, 152, 152, 152, 24: 152, 152, 27: 152, 152, 43: 152, 152, 47: 152, 152, 50: 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 67: 152, 76: 152, 152, 152, 152, 152, 152, 152, 152, 85: 152, 152, 152, 152},
+		{151, 151, 151, 151, 151, 151, 7: 151, 151, 151, 151, 24: 151, 151, 27: 151, 151, 43: 151, 151, 47: 151, 151, 50: 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 67: 151, 76: 151, 151, 151, 151, 151, 151, 151, 151, 85: 151, 151, 151, 151},
+		{148, 148, 148, 148, 148, 148, 7: 148, 148, 148, 148, 24: 148, 148, 27: 148, 148, 43: 148, 148, 47: 148, 148, 50: 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 67: 148, 76: 148, 148, 148, 148, 148, 148, 148, 148, 85: 148, 148, 148, 148},
 		// 65
-		{84, 84, 9: 84, 84, 12: 84, 84, 41: 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 486, 484, 485},
-		{77, 77, 9: 77, 77, 12: 77, 77, 41: 77, 77, 77, 77, 77, 77, 77, 477, 480, 482, 479, 481, 478},
-		{75, 475, 9: 75, 75, 12: 75, 75, 41: 75, 75, 75, 75, 75, 75, 75},
-		{73, 9: 73, 73, 12: 73, 73, 41: 73, 73, 73, 73, 73, 73, 473},
-		{71, 9: 71, 71, 12: 71, 71, 41: 71, 71, 71, 71, 71, 471},
+		{129, 129, 129, 472, 129, 129, 7: 471, 129, 475, 474, 24: 129, 129, 27: 129, 129, 43: 129, 129, 47: 129, 129, 50: 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 67: 129, 76: 842, 129, 129, 129, 129, 129, 129, 129, 85: 129, 129, 129, 129},
+		{3: 838, 76: 837},
+		{1: 429, 430, 417, 427, 428, 469, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 614, 421, 836, 615},
+		{1: 429, 430, 417, 427, 428, 469, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 614, 421, 835, 615},
+		{1: 429, 430, 417, 427, 428, 831, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 614, 421, 618, 615},
 		// 70
-		{69, 9: 69, 69, 12: 69, 69, 41: 69, 69, 69, 69, 469},
-		{67, 9: 67, 67, 12: 67, 67, 41: 67, 67, 464, 463},
-		{65, 9: 65, 65, 12: 65, 65, 41: 65, 65},
-		{61, 9: 61, 61, 12: 61, 61, 41: 61, 61},
-		{47, 10: 47, 12: 47, 47, 41: 47, 47},
+		{1: 125, 125, 125, 125, 125, 125, 125, 9: 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 26: 125, 29: 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 125, 45: 125, 125},
+		{1: 124, 124, 124, 124, 124, 124, 124, 9: 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 26: 124, 29: 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 124, 45: 124, 124},
+		{1: 123, 123, 123, 123, 123, 123, 123, 9: 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 26: 123, 29: 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 123, 45: 123, 123},
+		{1: 122, 122, 122, 122, 122, 122, 122, 9: 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 26: 122, 29: 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 122, 45: 122, 122},
+		{1: 121, 121, 121, 121, 121, 121, 121, 9: 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 26: 121, 29: 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 121, 45: 121, 121},
 		// 75
-		{374, 12: 326},
-		{2: 422, 11: 421, 117: 423, 420},
-		{41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 14: 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 62: 41, 84: 41, 41, 41, 41, 41, 41, 41, 41, 41},
-		{40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 14: 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 62: 40, 84: 40, 40, 40, 40, 40, 40, 40, 40, 40},
-		{39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 14: 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 62: 39, 84: 39, 39, 39, 39, 39, 39, 39, 39, 39},
+		{120, 120, 120, 4: 120, 120, 8: 120, 24: 120, 120, 27: 120, 120, 43: 120, 120, 47: 120, 120, 50: 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 67: 670, 77: 671, 675, 679, 673, 677, 674, 672, 85: 681, 678, 676, 680, 153: 830},
+		{116, 116, 116, 4: 116, 816, 8: 116, 24: 116, 116, 27: 116, 116, 43: 116, 116, 47: 116, 116, 50: 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 817, 818, 116},
+		{113, 813, 814, 4: 113, 8: 113, 24: 113, 113, 27: 113, 113, 43: 113, 113, 47: 113, 113, 50: 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 63: 113},
+		{109, 4: 109, 8: 109, 24: 109, 109, 27: 109, 109, 43: 109, 109, 47: 109, 109, 50: 109, 109, 109, 109, 109, 109, 109, 811, 809, 810, 109, 63: 109},
+		{102, 4: 102, 8: 102, 24: 102, 102, 27: 102, 102, 43: 102, 102, 47: 102, 102, 50: 102, 802, 805, 807, 804, 806, 803, 60: 102, 63: 102},
 		// 80
-		{38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 14: 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 38, 62: 38, 84: 38, 38, 38, 38, 38, 38, 38, 38, 38},
-		{37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 14: 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 37, 62: 37, 84: 37, 37, 37, 37, 37, 37, 37, 37, 37},
-		{36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 14: 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 36, 62: 36, 84: 36, 36, 36, 36, 36, 36, 36, 36, 36},
-		{1: 282, 273, 283, 284, 285, 257, 280, 279, 11: 337, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 63: 275, 274, 338, 276, 340, 339, 81: 288, 289, 290, 93: 291, 292, 293, 294, 295, 341, 296, 128: 417},
-		{41: 415},
+		{100, 4: 800, 8: 100, 24: 100, 100, 27: 100, 100, 43: 100, 100, 47: 100, 100, 50: 100, 60: 100, 63: 100},
+		{98, 8: 98, 24: 98, 98, 27: 98, 98, 43: 98, 98, 47: 98, 798, 50: 98, 60: 98, 63: 98},
+		{96, 8: 96, 24: 96, 96, 27: 96, 96, 43: 96, 96, 47: 796, 50: 96, 60: 96, 63: 96},
+		{94, 8: 94, 24: 94, 94, 27: 94, 94, 43: 94, 794, 50: 94, 60: 94, 63: 94},
+		{92, 8: 92, 24: 92, 92, 27: 92, 92, 43: 788, 50: 92, 60: 92, 63: 789},
 		// 85
-		{407},
-		{315, 282, 273, 283, 284, 285, 257, 280, 279, 414, 243, 259, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 62: 301, 275, 274, 277, 276, 287, 281, 81: 288, 289, 290, 316, 321, 308, 320, 309, 318, 319, 322, 317, 291, 292, 293, 294, 295, 297, 296, 299, 298, 103: 300, 303, 109: 304, 306, 307, 302, 305, 314, 313, 386},
-		{30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 14: 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 30, 62: 30, 84: 30, 30, 30, 30, 30, 30, 30, 30, 30},
-		{28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 14: 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 28, 62: 28, 84: 28, 28, 28, 28, 28, 28, 28, 28, 28},
-		{27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 14: 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 27, 62: 27, 84: 27, 27, 27, 27, 27, 27, 27, 27, 27},
+		{90, 8: 90, 24: 90, 90, 27: 90, 90, 50: 90, 60: 90},
+		{86, 8: 86, 24: 86, 86, 27: 86, 86, 50: 86, 60: 86},
+		{72, 24: 72, 72, 27: 72, 72, 50: 72},
+		{666, 27: 627},
+		{3: 778, 6: 777, 141: 779, 776},
 		// 90
-		{26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 14: 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 26, 62: 26, 84: 26, 26, 26, 26, 26, 26, 26, 26, 26},
-		{1: 282, 273, 283, 284, 285, 257, 280, 279, 11: 337, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 63: 275, 274, 338, 276, 340, 339, 81: 288, 289, 290, 93: 291, 292, 293, 294, 295, 380, 296},
-		{2: 376},
-		{315, 282, 273, 283, 284, 285, 257, 280, 279, 11: 323, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 62: 301, 275, 274, 277, 276, 287, 281, 81: 288, 289, 290, 93: 291, 292, 293, 294, 295, 297, 296, 299, 298, 103: 363, 109: 364, 115: 365},
-		{11: 361},
+		{67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 26: 67, 29: 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 67, 45: 67, 67, 65: 67, 67, 91: 67, 94: 67, 67, 67, 67, 67, 67, 67, 67, 67},
+		{65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 26: 65, 29: 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 65, 45: 65, 65, 65: 65, 65, 91: 65, 94: 65, 65, 65, 65, 65, 65, 65, 65, 65},
+		{64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 26: 64, 29: 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 64, 45: 64, 64, 65: 64, 64, 91: 64, 94: 64, 64, 64, 64, 64, 64, 64, 64, 64},
+		{63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 26: 63, 29: 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 63, 45: 63, 63, 65: 63, 63, 91: 63, 94: 63, 63, 63, 63, 63, 63, 63, 63, 63},
+		{62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 26: 62, 29: 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 62, 45: 62, 62, 65: 62, 62, 91: 62, 94: 62, 62, 62, 62, 62, 62, 62, 62, 62},
 		// 95
-		{360},
-		{359},
-		{325, 282, 273, 283, 284, 285, 257, 280, 279, 11: 323, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 63: 275, 274, 277, 276, 287, 281, 81: 288, 289, 290, 93: 291, 292, 293, 294, 295, 297, 296, 299, 298, 103: 324},
-		{133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 329, 12: 133, 133, 41: 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 58: 133, 60: 133, 133, 69: 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133},
-		{327, 12: 326},
+		{61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 26: 61, 29: 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 61, 45: 61, 61, 65: 61, 61, 91: 61, 94: 61, 61, 61, 61, 61, 61, 61, 61, 61},
+		{60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 26: 60, 29: 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 60, 45: 60, 60, 65: 60, 60, 91: 60, 94: 60, 60, 60, 60, 60, 60, 60, 60, 60},
+		{59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 26: 59, 29: 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 45: 59, 59, 65: 59, 59, 91: 59, 94: 59, 59, 59, 59, 59, 59, 59, 59, 59},
+		{768},
+		{460, 429, 430, 417, 427, 428, 401, 398, 775, 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 383, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 371, 446, 68: 419, 418, 402, 420, 422, 421, 432, 426, 84: 433, 89: 434, 435, 461, 436, 437, 466, 465, 468, 463, 464, 467, 462, 103: 438, 439, 440, 442, 441, 444, 443, 116: 445, 449, 121: 447, 450, 125: 458, 701, 454, 452, 453, 448, 451, 459},
 		// 100
-		{2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 14: 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 62: 2, 84: 2, 2, 2, 2, 2, 2, 2, 2, 2},
-		{1: 282, 273, 283, 284, 285, 257, 280, 279, 11: 323, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 63: 275, 274, 277, 276, 287, 281, 81: 288, 289, 290, 93: 291, 292, 293, 294, 295, 297, 296, 328, 298},
-		{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 14: 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 62: 1, 84: 1, 1, 1, 1, 1, 1, 1, 1, 1},
-		{46, 10: 46, 12: 46, 46, 41: 46, 46},
-		{9: 165, 11: 330, 165, 129: 331},
+		{55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 26: 55, 29: 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 45: 55, 55, 65: 55, 55, 91: 55, 94: 55, 55, 55, 55, 55, 55, 55, 55, 55},
+		{53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 26: 53, 29: 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 45: 53, 53, 65: 53, 53, 91: 53, 94: 53, 53, 53, 53, 53, 53, 53, 53, 53},
+		{52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 26: 52, 29: 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 45: 52, 52, 65: 52, 52, 91: 52, 94: 52, 52, 52, 52, 52, 52, 52, 52, 52},
+		{51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 26: 51, 29: 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 45: 51, 51, 65: 51, 51, 91: 51, 94: 51, 51, 51, 51, 51, 51, 51, 51, 51},
+		{1: 429, 430, 417, 427, 428, 469, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 614, 421, 616, 615, 84: 433, 89: 434, 435, 92: 436, 437, 103: 438, 439, 440, 742, 441},
 		// 105
-		{41: 357},
-		{9: 333, 12: 332},
-		{11: 334},
-		{64, 9: 64, 64, 12: 64, 64, 41: 64, 64},
-		{41: 335},
+		{1: 429, 430, 417, 427, 428, 690, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 693, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 691, 421, 616, 615, 84: 433, 89: 434, 435, 92: 436, 437, 103: 438, 439, 440, 692, 441},
+		{460, 429, 430, 417, 427, 428, 597, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 371, 446, 68: 419, 418, 402, 420, 422, 421, 650, 426, 84: 433, 89: 434, 435, 92: 436, 437, 103: 438, 439, 440, 442, 441, 444, 443, 116: 651, 121: 447, 652, 125: 653, 134: 654},
+		{6: 648},
+		{645, 6: 646},
+		{642, 6: 643},
 		// 110
-		{1: 282, 273, 283, 284, 285, 257, 280, 279, 11: 337, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 63: 275, 274, 338, 276, 340, 339, 81: 288, 289, 290, 93: 291, 292, 293, 294, 295, 341, 296, 128: 336},
-		{9: 163, 12: 163},
-		{133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 12: 133, 133, 41: 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 58: 133, 60: 133, 133, 69: 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133},
-		{104, 104, 344, 104, 104, 104, 343, 346, 345, 104, 104, 12: 104, 104, 41: 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 104, 58: 104, 60: 104, 104, 69: 104, 104, 104, 104, 104, 104, 104, 104, 347, 104, 104, 104},
-		{1: 282, 273, 283, 284, 285, 257, 280, 279, 11: 337, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 63: 275, 274, 338, 276, 342, 339},
+		{640, 429, 430, 417, 427, 428, 597, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 422, 421, 432, 426, 84: 433, 89: 434, 435, 92: 436, 437, 103: 438, 439, 440, 442, 441, 444, 443, 116: 639},
+		{3: 417, 6: 469, 398, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 470, 421},
+		{170, 170, 170, 170, 170, 170, 7: 170, 170, 170, 170, 24: 170, 170, 27: 170, 170, 43: 170, 170, 47: 170, 170, 50: 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 67: 170, 76: 170, 170, 170, 170, 170, 170, 170, 170, 85: 170, 170, 170, 170},
+		{476, 3: 472, 7: 471, 9: 475, 474, 76: 473},
+		{1: 429, 430, 417, 427, 428, 597, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 622, 64: 399, 68: 419, 418, 402, 420, 422, 421, 432, 426, 84: 433, 89: 434, 435, 92: 436, 437, 103: 438, 439, 440, 442, 441, 444, 443, 116: 621},
 		// 115
-		{95, 95, 3: 95, 95, 95, 9: 95, 95, 12: 95, 95, 41: 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 95, 60: 95, 95},
-		{9: 45, 12: 45, 41: 45},
-		{101, 101, 3: 101, 101, 101, 9: 101, 101, 12: 101, 101, 41: 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 101, 58: 101, 60: 101, 101, 69: 101, 101, 101, 101, 101, 101, 101, 101, 78: 101, 101, 101},
-		{1: 282, 273, 283, 284, 285, 257, 280, 279, 11: 323, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 63: 275, 274, 277, 276, 287, 281, 81: 288, 289, 290, 93: 291, 292, 293, 294, 295, 297, 296, 299, 298, 103: 355},
-		{1: 282, 273, 283, 284, 285, 257, 280, 279, 11: 323, 13: 349, 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 63: 275, 274, 277, 276, 287, 281, 81: 288, 289, 290, 93: 291, 292, 293, 294, 295, 297, 296, 351, 298, 126: 350},
+		{1: 429, 430, 417, 427, 428, 597, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 25: 598, 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 422, 421, 432, 426, 84: 433, 89: 434, 435, 92: 436, 437, 103: 438, 439, 440, 442, 441, 600, 443, 151: 599},
+		{3: 479, 6: 478, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 49: 477, 146: 480},
+		{134, 134, 134, 134, 134, 134, 7: 134, 134, 134, 134, 24: 134, 134, 27: 134, 134, 43: 134, 134, 47: 134, 134, 50: 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 134, 67: 134, 76: 134, 134, 134, 134, 134, 134, 134, 134, 85: 134, 134, 134, 134},
+		{133, 133, 133, 133, 133, 133, 7: 133, 133, 133, 133, 24: 133, 133, 27: 133, 133, 43: 133, 133, 47: 133, 133, 50: 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 67: 133, 76: 133, 133, 133, 133, 133, 133, 133, 133, 85: 133, 133, 133, 133},
+		{1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 26: 1, 29: 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 1, 45: 1, 1, 65: 1, 1, 91: 1, 94: 1, 1, 1, 1, 1, 1, 1, 1, 1},
 		// 120
-		{109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 12: 109, 109, 41: 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 58: 109, 60: 109, 109, 69: 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109, 109},
-		{108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 12: 108, 108, 41: 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 58: 108, 60: 108, 108, 69: 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108, 108},
-		{11: 348},
-		{107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 12: 107, 107, 41: 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 58: 107, 60: 107, 107, 69: 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107},
-		{111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 12: 111, 111, 41: 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 58: 111, 60: 111, 111, 69: 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111},
+		{150, 150, 150, 150, 150, 150, 7: 150, 150, 150, 150, 24: 150, 150, 27: 150, 150, 43: 150, 150, 47: 150, 150, 50: 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 67: 150, 76: 150, 150, 150, 150, 150, 150, 150, 150, 85: 150, 150, 150, 150},
+		{149, 149, 149, 149, 149, 149, 7: 149, 149, 149, 149, 24: 149, 149, 27: 149, 149, 43: 149, 149, 47: 149, 149, 50: 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 67: 149, 76: 149, 149, 149, 149, 149, 149, 149, 149, 85: 149, 149, 149, 149},
+		{5: 482, 486, 483, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 481, 41: 484, 49: 485, 64: 487, 124: 488},
+		{132, 132, 132, 132, 132, 132, 7: 132, 132, 132, 132, 24: 132, 132, 27: 132, 132, 43: 132, 132, 47: 132, 132, 50: 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 67: 132, 76: 132, 132, 132, 132, 132, 132, 132, 132, 85: 132, 132, 132, 132},
+		{3: 584, 179: 585},
 		// 125
-		{12: 353, 352},
-		{9: 106, 12: 106, 106},
-		{110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 12: 110, 110, 41: 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 58: 110, 60: 110, 110, 69: 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110},
-		{1: 282, 273, 283, 284, 285, 257, 280, 279, 11: 323, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 63: 275, 274, 277, 276, 287, 281, 81: 288, 289, 290, 93: 291, 292, 293, 294, 295, 297, 296, 354, 298},
-		{9: 105, 12: 105, 105},
+		{5: 482, 486, 483, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 481, 41: 484, 49: 485, 64: 487, 124: 583},
+		{1: 509, 510, 506, 6: 493, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 28: 580, 498, 494, 495, 496, 505, 504, 499, 497, 500, 502, 503, 501, 42: 511, 49: 507, 118: 508, 512, 513, 123: 514, 133: 515, 135: 516, 517, 518, 139: 519, 520, 144: 579},
+		{7: 575},
+		{229, 25: 229, 27: 229, 229, 50: 229, 67: 229, 76: 573},
+		{228, 25: 228, 27: 228, 228, 50: 228, 67: 228, 76: 571},
 		// 130
-		{12: 326, 42: 356},
-		{113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 12: 113, 113, 41: 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 58: 113, 60: 113, 113, 69: 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113, 113},
-		{1: 282, 273, 283, 284, 285, 257, 280, 279, 11: 337, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 63: 275, 274, 338, 276, 340, 339, 81: 288, 289, 290, 93: 291, 292, 293, 294, 295, 341, 296, 128: 358},
-		{9: 164, 12: 164},
-		{3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 14: 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 62: 3, 84: 3, 3, 3, 3, 3, 3, 3, 3, 3},
+		{6: 491, 492, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 49: 490},
+		{25: 489},
+		{135, 135, 135, 135, 135, 135, 7: 135, 135, 135, 135, 24: 135, 135, 27: 135, 135, 43: 135, 135, 47: 135, 135, 50: 135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 135, 67: 135, 76: 135, 135, 135, 135, 135, 135, 135, 135, 85: 135, 135, 135, 135},
+		{227, 25: 227, 27: 227, 227, 50: 227, 67: 227},
+		{226, 25: 226, 27: 226, 226, 50: 226, 67: 226},
 		// 135
-		{4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 14: 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 62: 4, 84: 4, 4, 4, 4, 4, 4, 4, 4, 4},
-		{362},
-		{5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 14: 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 62: 5, 84: 5, 5, 5, 5, 5, 5, 5, 5, 5},
-		{374, 10: 243, 12: 326, 104: 375},
-		{315, 282, 273, 283, 284, 285, 257, 280, 279, 11: 323, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 63: 275, 274, 277, 276, 287, 281, 81: 288, 289, 290, 93: 291, 292, 293, 294, 295, 297, 296, 299, 298, 103: 300, 109: 370},
+		{1: 509, 510, 506, 6: 493, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 29: 498, 494, 495, 496, 505, 504, 499, 497, 500, 502, 503, 501, 42: 511, 49: 507, 118: 508, 512, 513, 123: 514, 133: 515, 135: 516, 517, 518, 139: 519, 520, 144: 521},
+		{336, 336, 336, 4: 336, 336, 25: 336, 28: 336, 43: 336, 336, 47: 336, 336, 51: 336, 336, 336, 336, 336, 336, 336, 336, 336, 61: 336, 336, 76: 569},
+		{334, 334, 334, 4: 334, 334, 25: 334, 28: 334, 43: 334, 334, 47: 334, 334, 51: 334, 334, 334, 334, 334, 334, 334, 334, 334, 61: 334, 334},
+		{333, 333, 333, 4: 333, 333, 25: 333, 28: 333, 43: 333, 333, 47: 333, 333, 51: 333, 333, 333, 333, 333, 333, 333, 333, 333, 61: 333, 333},
+		{332, 332, 332, 4: 332, 332, 25: 332, 28: 332, 43: 332, 332, 47: 332, 332, 51: 332, 332, 332, 332, 332, 332, 332, 332, 332, 61: 332, 332},
 		// 140
-		{315, 282, 273, 283, 284, 285, 257, 280, 279, 11: 323, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 63: 275, 274, 277, 276, 287, 281, 81: 288, 289, 290, 93: 291, 292, 293, 294, 295, 297, 296, 299, 298, 103: 300, 109: 366},
-		{1: 282, 273, 283, 284, 285, 257, 280, 279, 10: 243, 323, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 63: 275, 274, 277, 276, 287, 281, 81: 288, 289, 290, 93: 291, 292, 293, 294, 295, 297, 296, 299, 298, 103: 367, 368},
-		{10: 243, 12: 326, 104: 369},
-		{7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 14: 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 62: 7, 84: 7, 7, 7, 7, 7, 7, 7, 7, 7},
-		{6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 14: 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 62: 6, 84: 6, 6, 6, 6, 6, 6, 6, 6, 6},
+		{331, 331, 331, 4: 331, 331, 25: 331, 28: 331, 43: 331, 331, 47: 331, 331, 51: 331, 331, 331, 331, 331, 331, 331, 331, 331, 61: 331, 331},
+		{330, 330, 330, 4: 330, 330, 25: 330, 28: 330, 43: 330, 330, 47: 330, 330, 51: 330, 330, 330, 330, 330, 330, 330, 330, 330, 61: 330, 330},
+		{329, 329, 329, 4: 329, 329, 25: 329, 28: 329, 43: 329, 329, 47: 329, 329, 51: 329, 329, 329, 329, 329, 329, 329, 329, 329, 61: 329, 329},
+		{328, 328, 328, 4: 328, 328, 25: 328, 28: 328, 43: 328, 328, 47: 328, 328, 51: 328, 328, 328, 328, 328, 328, 328, 328, 328, 61: 328, 328},
+		{327, 327, 327, 4: 327, 327, 25: 327, 28: 327, 43: 327, 327, 47: 327, 327, 51: 327, 327, 327, 327, 327, 327, 327, 327, 327, 61: 327, 327},
 		// 145
-		{1: 282, 273, 283, 284, 285, 257, 280, 279, 10: 243, 323, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 63: 275, 274, 277, 276, 287, 281, 81: 288, 289, 290, 93: 291, 292, 293, 294, 295, 297, 296, 299, 298, 103: 371, 372},
-		{10: 243, 12: 326, 104: 373},
-		{9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 14: 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 62: 9, 84: 9, 9, 9, 9, 9, 9, 9, 9, 9},
-		{8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 14: 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 62: 8, 84: 8, 8, 8, 8, 8, 8, 8, 8, 8},
-		{25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 14: 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 25, 62: 25, 84: 25, 25, 25, 25, 25, 25, 25, 25, 25},
+		{326, 326, 326, 4: 326, 326, 25: 326, 28: 326, 43: 326, 326, 47: 326, 326, 51: 326, 326, 326, 326, 326, 326, 326, 326, 326, 61: 326, 326},
+		{325, 325, 325, 4: 325, 325, 25: 325, 28: 325, 43: 325, 325, 47: 325, 325, 51: 325, 325, 325, 325, 325, 325, 325, 325, 325, 61: 325, 325},
+		{324, 324, 324, 4: 324, 324, 25: 324, 28: 324, 43: 324, 324, 47: 324, 324, 51: 324, 324, 324, 324, 324, 324, 324, 324, 324, 61: 324, 324},
+		{323, 323, 323, 4: 323, 323, 25: 323, 28: 323, 43: 323, 323, 47: 323, 323, 51: 323, 323, 323, 323, 323, 323, 323, 323, 323, 61: 323, 323},
+		{1: 509, 510, 506, 6: 493, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 29: 498, 494, 495, 496, 505, 504, 499, 497, 500, 502, 503, 501, 42: 511, 49: 507, 118: 508, 512, 513, 123: 514, 133: 515, 135: 516, 517, 518, 139: 519, 520, 144: 567},
 		// 150
-		{10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 14: 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 62: 10, 84: 10, 10, 10, 10, 10, 10, 10, 10, 10},
-		{1: 282, 273, 283, 284, 285, 257, 280, 279, 11: 337, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 63: 275, 274, 338, 276, 340, 339, 81: 288, 289, 290, 93: 291, 292, 293, 294, 295, 377, 296},
-		{13: 378},
-		{315, 282, 273, 283, 284, 285, 257, 280, 279, 10: 243, 259, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 63: 275, 274, 277, 276, 287, 281, 81: 288, 289, 290, 316, 321, 308, 320, 309, 318, 319, 322, 317, 291, 292, 293, 294, 295, 297, 296, 299, 298, 103: 300, 303, 109: 304, 306, 307, 302, 305, 379},
-		{17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 14: 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 17, 62: 17, 84: 17, 17, 17, 17, 17, 17, 17, 17, 17},
+		{3: 564},
+		{320, 320, 320, 4: 320, 320, 25: 320, 28: 320, 43: 320, 320, 47: 320, 320, 51: 320, 320, 320, 320, 320, 320, 320, 320, 320, 61: 320, 320},
+		{1: 509, 510, 506, 6: 493, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 29: 498, 494, 495, 496, 505, 504, 499, 497, 500, 502, 503, 501, 42: 511, 49: 507, 118: 508, 563},
+		{1: 509, 510, 506, 6: 493, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 29: 498, 494, 495, 496, 505, 504, 499, 497, 500, 502, 503, 501, 42: 511, 49: 507, 118: 508, 562},
+		{1: 509, 510, 506, 6: 493, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 29: 498, 494, 495, 496, 505, 504, 499, 497, 500, 502, 503, 501, 42: 511, 49: 507, 118: 508, 561},
 		// 155
-		{10: 381, 104: 382},
-		{315, 282, 273, 283, 284, 285, 257, 280, 279, 383, 243, 259, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 62: 301, 275, 274, 277, 276, 287, 281, 81: 288, 289, 290, 316, 321, 308, 320, 309, 318, 319, 322, 317, 291, 292, 293, 294, 295, 297, 296, 299, 298, 103: 300, 303, 109: 304, 306, 307, 302, 305, 314, 313, 312, 122: 384},
-		{18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 14: 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 18, 62: 18, 84: 18, 18, 18, 18, 18, 18, 18, 18, 18},
-		{407, 119: 390, 123: 408, 391, 130: 409},
-		{315, 282, 273, 283, 284, 285, 257, 280, 279, 385, 243, 259, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 62: 301, 275, 274, 277, 276, 287, 281, 81: 288, 289, 290, 316, 321, 308, 320, 309, 318, 319, 322, 317, 291, 292, 293, 294, 295, 297, 296, 299, 298, 103: 300, 303, 109: 304, 306, 307, 302, 305, 314, 313, 386},
+		{316, 316, 316, 4: 316, 316, 25: 316, 28: 316, 43: 316, 316, 47: 316, 316, 51: 316, 316, 316, 316, 316, 316, 316, 316, 316, 61: 316, 316},
+		{312, 312, 312, 4: 312, 547, 25: 312, 28: 312, 43: 312, 312, 47: 312, 312, 51: 312, 312, 312, 312, 312, 312, 312, 312, 312, 61: 548, 549},
+		{309, 544, 545, 4: 309, 25: 309, 28: 309, 43: 309, 309, 47: 309, 309, 51: 309, 309, 309, 309, 309, 309, 309, 309, 309},
+		{305, 4: 305, 25: 305, 28: 305, 43: 305, 305, 47: 305, 305, 51: 305, 305, 305, 305, 305, 305, 542, 540, 541},
+		{298, 4: 298, 25: 298, 28: 298, 43: 298, 298, 47: 298, 298, 51: 533, 536, 538, 535, 537, 534},
 		// 160
-		{387, 119: 390, 123: 389, 391, 130: 388},
-		{29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 14: 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 29, 62: 29, 84: 29, 29, 29, 29, 29, 29, 29, 29, 29},
-		{31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 14: 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 31, 62: 31, 84: 31, 31, 31, 31, 31, 31, 31, 31, 31, 102: 31, 105: 31, 31, 31, 31},
-		{404, 119: 390, 123: 403, 405},
-		{402},
+		{296, 4: 531, 25: 296, 28: 296, 43: 296, 296, 47: 296, 296},
+		{294, 25: 294, 28: 294, 43: 294, 294, 47: 294, 529},
+		{292, 25: 292, 28: 292, 43: 292, 292, 47: 527},
+		{290, 25: 290, 28: 290, 43: 290, 525},
+		{28: 523, 43: 522},
 		// 165
-		{10: 393, 84: 392},
-		{14, 119: 14},
-		{1: 282, 273, 283, 284, 285, 257, 280, 279, 11: 337, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 63: 275, 274, 338, 276, 340, 339, 81: 288, 289, 290, 93: 291, 292, 293, 294, 295, 397, 296},
-		{315, 282, 273, 283, 284, 285, 257, 280, 279, 395, 243, 259, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 62: 301, 275, 274, 277, 276, 287, 281, 81: 288, 289, 290, 316, 321, 308, 320, 309, 318, 319, 322, 317, 291, 292, 293, 294, 295, 297, 296, 299, 298, 103: 300, 303, 109: 304, 306, 307, 302, 305, 314, 313, 312, 122: 394},
-		{315, 282, 273, 283, 284, 285, 257, 280, 279, 396, 243, 259, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 62: 301, 275, 274, 277, 276, 287, 281, 81: 288, 289, 290, 316, 321, 308, 320, 309, 318, 319, 322, 317, 291, 292, 293, 294, 295, 297, 296, 299, 298, 103: 300, 303, 109: 304, 306, 307, 302, 305, 314, 313, 386},
+		{1: 509, 510, 506, 6: 493, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 29: 498, 494, 495, 496, 505, 504, 499, 497, 500, 502, 503, 501, 42: 511, 49: 507, 118: 508, 512, 513, 123: 514, 133: 515, 135: 516, 517, 518, 139: 519, 524},
+		{6: 203, 203, 11: 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203, 203},
+		{289, 25: 289, 28: 289, 43: 289, 525},
+		{1: 509, 510, 506, 6: 493, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 29: 498, 494, 495, 496, 505, 504, 499, 497, 500, 502, 503, 501, 42: 511, 49: 507, 118: 508, 512, 513, 123: 514, 133: 515, 135: 516, 517, 518, 139: 526},
+		{291, 25: 291, 28: 291, 43: 291, 291, 47: 527},
 		// 170
-		{11},
-		{12},
-		{10: 398},
-		{315, 282, 273, 283, 284, 285, 257, 280, 279, 400, 243, 259, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 62: 301, 275, 274, 277, 276, 287, 281, 81: 288, 289, 290, 316, 321, 308, 320, 309, 318, 319, 322, 317, 291, 292, 293, 294, 295, 297, 296, 299, 298, 103: 300, 303, 109: 304, 306, 307, 302, 305, 314, 313, 312, 122: 399},
-		{315, 282, 273, 283, 284, 285, 257, 280, 279, 401, 243, 259, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 62: 301, 275, 274, 277, 276, 287, 281, 81: 288, 289, 290, 316, 321, 308, 320, 309, 318, 319, 322, 317, 291, 292, 293, 294, 295, 297, 296, 299, 298, 103: 300, 303, 109: 304, 306, 307, 302, 305, 314, 313, 386},
+		{1: 509, 510, 506, 6: 493, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 29: 498, 494, 495, 496, 505, 504, 499, 497, 500, 502, 503, 501, 42: 511, 49: 507, 118: 508, 512, 513, 123: 514, 133: 515, 135: 516, 517, 528},
+		{293, 25: 293, 28: 293, 43: 293, 293, 47: 293, 529},
+		{1: 509, 510, 506, 6: 493, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 29: 498, 494, 495, 496, 505, 504, 499, 497, 500, 502, 503, 501, 42: 511, 49: 507, 118: 508, 512, 513, 123: 514, 133: 515, 135: 516, 530},
+		{295, 4: 531, 25: 295, 28: 295, 43: 295, 295, 47: 295, 295},
+		{1: 509, 510, 506, 6: 493, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 29: 498, 494, 495, 496, 505, 504, 499, 497, 500, 502, 503, 501, 42: 511, 49: 507, 118: 508, 512, 513, 123: 514, 133: 515, 135: 532},
 		// 175
-		{15, 119: 15},
-		{16, 119: 16},
-		{23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 14: 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 23, 62: 23, 84: 23, 23, 23, 23, 23, 23, 23, 23, 23},
-		{406},
-		{21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 14: 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 21, 62: 21, 84: 21, 21, 21, 21, 21, 21, 21, 21, 21},
+		{297, 4: 297, 25: 297, 28: 297, 43: 297, 297, 47: 297, 297, 51: 533, 536, 538, 535, 537, 534},
+		{1: 509, 510, 506, 6: 493, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 29: 498, 494, 495, 496, 505, 504, 499, 497, 500, 502, 503, 501, 42: 511, 49: 507, 118: 508, 512, 513, 123: 514, 133: 560},
+		{1: 509, 510, 506, 6: 493, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 29: 498, 494, 495, 496, 505, 504, 499, 497, 500, 502, 503, 501, 42: 511, 49: 507, 118: 508, 512, 513, 123: 514, 133: 559},
+		{1: 509, 510, 506, 6: 493, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 29: 498, 494, 495, 496, 505, 504, 499, 497, 500, 502, 503, 501, 42: 511, 49: 507, 118: 508, 512, 513, 123: 514, 133: 558},
+		{1: 509, 510, 506, 6: 493, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 29: 498, 494, 495, 496, 505, 504, 499, 497, 500, 502, 503, 501, 42: 511, 49: 507, 118: 508, 512, 513, 123: 514, 133: 557},
 		// 180
-		{13, 119: 13},
-		{24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 14: 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 24, 62: 24, 84: 24, 24, 24, 24, 24, 24, 24, 24, 24},
-		{32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 14: 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 32, 62: 32, 84: 32, 32, 32, 32, 32, 32, 32, 32, 32, 102: 32, 105: 32, 32, 32, 32},
-		{413},
-		{410, 119: 390, 123: 411, 405},
+		{1: 509, 510, 506, 6: 493, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 29: 498, 494, 495, 496, 505, 504, 499, 497, 500, 502, 503, 501, 42: 511, 49: 507, 118: 508, 512, 513, 123: 514, 133: 556},
+		{1: 509, 510, 506, 6: 493, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 29: 498, 494, 495, 496, 505, 504, 499, 497, 500, 502, 503, 501, 42: 511, 49: 507, 118: 508, 512, 513, 123: 514, 133: 539},
+		{299, 4: 299, 25: 299, 28: 299, 43: 299, 299, 47: 299, 299, 51: 299, 299, 299, 299, 299, 299, 542, 540, 541},
+		{1: 509, 510, 506, 6: 493, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 29: 498, 494, 495, 496, 505, 504, 499, 497, 500, 502, 503, 501, 42: 511, 49: 507, 118: 508, 512, 513, 123: 555},
+		{1: 509, 510, 506, 6: 493, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 29: 498, 494, 495, 496, 505, 504, 499, 497, 500, 502, 503, 501, 42: 511, 49: 507, 118: 508, 512, 513, 123: 554},
 		// 185
-		{20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 14: 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 20, 62: 20, 84: 20, 20, 20, 20, 20, 20, 20, 20, 20},
-		{412},
-		{19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 14: 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 19, 62: 19, 84: 19, 19, 19, 19, 19, 19, 19, 19, 19},
-		{22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 14: 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 22, 62: 22, 84: 22, 22, 22, 22, 22, 22, 22, 22, 22},
-		{387},
+		{1: 509, 510, 506, 6: 493, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 29: 498, 494, 495, 496, 505, 504, 499, 497, 500, 502, 503, 501, 42: 511, 49: 507, 118: 508, 512, 513, 123: 543},
+		{306, 544, 545, 4: 306, 25: 306, 28: 306, 43: 306, 306, 47: 306, 306, 51: 306, 306, 306, 306, 306, 306, 306, 306, 306},
+		{1: 509, 510, 506, 6: 493, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 29: 498, 494, 495, 496, 505, 504, 499, 497, 500, 502, 503, 501, 42: 511, 49: 507, 118: 508, 512, 553},
+		{1: 509, 510, 506, 6: 493, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 29: 498, 494, 495, 496, 505, 504, 499, 497, 500, 502, 503, 501, 42: 511, 49: 507, 118: 508, 512, 546},
+		{310, 310, 310, 4: 310, 547, 25: 310, 28: 310, 43: 310, 310, 47: 310, 310, 51: 310, 310, 310, 310, 310, 310, 310, 310, 310, 61: 548, 549},
 		// 190
-		{315, 282, 273, 283, 284, 285, 257, 280, 279, 10: 243, 259, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 63: 275, 274, 277, 276, 287, 281, 81: 288, 289, 290, 316, 321, 308, 320, 309, 318, 319, 322, 317, 291, 292, 293, 294, 295, 297, 296, 299, 298, 103: 300, 303, 109: 304, 306, 307, 302, 305, 416},
-		{33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 14: 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 33, 62: 33, 84: 33, 33, 33, 33, 33, 33, 33, 33, 33},
-		{41: 418},
-		{315, 282, 273, 283, 284, 285, 257, 280, 279, 10: 243, 259, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 63: 275, 274, 277, 276, 287, 281, 81: 288, 289, 290, 316, 321, 308, 320, 309, 318, 319, 322, 317, 291, 292, 293, 294, 295, 297, 296, 299, 298, 103: 300, 303, 109: 304, 306, 307, 302, 305, 419},
-		{34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 14: 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 34, 62: 34, 84: 34, 34, 34, 34, 34, 34, 34, 34, 34},
+		{1: 509, 510, 506, 6: 493, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 29: 498, 494, 495, 496, 505, 504, 499, 497, 500, 502, 503, 501, 42: 511, 49: 507, 118: 508, 552},
+		{1: 509, 510, 506, 6: 493, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 29: 498, 494, 495, 496, 505, 504, 499, 497, 500, 502, 503, 501, 42: 511, 49: 507, 118: 508, 551},
+		{1: 509, 510, 506, 6: 493, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 29: 498, 494, 495, 496, 505, 504, 499, 497, 500, 502, 503, 501, 42: 511, 49: 507, 118: 508, 550},
+		{313, 313, 313, 4: 313, 313, 25: 313, 28: 313, 43: 313, 313, 47: 313, 313, 51: 313, 313, 313, 313, 313, 313, 313, 313, 313, 61: 313, 313},
+		{314, 314, 314, 4: 314, 314, 25: 314, 28: 314, 43: 314, 314, 47: 314, 314, 51: 314, 314, 314, 314, 314, 314, 314, 314, 314, 61: 314, 314},
 		// 195
-		{3: 196, 6: 196, 11: 196, 13: 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 102: 196},
-		{3: 195, 6: 195, 11: 195, 13: 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 102: 195},
-		{2: 422, 11: 421, 117: 461, 420},
-		{3: 425, 6: 426, 11: 428, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 57: 427, 59: 429, 102: 424, 120: 430},
-		{2: 450, 142: 451},
+		{315, 315, 315, 4: 315, 315, 25: 315, 28: 315, 43: 315, 315, 47: 315, 315, 51: 315, 315, 315, 315, 315, 315, 315, 315, 315, 61: 315, 315},
+		{311, 311, 311, 4: 311, 547, 25: 311, 28: 311, 43: 311, 311, 47: 311, 311, 51: 311, 311, 311, 311, 311, 311, 311, 311, 311, 61: 548, 549},
+		{307, 544, 545, 4: 307, 25: 307, 28: 307, 43: 307, 307, 47: 307, 307, 51: 307, 307, 307, 307, 307, 307, 307, 307, 307},
+		{308, 544, 545, 4: 308, 25: 308, 28: 308, 43: 308, 308, 47: 308, 308, 51: 308, 308, 308, 308, 308, 308, 308, 308, 308},
+		{300, 4: 300, 25: 300, 28: 300, 43: 300, 300, 47: 300, 300, 51: 300, 300, 300, 300, 300, 300, 542, 540, 541},
 		// 200
-		{3: 425, 6: 426, 11: 428, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 57: 427, 59: 429, 102: 424, 120: 449},
-		{27: 446, 42: 445},
-		{184, 12: 184, 184, 58: 184, 77: 443},
-		{183, 12: 183, 183, 58: 183, 77: 441},
-		{6: 438, 11: 437, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 57: 436},
+		{301, 4: 301, 25: 301, 28: 301, 43: 301, 301, 47: 301, 301, 51: 301, 301, 301, 301, 301, 301, 542, 540, 541},
+		{302, 4: 302, 25: 302, 28: 302, 43: 302, 302, 47: 302, 302, 51: 302, 302, 302, 302, 302, 302, 542, 540, 541},
+		{303, 4: 303, 25: 303, 28: 303, 43: 303, 303, 47: 303, 303, 51: 303, 303, 303, 303, 303, 303, 542, 540, 541},
+		{304, 4: 304, 25: 304, 28: 304, 43: 304, 304, 47: 304, 304, 51: 304, 304, 304, 304, 304, 304, 542, 540, 541},
+		{317, 317, 317, 4: 317, 317, 25: 317, 28: 317, 43: 317, 317, 47: 317, 317, 51: 317, 317, 317, 317, 317, 317, 317, 317, 317, 61: 317, 317},
 		// 205
-		{431, 58: 432},
-		{44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 14: 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 62: 44, 84: 44, 44, 44, 44, 44, 44, 44, 44, 44},
-		{1: 282, 273, 283, 284, 285, 257, 280, 279, 11: 323, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 63: 275, 274, 277, 276, 287, 281, 81: 288, 289, 290, 93: 291, 292, 293, 294, 295, 297, 296, 434, 298, 137: 433},
-		{435},
-		{42},
+		{318, 318, 318, 4: 318, 318, 25: 318, 28: 318, 43: 318, 318, 47: 318, 318, 51: 318, 318, 318, 318, 318, 318, 318, 318, 318, 61: 318, 318},
+		{319, 319, 319, 4: 319, 319, 25: 319, 28: 319, 43: 319, 319, 47: 319, 319, 51: 319, 319, 319, 319, 319, 319, 319, 319, 319, 61: 319, 319},
+		{1: 509, 510, 506, 6: 493, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 29: 498, 494, 495, 496, 505, 504, 499, 497, 500, 502, 503, 501, 42: 511, 49: 507, 118: 508, 512, 513, 123: 514, 133: 515, 135: 516, 517, 518, 139: 519, 520, 144: 565},
+		{25: 566, 43: 522},
+		{321, 321, 321, 4: 321, 321, 25: 321, 28: 321, 43: 321, 321, 47: 321, 321, 51: 321, 321, 321, 321, 321, 321, 321, 321, 321, 61: 321, 321},
 		// 210
-		{43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 14: 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 62: 43, 84: 43, 43, 43, 43, 43, 43, 43, 43, 43},
-		{182, 12: 182, 182, 58: 182},
-		{181, 12: 181, 181, 58: 181},
-		{27: 439},
-		{42: 440},
+		{25: 568, 43: 522},
+		{322, 322, 322, 4: 322, 322, 25: 322, 28: 322, 43: 322, 322, 47: 322, 322, 51: 322, 322, 322, 322, 322, 322, 322, 322, 322, 61: 322, 322},
+		{6: 570},
+		{335, 335, 335, 4: 335, 335, 25: 335, 28: 335, 43: 335, 335, 47: 335, 335, 51: 335, 335, 335, 335, 335, 335, 335, 335, 335, 61: 335, 335},
+		{6: 572},
 		// 215
-		{6: 158, 11: 158, 14: 158, 158, 158, 158, 158, 158, 158, 158, 158, 158, 158, 158, 158},
-		{11: 442},
-		{180, 12: 180, 180, 58: 180},
-		{11: 444},
-		{179, 12: 179, 179, 58: 179},
+		{225, 25: 225, 27: 225, 225, 50: 225, 67: 225},
+		{6: 574},
+		{224, 25: 224, 27: 224, 224, 50: 224, 67: 224},
+		{5: 482, 486, 483, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 481, 41: 484, 49: 485, 64: 487, 124: 576},
+		{28: 577},
 		// 220
-		{3: 425, 6: 426, 11: 428, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 57: 427, 59: 429, 102: 424, 120: 448},
-		{42: 447},
-		{6: 159, 11: 159, 14: 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159, 159},
-		{185, 12: 185, 185, 58: 185},
-		{186, 12: 186, 186, 58: 186},
+		{5: 482, 486, 483, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 481, 41: 484, 49: 485, 64: 487, 124: 578},
+		{230, 25: 230, 27: 230, 230, 50: 230, 67: 230},
+		{28: 582, 43: 522},
+		{5: 482, 486, 483, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 481, 41: 484, 49: 485, 64: 487, 124: 581},
+		{231, 25: 231, 27: 231, 231, 50: 231, 67: 231},
 		// 225
-		{11: 453, 13: 456, 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 57: 454, 146: 455},
-		{2: 450, 142: 452},
-		{187, 12: 187, 187, 58: 187},
-		{12: 193, 193},
-		{12: 192, 192},
+		{6: 204, 204, 11: 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204, 204},
+		{232, 25: 232, 27: 232, 232, 50: 232, 67: 232},
+		{5: 482, 486, 483, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 25: 590, 481, 41: 484, 49: 485, 60: 589, 64: 487, 124: 587, 182: 588},
+		{233, 3: 584, 25: 233, 27: 233, 233, 50: 233, 67: 233, 179: 586},
+		{234, 25: 234, 27: 234, 234, 50: 234, 67: 234},
 		// 230
-		{12: 457, 458},
-		{188, 2: 188, 12: 188, 188, 58: 188},
-		{11: 459, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 57: 460},
-		{189, 2: 189, 12: 189, 189, 58: 189},
-		{12: 191, 191},
+		{25: 240, 27: 240},
+		{25: 593, 27: 592},
+		{5: 482, 486, 483, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 481, 41: 484, 49: 485, 64: 487, 124: 591},
+		{235, 3: 235, 25: 235, 27: 235, 235, 50: 235, 67: 235},
+		{25: 238, 27: 238},
 		// 235
-		{12: 190, 190},
-		{13: 462},
-		{3: 194, 6: 194, 11: 194, 13: 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 102: 194},
-		{1: 282, 273, 283, 284, 285, 257, 280, 279, 11: 337, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 63: 275, 274, 338, 276, 340, 339, 81: 288, 289, 290, 93: 291, 292, 293, 294, 468},
-		{1: 282, 273, 283, 284, 285, 257, 280, 279, 11: 323, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 63: 275, 274, 277, 276, 287, 281, 81: 288, 289, 290, 93: 291, 292, 293, 294, 295, 297, 296, 299, 298, 103: 465},
+		{5: 482, 486, 483, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 481, 41: 484, 49: 485, 60: 595, 64: 487, 124: 594},
+		{236, 3: 236, 25: 236, 27: 236, 236, 50: 236, 67: 236},
+		{25: 239, 27: 239},
+		{5: 482, 486, 483, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 481, 41: 484, 49: 485, 64: 487, 124: 596},
+		{25: 237, 27: 237},
 		// 240
-		{12: 326, 41: 466},
-		{1: 282, 273, 283, 284, 285, 257, 280, 279, 11: 337, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 63: 275, 274, 338, 276, 340, 339, 81: 288, 289, 290, 93: 291, 292, 293, 294, 295, 467, 296},
-		{66, 9: 66, 66, 12: 66, 66, 41: 66, 66},
-		{68, 9: 68, 68, 12: 68, 68, 41: 68, 68, 68, 68, 469},
-		{1: 282, 273, 283, 284, 285, 257, 280, 279, 11: 337, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 63: 275, 274, 338, 276, 340, 339, 81: 288, 289, 290, 93: 291, 292, 293, 470},
+		{170, 170, 170, 170, 170, 170, 7: 170, 170, 170, 170, 24: 606, 170, 27: 170, 170, 43: 170, 170, 47: 170, 170, 50: 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 67: 170, 76: 170, 170, 170, 170, 170, 170, 170, 170, 85: 170, 170, 170, 170},
+		{138, 138, 138, 138, 138, 138, 7: 138, 138, 138, 138, 24: 138, 138, 27: 138, 138, 43: 138, 138, 47: 138, 138, 50: 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 138, 67: 138, 76: 138, 138, 138, 138, 138, 138, 138, 138, 85: 138, 138, 138, 138},
+		{25: 601, 27: 603, 60: 602},
+		{8: 131, 25: 131, 27: 131, 60: 131},
+		{137, 137, 137, 137, 137, 137, 7: 137, 137, 137, 137, 24: 137, 137, 27: 137, 137, 43: 137, 137, 47: 137, 137, 50: 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 137, 67: 137, 76: 137, 137, 137, 137, 137, 137, 137, 137, 85: 137, 137, 137, 137},
 		// 245
-		{70, 9: 70, 70, 12: 70, 70, 41: 70, 70, 70, 70, 70, 471},
-		{1: 282, 273, 283, 284, 285, 257, 280, 279, 11: 337, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 63: 275, 274, 338, 276, 340, 339, 81: 288, 289, 290, 93: 291, 292, 472},
-		{72, 9: 72, 72, 12: 72, 72, 41: 72, 72, 72, 72, 72, 72, 473},
-		{1: 282, 273, 283, 284, 285, 257, 280, 279, 11: 337, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 63: 275, 274, 338, 276, 340, 339, 81: 288, 289, 290, 93: 291, 474},
-		{74, 475, 9: 74, 74, 12: 74, 74, 41: 74, 74, 74, 74, 74, 74, 74},
+		{25: 605},
+		{1: 429, 430, 417, 427, 428, 597, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 422, 421, 432, 426, 84: 433, 89: 434, 435, 92: 436, 437, 103: 438, 439, 440, 442, 441, 604, 443},
+		{8: 130, 25: 130, 27: 130, 60: 130},
+		{136, 136, 136, 136, 136, 136, 7: 136, 136, 136, 136, 24: 136, 136, 27: 136, 136, 43: 136, 136, 47: 136, 136, 50: 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 136, 67: 136, 76: 136, 136, 136, 136, 136, 136, 136, 136, 85: 136, 136, 136, 136},
+		{6: 607, 8: 210, 27: 210, 155: 608},
 		// 250
-		{1: 282, 273, 283, 284, 285, 257, 280, 279, 11: 337, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 63: 275, 274, 338, 276, 340, 339, 81: 288, 289, 290, 93: 476},
-		{76, 76, 9: 76, 76, 12: 76, 76, 41: 76, 76, 76, 76, 76, 76, 76, 477, 480, 482, 479, 481, 478},
-		{1: 282, 273, 283, 284, 285, 257, 280, 279, 11: 337, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 63: 275, 274, 338, 276, 340, 339, 81: 288, 289, 504},
-		{1: 282, 273, 283, 284, 285, 257, 280, 279, 11: 337, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 63: 275, 274, 338, 276, 340, 339, 81: 288, 289, 503},
-		{1: 282, 273, 283, 284, 285, 257, 280, 279, 11: 337, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 63: 275, 274, 338, 276, 340, 339, 81: 288, 289, 502},
+		{50: 619},
+		{8: 610, 27: 609},
+		{6: 611},
+		{89, 8: 89, 24: 89, 89, 27: 89, 89, 50: 89, 60: 89},
+		{50: 612},
 		// 255
-		{1: 282, 273, 283, 284, 285, 257, 280, 279, 11: 337, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 63: 275, 274, 338, 276, 340, 339, 81: 288, 289, 501},
-		{1: 282, 273, 283, 284, 285, 257, 280, 279, 11: 337, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 63: 275, 274, 338, 276, 340, 339, 81: 288, 289, 500},
-		{1: 282, 273, 283, 284, 285, 257, 280, 279, 11: 337, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 63: 275, 274, 338, 276, 340, 339, 81: 288, 289, 483},
-		{78, 78, 9: 78, 78, 12: 78, 78, 41: 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 486, 484, 485},
-		{1: 282, 273, 283, 284, 285, 257, 280, 279, 11: 337, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 63: 275, 274, 338, 276, 340, 339, 81: 288, 499},
+		{1: 429, 430, 417, 427, 428, 469, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 614, 421, 616, 615, 84: 433, 89: 434, 435, 92: 436, 437, 103: 438, 439, 440, 617, 441, 156: 613},
+		{8: 208, 27: 208},
+		{129, 129, 129, 472, 129, 129, 7: 471, 129, 475, 474, 24: 129, 129, 27: 129, 129, 43: 129, 129, 47: 129, 129, 50: 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 129, 67: 129, 76: 473, 129, 129, 129, 129, 129, 129, 129, 85: 129, 129, 129, 129},
+		{1: 429, 430, 417, 427, 428, 469, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 614, 421, 618, 615},
+		{120, 120, 120, 4: 120, 120, 8: 120, 24: 120, 120, 27: 120, 120, 43: 120, 120, 47: 120, 120, 50: 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120, 120},
 		// 260
-		{1: 282, 273, 283, 284, 285, 257, 280, 279, 11: 337, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 63: 275, 274, 338, 276, 340, 339, 81: 288, 498},
-		{1: 282, 273, 283, 284, 285, 257, 280, 279, 11: 337, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 63: 275, 274, 338, 276, 340, 339, 81: 288, 487},
-		{85, 85, 4: 488, 489, 9: 85, 85, 12: 85, 85, 41: 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85, 85},
-		{1: 282, 273, 283, 284, 285, 257, 280, 279, 11: 337, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 63: 275, 274, 338, 276, 340, 339, 81: 497},
-		{1: 282, 273, 283, 284, 285, 257, 280, 279, 11: 337, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 63: 275, 274, 338, 276, 340, 339, 81: 490},
+		{8: 70, 27: 70},
+		{126, 126, 126, 4: 126, 126, 8: 126, 24: 126, 126, 27: 126, 126, 43: 126, 126, 47: 126, 126, 50: 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 126, 67: 126, 77: 126, 126, 126, 126, 126, 126, 126, 85: 126, 126, 126, 126},
+		{1: 429, 430, 417, 427, 428, 469, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 614, 421, 616, 615, 84: 433, 89: 434, 435, 92: 436, 437, 103: 438, 439, 440, 617, 441, 156: 620},
+		{8: 209, 27: 209},
+		{27: 627, 631, 50: 632},
 		// 265
-		{89, 89, 3: 491, 89, 89, 9: 89, 89, 12: 89, 89, 41: 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 89, 60: 492, 493},
-		{1: 282, 273, 283, 284, 285, 257, 280, 279, 11: 337, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 63: 275, 274, 338, 276, 496, 339},
-		{1: 282, 273, 283, 284, 285, 257, 280, 279, 11: 337, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 63: 275, 274, 338, 276, 495, 339},
-		{1: 282, 273, 283, 284, 285, 257, 280, 279, 11: 337, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 63: 275, 274, 338, 276, 494, 339},
-		{92, 92, 3: 92, 92, 92, 9: 92, 92, 12: 92, 92, 41: 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 92, 60: 92, 92},
+		{1: 429, 430, 417, 427, 428, 597, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 28: 623, 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 422, 421, 432, 426, 84: 433, 89: 434, 435, 92: 436, 437, 103: 438, 439, 440, 442, 441, 444, 443, 116: 624},
+		{146, 146, 146, 146, 146, 146, 7: 146, 146, 146, 146, 24: 146, 146, 27: 146, 146, 43: 146, 146, 47: 146, 146, 50: 146, 146, 146, 146, 146, 146, 146, 146, 146, 146, 146, 146, 146, 146, 67: 146, 76: 146, 146, 146, 146, 146, 146, 146, 146, 85: 146, 146, 146, 146},
+		{27: 627, 625, 50: 626},
+		{144, 144, 144, 144, 144, 144, 7: 144, 144, 144, 144, 24: 144, 144, 27: 144, 144, 43: 144, 144, 47: 144, 144, 50: 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 144, 67: 144, 76: 144, 144, 144, 144, 144, 144, 144, 144, 85: 144, 144, 144, 144},
+		{1: 429, 430, 417, 427, 428, 597, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 422, 421, 432, 426, 84: 433, 89: 434, 435, 92: 436, 437, 103: 438, 439, 440, 442, 441, 444, 443, 116: 629},
 		// 270
-		{93, 93, 3: 93, 93, 93, 9: 93, 93, 12: 93, 93, 41: 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 93, 60: 93, 93},
-		{94, 94, 3: 94, 94, 94, 9: 94, 94, 12: 94, 94, 41: 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 94, 60: 94, 94},
-		{90, 90, 3: 491, 90, 90, 9: 90, 90, 12: 90, 90, 41: 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 90, 60: 492, 493},
-		{86, 86, 4: 488, 489, 9: 86, 86, 12: 86, 86, 41: 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86, 86},
-		{87, 87, 4: 488, 489, 9: 87, 87, 12: 87, 87, 41: 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87, 87},
+		{1: 429, 430, 417, 427, 428, 597, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 422, 421, 432, 426, 84: 433, 89: 434, 435, 92: 436, 437, 103: 438, 439, 440, 442, 441, 628, 443},
+		{71, 24: 71, 71, 27: 71, 71, 50: 71},
+		{27: 627, 630},
+		{142, 142, 142, 142, 142, 142, 7: 142, 142, 142, 142, 24: 142, 142, 27: 142, 142, 43: 142, 142, 47: 142, 142, 50: 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 142, 67: 142, 76: 142, 142, 142, 142, 142, 142, 142, 142, 85: 142, 142, 142, 142},
+		{147, 147, 147, 147, 147, 147, 7: 147, 147, 147, 147, 24: 147, 147, 27: 147, 147, 43: 147, 147, 47: 147, 147, 50: 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 67: 147, 76: 147, 147, 147, 147, 147, 147, 147, 147, 85: 147, 147, 147, 147},
 		// 275
-		{79, 79, 9: 79, 79, 12: 79, 79, 41: 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 486, 484, 485},
-		{80, 80, 9: 80, 80, 12: 80, 80, 41: 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 486, 484, 485},
-		{81, 81, 9: 81, 81, 12: 81, 81, 41: 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 486, 484, 485},
-		{82, 82, 9: 82, 82, 12: 82, 82, 41: 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 486, 484, 485},
-		{83, 83, 9: 83, 83, 12: 83, 83, 41: 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 486, 484, 485},
+		{1: 429, 430, 417, 427, 428, 597, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 28: 633, 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 422, 421, 432, 426, 84: 433, 89: 434, 435, 92: 436, 437, 103: 438, 439, 440, 442, 441, 444, 443, 116: 634},
+		{145, 145, 145, 145, 145, 145, 7: 145, 145, 145, 145, 24: 145, 145, 27: 145, 145, 43: 145, 145, 47: 145, 145, 50: 145, 145, 145, 145, 145, 145, 145, 145, 145, 145, 145, 145, 145, 145, 67: 145, 76: 145, 145, 145, 145, 145, 145, 145, 145, 85: 145, 145, 145, 145},
+		{27: 627, 635, 50: 636},
+		{143, 143, 143, 143, 143, 143, 7: 143, 143, 143, 143, 24: 143, 143, 27: 143, 143, 43: 143, 143, 47: 143, 143, 50: 143, 143, 143, 143, 143, 143, 143, 143, 143, 143, 143, 143, 143, 143, 67: 143, 76: 143, 143, 143, 143, 143, 143, 143, 143, 85: 143, 143, 143, 143},
+		{1: 429, 430, 417, 427, 428, 597, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 422, 421, 432, 426, 84: 433, 89: 434, 435, 92: 436, 437, 103: 438, 439, 440, 442, 441, 444, 443, 116: 637},
 		// 280
-		{1: 282, 273, 283, 284, 285, 257, 280, 279, 11: 323, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 63: 275, 274, 277, 276, 287, 281, 81: 288, 289, 290, 93: 291, 292, 293, 294, 295, 297, 296, 518, 298},
-		{1: 59, 59, 59, 59, 59, 59, 59, 59, 11: 59, 14: 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59, 59},
-		{1: 58, 58, 58, 58, 58, 58, 58, 58, 11: 58, 14: 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58},
-		{1: 57, 57, 57, 57, 57, 57, 57, 57, 11: 57, 14: 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57},
-		{1: 56, 56, 56, 56, 56, 56, 56, 56, 11: 56, 14: 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56},
+		{27: 627, 638},
+		{141, 141, 141, 141, 141, 141, 7: 141, 141, 141, 141, 24: 141, 141, 27: 141, 141, 43: 141, 141, 47: 141, 141, 50: 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 141, 67: 141, 76: 141, 141, 141, 141, 141, 141, 141, 141, 85: 141, 141, 141, 141},
+		{641, 27: 627},
+		{3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 26: 3, 29: 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 3, 45: 3, 3, 65: 3, 3, 91: 3, 94: 3, 3, 3, 3, 3, 3, 3, 3, 3},
+		{2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 26: 2, 29: 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 2, 45: 2, 2, 65: 2, 2, 91: 2, 94: 2, 2, 2, 2, 2, 2, 2, 2, 2},
 		// 285
-		{1: 55, 55, 55, 55, 55, 55, 55, 55, 11: 55, 14: 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55, 55},
-		{1: 54, 54, 54, 54, 54, 54, 54, 54, 11: 54, 14: 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54},
-		{1: 53, 53, 53, 53, 53, 53, 53, 53, 11: 53, 14: 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53, 53},
-		{1: 52, 52, 52, 52, 52, 52, 52, 52, 11: 52, 14: 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52, 52},
-		{1: 51, 51, 51, 51, 51, 51, 51, 51, 11: 51, 14: 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51, 51},
+		{5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 26: 5, 29: 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 5, 45: 5, 5, 65: 5, 5, 91: 5, 94: 5, 5, 5, 5, 5, 5, 5, 5, 5},
+		{644},
+		{4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 26: 4, 29: 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 4, 45: 4, 4, 65: 4, 4, 91: 4, 94: 4, 4, 4, 4, 4, 4, 4, 4, 4},
+		{7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 26: 7, 29: 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 7, 45: 7, 7, 65: 7, 7, 91: 7, 94: 7, 7, 7, 7, 7, 7, 7, 7, 7},
+		{647},
 		// 290
-		{1: 50, 50, 50, 50, 50, 50, 50, 50, 11: 50, 14: 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50},
-		{1: 49, 49, 49, 49, 49, 49, 49, 49, 11: 49, 14: 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49},
-		{1: 48, 48, 48, 48, 48, 48, 48, 48, 11: 48, 14: 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48},
-		{60, 9: 60, 60, 12: 60, 60, 41: 60, 60},
-		{133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 520, 12: 133, 133, 41: 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 58: 133, 60: 133, 133, 69: 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133, 133},
+		{6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 26: 6, 29: 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 6, 45: 6, 6, 65: 6, 6, 91: 6, 94: 6, 6, 6, 6, 6, 6, 6, 6, 6},
+		{649},
+		{8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 26: 8, 29: 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 8, 45: 8, 8, 65: 8, 8, 91: 8, 94: 8, 8, 8, 8, 8, 8, 8, 8, 8},
+		{120, 120, 120, 4: 120, 120, 24: 120, 27: 120, 43: 120, 120, 47: 120, 120, 51: 120, 120, 120, 120, 120, 120, 120, 120, 120, 61: 120, 120, 120, 67: 670, 77: 671, 675, 679, 673, 677, 674, 672, 85: 681, 678, 676, 680, 153: 686},
+		{666, 24: 383, 27: 665, 117: 667},
 		// 295
-		{9: 165, 11: 330, 165, 129: 521},
-		{9: 522, 12: 332},
-		{63, 9: 63, 63, 12: 63, 63, 41: 63, 63},
-		{102, 102, 3: 102, 102, 102, 9: 102, 102, 12: 102, 102, 41: 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 102, 58: 102, 60: 102, 102, 69: 102, 102, 102, 102, 102, 102, 102, 102, 78: 102, 102, 102},
-		{103, 103, 3: 103, 103, 103, 9: 103, 103, 12: 103, 103, 41: 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 103, 58: 103, 60: 103, 103, 69: 103, 103, 103, 103, 103, 103, 103, 103, 78: 103, 103, 103},
+		{460, 429, 430, 417, 427, 428, 597, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 422, 421, 432, 426, 84: 433, 89: 434, 435, 92: 436, 437, 103: 438, 439, 440, 442, 441, 444, 443, 116: 445, 122: 661},
+		{460, 429, 430, 417, 427, 428, 597, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 422, 421, 432, 426, 84: 433, 89: 434, 435, 92: 436, 437, 103: 438, 439, 440, 442, 441, 444, 443, 116: 445, 122: 657},
+		{1: 429, 430, 417, 427, 428, 469, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 614, 421, 616, 615, 84: 433, 89: 434, 435, 92: 436, 437, 103: 438, 439, 440, 655, 441},
+		{24: 383, 117: 656},
+		{11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 26: 11, 29: 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 11, 45: 11, 11, 65: 11, 11, 91: 11, 94: 11, 11, 11, 11, 11, 11, 11, 11, 11},
 		// 300
-		{11: 527, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 57: 526, 143: 528},
-		{116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 12: 116, 116, 41: 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 58: 116, 60: 116, 116, 69: 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116, 116},
-		{115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 12: 115, 115, 41: 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 58: 115, 60: 115, 115, 69: 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115},
-		{112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 12: 112, 112, 41: 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 58: 112, 60: 112, 112, 69: 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112},
-		{11: 530},
+		{1: 429, 430, 417, 427, 428, 597, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 383, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 422, 421, 432, 426, 84: 433, 89: 434, 435, 92: 436, 437, 103: 438, 439, 440, 442, 441, 444, 443, 116: 658, 659},
+		{24: 383, 27: 627, 117: 660},
+		{13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 26: 13, 29: 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 13, 45: 13, 13, 65: 13, 13, 91: 13, 94: 13, 13, 13, 13, 13, 13, 13, 13, 13},
+		{12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 26: 12, 29: 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 12, 45: 12, 12, 65: 12, 12, 91: 12, 94: 12, 12, 12, 12, 12, 12, 12, 12, 12},
+		{1: 429, 430, 417, 427, 428, 597, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 383, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 422, 421, 432, 426, 84: 433, 89: 434, 435, 92: 436, 437, 103: 438, 439, 440, 442, 441, 444, 443, 116: 662, 663},
 		// 305
-		{107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 531, 12: 107, 107, 41: 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 58: 107, 60: 107, 107, 69: 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107, 107},
-		{9: 165, 11: 330, 165, 129: 532},
-		{9: 533, 12: 332},
-		{62, 9: 62, 62, 12: 62, 62, 41: 62, 62},
-		{12: 326, 535},
+		{24: 383, 27: 627, 117: 664},
+		{15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 26: 15, 29: 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 15, 45: 15, 15, 65: 15, 15, 91: 15, 94: 15, 15, 15, 15, 15, 15, 15, 15, 15},
+		{14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 26: 14, 29: 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 14, 45: 14, 14, 65: 14, 14, 91: 14, 94: 14, 14, 14, 14, 14, 14, 14, 14, 14},
+		{1: 429, 430, 417, 427, 428, 597, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 422, 421, 668, 426, 84: 433, 89: 434, 435, 92: 436, 437, 103: 438, 439, 440, 442, 441, 628, 443},
+		{50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 26: 50, 29: 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 50, 45: 50, 50, 65: 50, 50, 91: 50, 94: 50, 50, 50, 50, 50, 50, 50, 50, 50},
 		// 310
-		{119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 12: 119, 119, 41: 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 58: 119, 60: 119, 119, 69: 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119},
-		{9: 137, 11: 537, 125: 538, 148: 539, 540},
-		{2: 545},
-		{544},
-		{9: 136, 11: 537, 125: 542},
+		{16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 26: 16, 29: 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 16, 45: 16, 16, 65: 16, 16, 91: 16, 94: 16, 16, 16, 16, 16, 16, 16, 16, 16},
+		{120, 120, 120, 4: 120, 120, 24: 120, 27: 120, 43: 120, 120, 47: 120, 120, 51: 120, 120, 120, 120, 120, 120, 120, 120, 120, 61: 120, 120, 120, 67: 670, 77: 671, 675, 679, 673, 677, 674, 672, 85: 681, 678, 676, 680, 153: 669},
+		{1: 429, 430, 417, 427, 428, 597, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 422, 421, 432, 426, 84: 433, 89: 434, 435, 92: 436, 437, 103: 438, 439, 440, 442, 441, 682, 443, 134: 683},
+		{1: 84, 84, 84, 84, 84, 84, 84, 9: 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 26: 84, 29: 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 84, 45: 84, 84, 134: 84},
+		{1: 83, 83, 83, 83, 83, 83, 83, 9: 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 26: 83, 29: 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 83, 45: 83, 83, 134: 83},
 		// 315
-		{9: 541},
-		{132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 12: 132, 132, 41: 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 58: 132, 60: 132, 132, 69: 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132, 132},
-		{543},
-		{9: 138, 11: 138},
-		{9: 139, 11: 139},
+		{1: 82, 82, 82, 82, 82, 82, 82, 9: 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 26: 82, 29: 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 82, 45: 82, 82, 134: 82},
+		{1: 81, 81, 81, 81, 81, 81, 81, 9: 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 26: 81, 29: 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 81, 45: 81, 81, 134: 81},
+		{1: 80, 80, 80, 80, 80, 80, 80, 9: 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 26: 80, 29: 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 80, 45: 80, 80, 134: 80},
+		{1: 79, 79, 79, 79, 79, 79, 79, 9: 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 26: 79, 29: 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 79, 45: 79, 79, 134: 79},
+		{1: 78, 78, 78, 78, 78, 78, 78, 9: 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 26: 78, 29: 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 78, 45: 78, 78, 134: 78},
 		// 320
-		{11: 546, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 547, 57: 548, 125: 550, 147: 549},
-		{2: 545, 12: 146, 146},
-		{12: 145, 145},
-		{77: 559},
-		{12: 555, 556},
+		{1: 77, 77, 77, 77, 77, 77, 77, 9: 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 26: 77, 29: 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 77, 45: 77, 77, 134: 77},
+		{1: 76, 76, 76, 76, 76, 76, 76, 9: 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 26: 76, 29: 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 76, 45: 76, 76, 134: 76},
+		{1: 75, 75, 75, 75, 75, 75, 75, 9: 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 26: 75, 29: 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 75, 45: 75, 75, 134: 75},
+		{1: 74, 74, 74, 74, 74, 74, 74, 9: 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 26: 74, 29: 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 74, 45: 74, 74, 134: 74},
+		{1: 73, 73, 73, 73, 73, 73, 73, 9: 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 26: 73, 29: 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 73, 45: 73, 73, 134: 73},
 		// 325
-		{12: 552, 551},
-		{141, 12: 141, 141},
-		{11: 537, 125: 553},
-		{13: 554},
-		{140, 12: 140, 140},
+		{85, 8: 85, 24: 85, 85, 27: 85, 85, 50: 85, 60: 85},
+		{1: 429, 430, 417, 427, 428, 469, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 614, 421, 616, 615, 84: 433, 89: 434, 435, 92: 436, 437, 103: 438, 439, 440, 684, 441},
+		{24: 383, 117: 685},
+		{9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 26: 9, 29: 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 9, 45: 9, 9, 65: 9, 9, 91: 9, 94: 9, 9, 9, 9, 9, 9, 9, 9, 9},
+		{1: 429, 430, 417, 427, 428, 597, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 422, 421, 432, 426, 84: 433, 89: 434, 435, 92: 436, 437, 103: 438, 439, 440, 442, 441, 682, 443, 134: 687},
 		// 330
-		{11: 557},
-		{142, 12: 142, 142},
-		{13: 558},
-		{143, 12: 143, 143},
-		{11: 560},
+		{1: 429, 430, 417, 427, 428, 469, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 614, 421, 616, 615, 84: 433, 89: 434, 435, 92: 436, 437, 103: 438, 439, 440, 688, 441},
+		{24: 383, 117: 689},
+		{10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 26: 10, 29: 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 10, 45: 10, 10, 65: 10, 10, 91: 10, 94: 10, 10, 10, 10, 10, 10, 10, 10, 10},
+		{1: 170, 170, 170, 170, 170, 7: 170, 9: 170, 170, 24: 170, 43: 170, 170, 47: 170, 170, 51: 170, 170, 170, 170, 170, 170, 170, 170, 170, 61: 170, 170, 170, 76: 170, 732},
+		{1: 129, 129, 472, 129, 129, 7: 471, 9: 475, 474, 24: 129, 43: 129, 129, 47: 129, 129, 51: 129, 129, 129, 129, 129, 129, 129, 129, 129, 61: 129, 129, 129, 76: 713},
 		// 335
-		{12: 144, 144},
-		{315, 282, 273, 283, 284, 285, 257, 280, 279, 10: 243, 259, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 62: 301, 275, 274, 277, 276, 287, 281, 81: 288, 289, 290, 316, 321, 308, 320, 309, 318, 319, 322, 317, 291, 292, 293, 294, 295, 297, 296, 299, 298, 103: 300, 303, 109: 304, 306, 307, 302, 305, 314, 313, 562},
-		{35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 14: 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 35, 62: 35, 84: 35, 35, 35, 35, 35, 35, 35, 35, 35},
-		{10: 575},
-		{10: 565},
+		{24: 709},
+		{8: 38, 101: 38, 38, 175: 694},
+		{8: 695, 101: 697, 698, 174: 696},
+		{708},
+		{8: 37, 101: 37, 37},
 		// 340
-		{1: 282, 273, 283, 284, 285, 257, 280, 279, 569, 567, 323, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 63: 275, 274, 277, 276, 287, 281, 81: 288, 289, 290, 93: 291, 292, 293, 294, 295, 297, 296, 566, 298, 127: 568},
-		{9: 162, 12: 162},
-		{1: 282, 273, 283, 284, 285, 257, 280, 279, 10: 567, 323, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 63: 275, 274, 277, 276, 287, 281, 81: 288, 289, 290, 93: 291, 292, 293, 294, 295, 297, 296, 566, 298, 127: 573},
-		{9: 571, 12: 570},
-		{152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 12: 152, 152, 41: 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 58: 152, 60: 152, 152, 69: 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152, 152},
+		{1: 429, 430, 417, 427, 428, 469, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 614, 421, 616, 615, 84: 433, 89: 434, 435, 92: 436, 437, 103: 438, 439, 440, 703, 441, 192: 702},
+		{50: 699},
+		{460, 429, 430, 417, 427, 428, 401, 398, 33, 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 383, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 371, 446, 68: 419, 418, 402, 420, 422, 421, 432, 426, 84: 433, 89: 434, 435, 461, 436, 437, 466, 465, 468, 463, 464, 467, 462, 33, 33, 438, 439, 440, 442, 441, 444, 443, 116: 445, 449, 121: 447, 450, 125: 458, 457, 454, 452, 453, 448, 451, 459, 138: 700},
+		{460, 429, 430, 417, 427, 428, 401, 398, 34, 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 383, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 371, 446, 68: 419, 418, 402, 420, 422, 421, 432, 426, 84: 433, 89: 434, 435, 461, 436, 437, 466, 465, 468, 463, 464, 467, 462, 34, 34, 438, 439, 440, 442, 441, 444, 443, 116: 445, 449, 121: 447, 450, 125: 458, 701, 454, 452, 453, 448, 451, 459},
+		{54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 26: 54, 29: 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 54, 45: 54, 54, 65: 54, 54, 91: 54, 94: 54, 54, 54, 54, 54, 54, 54, 54, 54},
 		// 345
-		{1: 282, 273, 283, 284, 285, 257, 280, 279, 11: 323, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 63: 275, 274, 277, 276, 287, 281, 81: 288, 289, 290, 93: 291, 292, 293, 294, 295, 297, 296, 572, 298},
-		{153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 12: 153, 153, 41: 153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 58: 153, 60: 153, 153, 69: 153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 153, 153},
-		{9: 160, 12: 160},
-		{9: 574, 12: 570},
-		{9: 161, 12: 161},
+		{27: 705, 50: 704},
+		{27: 32, 50: 32},
+		{460, 429, 430, 417, 427, 428, 401, 398, 35, 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 383, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 371, 446, 68: 419, 418, 402, 420, 422, 421, 432, 426, 84: 433, 89: 434, 435, 461, 436, 437, 466, 465, 468, 463, 464, 467, 462, 35, 35, 438, 439, 440, 442, 441, 444, 443, 116: 445, 449, 121: 447, 450, 125: 458, 457, 454, 452, 453, 448, 451, 459, 138: 707},
+		{1: 429, 430, 417, 427, 428, 469, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 614, 421, 616, 615, 84: 433, 89: 434, 435, 92: 436, 437, 103: 438, 439, 440, 706, 441},
+		{27: 31, 50: 31},
 		// 350
-		{1: 282, 273, 283, 284, 285, 257, 280, 279, 577, 567, 323, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 63: 275, 274, 277, 276, 287, 281, 81: 288, 289, 290, 93: 291, 292, 293, 294, 295, 297, 296, 566, 298, 127: 576},
-		{9: 578, 12: 570},
-		{154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 12: 154, 154, 41: 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 58: 154, 60: 154, 154, 69: 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 154},
-		{155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 12: 155, 155, 41: 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 58: 155, 60: 155, 155, 69: 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155, 155},
-		{6: 580, 11: 581, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 57: 582, 63: 583},
+		{460, 429, 430, 417, 427, 428, 401, 398, 36, 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 383, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 371, 446, 68: 419, 418, 402, 420, 422, 421, 432, 426, 84: 433, 89: 434, 435, 461, 436, 437, 466, 465, 468, 463, 464, 467, 462, 36, 36, 438, 439, 440, 442, 441, 444, 443, 116: 445, 449, 121: 447, 450, 125: 458, 701, 454, 452, 453, 448, 451, 459},
+		{41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 26: 41, 29: 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 41, 45: 41, 41, 65: 41, 41, 91: 41, 94: 41, 41, 41, 41, 41, 41, 41, 41, 41},
+		{8: 38, 101: 38, 38, 175: 710},
+		{8: 711, 101: 697, 698, 174: 696},
+		{712},
 		// 355
-		{42: 579},
-		{10: 588},
-		{10: 584},
-		{147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 12: 147, 147, 41: 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 58: 147, 60: 147, 147, 69: 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147, 147},
-		{1: 282, 273, 283, 284, 285, 257, 280, 279, 586, 11: 323, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 63: 275, 274, 277, 276, 287, 281, 81: 288, 289, 290, 93: 291, 292, 293, 294, 295, 297, 296, 351, 298, 126: 585},
+		{42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 26: 42, 29: 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 42, 45: 42, 42, 65: 42, 42, 91: 42, 94: 42, 42, 42, 42, 42, 42, 42, 42, 42},
+		{3: 714, 6: 478, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 49: 477, 146: 480},
+		{5: 482, 486, 483, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 481, 41: 484, 49: 485, 64: 487, 110: 715, 124: 488},
+		{25: 716},
+		{24: 717},
 		// 360
-		{9: 587, 12: 353},
-		{148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 12: 148, 148, 41: 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 58: 148, 60: 148, 148, 69: 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 148, 148},
-		{149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 12: 149, 149, 41: 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 58: 149, 60: 149, 149, 69: 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149},
-		{1: 282, 273, 283, 284, 285, 257, 280, 279, 590, 11: 323, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 63: 275, 274, 277, 276, 287, 281, 81: 288, 289, 290, 93: 291, 292, 293, 294, 295, 297, 296, 351, 298, 126: 589},
-		{9: 591, 12: 353},
+		{8: 30, 101: 30, 30, 178: 718},
+		{8: 719, 101: 721, 722, 177: 720},
+		{731},
+		{8: 29, 101: 29, 29},
+		{5: 482, 486, 483, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 481, 41: 484, 49: 485, 64: 487, 124: 726, 194: 725},
 		// 365
-		{150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 12: 150, 150, 41: 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 58: 150, 60: 150, 150, 69: 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150, 150},
-		{151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 12: 151, 151, 41: 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 58: 151, 60: 151, 151, 69: 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151, 151},
-		{62: 203, 102: 203, 105: 203, 203, 203, 203},
-		{2: 206, 10: 206},
-		{13: 601},
+		{50: 723},
+		{460, 429, 430, 417, 427, 428, 401, 398, 25, 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 383, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 371, 446, 68: 419, 418, 402, 420, 422, 421, 432, 426, 84: 433, 89: 434, 435, 461, 436, 437, 466, 465, 468, 463, 464, 467, 462, 25, 25, 438, 439, 440, 442, 441, 444, 443, 116: 445, 449, 121: 447, 450, 125: 458, 457, 454, 452, 453, 448, 451, 459, 138: 724},
+		{460, 429, 430, 417, 427, 428, 401, 398, 26, 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 383, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 371, 446, 68: 419, 418, 402, 420, 422, 421, 432, 426, 84: 433, 89: 434, 435, 461, 436, 437, 466, 465, 468, 463, 464, 467, 462, 26, 26, 438, 439, 440, 442, 441, 444, 443, 116: 445, 449, 121: 447, 450, 125: 458, 701, 454, 452, 453, 448, 451, 459},
+		{27: 728, 50: 727},
+		{27: 24, 50: 24},
 		// 370
-		{12: 599, 202},
-		{12: 201, 201},
-		{3: 425, 6: 426, 11: 428, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 57: 427, 59: 429, 102: 424, 120: 598},
-		{199, 12: 199, 199},
-		{2: 422, 11: 421, 117: 597, 420, 121: 600},
+		{460, 429, 430, 417, 427, 428, 401, 398, 27, 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 383, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 371, 446, 68: 419, 418, 402, 420, 422, 421, 432, 426, 84: 433, 89: 434, 435, 461, 436, 437, 466, 465, 468, 463, 464, 467, 462, 27, 27, 438, 439, 440, 442, 441, 444, 443, 116: 445, 449, 121: 447, 450, 125: 458, 457, 454, 452, 453, 448, 451, 459, 138: 730},
+		{5: 482, 486, 483, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 481, 41: 484, 49: 485, 64: 487, 124: 729},
+		{27: 23, 50: 23},
+		{460, 429, 430, 417, 427, 428, 401, 398, 28, 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 383, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 371, 446, 68: 419, 418, 402, 420, 422, 421, 432, 426, 84: 433, 89: 434, 435, 461, 436, 437, 466, 465, 468, 463, 464, 467, 462, 28, 28, 438, 439, 440, 442, 441, 444, 443, 116: 445, 449, 121: 447, 450, 125: 458, 701, 454, 452, 453, 448, 451, 459},
+		{39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 26: 39, 29: 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 39, 45: 39, 39, 65: 39, 39, 91: 39, 94: 39, 39, 39, 39, 39, 39, 39, 39, 39},
 		// 375
-		{12: 200, 200},
-		{2: 205, 10: 205},
-		{2: 208},
-		{2: 422, 11: 421, 117: 597, 420, 121: 596, 139: 595, 604},
-		{13: 605},
+		{3: 417, 6: 469, 398, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 733, 421},
+		{3: 472, 7: 471, 9: 475, 474, 76: 734},
+		{3: 735, 6: 478, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 49: 477, 146: 480},
+		{5: 482, 486, 483, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 481, 41: 484, 49: 485, 64: 487, 110: 736, 124: 488},
+		{25: 737},
 		// 380
-		{11: 606},
-		{2: 207},
-		{608},
-		{62: 209, 102: 209, 105: 209, 209, 209, 209},
-		{610},
+		{24: 738},
+		{8: 30, 101: 30, 30, 178: 739},
+		{8: 740, 101: 721, 722, 177: 720},
+		{741},
+		{40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 26: 40, 29: 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 40, 45: 40, 40, 65: 40, 40, 91: 40, 94: 40, 40, 40, 40, 40, 40, 40, 40, 40},
 		// 385
-		{62: 210, 102: 210, 105: 210, 210, 210, 210},
-		{150: 612},
-		{10: 614, 151: 613},
-		{62: 215, 102: 215, 105: 215, 215, 215, 215},
-		{2: 422, 9: 615, 11: 421, 117: 597, 420, 121: 617, 145: 616},
+		{24: 743, 117: 744},
+		{460, 429, 430, 417, 427, 428, 401, 398, 745, 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 383, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 371, 446, 68: 419, 418, 402, 420, 422, 421, 432, 426, 84: 433, 89: 434, 435, 461, 436, 437, 466, 465, 468, 463, 464, 467, 462, 103: 438, 439, 440, 442, 441, 444, 443, 116: 445, 449, 121: 447, 450, 125: 458, 457, 454, 452, 453, 448, 451, 459, 138: 746},
+		{43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 26: 43, 29: 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 43, 45: 43, 43, 65: 43, 43, 91: 43, 94: 43, 43, 43, 43, 43, 43, 43, 43, 43},
+		{768, 143: 751, 148: 769, 752, 157: 770},
+		{460, 429, 430, 417, 427, 428, 401, 398, 747, 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 383, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 371, 446, 68: 419, 418, 402, 420, 422, 421, 432, 426, 84: 433, 89: 434, 435, 461, 436, 437, 466, 465, 468, 463, 464, 467, 462, 103: 438, 439, 440, 442, 441, 444, 443, 116: 445, 449, 121: 447, 450, 125: 458, 701, 454, 452, 453, 448, 451, 459},
 		// 390
-		{623},
-		{2: 422, 9: 619, 11: 421, 117: 597, 420, 121: 620},
-		{618},
-		{2: 212, 9: 212, 11: 212},
-		{622},
+		{748, 143: 751, 148: 750, 752, 157: 749},
+		{56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 26: 56, 29: 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 56, 45: 56, 56, 65: 56, 56, 91: 56, 94: 56, 56, 56, 56, 56, 56, 56, 56, 56, 110: 56, 56, 56, 56, 56, 56},
+		{765, 143: 751, 148: 764, 766},
+		{763},
+		{24: 754, 91: 753},
 		// 395
-		{621},
-		{2: 211, 9: 211, 11: 211},
-		{62: 213, 102: 213, 105: 213, 213, 213, 213},
-		{62: 214, 102: 214, 105: 214, 214, 214, 214},
-		{3: 425, 6: 426, 11: 428, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 57: 427, 59: 429, 102: 424, 120: 625},
+		{20, 143: 20},
+		{1: 429, 430, 417, 427, 428, 469, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 614, 421, 616, 615, 84: 433, 89: 434, 435, 92: 436, 437, 103: 438, 439, 440, 758, 441},
+		{460, 429, 430, 417, 427, 428, 401, 398, 756, 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 383, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 371, 446, 68: 419, 418, 402, 420, 422, 421, 432, 426, 84: 433, 89: 434, 435, 461, 436, 437, 466, 465, 468, 463, 464, 467, 462, 103: 438, 439, 440, 442, 441, 444, 443, 116: 445, 449, 121: 447, 450, 125: 458, 457, 454, 452, 453, 448, 451, 459, 138: 755},
+		{460, 429, 430, 417, 427, 428, 401, 398, 757, 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 383, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 371, 446, 68: 419, 418, 402, 420, 422, 421, 432, 426, 84: 433, 89: 434, 435, 461, 436, 437, 466, 465, 468, 463, 464, 467, 462, 103: 438, 439, 440, 442, 441, 444, 443, 116: 445, 449, 121: 447, 450, 125: 458, 701, 454, 452, 453, 448, 451, 459},
+		{17},
 		// 400
-		{626, 58: 627},
-		{62: 217, 102: 217, 105: 217, 217, 217, 217},
-		{1: 282, 273, 283, 284, 285, 257, 280, 279, 11: 323, 14: 244, 245, 247, 248, 250, 251, 252, 249, 246, 254, 255, 256, 253, 265, 261, 262, 263, 272, 271, 260, 266, 286, 264, 267, 269, 270, 268, 57: 278, 59: 258, 63: 275, 274, 277, 276, 287, 281, 81: 288, 289, 290, 93: 291, 292, 293, 294, 295, 297, 296, 434, 298, 137: 628},
-		{629},
-		{62: 216, 102: 216, 105: 216, 216, 216, 216},
+		{18},
+		{24: 759},
+		{460, 429, 430, 417, 427, 428, 401, 398, 761, 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 383, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 371, 446, 68: 419, 418, 402, 420, 422, 421, 432, 426, 84: 433, 89: 434, 435, 461, 436, 437, 466, 465, 468, 463, 464, 467, 462, 103: 438, 439, 440, 442, 441, 444, 443, 116: 445, 449, 121: 447, 450, 125: 458, 457, 454, 452, 453, 448, 451, 459, 138: 760},
+		{460, 429, 430, 417, 427, 428, 401, 398, 762, 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 383, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 371, 446, 68: 419, 418, 402, 420, 422, 421, 432, 426, 84: 433, 89: 434, 435, 461, 436, 437, 466, 465, 468, 463, 464, 467, 462, 103: 438, 439, 440, 442, 441, 444, 443, 116: 445, 449, 121: 447, 450, 125: 458, 701, 454, 452, 453, 448, 451, 459},
+		{21, 143: 21},
 		// 405
-		{62: 223, 102: 223, 105: 223, 223, 223, 223},
+		{22, 143: 22},
+		{48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 26: 48, 29: 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 48, 45: 48, 48, 65: 48, 48, 91: 48, 94: 48, 48, 48, 48, 48, 48, 48, 48, 48},
+		{767},
+		{46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 26: 46, 29: 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 46, 45: 46, 46, 65: 46, 46, 91: 46, 94: 46, 46, 46, 46, 46, 46, 46, 46, 46},
+		{19, 143: 19},
+		// 410
+		{49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 26: 49, 29: 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 49, 45: 49, 49, 65: 49, 49, 91: 49, 94: 49, 49, 49, 49, 49, 49, 49, 49, 49},
+		{57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 26: 57, 29: 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 57, 45: 57, 57, 65: 57, 57, 91: 57, 94: 57, 57, 57, 57, 57, 57, 57, 57, 57, 110: 57, 57, 57, 57, 57, 57},
+		{774},
+		{771, 143: 751, 148: 772, 766},
+		{45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 26: 45, 29: 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45, 45: 45, 45, 65: 45, 45, 91: 45, 94: 45, 45, 45, 45, 45, 45, 45, 45, 45},
+		// 415
+		{773},
+		{44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 26: 44, 29: 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 44, 45: 44, 44, 65: 44, 44, 91: 44, 94: 44, 44, 44, 44, 44, 44, 44, 44, 44},
+		{47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 26: 47, 29: 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 47, 45: 47, 47, 65: 47, 47, 91: 47, 94: 47, 47, 47, 47, 47, 47, 47, 47, 47},
+		{748},
+		{5: 243, 243, 243, 11: 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 243, 25: 243, 243, 41: 243, 60: 243},
+		// 420
+		{5: 242, 242, 242, 11: 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 25: 242, 242, 41: 242, 60: 242},
+		{3: 778, 6: 777, 141: 786, 776},
+		{5: 482, 486, 483, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 481, 41: 484, 49: 485, 64: 487, 124: 780},
+		{781, 67: 782},
+		{69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 26: 69, 29: 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 69, 45: 69, 69, 65: 69, 69, 91: 69, 94: 69, 69, 69, 69, 69, 69, 69, 69, 69},
+		// 425
+		{1: 429, 430, 417, 427, 428, 597, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 422, 421, 432, 426, 84: 433, 89: 434, 435, 92: 436, 437, 103: 438, 439, 440, 442, 441, 784, 443, 166: 783},
+		{785},
+		{66},
+		{68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 26: 68, 29: 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 68, 45: 68, 68, 65: 68, 68, 91: 68, 94: 68, 68, 68, 68, 68, 68, 68, 68, 68},
+		{25: 787},
+		// 430
+		{5: 241, 241, 241, 11: 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 241, 25: 241, 241, 41: 241, 60: 241},
+		{1: 429, 430, 417, 427, 428, 469, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 614, 421, 616, 615, 84: 433, 89: 434, 435, 92: 436, 437, 103: 438, 439, 793},
+		{1: 429, 430, 417, 427, 428, 597, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 422, 421, 432, 426, 84: 433, 89: 434, 435, 92: 436, 437, 103: 438, 439, 440, 442, 441, 444, 443, 116: 790},
+		{27: 627, 50: 791},
+		{1: 429, 430, 417, 427, 428, 469, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 614, 421, 616, 615, 84: 433, 89: 434, 435, 92: 436, 437, 103: 438, 439, 440, 792, 441},
+		// 435
+		{91, 8: 91, 24: 91, 91, 27: 91, 91, 50: 91, 60: 91},
+		{93, 8: 93, 24: 93, 93, 27: 93, 93, 43: 93, 794, 50: 93, 60: 93, 63: 93},
+		{1: 429, 430, 417, 427, 428, 469, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 614, 421, 616, 615, 84: 433, 89: 434, 435, 92: 436, 437, 103: 438, 795},
+		{95, 8: 95, 24: 95, 95, 27: 95, 95, 43: 95, 95, 47: 796, 50: 95, 60: 95, 63: 95},
+		{1: 429, 430, 417, 427, 428, 469, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 614, 421, 616, 615, 84: 433, 89: 434, 435, 92: 436, 437, 103: 797},
+		// 440
+		{97, 8: 97, 24: 97, 97, 27: 97, 97, 43: 97, 97, 47: 97, 798, 50: 97, 60: 97, 63: 97},
+		{1: 429, 430, 417, 427, 428, 469, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 614, 421, 616, 615, 84: 433, 89: 434, 435, 92: 436, 799},
+		{99, 4: 800, 8: 99, 24: 99, 99, 27: 99, 99, 43: 99, 99, 47: 99, 99, 50: 99, 60: 99, 63: 99},
+		{1: 429, 430, 417, 427, 428, 469, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 614, 421, 616, 615, 84: 433, 89: 434, 435, 92: 801},
+		{101, 4: 101, 8: 101, 24: 101, 101, 27: 101, 101, 43: 101, 101, 47: 101, 101, 50: 101, 802, 805, 807, 804, 806, 803, 60: 101, 63: 101},
+		// 445
+		{1: 429, 430, 417, 427, 428, 469, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 614, 421, 616, 615, 84: 433, 89: 434, 829},
+		{1: 429, 430, 417, 427, 428, 469, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 614, 421, 616, 615, 84: 433, 89: 434, 828},
+		{1: 429, 430, 417, 427, 428, 469, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 614, 421, 616, 615, 84: 433, 89: 434, 827},
+		{1: 429, 430, 417, 427, 428, 469, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 614, 421, 616, 615, 84: 433, 89: 434, 826},
+		{1: 429, 430, 417, 427, 428, 469, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 614, 421, 616, 615, 84: 433, 89: 434, 825},
+		// 450
+		{1: 429, 430, 417, 427, 428, 469, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 614, 421, 616, 615, 84: 433, 89: 434, 808},
+		{103, 4: 103, 8: 103, 24: 103, 103, 27: 103, 103, 43: 103, 103, 47: 103, 103, 50: 103, 103, 103, 103, 103, 103, 103, 811, 809, 810, 103, 63: 103},
+		{1: 429, 430, 417, 427, 428, 469, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 614, 421, 616, 615, 84: 433, 89: 824},
+		{1: 429, 430, 417, 427, 428, 469, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 614, 421, 616, 615, 84: 433, 89: 823},
+		{1: 429, 430, 417, 427, 428, 469, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 614, 421, 616, 615, 84: 433, 89: 812},
+		// 455
+		{110, 813, 814, 4: 110, 8: 110, 24: 110, 110, 27: 110, 110, 43: 110, 110, 47: 110, 110, 50: 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 110, 63: 110},
+		{1: 429, 430, 417, 427, 428, 469, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 614, 421, 616, 615, 84: 822},
+		{1: 429, 430, 417, 427, 428, 469, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 614, 421, 616, 615, 84: 815},
+		{114, 114, 114, 4: 114, 816, 8: 114, 24: 114, 114, 27: 114, 114, 43: 114, 114, 47: 114, 114, 50: 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 114, 817, 818, 114},
+		{1: 429, 430, 417, 427, 428, 469, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 614, 421, 821, 615},
+		// 460
+		{1: 429, 430, 417, 427, 428, 469, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 614, 421, 820, 615},
+		{1: 429, 430, 417, 427, 428, 469, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 614, 421, 819, 615},
+		{117, 117, 117, 4: 117, 117, 8: 117, 24: 117, 117, 27: 117, 117, 43: 117, 117, 47: 117, 117, 50: 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117, 117},
+		{118, 118, 118, 4: 118, 118, 8: 118, 24: 118, 118, 27: 118, 118, 43: 118, 118, 47: 118, 118, 50: 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118, 118},
+		{119, 119, 119, 4: 119, 119, 8: 119, 24: 119, 119, 27: 119, 119, 43: 119, 119, 47: 119, 119, 50: 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119, 119},
+		// 465
+		{115, 115, 115, 4: 115, 816, 8: 115, 24: 115, 115, 27: 115, 115, 43: 115, 115, 47: 115, 115, 50: 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 115, 817, 818, 115},
+		{111, 813, 814, 4: 111, 8: 111, 24: 111, 111, 27: 111, 111, 43: 111, 111, 47: 111, 111, 50: 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 111, 63: 111},
+		{112, 813, 814, 4: 112, 8: 112, 24: 112, 112, 27: 112, 112, 43: 112, 112, 47: 112, 112, 50: 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 112, 63: 112},
+		{104, 4: 104, 8: 104, 24: 104, 104, 27: 104, 104, 43: 104, 104, 47: 104, 104, 50: 104, 104, 104, 104, 104, 104, 104, 811, 809, 810, 104, 63: 104},
+		{105, 4: 105, 8: 105, 24: 105, 105, 27: 105, 105, 43: 105, 105, 47: 105, 105, 50: 105, 105, 105, 105, 105, 105, 105, 811, 809, 810, 105, 63: 105},
+		// 470
+		{106, 4: 106, 8: 106, 24: 106, 106, 27: 106, 106, 43: 106, 106, 47: 106, 106, 50: 106, 106, 106, 106, 106, 106, 106, 811, 809, 810, 106, 63: 106},
+		{107, 4: 107, 8: 107, 24: 107, 107, 27: 107, 107, 43: 107, 107, 47: 107, 107, 50: 107, 107, 107, 107, 107, 107, 107, 811, 809, 810, 107, 63: 107},
+		{108, 4: 108, 8: 108, 24: 108, 108, 27: 108, 108, 43: 108, 108, 47: 108, 108, 50: 108, 108, 108, 108, 108, 108, 108, 811, 809, 810, 108, 63: 108},
+		{1: 429, 430, 417, 427, 428, 597, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 422, 421, 432, 426, 84: 433, 89: 434, 435, 92: 436, 437, 103: 438, 439, 440, 442, 441, 682, 443},
+		{170, 170, 170, 170, 170, 170, 7: 170, 170, 170, 170, 24: 832, 170, 27: 170, 170, 43: 170, 170, 47: 170, 170, 50: 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 170, 67: 170, 76: 170, 170, 170, 170, 170, 170, 170, 170, 85: 170, 170, 170, 170},
+		// 475
+		{6: 607, 8: 210, 27: 210, 155: 833},
+		{8: 834, 27: 609},
+		{88, 8: 88, 24: 88, 88, 27: 88, 88, 50: 88, 60: 88},
+		{127, 127, 127, 4: 127, 127, 8: 127, 24: 127, 127, 27: 127, 127, 43: 127, 127, 47: 127, 127, 50: 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 127, 67: 127, 77: 127, 127, 127, 127, 127, 127, 127, 85: 127, 127, 127, 127},
+		{128, 128, 128, 4: 128, 128, 8: 128, 24: 128, 128, 27: 128, 128, 43: 128, 128, 47: 128, 128, 50: 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 128, 67: 128, 77: 128, 128, 128, 128, 128, 128, 128, 85: 128, 128, 128, 128},
+		// 480
+		{6: 478, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 49: 477, 146: 841},
+		{1: 429, 430, 417, 427, 428, 597, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 422, 421, 432, 426, 84: 433, 89: 434, 435, 92: 436, 437, 103: 438, 439, 440, 442, 441, 839, 443},
+		{25: 840},
+		{139, 139, 139, 139, 139, 139, 7: 139, 139, 139, 139, 24: 139, 139, 27: 139, 139, 43: 139, 139, 47: 139, 139, 50: 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 139, 67: 139, 76: 139, 139, 139, 139, 139, 139, 139, 139, 85: 139, 139, 139, 139},
+		{140, 140, 140, 140, 140, 140, 7: 140, 140, 140, 140, 24: 140, 140, 27: 140, 140, 43: 140, 140, 47: 140, 140, 50: 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 140, 67: 140, 76: 140, 140, 140, 140, 140, 140, 140, 140, 85: 140, 140, 140, 140},
+		// 485
+		{3: 479, 6: 843, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 49: 477, 146: 480},
+		{149, 149, 149, 149, 149, 149, 7: 149, 149, 149, 149, 24: 844, 149, 27: 149, 149, 43: 149, 149, 47: 149, 149, 50: 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 149, 67: 149, 76: 149, 149, 149, 149, 149, 149, 149, 149, 85: 149, 149, 149, 149},
+		{6: 607, 8: 210, 27: 210, 155: 845},
+		{8: 846, 27: 609},
+		{87, 8: 87, 24: 87, 87, 27: 87, 87, 50: 87, 60: 87},
+		// 490
+		{25: 848, 27: 627},
+		{154, 154, 154, 154, 154, 154, 7: 154, 154, 154, 154, 24: 154, 154, 27: 154, 154, 43: 154, 154, 47: 154, 154, 50: 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 154, 67: 154, 76: 154, 154, 154, 154, 154, 154, 154, 154, 85: 154, 154, 154, 154},
+		{6: 850, 8: 174, 150: 851, 186: 852, 853},
+		{3: 858},
+		{857},
+		// 495
+		{6: 850, 8: 173, 150: 855},
+		{8: 854},
+		{168, 168, 168, 168, 168, 168, 7: 168, 168, 168, 168, 24: 168, 168, 27: 168, 168, 43: 168, 168, 47: 168, 168, 50: 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 168, 67: 168, 76: 168, 168, 168, 168, 168, 168, 168, 168, 85: 168, 168, 168, 168},
+		{856},
+		{6: 175, 8: 175},
+		// 500
+		{6: 176, 8: 176},
+		{6: 859, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 29: 860, 49: 861, 150: 863, 185: 862},
+		{3: 858, 25: 183, 27: 183},
+		{25: 182, 27: 182},
+		{76: 872},
+		// 505
+		{25: 869, 27: 868},
+		{25: 864, 27: 865},
+		{178, 25: 178, 27: 178},
+		{6: 850, 150: 866},
+		{25: 867},
+		// 510
+		{177, 25: 177, 27: 177},
+		{6: 870},
+		{179, 25: 179, 27: 179},
+		{25: 871},
+		{180, 25: 180, 27: 180},
+		// 515
+		{6: 873},
+		{25: 181, 27: 181},
+		{460, 429, 430, 417, 427, 428, 401, 398, 876, 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 383, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 371, 446, 68: 419, 418, 402, 420, 422, 421, 432, 426, 84: 433, 89: 434, 435, 461, 436, 437, 466, 465, 468, 463, 464, 467, 462, 103: 438, 439, 440, 442, 441, 444, 443, 116: 445, 449, 121: 447, 450, 125: 458, 457, 454, 452, 453, 448, 451, 459, 138: 877},
+		{169, 169, 169, 169, 169, 169, 7: 169, 169, 169, 169, 24: 169, 169, 27: 169, 169, 43: 169, 169, 47: 169, 169, 50: 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 169, 67: 169, 76: 169, 169, 169, 169, 169, 169, 169, 169, 85: 169, 169, 169, 169},
+		{256, 256, 256, 256, 256, 256, 7: 256, 256, 256, 256, 24: 256, 256, 27: 256, 256, 43: 256, 256, 47: 256, 256, 50: 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 256, 67: 256, 76: 256, 256, 256, 256, 256, 256, 256, 256, 85: 256, 256, 256, 256},
+		// 520
+		{460, 429, 430, 417, 427, 428, 401, 398, 878, 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 383, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 371, 446, 68: 419, 418, 402, 420, 422, 421, 432, 426, 84: 433, 89: 434, 435, 461, 436, 437, 466, 465, 468, 463, 464, 467, 462, 103: 438, 439, 440, 442, 441, 444, 443, 116: 445, 449, 121: 447, 450, 125: 458, 701, 454, 452, 453, 448, 451, 459},
+		{255, 255, 255, 255, 255, 255, 7: 255, 255, 255, 255, 24: 255, 255, 27: 255, 255, 43: 255, 255, 47: 255, 255, 50: 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 255, 67: 255, 76: 255, 255, 255, 255, 255, 255, 255, 255, 85: 255, 255, 255, 255},
+		{460, 429, 430, 417, 427, 428, 401, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 383, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 371, 446, 68: 419, 418, 402, 420, 422, 421, 432, 426, 84: 433, 89: 434, 435, 461, 436, 437, 466, 465, 468, 463, 464, 467, 462, 103: 438, 439, 440, 442, 441, 444, 443, 116: 445, 449, 121: 447, 450, 125: 458, 880, 454, 452, 453, 448, 451, 459},
+		{58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 26: 58, 29: 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 58, 45: 58, 58, 65: 58, 58, 91: 58, 94: 58, 58, 58, 58, 58, 58, 58, 58, 58},
+		{5: 482, 486, 483, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 481, 41: 484, 49: 485, 64: 487, 124: 882},
+		// 525
+		{28: 883},
+		{6: 885, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 49: 884},
+		{24: 898},
+		{24: 886},
+		{1: 429, 430, 417, 427, 428, 597, 398, 889, 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 422, 421, 432, 426, 84: 433, 89: 434, 435, 92: 436, 437, 103: 438, 439, 440, 442, 441, 887, 443, 169: 888},
+		// 530
+		{50: 896},
+		{8: 891, 27: 890},
+		{184, 184, 184, 184, 184, 184, 7: 184, 184, 184, 184, 24: 184, 184, 27: 184, 184, 43: 184, 184, 47: 184, 184, 50: 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 184, 67: 184, 76: 184, 184, 184, 184, 184, 184, 184, 184, 85: 184, 184, 184, 184},
+		{1: 429, 430, 417, 427, 428, 597, 398, 893, 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 422, 421, 432, 426, 84: 433, 89: 434, 435, 92: 436, 437, 103: 438, 439, 440, 442, 441, 892, 443},
+		{186, 186, 186, 186, 186, 186, 7: 186, 186, 186, 186, 24: 186, 186, 27: 186, 186, 43: 186, 186, 47: 186, 186, 50: 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 186, 67: 186, 76: 186, 186, 186, 186, 186, 186, 186, 186, 85: 186, 186, 186, 186},
+		// 535
+		{50: 894},
+		{185, 185, 185, 185, 185, 185, 7: 185, 185, 185, 185, 24: 185, 185, 27: 185, 185, 43: 185, 185, 47: 185, 185, 50: 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 185, 67: 185, 76: 185, 185, 185, 185, 185, 185, 185, 185, 85: 185, 185, 185, 185},
+		{1: 429, 430, 417, 427, 428, 597, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 422, 421, 432, 426, 84: 433, 89: 434, 435, 92: 436, 437, 103: 438, 439, 440, 442, 441, 895, 443},
+		{8: 190, 27: 190},
+		{1: 429, 430, 417, 427, 428, 597, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 422, 421, 432, 426, 84: 433, 89: 434, 435, 92: 436, 437, 103: 438, 439, 440, 442, 441, 897, 443},
+		// 540
+		{8: 191, 27: 191},
+		{1: 429, 430, 417, 427, 428, 597, 398, 900, 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 422, 421, 432, 426, 84: 433, 89: 434, 435, 92: 436, 437, 103: 438, 439, 440, 442, 441, 887, 443, 169: 899},
+		{8: 902, 27: 901},
+		{187, 187, 187, 187, 187, 187, 7: 187, 187, 187, 187, 24: 187, 187, 27: 187, 187, 43: 187, 187, 47: 187, 187, 50: 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 187, 67: 187, 76: 187, 187, 187, 187, 187, 187, 187, 187, 85: 187, 187, 187, 187},
+		{1: 429, 430, 417, 427, 428, 597, 398, 903, 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 422, 421, 432, 426, 84: 433, 89: 434, 435, 92: 436, 437, 103: 438, 439, 440, 442, 441, 892, 443},
+		// 545
+		{189, 189, 189, 189, 189, 189, 7: 189, 189, 189, 189, 24: 189, 189, 27: 189, 189, 43: 189, 189, 47: 189, 189, 50: 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 189, 67: 189, 76: 189, 189, 189, 189, 189, 189, 189, 189, 85: 189, 189, 189, 189},
+		{188, 188, 188, 188, 188, 188, 7: 188, 188, 188, 188, 24: 188, 188, 27: 188, 188, 43: 188, 188, 47: 188, 188, 50: 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 188, 67: 188, 76: 188, 188, 188, 188, 188, 188, 188, 188, 85: 188, 188, 188, 188},
+		{24: 916},
+		{24: 906},
+		{1: 429, 430, 417, 427, 428, 597, 398, 910, 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 908, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 422, 421, 432, 426, 84: 433, 89: 434, 435, 92: 436, 437, 103: 438, 439, 440, 442, 441, 907, 443, 152: 909},
+		// 550
+		{8: 207, 27: 207},
+		{1: 429, 430, 417, 427, 428, 597, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 908, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 422, 421, 432, 426, 84: 433, 89: 434, 435, 92: 436, 437, 103: 438, 439, 440, 442, 441, 907, 443, 152: 914},
+		{8: 912, 27: 911},
+		{197, 197, 197, 197, 197, 197, 7: 197, 197, 197, 197, 24: 197, 197, 27: 197, 197, 43: 197, 197, 47: 197, 197, 50: 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 197, 67: 197, 76: 197, 197, 197, 197, 197, 197, 197, 197, 85: 197, 197, 197, 197},
+		{1: 429, 430, 417, 427, 428, 597, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 422, 421, 432, 426, 84: 433, 89: 434, 435, 92: 436, 437, 103: 438, 439, 440, 442, 441, 913, 443},
+		// 555
+		{198, 198, 198, 198, 198, 198, 7: 198, 198, 198, 198, 24: 198, 198, 27: 198, 198, 43: 198, 198, 47: 198, 198, 50: 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 198, 67: 198, 76: 198, 198, 198, 198, 198, 198, 198, 198, 85: 198, 198, 198, 198},
+		{8: 205, 27: 205},
+		{8: 915, 27: 911},
+		{8: 206, 27: 206},
+		{1: 429, 430, 417, 427, 428, 597, 398, 918, 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 908, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 422, 421, 432, 426, 84: 433, 89: 434, 435, 92: 436, 437, 103: 438, 439, 440, 442, 441, 907, 443, 152: 917},
+		// 560
+		{8: 919, 27: 911},
+		{199, 199, 199, 199, 199, 199, 7: 199, 199, 199, 199, 24: 199, 199, 27: 199, 199, 43: 199, 199, 47: 199, 199, 50: 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 199, 67: 199, 76: 199, 199, 199, 199, 199, 199, 199, 199, 85: 199, 199, 199, 199},
+		{200, 200, 200, 200, 200, 200, 7: 200, 200, 200, 200, 24: 200, 200, 27: 200, 200, 43: 200, 200, 47: 200, 200, 50: 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 200, 67: 200, 76: 200, 200, 200, 200, 200, 200, 200, 200, 85: 200, 200, 200, 200},
+		{6: 922, 921, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 49: 923, 68: 924},
+		{28: 920},
+		// 565
+		{24: 929},
+		{24: 925},
+		{192, 192, 192, 192, 192, 192, 7: 192, 192, 192, 192, 24: 192, 192, 27: 192, 192, 43: 192, 192, 47: 192, 192, 50: 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 192, 67: 192, 76: 192, 192, 192, 192, 192, 192, 192, 192, 85: 192, 192, 192, 192},
+		{1: 429, 430, 417, 427, 428, 597, 398, 927, 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 422, 421, 432, 426, 84: 433, 89: 434, 435, 92: 436, 437, 103: 438, 439, 440, 442, 441, 600, 443, 151: 926},
+		{8: 928, 27: 603},
+		// 570
+		{193, 193, 193, 193, 193, 193, 7: 193, 193, 193, 193, 24: 193, 193, 27: 193, 193, 43: 193, 193, 47: 193, 193, 50: 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 193, 67: 193, 76: 193, 193, 193, 193, 193, 193, 193, 193, 85: 193, 193, 193, 193},
+		{194, 194, 194, 194, 194, 194, 7: 194, 194, 194, 194, 24: 194, 194, 27: 194, 194, 43: 194, 194, 47: 194, 194, 50: 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 194, 67: 194, 76: 194, 194, 194, 194, 194, 194, 194, 194, 85: 194, 194, 194, 194},
+		{1: 429, 430, 417, 427, 428, 597, 398, 931, 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 422, 421, 432, 426, 84: 433, 89: 434, 435, 92: 436, 437, 103: 438, 439, 440, 442, 441, 600, 443, 151: 930},
+		{8: 932, 27: 603},
+		{195, 195, 195, 195, 195, 195, 7: 195, 195, 195, 195, 24: 195, 195, 27: 195, 195, 43: 195, 195, 47: 195, 195, 50: 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 195, 67: 195, 76: 195, 195, 195, 195, 195, 195, 195, 195, 85: 195, 195, 195, 195},
+		// 575
+		{196, 196, 196, 196, 196, 196, 7: 196, 196, 196, 196, 24: 196, 196, 27: 196, 196, 43: 196, 196, 47: 196, 196, 50: 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 196, 67: 196, 76: 196, 196, 196, 196, 196, 196, 196, 196, 85: 196, 196, 196, 196},
+		{3: 379, 24: 258, 145: 934},
+		{24: 257},
+		{26: 251, 65: 251, 251, 110: 251, 251, 251, 251, 251, 251},
+		{254, 3: 254, 5: 254, 254, 254, 11: 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 254, 26: 254, 41: 254},
+		// 580
+		{25: 946},
+		{25: 250, 27: 944},
+		{25: 249, 27: 249},
+		{5: 482, 486, 483, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 481, 41: 484, 49: 485, 60: 942, 64: 487, 124: 941},
+		{247, 25: 247, 27: 247},
+		// 585
+		{5: 482, 486, 483, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 481, 41: 484, 49: 485, 64: 487, 124: 943},
+		{246, 25: 246, 27: 246},
+		{3: 778, 6: 777, 141: 940, 776, 147: 945},
+		{25: 248, 27: 248},
+		{253, 3: 253, 5: 253, 253, 253, 11: 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 253, 26: 253, 41: 253},
+		// 590
+		{3: 260},
+		{3: 778, 6: 777, 141: 940, 776, 147: 939, 171: 938, 949},
+		{25: 950},
+		{6: 951},
+		{3: 259},
+		// 595
+		{953},
+		{26: 261, 65: 261, 261, 110: 261, 261, 261, 261, 261, 261},
+		{955},
+		{26: 262, 65: 262, 262, 110: 262, 262, 262, 262, 262, 262},
+		{5: 482, 486, 483, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 481, 41: 484, 49: 485, 64: 487, 67: 959, 124: 958, 188: 960, 190: 957},
+		// 600
+		{24: 979, 191: 978},
+		{977},
+		{5: 482, 486, 483, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 481, 41: 484, 49: 485, 64: 487, 124: 975},
+		{24: 961},
+		{6: 965, 8: 962, 168: 964, 189: 963},
+		// 605
+		{974},
+		{6: 965, 8: 970, 168: 971},
+		{969},
+		{3: 379, 145: 966},
+		{273, 3: 379, 5: 482, 486, 483, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 481, 41: 484, 49: 485, 64: 487, 124: 968, 145: 967},
+		// 610
+		{272},
+		{271},
+		{6: 275, 8: 275},
+		{973},
+		{972},
+		// 615
+		{6: 274, 8: 274},
+		{26: 276, 65: 276, 276, 110: 276, 276, 276, 276, 276, 276},
+		{26: 277, 65: 277, 277, 110: 277, 277, 277, 277, 277, 277},
+		{976},
+		{26: 278, 65: 278, 278, 110: 278, 278, 278, 278, 278, 278},
+		// 620
+		{26: 279, 65: 279, 279, 110: 279, 279, 279, 279, 279, 279},
+		{26: 280, 65: 280, 280, 110: 280, 280, 280, 280, 280, 280},
+		{3: 778, 6: 983, 8: 980, 141: 940, 776, 147: 982, 183: 981},
+		{998},
+		{3: 778, 6: 991, 8: 989, 141: 940, 776, 147: 990},
+		// 625
+		{988},
+		{984, 5: 242, 242, 242, 11: 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 26: 242, 41: 242, 60: 242, 76: 985},
+		{3: 266, 6: 266, 8: 266},
+		{6: 986},
+		{987},
+		// 630
+		{3: 265, 6: 265, 8: 265},
+		{3: 268, 6: 268, 8: 268},
+		{997},
+		{996},
+		{992, 5: 242, 242, 242, 11: 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 242, 26: 242, 41: 242, 60: 242, 76: 993},
+		// 635
+		{3: 264, 6: 264, 8: 264},
+		{6: 994},
+		{995},
+		{3: 263, 6: 263, 8: 263},
+		{3: 267, 6: 267, 8: 267},
+		// 640
+		{26: 269, 65: 269, 269, 110: 269, 269, 269, 269, 269, 269},
+		{26: 270, 65: 270, 270, 110: 270, 270, 270, 270, 270, 270},
+		{24: 1000},
+		{6: 1001, 159: 1002},
+		{286, 8: 286, 27: 286},
+		// 645
+		{1005, 8: 1006, 27: 1004, 160: 1003},
+		{6: 1008, 8: 1009},
+		{6: 284, 8: 284},
+		{6: 283, 8: 283},
+		{1007},
+		// 650
+		{26: 282, 65: 282, 282, 110: 282, 282, 282, 282, 282, 282},
+		{285, 8: 285, 27: 285},
+		{1010},
+		{26: 281, 65: 281, 281, 110: 281, 281, 281, 281, 281, 281},
+		{24: 1012},
+		// 655
+		{6: 1001, 159: 1013},
+		{1005, 8: 1014, 27: 1004, 160: 1015},
+		{1018},
+		{6: 1008, 8: 1016},
+		{1017},
+		// 660
+		{26: 287, 65: 287, 287, 110: 287, 287, 287, 287, 287, 287},
+		{26: 288, 65: 288, 288, 110: 288, 288, 288, 288, 288, 288},
+		{342, 342, 342, 342, 342, 342, 342, 342, 342, 342, 342, 342, 342, 342, 342, 342, 342, 342, 342, 342, 342, 342, 342, 342, 342, 26: 342, 29: 342, 342, 342, 342, 342, 342, 342, 342, 342, 342, 342, 342, 342, 342, 45: 342, 342, 65: 342, 342, 91: 342, 94: 342, 342, 342, 342, 342, 342, 342, 342, 342, 110: 342, 342, 342, 342, 342, 342},
+		{6: 1021, 154: 1030, 181: 1029},
+		{11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 49: 1023, 67: 1022},
+		// 665
+		{1: 509, 510, 506, 6: 493, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 29: 498, 494, 495, 496, 505, 504, 499, 497, 500, 502, 503, 501, 42: 511, 49: 507, 118: 508, 512, 513, 123: 514, 133: 515, 135: 516, 517, 518, 139: 519, 520, 144: 1027},
+		{67: 1024},
+		{1: 509, 510, 506, 6: 493, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 29: 498, 494, 495, 496, 505, 504, 499, 497, 500, 502, 503, 501, 42: 511, 49: 507, 118: 508, 512, 513, 123: 514, 133: 515, 135: 516, 517, 518, 139: 519, 520, 144: 1025},
+		{1026, 43: 522},
+		{337, 337, 337, 337, 337, 337, 337, 337, 337, 337, 337, 337, 337, 337, 337, 337, 337, 337, 337, 337, 337, 337, 337, 337, 337, 337, 337, 29: 337, 337, 337, 337, 337, 337, 337, 337, 337, 337, 337, 337, 337, 337, 45: 337, 337, 65: 337, 337, 91: 337, 94: 337, 337, 337, 337, 337, 337, 337, 337, 337, 110: 337, 337, 337, 337, 337, 337},
+		// 670
+		{1028, 43: 522},
+		{338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 29: 338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 338, 45: 338, 338, 65: 338, 338, 91: 338, 94: 338, 338, 338, 338, 338, 338, 338, 338, 338, 110: 338, 338, 338, 338, 338, 338},
+		{6: 1021, 25: 1031, 154: 1032},
+		{6: 340, 25: 340},
+		{1033},
+		// 675
+		{6: 339, 25: 339},
+		{341, 341, 341, 341, 341, 341, 341, 341, 341, 341, 341, 341, 341, 341, 341, 341, 341, 341, 341, 341, 341, 341, 341, 341, 341, 26: 341, 29: 341, 341, 341, 341, 341, 341, 341, 341, 341, 341, 341, 341, 341, 341, 45: 341, 341, 65: 341, 341, 91: 341, 94: 341, 341, 341, 341, 341, 341, 341, 341, 341, 110: 341, 341, 341, 341, 341, 341},
+		{5: 482, 486, 483, 11: 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 481, 41: 484, 49: 485, 64: 487, 124: 1035},
+		{1036, 67: 1037},
+		{26: 344, 65: 344, 344, 110: 344, 344, 344, 344, 344, 344},
+		// 680
+		{1: 429, 430, 417, 427, 428, 597, 398, 9: 425, 424, 385, 386, 388, 389, 391, 392, 393, 390, 387, 395, 396, 397, 394, 26: 384, 29: 409, 404, 405, 407, 416, 415, 410, 408, 411, 413, 414, 412, 400, 431, 45: 403, 406, 49: 423, 64: 399, 68: 419, 418, 402, 420, 422, 421, 432, 426, 84: 433, 89: 434, 435, 92: 436, 437, 103: 438, 439, 440, 442, 441, 784, 443, 166: 1038},
+		{1039},
+		{26: 343, 65: 343, 343, 110: 343, 343, 343, 343, 343, 343},
+		{26: 355, 65: 355, 355, 110: 355, 355, 355, 355, 355, 355},
 	}
 )
 
@@ -1421,9 +1980,9 @@ func yylex1(yylex yyLexer, lval *yySymType) (n int) {
 	}
 	return n
 }
-
+	
 func yyParse(yylex yyLexer) int {
-	const yyError = 173
+	const yyError = 213
 
 	yyEx, _ := yylex.(yyLexerEx)
 	var yyn int
@@ -1431,9 +1990,10 @@ func yyParse(yylex yyLexer) int {
 	var yyVAL yySymType
 	yyS := make([]yySymType, 200)
 
+
 	Nerrs := 0   /* number of errors */
 	Errflag := 0 /* error recovery flag */
-	yyerrok := func() {
+	yyerrok := func() { 
 		if yyDebug >= 2 {
 			__yyfmt__.Printf("yyerrok()\n")
 		}
@@ -1553,7 +2113,7 @@ yynewstate:
 			for yyp >= 0 {
 				row := yyParseTab[yyS[yyp].yys]
 				if yyError < len(row) {
-					yyn = int(row[yyError]) + yyTabOfs
+					yyn = int(row[yyError])+yyTabOfs
 					if yyn > 0 { // hit
 						if yyDebug >= 2 {
 							__yyfmt__.Printf("error recovery found error shift in state %d\n", yyS[yyp].yys)
@@ -1604,112 +2164,273 @@ yynewstate:
 
 	/* consult goto table to find next state */
 	exState := yystate
-	yystate = int(yyParseTab[yyS[yyp].yys][x]) + yyTabOfs
+	yystate = int(yyParseTab[yyS[yyp].yys][x])+yyTabOfs
 	/* reduction by production r */
 	if yyDebug >= 2 {
 		__yyfmt__.Printf("reduce using rule %v (%s), and goto state %d\n", r, yySymNames[x], yystate)
 	}
 
 	switch r {
-	case 8:
-		{
+	case 13: {
 			actions.DeclareGlobal(yyS[yypt-2].argument, yyS[yypt-1].argument, nil, false)
-		}
-	case 9:
-		{
+                }
+	case 14: {
 			actions.DeclareGlobal(yyS[yypt-4].argument, yyS[yypt-3].argument, nil, false)
-		}
-	case 10:
-		{
+                }
+	case 19: {
+			actions.DeclareConstant(yyS[yypt-3].tok, yyS[yypt-3].line, constants.TYPE_UNDEFINED, yyS[yypt-1].constant)
+                }
+	case 20: {
+			actions.DeclareConstant(yyS[yypt-4].tok, yyS[yypt-4].line, yyS[yypt-3].i, yyS[yypt-1].constant)
+                }
+	case 21: {
+			yyVAL.constant = actions.ConstantIdentifier("", yyS[yypt-0].tok)
+                }
+	case 22: {
+			yyVAL.constant = actions.ConstantIdentifier(yyS[yypt-2].tok, yyS[yypt-0].tok)
+                }
+	case 23: {
+			yyVAL.constant = actions.ConstantStr(yyS[yypt-0].tok)
+                }
+	case 24: {
+			yyVAL.constant = actions.ConstantBool(yyS[yypt-0].bool)
+                }
+	case 25: {
+			yyVAL.constant = actions.ConstantInt(constants.TYPE_I8, int64(yyS[yypt-0].i8))
+                }
+	case 26: {
+			yyVAL.constant = actions.ConstantInt(constants.TYPE_I16, int64(yyS[yypt-0].i16))
+                }
+	case 27: {
+			yyVAL.constant = actions.ConstantInt(constants.TYPE_I32, int64(yyS[yypt-0].i32))
+                }
+	case 28: {
+			yyVAL.constant = actions.ConstantInt(constants.TYPE_I64, int64(yyS[yypt-0].i64))
+                }
+	case 29: {
+			yyVAL.constant = actions.ConstantInt(constants.TYPE_UI8, int64(yyS[yypt-0].ui8))
+                }
+	case 30: {
+			yyVAL.constant = actions.ConstantInt(constants.TYPE_UI16, int64(yyS[yypt-0].ui16))
+                }
+	case 31: {
+			yyVAL.constant = actions.ConstantInt(constants.TYPE_UI32, int64(yyS[yypt-0].ui32))
+                }
+	case 32: {
+			yyVAL.constant = actions.ConstantInt(constants.TYPE_UI64, int64(yyS[yypt-0].ui64))
+                }
+	case 33: {
+			yyVAL.constant = actions.ConstantFloat(constants.TYPE_F32, float64(yyS[yypt-0].f32))
+                }
+	case 34: {
+			yyVAL.constant = actions.ConstantFloat(constants.TYPE_F64, yyS[yypt-0].f64)
+                }
+	case 35: {
+			yyVAL.constant = yyS[yypt-1].constant
+                }
+	case 36: {
+			yyVAL.constant = actions.ConstantConversion(yyS[yypt-3].i, yyS[yypt-1].constant)
+                }
+	case 38: {
+			yyVAL.constant = yyS[yypt-0].constant
+                }
+	case 39: {
+			yyVAL.constant = actions.ConstantUnary(constants.OP_NEG, yyS[yypt-0].constant)
+                }
+	case 40: {
+			yyVAL.constant = actions.ConstantUnary(constants.OP_BOOL_NOT, yyS[yypt-0].constant)
+                }
+	case 42: {
+			yyVAL.constant = actions.ConstantBinary(yyS[yypt-2].constant, yyS[yypt-0].constant, constants.OP_MUL)
+                }
+	case 43: {
+			yyVAL.constant = actions.ConstantBinary(yyS[yypt-2].constant, yyS[yypt-0].constant, constants.OP_DIV)
+                }
+	case 44: {
+			yyVAL.constant = actions.ConstantBinary(yyS[yypt-2].constant, yyS[yypt-0].constant, constants.OP_MOD)
+                }
+	case 46: {
+			yyVAL.constant = actions.ConstantBinary(yyS[yypt-2].constant, yyS[yypt-0].constant, constants.OP_ADD)
+                }
+	case 47: {
+			yyVAL.constant = actions.ConstantBinary(yyS[yypt-2].constant, yyS[yypt-0].constant, constants.OP_SUB)
+                }
+	case 49: {
+			yyVAL.constant = actions.ConstantBinary(yyS[yypt-2].constant, yyS[yypt-0].constant, constants.OP_BITSHL)
+                }
+	case 50: {
+			yyVAL.constant = actions.ConstantBinary(yyS[yypt-2].constant, yyS[yypt-0].constant, constants.OP_BITSHR)
+                }
+	case 51: {
+			yyVAL.constant = actions.ConstantBinary(yyS[yypt-2].constant, yyS[yypt-0].constant, constants.OP_BITCLEAR)
+                }
+	case 53: {
+			yyVAL.constant = actions.ConstantBinary(yyS[yypt-2].constant, yyS[yypt-0].constant, constants.OP_EQUAL)
+                }
+	case 54: {
+			yyVAL.constant = actions.ConstantBinary(yyS[yypt-2].constant, yyS[yypt-0].constant, constants.OP_UNEQUAL)
+                }
+	case 55: {
+			yyVAL.constant = actions.ConstantBinary(yyS[yypt-2].constant, yyS[yypt-0].constant, constants.OP_LT)
+                }
+	case 56: {
+			yyVAL.constant = actions.ConstantBinary(yyS[yypt-2].constant, yyS[yypt-0].constant, constants.OP_GT)
+                }
+	case 57: {
+			yyVAL.constant = actions.ConstantBinary(yyS[yypt-2].constant, yyS[yypt-0].constant, constants.OP_LTEQ)
+                }
+	case 58: {
+			yyVAL.constant = actions.ConstantBinary(yyS[yypt-2].constant, yyS[yypt-0].constant, constants.OP_GTEQ)
+                }
+	case 60: {
+			yyVAL.constant = actions.ConstantBinary(yyS[yypt-2].constant, yyS[yypt-0].constant, constants.OP_BITAND)
+                }
+	case 62: {
+			yyVAL.constant = actions.ConstantBinary(yyS[yypt-2].constant, yyS[yypt-0].constant, constants.OP_BITXOR)
+                }
+	case 64: {
+			yyVAL.constant = actions.ConstantBinary(yyS[yypt-2].constant, yyS[yypt-0].constant, constants.OP_BITOR)
+                }
+	case 66: {
+			yyVAL.constant = actions.ConstantBinary(yyS[yypt-2].constant, yyS[yypt-0].constant, constants.OP_BOOL_AND)
+                }
+	case 68: {
+			yyVAL.constant = actions.ConstantBinary(yyS[yypt-2].constant, yyS[yypt-0].constant, constants.OP_BOOL_OR)
+                }
+	case 69: {
+			actions.DeclareEnum(yyS[yypt-4].tok, yyS[yypt-4].line, yyS[yypt-2].stringA)
+                }
+	case 70: {
+			actions.DeclareEnum(yyS[yypt-5].tok, yyS[yypt-5].line, yyS[yypt-3].stringA)
+                }
+	case 71: {
+			yyVAL.stringA = []string{yyS[yypt-0].tok}
+                }
+	case 72: {
+			yyVAL.stringA = append(yyS[yypt-2].stringA, yyS[yypt-0].tok)
+                }
+	case 75: {
+			actions.DeclareUnion(yyS[yypt-4].tok, yyS[yypt-4].line, yyS[yypt-2].stringA, false)
+                }
+	case 76: {
+			actions.DeclareUnion(yyS[yypt-5].tok, yyS[yypt-5].line, yyS[yypt-3].stringA, false)
+                }
+	case 77: {
 			actions.DeclareStruct(yyS[yypt-2].tok, yyS[yypt-0].arguments)
-		}
-	case 11:
-		{
-			yyVAL.arguments = nil
-		}
-	case 12:
-		{
-			yyVAL.arguments = yyS[yypt-2].arguments
-		}
-	case 13:
-		{
+                }
+	case 78: {
+			actions.DeclareNamedType(yyS[yypt-2].tok, yyS[yypt-2].line, yyS[yypt-1].argument, false)
+                }
+	case 79: {
+			actions.DeclareNamedType(yyS[yypt-3].tok, yyS[yypt-3].line, yyS[yypt-1].argument, true)
+                }
+	case 80: {
+			actions.DeclareInterface(yyS[yypt-4].tok, nil, false)
+                }
+	case 81: {
+			actions.DeclareInterface(yyS[yypt-5].tok, yyS[yypt-2].InterfaceMethods, false)
+                }
+	case 82: {
+			yyVAL.InterfaceMethods = []actions.InterfaceMethod{yyS[yypt-1].InterfaceMethod}
+                }
+	case 83: {
+			yyVAL.InterfaceMethods = append(yyS[yypt-2].InterfaceMethods, yyS[yypt-1].InterfaceMethod)
+                }
+	case 84: {
+			yyVAL.InterfaceMethod = actions.InterfaceMethod{Name: yyS[yypt-1].tok, Line: lineNo, Inputs: yyS[yypt-0].arguments}
+                }
+	case 85: {
+			yyVAL.InterfaceMethod = actions.InterfaceMethod{Name: yyS[yypt-2].tok, Line: lineNo, Inputs: yyS[yypt-1].arguments, Outputs: yyS[yypt-0].arguments}
+                }
+	case 86: {
+			yyVAL.InterfaceMethod = actions.InterfaceMethod{Name: yyS[yypt-2].tok, Line: lineNo, Inputs: yyS[yypt-1].arguments, Outputs: []*ast.CXArgument{yyS[yypt-0].argument}}
+                }
+	case 87: { yyVAL.arguments = nil }
+	case 88: { yyVAL.arguments = yyS[yypt-2].arguments }
+	case 89: {
 			yyVAL.arguments = []*ast.CXArgument{yyS[yypt-1].argument}
-		}
-	case 14:
-		{
+                }
+	case 90: {
 			yyVAL.arguments = append(yyS[yypt-2].arguments, yyS[yypt-1].argument)
-		}
-	case 15:
-		{
+                }
+	case 91: {
+			yyVAL.arguments = []*ast.CXArgument{actions.EmbeddedField(yyS[yypt-1].tok, "", false)}
+                }
+	case 92: {
+			yyVAL.arguments = []*ast.CXArgument{actions.EmbeddedField(yyS[yypt-1].tok, yyS[yypt-3].tok, true)}
+                }
+	case 93: {
+			yyVAL.arguments = append(yyS[yypt-2].arguments, actions.EmbeddedField(yyS[yypt-1].tok, "", false))
+                }
+	case 94: {
+			yyVAL.arguments = append(yyS[yypt-4].arguments, actions.EmbeddedField(yyS[yypt-1].tok, yyS[yypt-3].tok, true))
+                }
+	case 95: {
 			actions.DeclarePackage(yyS[yypt-1].tok)
-		}
-	case 16:
-		{
+                }
+	case 96: {
 			actions.DeclareImport(yyS[yypt-1].tok, CurrentFileName, lineNo)
-		}
-	case 17:
-		{
+                }
+	case 97: {
+			actions.OpenConstantScope()
 			if pkg, err := Program.GetCurrentPackage(); err == nil {
-				fn := ast.MakeFunction(yyS[yypt-0].tok, CurrentFileName, lineNo)
+				name := yyS[yypt-0].tok
+				if name == constants.PKG_INIT_FUNC {
+					name = actions.NextInitFunctionName(pkg)
+				}
+				fn := ast.MakeFunction(name, CurrentFileName, lineNo)
 				pkg.AddFunction(fn)
 
-				yyVAL.function = fn
+                                yyVAL.function = fn
 			} else {
 				panic(err)
 			}
-		}
-	case 18:
-		{
+                }
+	case 98: {
+			actions.OpenConstantScope()
 			if len(yyS[yypt-2].arguments) > 1 {
 				panic("method has multiple receivers")
 			}
 
-			fnName := yyS[yypt-2].arguments[0].CustomType.Name + "." + yyS[yypt-0].tok
+			fnName := ast.ReceiverTypeName(yyS[yypt-2].arguments[0]) + "." + yyS[yypt-0].tok
 
 			if pkg, err := Program.GetCurrentPackage(); err == nil {
 				fn := ast.MakeFunction(fnName, CurrentFileName, lineNo)
 				pkg.AddFunction(fn)
 
-				fn.AddInput(yyS[yypt-2].arguments[0])
+                                fn.AddInput(yyS[yypt-2].arguments[0])
 
-				yyVAL.function = fn
+                                yyVAL.function = fn
 			} else {
 				panic(err)
 			}
-		}
-	case 19:
-		{
-			yyVAL.arguments = nil
-		}
-	case 20:
-		{
-			yyVAL.arguments = yyS[yypt-1].arguments
-		}
-	case 21:
-		{
+                }
+	case 103: { yyVAL.arguments = nil }
+	case 104: { yyVAL.arguments = yyS[yypt-1].arguments }
+	case 105: {
+			actions.CloseConstantScope()
 			PreFunctionDeclaration(yyS[yypt-2].function, yyS[yypt-1].arguments, nil)
-		}
-	case 22:
-		{
+                }
+	case 106: {
+			actions.CloseConstantScope()
 			PreFunctionDeclaration(yyS[yypt-3].function, yyS[yypt-2].arguments, yyS[yypt-1].arguments)
-		}
-	case 24:
-		{
+                }
+	case 108: {
 			yyVAL.arguments = []*ast.CXArgument{yyS[yypt-0].argument}
-		}
-	case 25:
-		{
+                }
+	case 109: {
 			yyVAL.arguments = append(yyS[yypt-2].arguments, yyS[yypt-0].argument)
-		}
-	case 26:
-		{
+                }
+	case 110: {
 			yyS[yypt-0].argument.ArgDetails.Name = yyS[yypt-1].argument.ArgDetails.Name
 			yyS[yypt-0].argument.ArgDetails.Package = yyS[yypt-1].argument.ArgDetails.Package
 			yyS[yypt-0].argument.IsLocalDeclaration = true
 			yyVAL.argument = yyS[yypt-0].argument
-		}
-	case 30:
-		{
+                }
+	case 111: {
+			yyVAL.argument = actions.VariadicParameterDeclaration(yyS[yypt-2].argument, yyS[yypt-0].argument)
+                }
+	case 115: {
 			if pkg, err := Program.GetCurrentPackage(); err == nil {
 				arg := ast.MakeArgument("", actions.CurrentFile, actions.LineNo)
 				arg.AddType(constants.TypeNames[constants.TYPE_UNDEFINED])
@@ -1719,154 +2440,95 @@ yynewstate:
 			} else {
 				panic(err)
 			}
+                }
+	case 116: { yyVAL.argument = yyS[yypt-1].argument }
+	case 117: {
+			yyVAL.arguments = []*ast.CXArgument{yyS[yypt-0].argument}
 		}
-	case 31:
-		{
-			yyVAL.argument = yyS[yypt-1].argument
-		}
-	case 32:
-		{
-			arg := actions.DeclarationSpecifiersStruct(yyS[yypt-0].tok, "", false, actions.CurrentFile, actions.LineNo)
-			yyVAL.arguments = []*ast.CXArgument{arg}
-		}
-	case 33:
-		{
-			arg := actions.DeclarationSpecifiersBasic(yyS[yypt-0].i)
-			yyVAL.arguments = []*ast.CXArgument{arg}
+	case 118: {
+			yyVAL.arguments = append(yyS[yypt-2].arguments, yyS[yypt-0].argument)
 		}
-	case 34:
-		{
-			arg := actions.DeclarationSpecifiersStruct(yyS[yypt-0].tok, "", false, actions.CurrentFile, actions.LineNo)
-			yyVAL.arguments = append(yyS[yypt-2].arguments, arg)
+	case 119: {
+			yyVAL.arguments = []*ast.CXArgument{actions.DeclarationSpecifiers(yyS[yypt-0].argument, []int{0}, constants.DECL_SLICE)}
 		}
-	case 35:
-		{
-			arg := actions.DeclarationSpecifiersBasic(yyS[yypt-0].i)
-			yyVAL.arguments = append(yyS[yypt-2].arguments, arg)
+	case 120: {
+			yyVAL.arguments = append(yyS[yypt-3].arguments, actions.DeclarationSpecifiers(yyS[yypt-0].argument, []int{0}, constants.DECL_SLICE))
 		}
-	case 36:
-		{
+	case 121: {
 			yyVAL.arguments = yyS[yypt-1].arguments
 		}
-	case 37:
-		{
+	case 122: {
 			yyVAL.arguments = nil
 		}
-	case 38:
-		{
+	case 123: {
 			arg := ast.MakeArgument("", actions.CurrentFile, actions.LineNo).AddType("func")
 			arg.Inputs = yyS[yypt-1].arguments
 			arg.Outputs = yyS[yypt-0].arguments
 			yyVAL.argument = actions.DeclarationSpecifiers(arg, []int{0}, constants.DECL_FUNC)
 		}
-	case 39:
-		{
-			yyVAL.argument = actions.DeclarationSpecifiers(yyS[yypt-0].argument, []int{0}, constants.DECL_POINTER)
+	case 124: {
+			arg := ast.MakeArgument("", actions.CurrentFile, actions.LineNo).AddType("func")
+			arg.Inputs = yyS[yypt-0].arguments
+			yyVAL.argument = actions.DeclarationSpecifiers(arg, []int{0}, constants.DECL_FUNC)
 		}
-	case 40:
-		{
+	case 125: {
+			yyVAL.argument = actions.DeclarationSpecifiers(yyS[yypt-0].argument, []int{0}, constants.DECL_POINTER)
+                }
+	case 126: {
 			yyVAL.argument = actions.DeclarationSpecifiers(yyS[yypt-0].argument, []int{0}, constants.DECL_SLICE)
-		}
-	case 41:
-		{
+                }
+	case 127: {
+			yyVAL.argument = actions.DeclarationSpecifiersMap(yyS[yypt-2].argument, yyS[yypt-0].argument)
+                }
+	case 128: {
 			yyVAL.argument = actions.DeclarationSpecifiersBasic(yyS[yypt-0].i)
-		}
-	case 42:
-		{
+                }
+	case 129: {
 			yyVAL.argument = actions.DeclarationSpecifiersStruct(yyS[yypt-0].tok, "", false, CurrentFileName, lineNo)
-		}
-	case 43:
-		{
+                }
+	case 130: {
 			basic := actions.DeclarationSpecifiersBasic(yyS[yypt-0].i)
 			yyVAL.argument = actions.DeclarationSpecifiers(basic, yyS[yypt-1].ints, constants.DECL_ARRAY)
-		}
-	case 44:
-		{
+                }
+	case 131: {
 			strct := actions.DeclarationSpecifiersStruct(yyS[yypt-0].tok, "", false, actions.CurrentFile, actions.LineNo)
 			yyVAL.argument = actions.DeclarationSpecifiers(strct, yyS[yypt-1].ints, constants.DECL_ARRAY)
-		}
-	case 45:
-		{
+                }
+	case 132: {
 			yyVAL.argument = actions.DeclarationSpecifiersStruct(yyS[yypt-0].tok, yyS[yypt-2].tok, true, CurrentFileName, lineNo)
-		}
-	case 46:
-		{
+                }
+	case 133: {
 			yyVAL.argument = actions.DeclarationSpecifiersStruct(yyS[yypt-0].tok, constants.TypeNames[yyS[yypt-2].i], true, CurrentFileName, lineNo)
 		}
-	case 47:
-		{
-			yyVAL.i = constants.TYPE_AFF
-		}
-	case 48:
-		{
-			yyVAL.i = constants.TYPE_BOOL
-		}
-	case 49:
-		{
-			yyVAL.i = constants.TYPE_STR
-		}
-	case 50:
-		{
-			yyVAL.i = constants.TYPE_F32
-		}
-	case 51:
-		{
-			yyVAL.i = constants.TYPE_F64
-		}
-	case 52:
-		{
-			yyVAL.i = constants.TYPE_I8
-		}
-	case 53:
-		{
-			yyVAL.i = constants.TYPE_I16
-		}
-	case 54:
-		{
-			yyVAL.i = constants.TYPE_I32
-		}
-	case 55:
-		{
-			yyVAL.i = constants.TYPE_I64
-		}
-	case 56:
-		{
-			yyVAL.i = constants.TYPE_UI8
-		}
-	case 57:
-		{
-			yyVAL.i = constants.TYPE_UI16
-		}
-	case 58:
-		{
-			yyVAL.i = constants.TYPE_UI32
-		}
-	case 59:
-		{
-			yyVAL.i = constants.TYPE_UI64
-		}
-	case 66:
-		{
-			yyVAL.ints = []int{int(yyS[yypt-1].i32)}
+	case 134: { yyVAL.i = constants.TYPE_AFF }
+	case 135: { yyVAL.i = constants.TYPE_BOOL }
+	case 136: { yyVAL.i = constants.TYPE_STR }
+	case 137: { yyVAL.i = constants.TYPE_F32 }
+	case 138: { yyVAL.i = constants.TYPE_F64 }
+	case 139: { yyVAL.i = constants.TYPE_I8 }
+	case 140: { yyVAL.i = constants.TYPE_I16 }
+	case 141: { yyVAL.i = constants.TYPE_I32 }
+	case 142: { yyVAL.i = constants.TYPE_I64 }
+	case 143: { yyVAL.i = constants.TYPE_UI8 }
+	case 144: { yyVAL.i = constants.TYPE_UI16 }
+	case 145: { yyVAL.i = constants.TYPE_UI32 }
+	case 146: { yyVAL.i = constants.TYPE_UI64 }
+	case 153: {
+			yyVAL.ints = []int{actions.ConstantLength(yyS[yypt-1].constant)}
 		}
-	case 67:
-		{
-			yyVAL.ints = append(yyS[yypt-3].ints, int(yyS[yypt-1].i32))
+	case 154: {
+			yyVAL.ints = append(yyS[yypt-3].ints, actions.ConstantLength(yyS[yypt-1].constant))
 		}
-	case 68:
-		{
+	case 155: {
 			yyVAL.ints = []int{0}
 		}
-	case 69:
-		{
+	case 156: {
 			yyVAL.ints = append(yyS[yypt-2].ints, 0)
 		}
-	case 90:
-		{
+	case 185: {
 			yyVAL.i32 = yyS[yypt-0].i32
 		}
-	case 91:
-		{
+	case 186: {
 			yyVAL.i32 = -yyS[yypt-0].i32
 		}
 
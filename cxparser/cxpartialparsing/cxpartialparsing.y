@@ -58,6 +58,8 @@
 	stringA []string
 	ints    []int
 
+	constant actions.ConstantValue
+
 	line int
 
 	argument *ast.CXArgument
@@ -66,6 +68,9 @@
 	expression *ast.CXExpression
 	expressions []*ast.CXExpression
 
+	InterfaceMethod actions.InterfaceMethod
+	InterfaceMethods []actions.InterfaceMethod
+
         function *ast.CXFunction
 }
 
@@ -102,7 +107,8 @@
                         I8 I16 I32 I64
                         STR
                         UI8 UI16 UI32 UI64
-                        UNION ENUM CONST CASE DEFAULT SWITCH BREAK CONTINUE
+                        UNION ENUM CONST CASE DEFAULT SWITCH BREAK CONTINUE MAP
+                        INTERFACE DEFER NIL RANGE ELLIPSIS
                         TYPE
                         
                         /* Types */
@@ -122,6 +128,17 @@
 
 %type   <tok>           unary_operator
 %type   <i>             type_specifier
+%type   <constant>      const_primary_expression
+%type   <constant>      const_unary_expression
+%type   <constant>      const_multiplicative_expression
+%type   <constant>      const_additive_expression
+%type   <constant>      const_shift_expression
+%type   <constant>      const_relational_expression
+%type   <constant>      const_and_expression
+%type   <constant>      const_exclusive_or_expression
+%type   <constant>      const_inclusive_or_expression
+%type   <constant>      const_logical_and_expression
+%type   <constant>      const_expression
 %type   <argument>      declaration_specifiers
 %type   <argument>      declarator
 %type   <argument>      direct_declarator
@@ -131,7 +148,10 @@
 %type   <arguments>     parameter_list
 %type   <arguments>     fields
 %type   <arguments>     struct_fields
-%type   <arguments>     id_list
+%type   <stringA>       enum_members
+%type   <InterfaceMethod>       interface_method
+%type   <InterfaceMethods>      interface_methods
+%type   <arguments>     declaration_specifiers_list
 %type   <arguments>     types_list
                                                 
 %type   <function>      function_header
@@ -157,6 +177,11 @@ external_declaration:
         |       function_declaration
         |       import_declaration
         |       struct_declaration
+        |       type_declaration
+        |       enum_declaration
+        |       interface_declaration
+        |       union_declaration
+        |       const_declaration
 
        // |       stepping
         ;
@@ -178,6 +203,259 @@ global_declaration:
                 }
                 ;
 
+const_declaration:
+                CONST const_spec
+        |       CONST LPAREN const_spec_list RPAREN SEMICOLON
+                ;
+
+const_spec_list:
+                const_spec
+        |       const_spec_list const_spec
+                ;
+
+const_spec:     IDENTIFIER ASSIGN const_expression SEMICOLON
+                {
+			actions.DeclareConstant($1, $<line>1, constants.TYPE_UNDEFINED, $3)
+                }
+        |       IDENTIFIER type_specifier ASSIGN const_expression SEMICOLON
+                {
+			actions.DeclareConstant($1, $<line>1, $2, $4)
+                }
+                ;
+
+// constant expressions are folded while parsing
+const_primary_expression:
+                IDENTIFIER
+                {
+			$$ = actions.ConstantIdentifier("", $1)
+                }
+        |       IDENTIFIER PERIOD IDENTIFIER
+                {
+			$$ = actions.ConstantIdentifier($1, $3)
+                }
+        |       STRING_LITERAL
+                {
+			$$ = actions.ConstantStr($1)
+                }
+        |       BOOLEAN_LITERAL
+                {
+			$$ = actions.ConstantBool($1)
+                }
+        |       BYTE_LITERAL
+                {
+			$$ = actions.ConstantInt(constants.TYPE_I8, int64($1))
+                }
+        |       SHORT_LITERAL
+                {
+			$$ = actions.ConstantInt(constants.TYPE_I16, int64($1))
+                }
+        |       INT_LITERAL
+                {
+			$$ = actions.ConstantInt(constants.TYPE_I32, int64($1))
+                }
+        |       LONG_LITERAL
+                {
+			$$ = actions.ConstantInt(constants.TYPE_I64, int64($1))
+                }
+        |       UNSIGNED_BYTE_LITERAL
+                {
+			$$ = actions.ConstantInt(constants.TYPE_UI8, int64($1))
+                }
+        |       UNSIGNED_SHORT_LITERAL
+                {
+			$$ = actions.ConstantInt(constants.TYPE_UI16, int64($1))
+                }
+        |       UNSIGNED_INT_LITERAL
+                {
+			$$ = actions.ConstantInt(constants.TYPE_UI32, int64($1))
+                }
+        |       UNSIGNED_LONG_LITERAL
+                {
+			$$ = actions.ConstantInt(constants.TYPE_UI64, int64($1))
+                }
+        |       FLOAT_LITERAL
+                {
+			$$ = actions.ConstantFloat(constants.TYPE_F32, float64($1))
+                }
+        |       DOUBLE_LITERAL
+                {
+			$$ = actions.ConstantFloat(constants.TYPE_F64, $1)
+                }
+        |       LPAREN const_expression RPAREN
+                {
+			$$ = $2
+                }
+        |       type_specifier LPAREN const_expression RPAREN
+                {
+			$$ = actions.ConstantConversion($1, $3)
+                }
+                ;
+
+const_unary_expression:
+                const_primary_expression
+        |       ADD_OP const_unary_expression
+                {
+			$$ = $2
+                }
+        |       SUB_OP const_unary_expression
+                {
+			$$ = actions.ConstantUnary(constants.OP_NEG, $2)
+                }
+        |       NEG_OP const_unary_expression
+                {
+			$$ = actions.ConstantUnary(constants.OP_BOOL_NOT, $2)
+                }
+                ;
+
+const_multiplicative_expression:
+                const_unary_expression
+        |       const_multiplicative_expression MUL_OP const_unary_expression
+                {
+			$$ = actions.ConstantBinary($1, $3, constants.OP_MUL)
+                }
+        |       const_multiplicative_expression DIV_OP const_unary_expression
+                {
+			$$ = actions.ConstantBinary($1, $3, constants.OP_DIV)
+                }
+        |       const_multiplicative_expression MOD_OP const_unary_expression
+                {
+			$$ = actions.ConstantBinary($1, $3, constants.OP_MOD)
+                }
+                ;
+
+const_additive_expression:
+                const_multiplicative_expression
+        |       const_additive_expression ADD_OP const_multiplicative_expression
+                {
+			$$ = actions.ConstantBinary($1, $3, constants.OP_ADD)
+                }
+        |       const_additive_expression SUB_OP const_multiplicative_expression
+                {
+			$$ = actions.ConstantBinary($1, $3, constants.OP_SUB)
+                }
+                ;
+
+const_shift_expression:
+                const_additive_expression
+        |       const_shift_expression LEFT_OP const_additive_expression
+                {
+			$$ = actions.ConstantBinary($1, $3, constants.OP_BITSHL)
+                }
+        |       const_shift_expression RIGHT_OP const_additive_expression
+                {
+			$$ = actions.ConstantBinary($1, $3, constants.OP_BITSHR)
+                }
+        |       const_shift_expression BITCLEAR_OP const_additive_expression
+                {
+			$$ = actions.ConstantBinary($1, $3, constants.OP_BITCLEAR)
+                }
+                ;
+
+const_relational_expression:
+                const_shift_expression
+        |       const_relational_expression EQ_OP const_shift_expression
+                {
+			$$ = actions.ConstantBinary($1, $3, constants.OP_EQUAL)
+                }
+        |       const_relational_expression NE_OP const_shift_expression
+                {
+			$$ = actions.ConstantBinary($1, $3, constants.OP_UNEQUAL)
+                }
+        |       const_relational_expression LT_OP const_shift_expression
+                {
+			$$ = actions.ConstantBinary($1, $3, constants.OP_LT)
+                }
+        |       const_relational_expression GT_OP const_shift_expression
+                {
+			$$ = actions.ConstantBinary($1, $3, constants.OP_GT)
+                }
+        |       const_relational_expression LTEQ_OP const_shift_expression
+                {
+			$$ = actions.ConstantBinary($1, $3, constants.OP_LTEQ)
+                }
+        |       const_relational_expression GTEQ_OP const_shift_expression
+                {
+			$$ = actions.ConstantBinary($1, $3, constants.OP_GTEQ)
+                }
+                ;
+
+const_and_expression:
+                const_relational_expression
+        |       const_and_expression REF_OP const_relational_expression
+                {
+			$$ = actions.ConstantBinary($1, $3, constants.OP_BITAND)
+                }
+                ;
+
+const_exclusive_or_expression:
+                const_and_expression
+        |       const_exclusive_or_expression BITXOR_OP const_and_expression
+                {
+			$$ = actions.ConstantBinary($1, $3, constants.OP_BITXOR)
+                }
+                ;
+
+const_inclusive_or_expression:
+                const_exclusive_or_expression
+        |       const_inclusive_or_expression BITOR_OP const_exclusive_or_expression
+                {
+			$$ = actions.ConstantBinary($1, $3, constants.OP_BITOR)
+                }
+                ;
+
+const_logical_and_expression:
+                const_inclusive_or_expression
+        |       const_logical_and_expression AND_OP const_inclusive_or_expression
+                {
+			$$ = actions.ConstantBinary($1, $3, constants.OP_BOOL_AND)
+                }
+                ;
+
+const_expression:
+                const_logical_and_expression
+        |       const_expression OR_OP const_logical_and_expression
+                {
+			$$ = actions.ConstantBinary($1, $3, constants.OP_BOOL_OR)
+                }
+                ;
+
+enum_declaration:
+                ENUM IDENTIFIER LBRACE enum_members RBRACE SEMICOLON
+                {
+			actions.DeclareEnum($2, $<line>2, $4)
+                }
+        |       ENUM IDENTIFIER LBRACE enum_members enum_separator RBRACE SEMICOLON
+                {
+			actions.DeclareEnum($2, $<line>2, $4)
+                }
+                ;
+
+enum_members:   IDENTIFIER
+                {
+			$$ = []string{$1}
+                }
+        |       enum_members enum_separator IDENTIFIER
+                {
+			$$ = append($1, $3)
+                }
+                ;
+
+// members are separated by commas or, one per line, by newlines
+enum_separator: COMMA
+        |       SEMICOLON
+                ;
+
+union_declaration:
+                UNION IDENTIFIER LBRACE enum_members RBRACE SEMICOLON
+                {
+			actions.DeclareUnion($2, $<line>2, $4, false)
+                }
+        |       UNION IDENTIFIER LBRACE enum_members enum_separator RBRACE SEMICOLON
+                {
+			actions.DeclareUnion($2, $<line>2, $4, false)
+                }
+                ;
+
 struct_declaration:
                 TYPE IDENTIFIER STRUCT struct_fields
                 {
@@ -185,6 +463,54 @@ struct_declaration:
                 }
                 ;
 
+type_declaration:
+                TYPE IDENTIFIER declaration_specifiers SEMICOLON
+                {
+			actions.DeclareNamedType($2, $<line>2, $3, false)
+                }
+        |       TYPE IDENTIFIER ASSIGN declaration_specifiers SEMICOLON
+                {
+			actions.DeclareNamedType($2, $<line>2, $4, true)
+                }
+                ;
+
+interface_declaration:
+                TYPE IDENTIFIER INTERFACE LBRACE RBRACE SEMICOLON
+                {
+			actions.DeclareInterface($2, nil, false)
+                }
+        |       TYPE IDENTIFIER INTERFACE LBRACE interface_methods RBRACE SEMICOLON
+                {
+			actions.DeclareInterface($2, $5, false)
+                }
+                ;
+
+interface_methods:
+                interface_method SEMICOLON
+                {
+			$$ = []actions.InterfaceMethod{$1}
+                }
+        |       interface_methods interface_method SEMICOLON
+                {
+			$$ = append($1, $2)
+                }
+                ;
+
+interface_method:
+                IDENTIFIER function_parameters
+                {
+			$$ = actions.InterfaceMethod{Name: $1, Line: lineNo, Inputs: $2}
+                }
+        |       IDENTIFIER function_parameters function_parameters
+                {
+			$$ = actions.InterfaceMethod{Name: $1, Line: lineNo, Inputs: $2, Outputs: $3}
+                }
+        |       IDENTIFIER function_parameters declaration_specifiers
+                {
+			$$ = actions.InterfaceMethod{Name: $1, Line: lineNo, Inputs: $2, Outputs: []*ast.CXArgument{$3}}
+                }
+                ;
+
 struct_fields:
                 LBRACE RBRACE SEMICOLON
                 { $$ = nil }
@@ -200,6 +526,22 @@ fields:         parameter_declaration SEMICOLON
                 {
 			$$ = append($1, $2)
                 }
+        |       IDENTIFIER SEMICOLON
+                {
+			$$ = []*ast.CXArgument{actions.EmbeddedField($1, "", false)}
+                }
+        |       IDENTIFIER PERIOD IDENTIFIER SEMICOLON
+                {
+			$$ = []*ast.CXArgument{actions.EmbeddedField($3, $1, true)}
+                }
+        |       fields IDENTIFIER SEMICOLON
+                {
+			$$ = append($1, actions.EmbeddedField($2, "", false))
+                }
+        |       fields IDENTIFIER PERIOD IDENTIFIER SEMICOLON
+                {
+			$$ = append($1, actions.EmbeddedField($4, $2, true))
+                }
         ;
 
 package_declaration:
@@ -219,8 +561,13 @@ import_declaration:
 function_header:
                 FUNC IDENTIFIER
                 {
+			actions.OpenConstantScope()
 			if pkg, err := Program.GetCurrentPackage(); err == nil {
-				fn := ast.MakeFunction($2, CurrentFileName, lineNo)
+				name := $2
+				if name == constants.PKG_INIT_FUNC {
+					name = actions.NextInitFunctionName(pkg)
+				}
+				fn := ast.MakeFunction(name, CurrentFileName, lineNo)
 				pkg.AddFunction(fn)
 
                                 $$ = fn
@@ -230,11 +577,12 @@ function_header:
                 }
         |       FUNC LPAREN parameter_type_list RPAREN IDENTIFIER
                 {
+			actions.OpenConstantScope()
 			if len($3) > 1 {
 				panic("method has multiple receivers")
 			}
 
-			fnName := $3[0].CustomType.Name + "." + $5
+			fnName := ast.ReceiverTypeName($3[0]) + "." + $5
 
 			if pkg, err := Program.GetCurrentPackage(); err == nil {
 				fn := ast.MakeFunction(fnName, CurrentFileName, lineNo)
@@ -249,6 +597,16 @@ function_header:
                 }
         ;
 
+function_literal_header:
+                FUNC function_parameters
+        |       FUNC function_parameters function_parameters
+        ;
+
+function_literal_body:
+                LBRACE RBRACE
+        |       LBRACE block_item_list RBRACE
+        ;
+
 function_parameters:
                 LPAREN RPAREN
                 { $$ = nil }
@@ -259,10 +617,12 @@ function_parameters:
 function_declaration:
                 function_header function_parameters compound_statement
                 {
+			actions.CloseConstantScope()
 			PreFunctionDeclaration($1, $2, nil)
                 }
         |       function_header function_parameters function_parameters compound_statement
                 {
+			actions.CloseConstantScope()
 			PreFunctionDeclaration($1, $2, $3)
                 }
         ;
@@ -291,6 +651,10 @@ parameter_declaration:
 			$2.IsLocalDeclaration = true
 			$$ = $2
                 }
+	|       declarator ELLIPSIS declaration_specifiers
+                {
+			$$ = actions.VariadicParameterDeclaration($1, $3)
+                }
                 ;
 
 identifier_list:
@@ -318,30 +682,27 @@ direct_declarator:
                 { $$ = $2 }
                 ;
 
-id_list:	IDENTIFIER
+declaration_specifiers_list:
+		declaration_specifiers
 		{
-			arg := actions.DeclarationSpecifiersStruct($1, "", false, actions.CurrentFile, actions.LineNo)
-			$$ = []*ast.CXArgument{arg}
+			$$ = []*ast.CXArgument{$1}
 		}
-	|	type_specifier
+	|	declaration_specifiers_list COMMA declaration_specifiers
 		{
-			arg := actions.DeclarationSpecifiersBasic($1)			 
-			$$ = []*ast.CXArgument{arg}
+			$$ = append($1, $3)
 		}
-	|	id_list COMMA IDENTIFIER
+	|	ELLIPSIS declaration_specifiers
 		{
-			arg := actions.DeclarationSpecifiersStruct($3, "", false, actions.CurrentFile, actions.LineNo)
-			$$ = append($1, arg)
+			$$ = []*ast.CXArgument{actions.DeclarationSpecifiers($2, []int{0}, constants.DECL_SLICE)}
 		}
-	|	id_list COMMA type_specifier
+	|	declaration_specifiers_list COMMA ELLIPSIS declaration_specifiers
 		{
-			arg := actions.DeclarationSpecifiersBasic($3)
-			$$ = append($1, arg)
+			$$ = append($1, actions.DeclarationSpecifiers($4, []int{0}, constants.DECL_SLICE))
 		}
 	;
 
 types_list:
-		LPAREN id_list RPAREN
+		LPAREN declaration_specifiers_list RPAREN
 		{
 			$$ = $2
 		}
@@ -359,6 +720,12 @@ declaration_specifiers:
 			arg.Outputs = $3
 			$$ = actions.DeclarationSpecifiers(arg, []int{0}, constants.DECL_FUNC)
 		}
+	|	FUNC types_list
+		{
+			arg := ast.MakeArgument("", actions.CurrentFile, actions.LineNo).AddType("func")
+			arg.Inputs = $2
+			$$ = actions.DeclarationSpecifiers(arg, []int{0}, constants.DECL_FUNC)
+		}
         |       MUL_OP declaration_specifiers
                 {
 			$$ = actions.DeclarationSpecifiers($2, []int{0}, constants.DECL_POINTER)
@@ -371,6 +738,10 @@ declaration_specifiers:
                 {
 			$$ = actions.DeclarationSpecifiers($3, []int{0}, constants.DECL_SLICE)
                 }
+        |       MAP LBRACK declaration_specifiers RBRACK declaration_specifiers
+                {
+			$$ = actions.DeclarationSpecifiersMap($3, $5)
+                }
         |       type_specifier
                 {
 			$$ = actions.DeclarationSpecifiersBasic($1)
@@ -456,13 +827,13 @@ array_literal_expression_list:
                 ;
 
 indexing_literal:
-		LBRACK INT_LITERAL RBRACK
+		LBRACK const_expression RBRACK
 		{
-			$$ = []int{int($2)}
+			$$ = []int{actions.ConstantLength($2)}
 		}
-        |       indexing_literal LBRACK INT_LITERAL RBRACK
+        |       indexing_literal LBRACK const_expression RBRACK
 		{
-			$$ = append($1, int($3))
+			$$ = append($1, actions.ConstantLength($3))
 		}
 		;
 
@@ -493,6 +864,20 @@ slice_literal_expression:
         |       LBRACK RBRACK slice_literal_expression
                 ;
 
+map_literal_entries:
+                assignment_expression COLON assignment_expression
+        |       map_literal_entries COMMA assignment_expression COLON assignment_expression
+                ;
+
+map_literal_expression:
+                MAP LBRACK declaration_specifiers RBRACK type_specifier LBRACE map_literal_entries RBRACE
+        |       MAP LBRACK declaration_specifiers RBRACK type_specifier LBRACE map_literal_entries COMMA RBRACE
+        |       MAP LBRACK declaration_specifiers RBRACK type_specifier LBRACE RBRACE
+        |       MAP LBRACK declaration_specifiers RBRACK IDENTIFIER LBRACE map_literal_entries RBRACE
+        |       MAP LBRACK declaration_specifiers RBRACK IDENTIFIER LBRACE map_literal_entries COMMA RBRACE
+        |       MAP LBRACK declaration_specifiers RBRACK IDENTIFIER LBRACE RBRACE
+                ;
+
 
 
 /* infer_action_arg: */
@@ -545,9 +930,11 @@ int_value:
 primary_expression:
                 IDENTIFIER
         /* |       IDENTIFIER LBRACE struct_literal_fields RBRACE */
+        |       function_literal_header function_literal_body
         |       INFER LBRACE infer_clauses RBRACE
         |       STRING_LITERAL
         |       BOOLEAN_LITERAL
+        |       NIL
         |       BYTE_LITERAL
         |       SHORT_LITERAL
         |       INT_LITERAL
@@ -561,6 +948,7 @@ primary_expression:
         |       LPAREN expression RPAREN
         |       array_literal_expression
         |       slice_literal_expression
+        |       map_literal_expression
                 ;
 
 after_period:   type_specifier
@@ -570,12 +958,21 @@ after_period:   type_specifier
 postfix_expression:
                 primary_expression
 	|       postfix_expression LBRACK expression RBRACK
+	|       postfix_expression LBRACK COLON RBRACK
+	|       postfix_expression LBRACK expression COLON RBRACK
+	|       postfix_expression LBRACK COLON expression RBRACK
+	|       postfix_expression LBRACK expression COLON expression RBRACK
+	|       postfix_expression LBRACK COLON expression COLON expression RBRACK
+	|       postfix_expression LBRACK expression COLON expression COLON expression RBRACK
         |       type_specifier PERIOD after_period
+        |       type_specifier LPAREN assignment_expression RPAREN
 	|       postfix_expression LPAREN RPAREN
 	|       postfix_expression LPAREN argument_expression_list RPAREN
+	|       postfix_expression LPAREN argument_expression_list ELLIPSIS RPAREN
+	|       postfix_expression PERIOD LPAREN declaration_specifiers RPAREN
 	|       postfix_expression INC_OP
         |       postfix_expression DEC_OP
-        |       postfix_expression PERIOD IDENTIFIER
+        |       postfix_expression PERIOD after_period
         /* |       postfix_expression PERIOD IDENTIFIER LBRACE struct_literal_fields RBRACE */
                 ;
 
@@ -697,6 +1094,7 @@ constant_expression:
 declaration:
                 VAR declarator declaration_specifiers SEMICOLON
         |       VAR declarator declaration_specifiers ASSIGN initializer SEMICOLON
+        |       const_declaration
                 ;
 
 initializer:
@@ -710,12 +1108,11 @@ statement:      labeled_statement
 	|       selection_statement
 	|       iteration_statement
         |       jump_statement
+        |       defer_statement
                 ;
 
 labeled_statement:
                 IDENTIFIER COLON block_item
-	|       CASE constant_expression COLON statement
-	|       DEFAULT COLON statement
                 ;
 
 compound_statement:
@@ -745,7 +1142,43 @@ selection_statement:
         |       IF conditional_expression LBRACE RBRACE elseif_list SEMICOLON
         |       IF conditional_expression LBRACE RBRACE elseif_list else_statement SEMICOLON
         |       IF conditional_expression compound_statement
-	|       SWITCH LPAREN conditional_expression RPAREN statement
+	|       SWITCH conditional_expression LBRACE switch_cases RBRACE SEMICOLON
+	|       SWITCH LBRACE switch_cases RBRACE SEMICOLON
+	|       SWITCH IDENTIFIER CASSIGN postfix_expression PERIOD LPAREN TYPE RPAREN LBRACE type_switch_cases RBRACE SEMICOLON
+	|       SWITCH postfix_expression PERIOD LPAREN TYPE RPAREN LBRACE type_switch_cases RBRACE SEMICOLON
+                ;
+
+switch_cases:
+                /* empty */
+	|       switch_cases switch_case
+                ;
+
+switch_case:    CASE switch_case_values COLON block_item_list
+	|       CASE switch_case_values COLON
+	|       DEFAULT COLON block_item_list
+	|       DEFAULT COLON
+                ;
+
+switch_case_values:
+                conditional_expression
+	|       switch_case_values COMMA conditional_expression
+                ;
+
+type_switch_cases:
+                /* empty */
+	|       type_switch_cases type_switch_case
+                ;
+
+type_switch_case:
+                CASE type_switch_types COLON block_item_list
+	|       CASE type_switch_types COLON
+	|       DEFAULT COLON block_item_list
+	|       DEFAULT COLON
+                ;
+
+type_switch_types:
+                declaration_specifiers
+	|       type_switch_types COMMA declaration_specifiers
                 ;
 
 elseif:         ELSE IF conditional_expression LBRACE block_item_list RBRACE
@@ -768,13 +1201,22 @@ iteration_statement:
         |       FOR expression_statement expression_statement expression compound_statement
         |       FOR declaration expression_statement compound_statement
         |       FOR declaration expression_statement expression compound_statement
+        |       FOR RANGE conditional_expression compound_statement
+        |       FOR unary_expression assignment_operator RANGE conditional_expression compound_statement
+        |       FOR expression COMMA unary_expression assignment_operator RANGE conditional_expression compound_statement
                 ;
 
 jump_statement: GOTO IDENTIFIER SEMICOLON
 	|       CONTINUE SEMICOLON
+	|       CONTINUE IDENTIFIER SEMICOLON
 	|       BREAK SEMICOLON
+	|       BREAK IDENTIFIER SEMICOLON
 	|       RETURN SEMICOLON
 	|       RETURN expression SEMICOLON
                 ;
 
+defer_statement:
+                DEFER postfix_expression SEMICOLON
+                ;
+
 %%
@@ -3,6 +3,8 @@ package partialparsing
 import (
 	"fmt"
 	"io"
+
+	"github.com/skycoin/cx/cxparser/actions"
 )
 
 var CurrentFileName string
@@ -25,6 +27,8 @@ func (yylex *Lexer) Lex(lval *yySymType) int {
 	yylex.next()
 	lval.scancopy(yylex.tok)
 	lineNo = lval.line
+	actions.LineNo = lval.line
+	actions.LexBlockScope(lval.yys == LBRACE, lval.yys == RBRACE)
 	return lval.yys
 }
 
@@ -40,6 +44,7 @@ func (lval *yySymType) scancopy(tok *yySymType) {
 	lval.argument = tok.argument
 	lval.arguments = tok.arguments
 	lval.bool = tok.bool
+	lval.constant = tok.constant
 	lval.expression = tok.expression
 	lval.expressions = tok.expressions
 	lval.f32 = tok.f32
@@ -5,6 +5,7 @@ import (
 	"io"
 	"os"
 	"strconv"
+	"strings"
 	"unicode"
 	"unicode/utf8"
 
@@ -249,6 +250,14 @@ redonext:
 		if isDecimal(s.ch) {
 			s.number(true)
 			break
+		} else if s.ch == '.' {
+			s.nextch()
+			if s.ch != '.' {
+				s.errorf("unexpected '..'")
+			}
+			s.nextch()
+			s.tok.yys = ELLIPSIS
+			break
 		}
 		s.tok.yys = PERIOD
 	case '=':
@@ -444,7 +453,7 @@ func (s *Lexer) ident() {
 
 	// possibly a keyword
 	lit := s.segment()
-	s.tok = &yySymType{}
+	s.tok = &yySymType{line: s.tok.line}
 	if len(lit) >= 2 {
 		if tok := KeywordMap[string(lit)]; tok != 0 {
 			switch tok {
@@ -453,7 +462,7 @@ func (s *Lexer) ident() {
 				I8, I16, I32, I64,
 				UI8, UI16, UI32, UI64,
 				F32, F64, AFF,
-				RETURN, BREAK, CONTINUE, BOOLEAN_LITERAL:
+				RETURN, BREAK, CONTINUE, BOOLEAN_LITERAL, NIL:
 				s.nlsemi = true
 				s.tok.tok = getTypeName(tok)
 			}
@@ -657,7 +666,7 @@ func (s *Lexer) number(seenPoint bool) {
 		ok = false
 	}
 
-	s.tok = &yySymType{}
+	s.tok = &yySymType{line: s.tok.line}
 	switch kind {
 	case INT_LITERAL:
 		result, err := strconv.ParseInt(yylex, base, 32)
@@ -756,9 +765,25 @@ func (s *Lexer) fullComment() {
 
 func (s *Lexer) lineComment() {
 	// don't consume '\n' - needed for nlsemi logic
+	s.start()
 	for s.ch >= 0 && s.ch != '\n' {
 		s.nextch()
 	}
+	s.lineDirective(string(s.segment()))
+	s.stop()
+}
+
+// lineDirective handles `//line N` comments, which make N the number of the
+// next line. They precede the instances of generics, so the errors found in
+// them report the lines of the generic declarations.
+func (s *Lexer) lineDirective(comment string) {
+	if !strings.HasPrefix(comment, "line ") {
+		return
+	}
+	if line, err := strconv.Atoi(strings.TrimSpace(comment[len("line "):])); err == nil && line > 0 {
+		// the line is incremented by the newline ending the comment
+		s.l = line - 2
+	}
 }
 
 func (s *Lexer) rawString() {
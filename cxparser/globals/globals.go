@@ -2,8 +2,8 @@ package globals
 
 import "github.com/skycoin/cx/cx/ast"
 
-//What does this do?
-//This is where intializers get pushed, but only used 4 times
+// SysInitExprs are the expressions initializing the globals of each package,
+// by package name, in declaration order.
 //TODO: Get rid of this
 //TODO: Move list of inits to do, to AST, not here
-var SysInitExprs []*ast.CXExpression
\ No newline at end of file
+var SysInitExprs = map[string][]*ast.CXExpression{}
\ No newline at end of file